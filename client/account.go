@@ -0,0 +1,26 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// Account returns the local peer's account info as a contact
+func (c *Client) Account() (*pb.Contact, error) {
+	var contact pb.Contact
+	if err := c.doPb(http.MethodGet, "account", params{}, &contact); err != nil {
+		return nil, err
+	}
+	return &contact, nil
+}
+
+// AccountSeed returns the local peer's account seed
+func (c *Client) AccountSeed() (string, error) {
+	return c.doString(http.MethodGet, "account/seed", params{})
+}
+
+// AccountAddress returns the local peer's account address
+func (c *Client) AccountAddress() (string, error) {
+	return c.doString(http.MethodGet, "account/address", params{})
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// CafeClients lists clients registered with the local cafe, ordered by last seen
+func (c *Client) CafeClients() (*pb.CafeClientList, error) {
+	var list pb.CafeClientList
+	if err := c.doPb(http.MethodGet, "admin/clients", params{}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// EvictCafeClient evicts a registered client, removing its stored threads, messages, and pins
+func (c *Client) EvictCafeClient(id string) error {
+	return c.do(http.MethodDelete, "admin/clients/"+id, params{})
+}
+
+// CafeClientsUsage returns the usage of all registered clients
+func (c *Client) CafeClientsUsage() (*pb.CafeClientUsageList, error) {
+	var list pb.CafeClientUsageList
+	if err := c.doPb(http.MethodGet, "admin/usage", params{}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// CafeClientUsage returns the usage of a registered client
+func (c *Client) CafeClientUsage(id string) (*pb.CafeClientUsage, error) {
+	var usage pb.CafeClientUsage
+	if err := c.doPb(http.MethodGet, "admin/usage/"+id, params{}, &usage); err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// CafeReplicationStatus returns the status of client record replication to the neighbor cafes
+func (c *Client) CafeReplicationStatus() (*pb.CafeReplicationStatus, error) {
+	var status pb.CafeReplicationStatus
+	if err := c.doPb(http.MethodGet, "admin/replication", params{}, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
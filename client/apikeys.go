@@ -0,0 +1,47 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// ApiKeyConfig is used to create an API key
+type ApiKeyConfig struct {
+	Name    string
+	Scopes  []string  // read, write, admin, seed
+	Expires time.Time // zero never expires
+}
+
+// CreateApiKey creates a local API key, returning it with its token, which is only shown once
+func (c *Client) CreateApiKey(conf ApiKeyConfig) (*pb.ApiKey, error) {
+	opts := map[string]string{
+		"name":   conf.Name,
+		"scopes": strings.Join(conf.Scopes, ","),
+	}
+	if !conf.Expires.IsZero() {
+		opts["expires"] = conf.Expires.Format(time.RFC3339)
+	}
+
+	var key pb.ApiKey
+	if err := c.doPb(http.MethodPost, "api-keys", params{opts: opts}, &key); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// ApiKeys lists local API keys
+func (c *Client) ApiKeys() (*pb.ApiKeyList, error) {
+	var list pb.ApiKeyList
+	if err := c.doPb(http.MethodGet, "api-keys", params{}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// RemoveApiKey revokes an API key
+func (c *Client) RemoveApiKey(id string) error {
+	return c.do(http.MethodDelete, "api-keys/"+id, params{})
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// BatchOp is a single operation in a batch, one of mill, files, message, comment, or like.
+// Values prefixed with '$' reference the result of an earlier operation by its id.
+type BatchOp struct {
+	Id      string            `json:"id,omitempty"`
+	Type    string            `json:"type"`
+	Thread  string            `json:"thread,omitempty"`  // files, message
+	Block   string            `json:"block,omitempty"`   // comment, like
+	Body    string            `json:"body,omitempty"`    // message, comment
+	Caption string            `json:"caption,omitempty"` // files
+	Mill    string            `json:"mill,omitempty"`    // mill, e.g., /blob
+	Opts    map[string]string `json:"opts,omitempty"`    // mill
+	Data    []byte            `json:"data,omitempty"`    // mill
+	Name    string            `json:"name,omitempty"`    // mill
+	Use     string            `json:"use,omitempty"`     // mill, instead of data
+	Files   []*BatchFile      `json:"files,omitempty"`   // files
+}
+
+// BatchFile is a file added by a files operation. Data is milled with the thread schema.
+// Otherwise, Use or Dir reference earlier mill operations.
+type BatchFile struct {
+	Data []byte            `json:"data,omitempty"`
+	Name string            `json:"name,omitempty"`
+	Use  string            `json:"use,omitempty"`
+	Dir  map[string]string `json:"dir,omitempty"`
+}
+
+// BatchResult is the outcome of a single operation. Data is the JSON rendered
+// result, e.g., a file index for mill operations.
+type BatchResult struct {
+	Id    string          `json:"id,omitempty"`
+	Type  string          `json:"type"`
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// BatchResponse lists operation results in request order
+type BatchResponse struct {
+	Results []*BatchResult `json:"results"`
+	Failed  int            `json:"failed"`
+}
+
+// Batch runs operations in order in a single request. Failed operations are reported
+// in their results, and only stop the batch if stopOnError is set.
+func (c *Client) Batch(ops []*BatchOp, stopOnError bool) (*BatchResponse, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"ops":           ops,
+		"stop_on_error": stopOnError,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res BatchResponse
+	if err := c.doJson(http.MethodPost, "batch", params{
+		payload: bytes.NewReader(body),
+		ctype:   "application/json",
+	}, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
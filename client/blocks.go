@@ -0,0 +1,48 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// Blocks paginates blocks in a thread, which can also be 'default'
+func (c *Client) Blocks(thread string, offset string, limit int) (*pb.BlockList, error) {
+	var list pb.BlockList
+	if err := c.doPb(http.MethodGet, "blocks", params{
+		opts: listOpts(thread, offset, limit),
+	}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// BlockDots paginates blocks in a thread as GraphViz dots
+func (c *Client) BlockDots(thread string, offset string, limit int) (*pb.BlockViz, error) {
+	opts := listOpts(thread, offset, limit)
+	opts["dots"] = "true"
+
+	var viz pb.BlockViz
+	if err := c.doPb(http.MethodGet, "blocks", params{opts: opts}, &viz); err != nil {
+		return nil, err
+	}
+	return &viz, nil
+}
+
+// Block returns a thread block
+func (c *Client) Block(id string) (*pb.Block, error) {
+	var block pb.Block
+	if err := c.doPb(http.MethodGet, "blocks/"+id, params{}, &block); err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// IgnoreBlock ignores a thread block, returning the new ignore block
+func (c *Client) IgnoreBlock(id string) (*pb.Block, error) {
+	var block pb.Block
+	if err := c.doPb(http.MethodDelete, "blocks/"+id, params{}, &block); err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// AddCafe registers with a cafe using an access token obtained from the cafe
+func (c *Client) AddCafe(host string, token string) (*pb.CafeSession, error) {
+	var session pb.CafeSession
+	if err := c.doPb(http.MethodPost, "cafes", params{
+		args: []string{host},
+		opts: map[string]string{"token": token},
+	}, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// CafeSessions lists active cafe sessions, ordered by priority
+func (c *Client) CafeSessions() (*pb.CafeSessionList, error) {
+	var list pb.CafeSessionList
+	if err := c.doPb(http.MethodGet, "cafes", params{}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// CafeSession returns a cafe session
+func (c *Client) CafeSession(id string) (*pb.CafeSession, error) {
+	var session pb.CafeSession
+	if err := c.doPb(http.MethodGet, "cafes/"+id, params{}, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// UpdateCafePriority updates a cafe session's routing priority
+func (c *Client) UpdateCafePriority(id string, priority int) (*pb.CafeSession, error) {
+	var session pb.CafeSession
+	if err := c.doPb(http.MethodPut, "cafes/"+id, params{
+		opts: map[string]string{"priority": strconv.Itoa(priority)},
+	}, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// RemoveCafe deregisters a cafe
+func (c *Client) RemoveCafe(id string) error {
+	return c.do(http.MethodDelete, "cafes/"+id, params{})
+}
+
+// CheckCafeMessages checks for messages at all cafes
+func (c *Client) CheckCafeMessages() error {
+	return c.do(http.MethodPost, "cafes/messages", params{})
+}
+
+// CafeRequests lists queued cafe requests, or failed requests if failed is true.
+// Use a limit of -1 to list all requests.
+func (c *Client) CafeRequests(failed bool, offset string, limit int) (*pb.CafeRequestList, error) {
+	opts := map[string]string{
+		"failed": strconv.FormatBool(failed),
+		"offset": offset,
+		"limit":  strconv.Itoa(limit),
+	}
+
+	var list pb.CafeRequestList
+	if err := c.doPb(http.MethodGet, "requests", params{opts: opts}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// RetryCafeRequests queues a failed cafe request to be handled again, returning how many
+// were retried. Use an empty id to retry all failed requests.
+func (c *Client) RetryCafeRequests(id string) (int, error) {
+	return c.doCount(http.MethodPost, "requests/retry", id)
+}
+
+// PurgeCafeRequests deletes a failed cafe request, returning how many were deleted.
+// Use an empty id to delete all failed requests.
+func (c *Client) PurgeCafeRequests(id string) (int, error) {
+	return c.doCount(http.MethodDelete, "requests", id)
+}
+
+// doCount sends a request for an optional id whose response is a count
+func (c *Client) doCount(meth string, pth string, id string) (int, error) {
+	var pars params
+	if id != "" {
+		pars.args = []string{id}
+	}
+	res, err := c.doString(meth, pth, pars)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(res)
+}
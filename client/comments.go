@@ -0,0 +1,36 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// AddComment adds a comment to a thread block
+func (c *Client) AddComment(block string, body string) (*pb.Comment, error) {
+	var comment pb.Comment
+	if err := c.doPb(http.MethodPost, "blocks/"+block+"/comments", params{
+		args: []string{body},
+	}, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// Comments lists comments on a thread block
+func (c *Client) Comments(block string) (*pb.CommentList, error) {
+	var list pb.CommentList
+	if err := c.doPb(http.MethodGet, "blocks/"+block+"/comments", params{}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// Comment returns a thread comment by its block id
+func (c *Client) Comment(id string) (*pb.Comment, error) {
+	var comment pb.Comment
+	if err := c.doPb(http.MethodGet, "blocks/"+id+"/comment", params{}, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
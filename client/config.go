@@ -0,0 +1,68 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/textileio/go-textile/repo/config"
+)
+
+// Config returns the active config
+func (c *Client) Config() (*config.Config, error) {
+	var conf config.Config
+	if err := c.doJson(http.MethodGet, "config", params{}, &conf); err != nil {
+		return nil, err
+	}
+	return &conf, nil
+}
+
+// ConfigValue returns the active value of a config key (e.g., Addresses.API or Addresses/API)
+func (c *Client) ConfigValue(key string) (json.RawMessage, error) {
+	var value json.RawMessage
+	if err := c.doJson(http.MethodGet, "config/"+configPath(key), params{}, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// SetConfig replaces the config. Restart the daemon for changes to take effect.
+func (c *Client) SetConfig(conf *config.Config) error {
+	body, err := json.Marshal(conf)
+	if err != nil {
+		return err
+	}
+	return c.do(http.MethodPut, "config", params{
+		payload: bytes.NewReader(body),
+		ctype:   "application/json",
+	})
+}
+
+// SetConfigValue replaces the value of a config key with a JSON value.
+// Restart the daemon for changes to take effect.
+func (c *Client) SetConfigValue(key string, value json.RawMessage) error {
+	patch, err := json.Marshal([]map[string]interface{}{{
+		"op":    "replace",
+		"path":  "/" + configPath(key),
+		"value": value,
+	}})
+	if err != nil {
+		return err
+	}
+	return c.PatchConfig(patch)
+}
+
+// PatchConfig applies an RFC6902 JSON patch (array of ops) to the config.
+// Restart the daemon for changes to take effect.
+func (c *Client) PatchConfig(patch json.RawMessage) error {
+	return c.do(http.MethodPatch, "config", params{
+		payload: bytes.NewReader(patch),
+		ctype:   "application/json",
+	})
+}
+
+// configPath returns a config key as a path
+func configPath(key string) string {
+	return strings.Trim(strings.Replace(key, ".", "/", -1), "/")
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// AddContact adds or updates a contact, usually from a search result
+func (c *Client) AddContact(contact *pb.Contact) error {
+	pars, err := pbBody(contact)
+	if err != nil {
+		return err
+	}
+	return c.do(http.MethodPut, "contacts/"+contact.Address, pars)
+}
+
+// Contacts lists known contacts
+func (c *Client) Contacts() (*pb.ContactList, error) {
+	var list pb.ContactList
+	if err := c.doPb(http.MethodGet, "contacts", params{}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// Contact returns a known contact
+func (c *Client) Contact(address string) (*pb.Contact, error) {
+	var contact pb.Contact
+	if err := c.doPb(http.MethodGet, "contacts/"+address, params{}, &contact); err != nil {
+		return nil, err
+	}
+	return &contact, nil
+}
+
+// RemoveContact removes a known contact
+func (c *Client) RemoveContact(address string) error {
+	return c.do(http.MethodDelete, "contacts/"+address, params{})
+}
+
+// SearchContacts searches locally and on the network for contacts.
+// Results are streamed until the search completes or cancel is called.
+func (c *Client) SearchContacts(query *pb.ContactQuery, options *pb.QueryOptions) (<-chan *pb.QueryResult, <-chan error, func(), error) {
+	if query == nil {
+		query = &pb.ContactQuery{}
+	}
+	if options == nil {
+		options = &pb.QueryOptions{}
+	}
+	return c.search("contacts/search", map[string]string{
+		"name":      query.Name,
+		"address":   query.Address,
+		"local":     strconv.FormatBool(options.LocalOnly),
+		"remote":    strconv.FormatBool(options.RemoteOnly),
+		"limit":     strconv.Itoa(int(options.Limit)),
+		"wait":      strconv.Itoa(int(options.Wait)),
+		"federated": strconv.FormatBool(options.Federated),
+	})
+}
@@ -0,0 +1,21 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// Feed paginates post and annotation blocks as a consumable feed.
+// Omit the request thread to paginate all threads.
+func (c *Client) Feed(req *pb.FeedRequest) (*pb.FeedItemList, error) {
+	opts := listOpts(req.Thread, req.Offset, int(req.Limit))
+	opts["mode"] = strings.ToLower(req.Mode.String())
+
+	var list pb.FeedItemList
+	if err := c.doPb(http.MethodGet, "feed", params{opts: opts}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// AddFiles adds milled file directories to a thread, which can also be 'default'
+func (c *Client) AddFiles(thread string, dirs *pb.DirectoryList, caption string) (*pb.Files, error) {
+	pars, err := pbBody(dirs)
+	if err != nil {
+		return nil, err
+	}
+	pars.opts = map[string]string{"caption": caption}
+
+	var files pb.Files
+	if err := c.doPb(http.MethodPost, "threads/"+thread+"/files", pars, &files); err != nil {
+		return nil, err
+	}
+	return &files, nil
+}
+
+// Files paginates thread files. Omit thread to paginate all files.
+func (c *Client) Files(thread string, offset string, limit int) (*pb.FilesList, error) {
+	var list pb.FilesList
+	if err := c.doPb(http.MethodGet, "files", params{
+		opts: listOpts(thread, offset, limit),
+	}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// File returns thread files by their block id
+func (c *Client) File(id string) (*pb.Files, error) {
+	var files pb.Files
+	if err := c.doPb(http.MethodGet, "files/"+id, params{}, &files); err != nil {
+		return nil, err
+	}
+	return &files, nil
+}
+
+// FileKeys returns the encryption keys of files under a target from an add
+func (c *Client) FileKeys(target string) (*pb.Keys, error) {
+	var keys pb.Keys
+	if err := c.doPb(http.MethodGet, "keys/"+target, params{}, &keys); err != nil {
+		return nil, err
+	}
+	return &keys, nil
+}
+
+// FileData returns the decrypted data of a file by its hash.
+// Callers must close the returned reader.
+func (c *Client) FileData(hash string) (io.ReadCloser, error) {
+	return c.doData(http.MethodGet, "file/"+hash+"/data", params{})
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// AddInvite invites a contact to a thread, which can also be 'default'
+func (c *Client) AddInvite(thread string, address string) error {
+	return c.do(http.MethodPost, "invites", params{
+		opts: map[string]string{
+			"thread":  thread,
+			"address": address,
+		},
+	})
+}
+
+// AddExternalInvite creates an external invite to a thread, which can be accepted with its key
+func (c *Client) AddExternalInvite(thread string) (*pb.ExternalInvite, error) {
+	var invite pb.ExternalInvite
+	if err := c.doPb(http.MethodPost, "invites", params{
+		opts: map[string]string{"thread": thread},
+	}, &invite); err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+// Invites lists pending thread invites
+func (c *Client) Invites() (*pb.InviteViewList, error) {
+	var list pb.InviteViewList
+	if err := c.doPb(http.MethodGet, "invites", params{}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// AcceptInvite accepts a direct invite, returning the thread join block
+func (c *Client) AcceptInvite(id string) (*pb.Block, error) {
+	return c.AcceptExternalInvite(id, "")
+}
+
+// AcceptExternalInvite accepts an external invite with its key, returning the thread join block
+func (c *Client) AcceptExternalInvite(id string, key string) (*pb.Block, error) {
+	var block pb.Block
+	if err := c.doPb(http.MethodPost, "invites/"+id+"/accept", params{
+		opts: map[string]string{"key": key},
+	}, &block); err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// IgnoreInvite ignores a direct invite
+func (c *Client) IgnoreInvite(id string) error {
+	return c.do(http.MethodPost, "invites/"+id+"/ignore", params{})
+}
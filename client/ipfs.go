@@ -0,0 +1,49 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/textileio/go-textile/ipfs"
+)
+
+// IpfsPeerId returns the local IPFS peer id
+func (c *Client) IpfsPeerId() (string, error) {
+	return c.doString(http.MethodGet, "ipfs/id", params{})
+}
+
+// IpfsSwarmConnect opens a direct connection to a peer multiaddress
+func (c *Client) IpfsSwarmConnect(addr string) ([]string, error) {
+	var res []string
+	if err := c.doJson(http.MethodPost, "ipfs/swarm/connect", params{
+		args: []string{addr},
+	}, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IpfsSwarmPeers lists the peers the local node is connected to
+func (c *Client) IpfsSwarmPeers(verbose bool, latency bool, streams bool, direction bool) (*ipfs.ConnInfos, error) {
+	var infos ipfs.ConnInfos
+	if err := c.doJson(http.MethodGet, "ipfs/swarm/peers", params{
+		opts: map[string]string{
+			"verbose":   strconv.FormatBool(verbose),
+			"latency":   strconv.FormatBool(latency),
+			"streams":   strconv.FormatBool(streams),
+			"direction": strconv.FormatBool(direction),
+		},
+	}, &infos); err != nil {
+		return nil, err
+	}
+	return &infos, nil
+}
+
+// IpfsCat returns the data at an IPFS path, decrypted with key if it is non-empty.
+// Callers must close the returned reader.
+func (c *Client) IpfsCat(pth string, key string) (io.ReadCloser, error) {
+	return c.doData(http.MethodGet, "ipfs/cat/"+pth, params{
+		opts: map[string]string{"key": key},
+	})
+}
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// AddLike adds a like to a thread block
+func (c *Client) AddLike(block string) (*pb.Like, error) {
+	var like pb.Like
+	if err := c.doPb(http.MethodPost, "blocks/"+block+"/likes", params{}, &like); err != nil {
+		return nil, err
+	}
+	return &like, nil
+}
+
+// Likes lists likes on a thread block
+func (c *Client) Likes(block string) (*pb.LikeList, error) {
+	var list pb.LikeList
+	if err := c.doPb(http.MethodGet, "blocks/"+block+"/likes", params{}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// Like returns a thread like by its block id
+func (c *Client) Like(id string) (*pb.Like, error) {
+	var like pb.Like
+	if err := c.doPb(http.MethodGet, "blocks/"+id+"/like", params{}, &like); err != nil {
+		return nil, err
+	}
+	return &like, nil
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// Logs returns the log level of a subsystem, or all subsystems if empty.
+// Use texOnly to only include Textile subsystems.
+func (c *Client) Logs(subsystem string, texOnly bool) (map[string]string, error) {
+	return c.logs(http.MethodGet, subsystem, "", texOnly)
+}
+
+// SetLogLevel sets the log level (one of debug, info, warning, error, critical) of a subsystem,
+// or all subsystems if empty, returning the new levels
+func (c *Client) SetLogLevel(subsystem string, level string, texOnly bool) (map[string]string, error) {
+	return c.logs(http.MethodPost, subsystem, level, texOnly)
+}
+
+func (c *Client) logs(meth string, subsystem string, level string, texOnly bool) (map[string]string, error) {
+	pth := "logs"
+	if subsystem != "" {
+		pth += "/" + subsystem
+	}
+
+	levels := make(map[string]string)
+	if err := c.doJson(meth, pth, params{
+		opts: map[string]string{
+			"level":    level,
+			"tex-only": strconv.FormatBool(texOnly),
+		},
+	}, &levels); err != nil {
+		return nil, err
+	}
+	return levels, nil
+}
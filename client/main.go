@@ -0,0 +1,328 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/textileio/go-textile/pb"
+)
+
+// DefaultAddr is the default address of the local REST API
+const DefaultAddr = "http://127.0.0.1:40600"
+
+// Config is used to create a Client
+type Config struct {
+	Addr    string // e.g., http://127.0.0.1:40600
+	Version string // one of v0 (default) or v1
+	ApiKey  string // sent as a bearer token when non-empty
+}
+
+// Client is a typed client for the local REST API
+type Client struct {
+	addr    string
+	version string
+	apiKey  string
+	http    *http.Client
+}
+
+// New returns a client for the API described by conf
+func New(conf Config) *Client {
+	addr := conf.Addr
+	if addr == "" {
+		addr = DefaultAddr
+	}
+	version := conf.Version
+	if version == "" {
+		version = "v0"
+	}
+	return &Client{
+		addr:    strings.TrimSuffix(addr, "/"),
+		version: version,
+		apiKey:  conf.ApiKey,
+		http:    &http.Client{},
+	}
+}
+
+// Error is an error response from the API
+type Error struct {
+	Status  int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+var pbMarshaler = jsonpb.Marshaler{
+	OrigName: true,
+}
+
+var pbUnmarshaler = jsonpb.Unmarshaler{
+	AllowUnknownFields: true,
+}
+
+// params are the positional args, options, and body of a request
+type params struct {
+	args    []string
+	opts    map[string]string
+	payload io.Reader
+	ctype   string
+}
+
+// request sends a request, returning an *Error if the API responded with one.
+// Callers must close the response body.
+func (c *Client) request(ctx context.Context, meth string, pth string, pars params) (*http.Response, error) {
+	apiUrl := fmt.Sprintf("%s/api/%s/%s", c.addr, c.version, pth)
+
+	var req *http.Request
+	var err error
+	if c.version == "v1" {
+		req, err = newRequestV1(meth, apiUrl, pars)
+	} else {
+		req, err = newRequestV0(meth, apiUrl, pars)
+	}
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode >= 400 {
+		defer res.Body.Close()
+		return nil, responseError(res)
+	}
+	return res, nil
+}
+
+// newRequestV0 sends args and options in headers
+func newRequestV0(meth string, apiUrl string, pars params) (*http.Request, error) {
+	req, err := http.NewRequest(meth, apiUrl, pars.payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pars.args) > 0 {
+		var args []string
+		for _, arg := range pars.args {
+			args = append(args, url.PathEscape(arg))
+		}
+		req.Header.Set("X-Textile-Args", strings.Join(args, ","))
+	}
+
+	if len(pars.opts) > 0 {
+		var items []string
+		for k, v := range pars.opts {
+			items = append(items, k+"="+url.PathEscape(v))
+		}
+		req.Header.Set("X-Textile-Opts", strings.Join(items, ","))
+	}
+
+	if pars.ctype != "" {
+		req.Header.Set("Content-Type", pars.ctype)
+	}
+	return req, nil
+}
+
+// newRequestV1 sends options as query params and args in a JSON body,
+// or as query params if the request has another body
+func newRequestV1(meth string, apiUrl string, pars params) (*http.Request, error) {
+	query := url.Values{}
+	for k, v := range pars.opts {
+		query.Set(k, v)
+	}
+
+	payload := pars.payload
+	ctype := pars.ctype
+	if len(pars.args) > 0 {
+		if payload == nil && meth != http.MethodGet && meth != http.MethodDelete {
+			body, err := json.Marshal(map[string][]string{"args": pars.args})
+			if err != nil {
+				return nil, err
+			}
+			payload = bytes.NewReader(body)
+			ctype = "application/json"
+		} else {
+			query["arg"] = pars.args
+		}
+	}
+	if len(query) > 0 {
+		apiUrl += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(meth, apiUrl, payload)
+	if err != nil {
+		return nil, err
+	}
+	if ctype != "" {
+		req.Header.Set("Content-Type", ctype)
+	}
+	return req, nil
+}
+
+// responseError returns the error in a failed response, which
+// v1 sends as a JSON error object
+func responseError(res *http.Response) error {
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	var obj struct {
+		Error string `json:"error"`
+	}
+	msg := strings.TrimSpace(string(body))
+	if err := json.Unmarshal(body, &obj); err == nil && obj.Error != "" {
+		msg = obj.Error
+	}
+	return &Error{Status: res.StatusCode, Message: msg}
+}
+
+// do sends a request, discarding the response body
+func (c *Client) do(meth string, pth string, pars params) error {
+	res, err := c.request(context.Background(), meth, pth, pars)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	_, err = io.Copy(ioutil.Discard, res.Body)
+	return err
+}
+
+// doString sends a request, returning the response body as a string
+func (c *Client) doString(meth string, pth string, pars params) (string, error) {
+	res, err := c.request(context.Background(), meth, pth, pars)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// doPb sends a request, decoding the response body into a protobuf message
+func (c *Client) doPb(meth string, pth string, pars params, target proto.Message) error {
+	res, err := c.request(context.Background(), meth, pth, pars)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return pbUnmarshaler.Unmarshal(res.Body, target)
+}
+
+// doJson sends a request, decoding the response body into target
+func (c *Client) doJson(meth string, pth string, pars params, target interface{}) error {
+	res, err := c.request(context.Background(), meth, pth, pars)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return json.NewDecoder(res.Body).Decode(target)
+}
+
+// doData sends a request, returning the response body for the caller to read and close
+func (c *Client) doData(meth string, pth string, pars params) (io.ReadCloser, error) {
+	res, err := c.request(context.Background(), meth, pth, pars)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// readStream calls send with each protobuf message in a streaming response
+// until the body ends, send returns false, or ctx is done
+func readStream(ctx context.Context, res *http.Response, msg func() proto.Message, send func(proto.Message) bool) error {
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+	for decoder.More() {
+		next := msg()
+		if err := pbUnmarshaler.UnmarshalNext(decoder, next); err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if !send(next) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// search starts a search, streaming results until it completes or cancel is called
+func (c *Client) search(pth string, opts map[string]string) (<-chan *pb.QueryResult, <-chan error, func(), error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	res, err := c.request(ctx, http.MethodPost, pth, params{opts: opts})
+	if err != nil {
+		cancel()
+		return nil, nil, nil, err
+	}
+
+	resultCh := make(chan *pb.QueryResult)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(resultCh)
+		err := readStream(ctx, res, func() proto.Message {
+			return new(pb.QueryResult)
+		}, func(msg proto.Message) bool {
+			select {
+			case resultCh <- msg.(*pb.QueryResult):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		if err != nil {
+			errCh <- err
+		}
+	}()
+
+	return resultCh, errCh, cancel, nil
+}
+
+// listOpts returns the options for a paginated list, where a zero limit uses the API default
+func listOpts(thread string, offset string, limit int) map[string]string {
+	opts := map[string]string{
+		"thread": thread,
+		"offset": offset,
+	}
+	if limit != 0 {
+		opts["limit"] = strconv.Itoa(limit)
+	}
+	return opts
+}
+
+// pbBody returns a JSON body for a protobuf message
+func pbBody(msg proto.Message) (params, error) {
+	body, err := pbMarshaler.MarshalToString(msg)
+	if err != nil {
+		return params{}, err
+	}
+	return params{
+		payload: strings.NewReader(body),
+		ctype:   "application/json",
+	}, nil
+}
@@ -0,0 +1,199 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestClient_V0(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v0/threads/abc/messages" {
+			t.Errorf("wrong path: %s", r.URL.Path)
+		}
+		if r.Method != http.MethodPost {
+			t.Errorf("wrong method: %s", r.Method)
+		}
+		if args := r.Header.Get("X-Textile-Args"); args != "hello%20world" {
+			t.Errorf("wrong args header: %s", args)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer key" {
+			t.Errorf("wrong authorization header: %s", auth)
+		}
+		fmt.Fprint(w, `{"block":"blk","body":"hello world","unknown":true}`)
+	}))
+	defer server.Close()
+
+	api := New(Config{Addr: server.URL + "/", ApiKey: "key"})
+	msg, err := api.AddMessage("abc", "hello world")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Block != "blk" || msg.Body != "hello world" {
+		t.Errorf("wrong message: %v", msg)
+	}
+}
+
+func TestClient_V1(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/threads/abc/messages" {
+			t.Errorf("wrong path: %s", r.URL.Path)
+		}
+		if r.Header.Get("X-Textile-Args") != "" {
+			t.Error("v1 should not send args headers")
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var req struct {
+			Args []string `json:"args"`
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Fatal(err)
+		}
+		if len(req.Args) != 1 || req.Args[0] != "hello world" {
+			t.Errorf("wrong args: %v", req.Args)
+		}
+		fmt.Fprint(w, `{"block":"blk"}`)
+	}))
+	defer server.Close()
+
+	api := New(Config{Addr: server.URL, Version: "v1"})
+	if _, err := api.AddMessage("abc", "hello world"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_ListOpts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("thread") != "abc" || query.Get("offset") != "blk" {
+			t.Errorf("wrong query: %s", r.URL.RawQuery)
+		}
+		if _, ok := query["limit"]; ok {
+			t.Error("zero limit should be omitted")
+		}
+		fmt.Fprint(w, `{"items":[{"block":"blk2"}],"next":"blk2"}`)
+	}))
+	defer server.Close()
+
+	api := New(Config{Addr: server.URL, Version: "v1"})
+	list, err := api.Messages("abc", "blk", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 || list.Next != "blk2" {
+		t.Errorf("wrong list: %v", list)
+	}
+}
+
+func TestClient_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/threads/abc" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"thread not found"}`)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, "bad request\n")
+	}))
+	defer server.Close()
+
+	api := New(Config{Addr: server.URL, Version: "v1"})
+	_, err := api.Thread("abc")
+	apiErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected an *Error, got %v", err)
+	}
+	if apiErr.Status != http.StatusNotFound || apiErr.Message != "thread not found" {
+		t.Errorf("wrong error: %d %s", apiErr.Status, apiErr.Message)
+	}
+
+	err = api.RemoveThread("def")
+	apiErr, ok = err.(*Error)
+	if !ok {
+		t.Fatalf("expected an *Error, got %v", err)
+	}
+	if apiErr.Status != http.StatusBadRequest || apiErr.Message != "bad request" {
+		t.Errorf("wrong error: %d %s", apiErr.Status, apiErr.Message)
+	}
+}
+
+func TestClient_Subscribe(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if typ := r.URL.Query().Get("type"); typ != "FILES|TEXT" {
+			t.Errorf("wrong type: %s", typ)
+		}
+		if since := r.URL.Query().Get("since"); since != "blk" {
+			t.Errorf("wrong since: %s", since)
+		}
+		fmt.Fprint(w, `{"block":"a"}`)
+		fmt.Fprint(w, `{"block":"b"}`)
+		w.(http.Flusher).Flush()
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	api := New(Config{Addr: server.URL, Version: "v1"})
+	updates, errs, cancel, err := api.Subscribe("", []string{"FILES", "TEXT"}, "blk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var blocks []string
+	for _, update := range []*pb.FeedItem{<-updates, <-updates} {
+		blocks = append(blocks, update.Block)
+	}
+	if len(blocks) != 2 || blocks[0] != "a" || blocks[1] != "b" {
+		t.Errorf("wrong updates: %v", blocks)
+	}
+
+	cancel()
+	for range updates {
+	}
+	select {
+	case err := <-errs:
+		t.Errorf("cancel should not error: %s", err)
+	default:
+	}
+}
+
+func TestClient_Batch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/batch" {
+			t.Errorf("wrong path: %s", r.URL.Path)
+		}
+		var req struct {
+			Ops         []*BatchOp `json:"ops"`
+			StopOnError bool       `json:"stop_on_error"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if len(req.Ops) != 2 || string(req.Ops[0].Data) != "data" || req.Ops[1].Block != "$m" || !req.StopOnError {
+			t.Errorf("wrong request: %v", req)
+		}
+		fmt.Fprint(w, `{"results":[{"id":"m","type":"mill","data":{"hash":"Qm"}},{"type":"like","error":"skipped"}],"failed":1}`)
+	}))
+	defer server.Close()
+
+	api := New(Config{Addr: server.URL, Version: "v1"})
+	res, err := api.Batch([]*BatchOp{
+		{Id: "m", Type: "mill", Mill: "/blob", Data: []byte("data")},
+		{Type: "like", Block: "$m"},
+	}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Results) != 2 || res.Failed != 1 || res.Results[1].Error != "skipped" {
+		t.Errorf("wrong response: %v", res)
+	}
+}
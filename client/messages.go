@@ -0,0 +1,38 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// AddMessage adds a text message to a thread, which can also be 'default'
+func (c *Client) AddMessage(thread string, body string) (*pb.Text, error) {
+	var text pb.Text
+	if err := c.doPb(http.MethodPost, "threads/"+thread+"/messages", params{
+		args: []string{body},
+	}, &text); err != nil {
+		return nil, err
+	}
+	return &text, nil
+}
+
+// Messages paginates thread messages. Omit thread to paginate all messages.
+func (c *Client) Messages(thread string, offset string, limit int) (*pb.TextList, error) {
+	var list pb.TextList
+	if err := c.doPb(http.MethodGet, "messages", params{
+		opts: listOpts(thread, offset, limit),
+	}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// Message returns a thread message by its block id
+func (c *Client) Message(id string) (*pb.Text, error) {
+	var text pb.Text
+	if err := c.doPb(http.MethodGet, "messages/"+id, params{}, &text); err != nil {
+		return nil, err
+	}
+	return &text, nil
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// Mill processes data with a mill (e.g., /blob, /image/resize, /json, /schema), returning
+// the file index of the result. Opts are the mill's options (e.g., width, quality, plaintext),
+// and use may be set instead of payload to mill an existing file hash.
+func (c *Client) Mill(mill string, opts map[string]string, payload io.Reader, ctype string) (*pb.FileIndex, error) {
+	var file pb.FileIndex
+	if err := c.doPb(http.MethodPost, "mills/"+strings.TrimPrefix(mill, "/"), params{
+		opts:    opts,
+		payload: payload,
+		ctype:   ctype,
+	}, &file); err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// AddSchema mills a thread schema, returning its file index
+func (c *Client) AddSchema(schema string) (*pb.FileIndex, error) {
+	return c.Mill("/schema", nil, strings.NewReader(schema), "application/json")
+}
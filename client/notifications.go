@@ -0,0 +1,82 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+)
+
+// Notifications lists notifications matching req
+func (c *Client) Notifications(req *pb.NotificationRequest) (*pb.NotificationList, error) {
+	opts, err := notificationOpts(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var list pb.NotificationList
+	if err := c.doPb(http.MethodGet, "notifications", params{opts: opts}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// ReadNotification marks a notification as read
+func (c *Client) ReadNotification(id string) error {
+	return c.do(http.MethodPost, "notifications/"+id+"/read", params{})
+}
+
+// ReadNotifications marks all notifications matching req as read
+func (c *Client) ReadNotifications(req *pb.NotificationRequest) error {
+	opts, err := notificationOpts(req)
+	if err != nil {
+		return err
+	}
+	return c.do(http.MethodPost, "notifications/all/read", params{opts: opts})
+}
+
+// RemoveNotification removes a notification
+func (c *Client) RemoveNotification(id string) error {
+	return c.do(http.MethodDelete, "notifications/"+id, params{})
+}
+
+// RemoveNotifications removes all notifications matching req
+func (c *Client) RemoveNotifications(req *pb.NotificationRequest) error {
+	opts, err := notificationOpts(req)
+	if err != nil {
+		return err
+	}
+	return c.do(http.MethodDelete, "notifications/all", params{opts: opts})
+}
+
+// notificationOpts returns the options for a notification request
+func notificationOpts(req *pb.NotificationRequest) (map[string]string, error) {
+	if req == nil {
+		req = &pb.NotificationRequest{}
+	}
+	opts := listOpts(req.Thread, req.Offset, int(req.Limit))
+
+	var types []string
+	for _, t := range req.Types {
+		types = append(types, t.String())
+	}
+	opts["type"] = strings.Join(types, "|")
+
+	switch req.Read {
+	case pb.NotificationRequest_READ:
+		opts["read"] = "true"
+	case pb.NotificationRequest_UNREAD:
+		opts["read"] = "false"
+	}
+
+	if req.Before != nil {
+		before, err := ptypes.Timestamp(req.Before)
+		if err != nil {
+			return nil, err
+		}
+		opts["before"] = before.Format(time.RFC3339)
+	}
+	return opts, nil
+}
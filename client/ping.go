@@ -0,0 +1,10 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Ping pings another peer, returning one of online or offline
+func (c *Client) Ping(peerId string) (string, error) {
+	return c.doString(http.MethodGet, "ping", params{args: []string{peerId}})
+}
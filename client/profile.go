@@ -0,0 +1,26 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// Profile returns the local peer's profile
+func (c *Client) Profile() (*pb.Peer, error) {
+	var peer pb.Peer
+	if err := c.doPb(http.MethodGet, "profile", params{}, &peer); err != nil {
+		return nil, err
+	}
+	return &peer, nil
+}
+
+// SetName sets the local peer's display name
+func (c *Client) SetName(name string) error {
+	return c.do(http.MethodPost, "profile/name", params{args: []string{name}})
+}
+
+// SetAvatar sets the local peer's avatar to the latest image in the account thread
+func (c *Client) SetAvatar() error {
+	return c.do(http.MethodPost, "profile/avatar", params{})
+}
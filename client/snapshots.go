@@ -0,0 +1,43 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+)
+
+// CreateThreadSnapshots snapshots all threads and pushes them to registered cafes
+func (c *Client) CreateThreadSnapshots() error {
+	return c.do(http.MethodPost, "snapshots", params{})
+}
+
+// SearchThreadSnapshots searches the network for snapshots of the local account's threads.
+// Results are streamed until the search completes or cancel is called.
+func (c *Client) SearchThreadSnapshots(query *pb.ThreadSnapshotQuery, options *pb.QueryOptions) (<-chan *pb.QueryResult, <-chan error, func(), error) {
+	if query == nil {
+		query = &pb.ThreadSnapshotQuery{}
+	}
+	if options == nil {
+		options = &pb.QueryOptions{}
+	}
+
+	opts := map[string]string{
+		"thread":    query.Thread,
+		"wait":      strconv.Itoa(int(options.Wait)),
+		"federated": strconv.FormatBool(options.Federated),
+	}
+	if query.Version > 0 {
+		opts["version"] = strconv.Itoa(int(query.Version))
+	}
+	if query.AsOf != nil {
+		asOf, err := ptypes.Timestamp(query.AsOf)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		opts["as_of"] = asOf.Format(time.RFC3339)
+	}
+	return c.search("snapshots/search", opts)
+}
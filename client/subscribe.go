@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/textileio/go-textile/pb"
+)
+
+// Subscribe streams updates to a thread, or all threads if thread is empty, until cancel is called.
+// Types are block types to filter for (e.g., FILES, COMMENTS, LIKES), or empty for all.
+// Since is the block id of the last update received, or an RFC3339 date, from which to replay
+// missed updates, or empty for only new updates.
+func (c *Client) Subscribe(thread string, types []string, since string) (<-chan *pb.FeedItem, <-chan error, func(), error) {
+	pth := "subscribe"
+	if thread != "" {
+		pth += "/" + thread
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	res, err := c.request(ctx, http.MethodGet, pth, params{
+		opts: map[string]string{
+			"type":  strings.Join(types, "|"),
+			"since": since,
+		},
+	})
+	if err != nil {
+		cancel()
+		return nil, nil, nil, err
+	}
+
+	updateCh := make(chan *pb.FeedItem)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(updateCh)
+		err := readStream(ctx, res, func() proto.Message {
+			return new(pb.FeedItem)
+		}, func(msg proto.Message) bool {
+			select {
+			case updateCh <- msg.(*pb.FeedItem):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		if err != nil {
+			errCh <- err
+		}
+	}()
+
+	return updateCh, errCh, cancel, nil
+}
@@ -0,0 +1,16 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// Summary returns a summary of node data
+func (c *Client) Summary() (*pb.Summary, error) {
+	var summary pb.Summary
+	if err := c.doPb(http.MethodGet, "summary", params{}, &summary); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/schema/textile"
+)
+
+// AddThread adds and joins a new thread. Schema JSON and presets are milled
+// before the thread is added.
+func (c *Client) AddThread(conf *pb.AddThreadConfig) (*pb.Thread, error) {
+	var schema string
+	if conf.Schema != nil {
+		schema = conf.Schema.Id
+		if schema == "" {
+			sjson := conf.Schema.Json
+			if sjson == "" {
+				switch conf.Schema.Preset {
+				case pb.AddThreadConfig_Schema_BLOB:
+					sjson = textile.Blob
+				case pb.AddThreadConfig_Schema_CAMERA_ROLL:
+					sjson = textile.CameraRoll
+				case pb.AddThreadConfig_Schema_MEDIA:
+					sjson = textile.Media
+				}
+			}
+			if sjson != "" {
+				file, err := c.AddSchema(sjson)
+				if err != nil {
+					return nil, err
+				}
+				schema = file.Hash
+			}
+		}
+	}
+
+	var thrd pb.Thread
+	if err := c.doPb(http.MethodPost, "threads", params{
+		args: []string{conf.Name},
+		opts: map[string]string{
+			"key":       conf.Key,
+			"schema":    schema,
+			"type":      strings.ToLower(conf.Type.String()),
+			"sharing":   strings.ToLower(conf.Sharing.String()),
+			"whitelist": strings.Join(conf.Whitelist, ","),
+		},
+	}, &thrd); err != nil {
+		return nil, err
+	}
+	return &thrd, nil
+}
+
+// AddOrUpdateThread adds or updates a thread directly, usually from a snapshot.
+// Use restore to reset the thread to the snapshot head, even if it is older than the current head.
+func (c *Client) AddOrUpdateThread(thrd *pb.Thread, restore bool) error {
+	pars, err := pbBody(thrd)
+	if err != nil {
+		return err
+	}
+	pars.opts = map[string]string{"restore": strconv.FormatBool(restore)}
+	return c.do(http.MethodPut, "threads/"+thrd.Id, pars)
+}
+
+// RenameThread renames a thread. Only the initiator can rename a thread.
+func (c *Client) RenameThread(id string, name string) error {
+	return c.do(http.MethodPut, "threads/"+id+"/name", params{args: []string{name}})
+}
+
+// Threads lists all threads
+func (c *Client) Threads() (*pb.ThreadList, error) {
+	var list pb.ThreadList
+	if err := c.doPb(http.MethodGet, "threads", params{}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// Thread returns a thread by id, which can also be 'default'
+func (c *Client) Thread(id string) (*pb.Thread, error) {
+	var thrd pb.Thread
+	if err := c.doPb(http.MethodGet, "threads/"+id, params{}, &thrd); err != nil {
+		return nil, err
+	}
+	return &thrd, nil
+}
+
+// ThreadPeers lists all peers in a thread
+func (c *Client) ThreadPeers(id string) (*pb.PeerList, error) {
+	var list pb.PeerList
+	if err := c.doPb(http.MethodGet, "threads/"+id+"/peers", params{}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// RemoveThread leaves and removes a thread
+func (c *Client) RemoveThread(id string) error {
+	return c.do(http.MethodDelete, "threads/"+id, params{})
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// CafeTokenConfig is used to create a cafe access token
+type CafeTokenConfig struct {
+	Token   string    // an existing token to use, empty to create a new one
+	Store   bool      // whether to store the token in the local db
+	Scopes  []string  // store, inbox, threads, search (empty for all)
+	Plan    string    // name of a quota plan from the cafe host config
+	Label   string    // a label for the token
+	Expires time.Time // zero never expires
+}
+
+// CreateCafeToken creates a cafe access token
+func (c *Client) CreateCafeToken(conf CafeTokenConfig) (string, error) {
+	opts := map[string]string{
+		"token":  conf.Token,
+		"store":  strconv.FormatBool(conf.Store),
+		"scopes": strings.Join(conf.Scopes, ","),
+		"plan":   conf.Plan,
+		"label":  conf.Label,
+	}
+	if !conf.Expires.IsZero() {
+		opts["expires"] = conf.Expires.Format(time.RFC3339)
+	}
+	return c.doString(http.MethodPost, "tokens", params{opts: opts})
+}
+
+// CafeTokens lists stored cafe tokens and their usage
+func (c *Client) CafeTokens() (*pb.CafeTokenUsageList, error) {
+	var list pb.CafeTokenUsageList
+	if err := c.doPb(http.MethodGet, "tokens", params{}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// ValidateCafeToken returns whether or not a cafe token is valid
+func (c *Client) ValidateCafeToken(token string) (bool, error) {
+	err := c.do(http.MethodGet, "tokens/"+token, params{})
+	if err != nil {
+		if aerr, ok := err.(*Error); ok && aerr.Status == http.StatusUnauthorized {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// RemoveCafeToken removes a stored cafe token
+func (c *Client) RemoveCafeToken(token string) error {
+	return c.do(http.MethodDelete, "tokens/"+token, params{})
+}
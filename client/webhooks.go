@@ -0,0 +1,39 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/textileio/go-textile/repo/config"
+)
+
+// AddWebhook adds a webhook for block updates in threads (or all threads if empty)
+// of types (e.g., FILES, TEXT, or all types if empty). A secret is generated if empty.
+func (c *Client) AddWebhook(url string, threads []string, types []string, secret string) (*config.Webhook, error) {
+	var hook config.Webhook
+	if err := c.doJson(http.MethodPost, "webhooks", params{
+		args: []string{url},
+		opts: map[string]string{
+			"thread": strings.Join(threads, ","),
+			"type":   strings.Join(types, "|"),
+			"secret": secret,
+		},
+	}, &hook); err != nil {
+		return nil, err
+	}
+	return &hook, nil
+}
+
+// Webhooks lists webhooks
+func (c *Client) Webhooks() ([]config.Webhook, error) {
+	var hooks []config.Webhook
+	if err := c.doJson(http.MethodGet, "webhooks", params{}, &hooks); err != nil {
+		return nil, err
+	}
+	return hooks, nil
+}
+
+// RemoveWebhook removes a webhook, along with its pending deliveries
+func (c *Client) RemoveWebhook(id string) error {
+	return c.do(http.MethodDelete, "webhooks/"+id, params{})
+}
@@ -2,8 +2,9 @@ package cmd
 
 import (
 	"fmt"
-	"strconv"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/textileio/go-textile/pb"
 )
 
@@ -46,21 +47,11 @@ Shows the local peer's account info as a contact.`
 func (x *accountGetCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, _, err := callGetAccount()
+	contact, err := api.Account()
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
-}
-
-func callGetAccount() (string, *pb.Contact, error) {
-	var contact pb.Contact
-	res, err := executeJsonPbCmd(GET, "account", params{}, &contact)
-	if err != nil {
-		return "", nil, err
-	}
-	return res, &contact, err
+	return outputPb(contact)
 }
 
 type accountSeedCmd struct {
@@ -75,7 +66,7 @@ Shows the local peer's account seed.`
 
 func (x *accountSeedCmd) Execute(args []string) error {
 	setApi(x.Client)
-	res, err := executeStringCmd(GET, "account/seed", params{})
+	res, err := api.AccountSeed()
 	if err != nil {
 		return err
 	}
@@ -95,7 +86,7 @@ Shows the local peer's account address.`
 
 func (x *accountAddressCmd) Execute(args []string) error {
 	setApi(x.Client)
-	res, err := executeStringCmd(GET, "account/address", params{})
+	res, err := api.AccountAddress()
 	if err != nil {
 		return err
 	}
@@ -104,26 +95,48 @@ func (x *accountAddressCmd) Execute(args []string) error {
 }
 
 type accountSyncCmd struct {
-	Client ClientOptions `group:"Client Options"`
-	Wait   int           `long:"wait" description:"Stops searching after 'wait' seconds have elapsed (max 30s)." default:"2"`
+	Client    ClientOptions `group:"Client Options"`
+	Wait      int           `long:"wait" description:"Stops searching after 'wait' seconds have elapsed (max 30s)." default:"2"`
+	Thread    string        `short:"t" long:"thread" description:"Only sync this thread."`
+	Version   int           `short:"v" long:"version" description:"Restore threads to this cafe snapshot version."`
+	AsOf      string        `long:"as-of" description:"Restore threads to the latest cafe snapshots stored at or before this RFC3339 date."`
+	Federated bool          `long:"federated" description:"Ask cafes to forward the search to their federated peer cafes instead of using pubsub."`
 }
 
 func (x *accountSyncCmd) Usage() string {
 	return `
 
-Syncs the local account peer with other peers found on the network.`
+Syncs the local account peer with other peers found on the network.
+
+Use --version or --as-of to restore threads to an earlier snapshot
+kept by your cafes. Restored threads are reset to the snapshot head.`
 }
 
 func (x *accountSyncCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	results := handleSearchStream("snapshots/search", params{
-		opts: map[string]string{
-			"wait": strconv.Itoa(x.Wait),
-		},
-	})
+	query := &pb.ThreadSnapshotQuery{
+		Thread:  x.Thread,
+		Version: int32(x.Version),
+	}
+	if x.AsOf != "" {
+		asOf, err := time.Parse(time.RFC3339, x.AsOf)
+		if err != nil {
+			return err
+		}
+		query.AsOf, err = ptypes.TimestampProto(asOf)
+		if err != nil {
+			return err
+		}
+	}
+	restore := x.Version > 0 || x.AsOf != ""
 
-	var remote []pb.QueryResult
+	results := handleSearchStream(api.SearchThreadSnapshots(query, &pb.QueryOptions{
+		Wait:      int32(x.Wait),
+		Federated: x.Federated,
+	}))
+
+	var remote []*pb.QueryResult
 	for _, res := range results {
 		if !res.Local {
 			remote = append(remote, res)
@@ -138,17 +151,21 @@ func (x *accountSyncCmd) Execute(args []string) error {
 	if len(remote) > 1 {
 		postfix = "s"
 	}
-	if !confirm(fmt.Sprintf("Apply %d snapshot%s?", len(remote), postfix)) {
+	action := "Apply"
+	if restore {
+		action = "Restore"
+	}
+	if !confirm(fmt.Sprintf("%s %d snapshot%s?", action, len(remote), postfix)) {
 		return nil
 	}
 
 	for _, result := range remote {
-		if err := applySnapshot(&result); err != nil {
+		if err := applySnapshot(result, restore); err != nil {
 			return err
 		}
 	}
 
-	if _, err := callCreateSnapshotsThreads(); err != nil {
+	if err := api.CreateThreadSnapshots(); err != nil {
 		return err
 	}
 
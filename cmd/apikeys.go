@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/textileio/go-textile/client"
+	"github.com/textileio/go-textile/util"
+)
+
+var errMissingApiKeyId = fmt.Errorf("missing api key id")
+
+func init() {
+	register(&apiKeysCmd{})
+}
+
+type apiKeysCmd struct {
+	Create createApiKeysCmd `command:"create" description:"Create a new API key"`
+	List   lsApiKeysCmd     `command:"ls" description:"List API keys"`
+	Remove rmApiKeysCmd     `command:"rm" description:"Revoke an API key"`
+}
+
+func (x *apiKeysCmd) Name() string {
+	return "api-keys"
+}
+
+func (x *apiKeysCmd) Short() string {
+	return "Manage local API keys"
+}
+
+func (x *apiKeysCmd) Long() string {
+	return `
+API keys grant access to the local REST API.
+Keys are signed by the account key and are sent as bearer tokens.
+
+Scopes:
+read:  GET requests
+write: All other requests
+admin: Config, logs, tokens, webhooks, cafe admin, and API keys (implies read and write)
+seed:  The account seed
+
+The daemon saves a key with all scopes to the local credentials file
+(~/.textile/credentials, or $TEXTILE_CREDENTIALS), which is used by
+default. Use '--api-key' or $API_KEY to use a different key.`
+}
+
+type createApiKeysCmd struct {
+	Client  ClientOptions `group:"Client Options"`
+	Name    string        `short:"n" long:"name" description:"A name for the key."`
+	Scopes  []string      `short:"s" long:"scope" description:"A scope granted to the key, one of 'read', 'write', 'admin', or 'seed'. Can be used multiple times." default:"read" default:"write"`
+	Expires string        `short:"e" long:"expires" description:"A duration (e.g., 720h) or RFC3339 date after which the key can no longer be used."`
+}
+
+func (x *createApiKeysCmd) Usage() string {
+	return `
+
+Creates a new API key. The key's token is only shown once.`
+}
+
+func (x *createApiKeysCmd) Execute(args []string) error {
+	setApi(x.Client)
+	conf := client.ApiKeyConfig{
+		Name:   x.Name,
+		Scopes: x.Scopes,
+	}
+	if x.Expires != "" {
+		expires, err := parseExpiry(x.Expires)
+		if err != nil {
+			return err
+		}
+		conf.Expires = expires
+	}
+
+	key, err := api.CreateApiKey(conf)
+	if err != nil {
+		return err
+	}
+	return outputPb(key)
+}
+
+type lsApiKeysCmd struct {
+	Client ClientOptions `group:"Client Options"`
+}
+
+func (x *lsApiKeysCmd) Usage() string {
+	return `
+
+Lists info about all local API keys.`
+}
+
+func (x *lsApiKeysCmd) Execute(args []string) error {
+	setApi(x.Client)
+
+	keys, err := api.ApiKeys()
+	if err != nil {
+		return err
+	}
+	return outputPb(keys)
+}
+
+type rmApiKeysCmd struct {
+	Client ClientOptions `group:"Client Options"`
+}
+
+func (x *rmApiKeysCmd) Usage() string {
+	return `
+
+Revokes an API key by ID.`
+}
+
+func (x *rmApiKeysCmd) Execute(args []string) error {
+	setApi(x.Client)
+	if len(args) < 1 {
+		return errMissingApiKeyId
+	}
+
+	if err := api.RemoveApiKey(util.TrimQuotes(args[0])); err != nil {
+		return err
+	}
+	output("ok")
+	return nil
+}
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/textileio/go-textile/client"
+)
+
+var errMissingBatchOps = fmt.Errorf("no batch operations found")
+
+func init() {
+	register(&batchCmd{})
+}
+
+type batchCmd struct {
+	Client      ClientOptions `group:"Client Options"`
+	StopOnError bool          `short:"s" long:"stop-on-error" description:"Skip the remaining operations after the first failure."`
+}
+
+func (x *batchCmd) Name() string {
+	return "batch"
+}
+
+func (x *batchCmd) Short() string {
+	return "Run a batch of operations"
+}
+
+func (x *batchCmd) Long() string {
+	return `
+Runs operations from a JSONL file (or stdin) in order, in a single request.
+Each line is an operation with a type of mill, files, message, comment, or like:
+
+  {"id": "m", "type": "message", "thread": "default", "body": "hello"}
+  {"type": "like", "block": "$m"}
+  {"id": "f", "type": "files", "thread": "default", "caption": "cats", "files": [{"path": "cat.jpg"}]}
+  {"type": "comment", "block": "$f", "body": "nice"}
+  {"id": "r", "type": "mill", "mill": "/image/resize", "opts": {"width": "100"}, "path": "cat.jpg"}
+
+Files are milled with the thread schema. Paths are read locally, relative to the JSONL file,
+and sent as base64 data. Values prefixed with '$' reference the result of an earlier
+operation by its id, e.g., a files or message block, or a mill's file hash ("use") or
+file index ("files": [{"use": "$r"}] or [{"dir": {"large": "$r"}}]).
+
+Results are listed in order. Failed operations do not stop the batch unless
+'--stop-on-error' is used.`
+}
+
+// batchLine is a batch operation which may read data from local paths
+type batchLine struct {
+	client.BatchOp
+	Path  string           `json:"path,omitempty"`
+	Files []*batchLineFile `json:"files,omitempty"`
+}
+
+type batchLineFile struct {
+	client.BatchFile
+	Path string `json:"path,omitempty"`
+}
+
+func (x *batchCmd) Execute(args []string) error {
+	setApi(x.Client)
+
+	reader := io.Reader(os.Stdin)
+	var dir string
+	if len(args) > 0 {
+		pth, err := homedir.Expand(args[0])
+		if err != nil {
+			pth = args[0]
+		}
+		f, err := os.Open(pth)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		reader = f
+		dir = filepath.Dir(pth)
+	}
+
+	ops, err := readBatchOps(reader, dir)
+	if err != nil {
+		return err
+	}
+	if len(ops) == 0 {
+		return errMissingBatchOps
+	}
+
+	res, err := api.Batch(ops, x.StopOnError)
+	if err != nil {
+		return err
+	}
+	if err := outputJson(res); err != nil {
+		return err
+	}
+
+	if res.Failed > 0 {
+		return fmt.Errorf("%d of %d operations failed", res.Failed, len(res.Results))
+	}
+	return nil
+}
+
+// readBatchOps reads JSONL operations, loading paths relative to dir
+func readBatchOps(reader io.Reader, dir string) ([]*client.BatchOp, error) {
+	var ops []*client.BatchOp
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+
+	var num int
+	for scanner.Scan() {
+		num++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		var line batchLine
+		if err := json.Unmarshal([]byte(text), &line); err != nil {
+			return nil, fmt.Errorf("line %d: %s", num, err)
+		}
+
+		op := line.BatchOp
+		if line.Path != "" {
+			data, name, err := readBatchPath(line.Path, dir)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", num, err)
+			}
+			op.Data = data
+			if op.Name == "" {
+				op.Name = name
+			}
+		}
+
+		op.Files = nil
+		for _, lf := range line.Files {
+			file := lf.BatchFile
+			if lf.Path != "" {
+				data, name, err := readBatchPath(lf.Path, dir)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %s", num, err)
+				}
+				file.Data = data
+				if file.Name == "" {
+					file.Name = name
+				}
+			}
+			op.Files = append(op.Files, &file)
+		}
+
+		ops = append(ops, &op)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+// readBatchPath reads a local file, returning its data and name
+func readBatchPath(pth string, dir string) ([]byte, string, error) {
+	if exp, err := homedir.Expand(pth); err == nil {
+		pth = exp
+	}
+	if !filepath.IsAbs(pth) && dir != "" {
+		pth = filepath.Join(dir, pth)
+	}
+	data, err := ioutil.ReadFile(pth)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, filepath.Base(pth), nil
+}
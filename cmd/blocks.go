@@ -4,9 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
-	"strconv"
 
-	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/util"
 )
 
@@ -69,38 +67,28 @@ Use the --dots option to return GraphViz dots instead of JSON.`
 
 func (x *lsBlocksCmd) Execute(args []string) error {
 	setApi(x.Client)
-	opts := map[string]string{
-		"thread": x.Thread,
-		"offset": x.Offset,
-		"limit":  strconv.Itoa(x.Limit),
-		"dots":   strconv.FormatBool(x.Dots),
+	if x.Thread == "" {
+		x.Thread = "default"
 	}
 
 	if x.Dots {
-		return callLsDots(opts)
+		return callLsDots(x.Thread, x.Offset, x.Limit)
 	}
-	return callLsBlocks(opts)
+	return callLsBlocks(x.Thread, x.Offset, x.Limit)
 }
 
-func callLsBlocks(opts map[string]string) error {
-	if opts["thread"] == "" {
-		opts["thread"] = "default"
-	}
-
-	var list pb.BlockList
-	res, err := executeJsonPbCmd(GET, "blocks", params{opts: opts}, &list)
+func callLsBlocks(thread string, offset string, limit int) error {
+	list, err := api.Blocks(thread, offset, limit)
 	if err != nil {
 		return err
 	}
 	if len(list.Items) > 0 {
-		output(res)
+		if err := outputPb(list); err != nil {
+			return err
+		}
 	}
 
-	limit, err := strconv.Atoi(opts["limit"])
-	if err != nil {
-		return err
-	}
-	if len(list.Items) < limit {
+	if list.Next == "" {
 		return nil
 	}
 
@@ -110,21 +98,11 @@ func callLsBlocks(opts map[string]string) error {
 		return err
 	}
 
-	return callLsBlocks(map[string]string{
-		"thread": opts["thread"],
-		"offset": list.Items[len(list.Items)-1].Id,
-		"limit":  opts["limit"],
-		"dots":   opts["dots"],
-	})
+	return callLsBlocks(thread, list.Next, limit)
 }
 
-func callLsDots(opts map[string]string) error {
-	if opts["thread"] == "" {
-		opts["thread"] = "default"
-	}
-
-	var viz pb.BlockViz
-	_, err := executeJsonPbCmd(GET, "blocks", params{opts: opts}, &viz)
+func callLsDots(thread string, offset string, limit int) error {
+	viz, err := api.BlockDots(thread, offset, limit)
 	if err != nil {
 		return err
 	}
@@ -142,12 +120,7 @@ func callLsDots(opts map[string]string) error {
 		return err
 	}
 
-	return callLsDots(map[string]string{
-		"thread": opts["thread"],
-		"offset": viz.Next,
-		"limit":  opts["limit"],
-		"dots":   opts["dots"],
-	})
+	return callLsDots(thread, viz.Next, limit)
 }
 
 type getBlocksCmd struct {
@@ -166,21 +139,11 @@ func (x *getBlocksCmd) Execute(args []string) error {
 		return errMissingBlockId
 	}
 
-	_, res, err := callGetBlocks(args[0])
+	block, err := api.Block(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
-}
-
-func callGetBlocks(id string) (*pb.Block, string, error) {
-	var block pb.Block
-	res, err := executeJsonPbCmd(GET, "blocks/"+id, params{}, &block)
-	if err != nil {
-		return nil, "", err
-	}
-	return &block, res, nil
+	return outputPb(block)
 }
 
 func callRmBlocks(args []string) error {
@@ -188,10 +151,9 @@ func callRmBlocks(args []string) error {
 		return errMissingBlockId
 	}
 
-	res, err := executeJsonCmd(DEL, "blocks/"+util.TrimQuotes(args[0]), params{}, nil)
+	block, err := api.IgnoreBlock(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(block)
 }
@@ -2,11 +2,14 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/textileio/go-textile/util"
 )
 
+var errMissingCafeHost = fmt.Errorf("missing cafe host")
 var errMissingCafeId = fmt.Errorf("missing cafe id")
+var errMissingClientId = fmt.Errorf("missing client id")
 
 func init() {
 	register(&cafesCmd{})
@@ -16,8 +19,11 @@ type cafesCmd struct {
 	Add      addCafesCmd          `command:"add" description:"Register with a cafe"`
 	List     lsCafesCmd           `command:"ls" description:"List cafes"`
 	Get      getCafesCmd          `command:"get" description:"Get a cafe"`
+	Update   updateCafesCmd       `command:"update" description:"Update a cafe's routing priority"`
 	Remove   rmCafesCmd           `command:"rm" description:"Remove a cafe"`
 	Messages checkCafeMessagesCmd `command:"messages" description:"Checks cafe messages"`
+	Requests cafeRequestsCmd      `command:"requests" description:"Inspect, retry, and purge cafe requests"`
+	Admin    cafeAdminCmd         `command:"admin" description:"Manage clients registered with this cafe"`
 }
 
 func (x *cafesCmd) Name() string {
@@ -31,7 +37,11 @@ func (x *cafesCmd) Short() string {
 func (x *cafesCmd) Long() string {
 	return `
 Cafes are other peers on the network who offer pinning, backup, and inbox services.
-Use this command to add, list, get, and remove cafes and check messages.`
+Use this command to add, list, get, update, and remove cafes and check messages.
+Failed cafe requests are retried with exponential backoff, and can be inspected,
+retried, or purged with the requests subcommands.
+Cafe health is tracked automatically, and requests are routed away from unhealthy cafes.
+Cafe hosts can use the admin subcommands to inspect and evict registered clients.`
 }
 
 type addCafesCmd struct {
@@ -49,15 +59,15 @@ An access token is required to register, and should be obtained separately from
 func (x *addCafesCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(POST, "cafes", params{
-		args: args,
-		opts: map[string]string{"token": x.Token},
-	}, nil)
+	if len(args) == 0 {
+		return errMissingCafeHost
+	}
+
+	session, err := api.AddCafe(args[0], x.Token)
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(session)
 }
 
 type lsCafesCmd struct {
@@ -67,18 +77,18 @@ type lsCafesCmd struct {
 func (x *lsCafesCmd) Usage() string {
 	return `
 
-List info about all active cafe sessions.`
+List info about all active cafe sessions, ordered by priority.
+Each session includes its cafe's health status, consecutive failures, and last ping latency.`
 }
 
 func (x *lsCafesCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(GET, "cafes", params{}, nil)
+	sessions, err := api.CafeSessions()
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(sessions)
 }
 
 type getCafesCmd struct {
@@ -97,12 +107,36 @@ func (x *getCafesCmd) Execute(args []string) error {
 		return errMissingCafeId
 	}
 
-	res, err := executeJsonCmd(GET, "cafes/"+util.TrimQuotes(args[0]), params{}, nil)
+	session, err := api.CafeSession(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(session)
+}
+
+type updateCafesCmd struct {
+	Client   ClientOptions `group:"Client Options"`
+	Priority int           `required:"true" short:"p" long:"priority" description:"Routing priority. Higher priority cafes are preferred."`
+}
+
+func (x *updateCafesCmd) Usage() string {
+	return `
+
+Updates a cafe session's routing priority.
+Higher priority cafes are preferred for new requests and are listed first as inboxes.`
+}
+
+func (x *updateCafesCmd) Execute(args []string) error {
+	setApi(x.Client)
+	if len(args) == 0 {
+		return errMissingCafeId
+	}
+
+	session, err := api.UpdateCafePriority(util.TrimQuotes(args[0]), x.Priority)
+	if err != nil {
+		return err
+	}
+	return outputPb(session)
 }
 
 type rmCafesCmd struct {
@@ -121,11 +155,10 @@ func (x *rmCafesCmd) Execute(args []string) error {
 		return errMissingCafeId
 	}
 
-	res, err := executeStringCmd(DEL, "cafes/"+util.TrimQuotes(args[0]), params{})
-	if err != nil {
+	if err := api.RemoveCafe(util.TrimQuotes(args[0])); err != nil {
 		return err
 	}
-	output(res)
+	output("ok")
 	return nil
 }
 
@@ -142,10 +175,196 @@ Check for messages at all cafes. New messages are downloaded and processed oppor
 func (x *checkCafeMessagesCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeStringCmd(POST, "cafes/messages", params{})
+	if err := api.CheckCafeMessages(); err != nil {
+		return err
+	}
+	output("ok")
+	return nil
+}
+
+type cafeRequestsCmd struct {
+	List  lsCafeRequestsCmd    `command:"ls" description:"List cafe requests"`
+	Retry retryCafeRequestsCmd `command:"retry" description:"Retry failed cafe requests"`
+	Purge purgeCafeRequestsCmd `command:"purge" description:"Purge failed cafe requests"`
+}
+
+func (x *cafeRequestsCmd) Usage() string {
+	return `
+
+Provides access to requests queued for this peer's cafes.
+Requests that fail are retried with exponential backoff until they reach
+the max number of attempts, after which they are marked as failed.`
+}
+
+type lsCafeRequestsCmd struct {
+	Client ClientOptions `group:"Client Options"`
+	Failed bool          `short:"f" long:"failed" description:"List failed requests."`
+	Offset string        `short:"o" long:"offset" description:"Offset ID to start listing from."`
+	Limit  int           `short:"l" long:"limit" description:"List page size." default:"-1"`
+}
+
+func (x *lsCafeRequestsCmd) Usage() string {
+	return `
+
+Lists queued cafe requests along with their attempt counts and next attempt dates.
+Use the --failed option to list requests that have been given up on.`
+}
+
+func (x *lsCafeRequestsCmd) Execute(args []string) error {
+	setApi(x.Client)
+
+	requests, err := api.CafeRequests(x.Failed, x.Offset, x.Limit)
+	if err != nil {
+		return err
+	}
+	return outputPb(requests)
+}
+
+type retryCafeRequestsCmd struct {
+	Client ClientOptions `group:"Client Options"`
+}
+
+func (x *retryCafeRequestsCmd) Usage() string {
+	return `
+
+Queues a failed cafe request to be handled again with a fresh attempt count.
+Omit the request ID to retry all failed requests.`
+}
+
+func (x *retryCafeRequestsCmd) Execute(args []string) error {
+	setApi(x.Client)
+
+	n, err := api.RetryCafeRequests(optionalArg(args))
+	if err != nil {
+		return err
+	}
+	output(strconv.Itoa(n))
+	return nil
+}
+
+type purgeCafeRequestsCmd struct {
+	Client ClientOptions `group:"Client Options"`
+}
+
+func (x *purgeCafeRequestsCmd) Usage() string {
+	return `
+
+Deletes a failed cafe request. Omit the request ID to delete all failed requests.`
+}
+
+func (x *purgeCafeRequestsCmd) Execute(args []string) error {
+	setApi(x.Client)
+
+	n, err := api.PurgeCafeRequests(optionalArg(args))
 	if err != nil {
 		return err
 	}
-	output(res)
+	output(strconv.Itoa(n))
 	return nil
 }
+
+type cafeAdminCmd struct {
+	Clients     cafeAdminClientsCmd     `command:"clients" description:"List registered clients"`
+	Evict       cafeAdminEvictCmd       `command:"evict" description:"Evict a registered client"`
+	Show        cafeAdminUsageCmd       `command:"usage" description:"Show client usage"`
+	Replication cafeAdminReplicationCmd `command:"replication" description:"Show neighbor replication status"`
+}
+
+func (x *cafeAdminCmd) Usage() string {
+	return `
+
+Provides access to clients registered with this peer's Cafe.`
+}
+
+type cafeAdminClientsCmd struct {
+	Client ClientOptions `group:"Client Options"`
+}
+
+func (x *cafeAdminClientsCmd) Usage() string {
+	return `
+
+Lists all clients registered with this peer's Cafe, ordered by last seen.`
+}
+
+func (x *cafeAdminClientsCmd) Execute(args []string) error {
+	setApi(x.Client)
+
+	clients, err := api.CafeClients()
+	if err != nil {
+		return err
+	}
+	return outputPb(clients)
+}
+
+type cafeAdminEvictCmd struct {
+	Client ClientOptions `group:"Client Options"`
+}
+
+func (x *cafeAdminEvictCmd) Usage() string {
+	return `
+
+Evicts a registered client, removing its stored threads, inbox messages, and pin records.`
+}
+
+func (x *cafeAdminEvictCmd) Execute(args []string) error {
+	setApi(x.Client)
+	if len(args) == 0 {
+		return errMissingClientId
+	}
+
+	if err := api.EvictCafeClient(util.TrimQuotes(args[0])); err != nil {
+		return err
+	}
+	output("ok")
+	return nil
+}
+
+type cafeAdminUsageCmd struct {
+	Client ClientOptions `group:"Client Options"`
+}
+
+func (x *cafeAdminUsageCmd) Usage() string {
+	return `
+
+Shows stored thread count, inbox depth, and pinned content for all registered clients.
+Specify a client ID to only show usage for that client.`
+}
+
+func (x *cafeAdminUsageCmd) Execute(args []string) error {
+	setApi(x.Client)
+
+	if len(args) > 0 {
+		usage, err := api.CafeClientUsage(util.TrimQuotes(args[0]))
+		if err != nil {
+			return err
+		}
+		return outputPb(usage)
+	}
+
+	usage, err := api.CafeClientsUsage()
+	if err != nil {
+		return err
+	}
+	return outputPb(usage)
+}
+
+type cafeAdminReplicationCmd struct {
+	Client ClientOptions `group:"Client Options"`
+}
+
+func (x *cafeAdminReplicationCmd) Usage() string {
+	return `
+
+Shows the status of client record replication to this peer's neighbor Cafes,
+including the number of pending replications and the last error of each, if any.`
+}
+
+func (x *cafeAdminReplicationCmd) Execute(args []string) error {
+	setApi(x.Client)
+
+	status, err := api.CafeReplicationStatus()
+	if err != nil {
+		return err
+	}
+	return outputPb(status)
+}
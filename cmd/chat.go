@@ -51,10 +51,11 @@ func (x *chatCmd) Execute(args []string) error {
 	}
 	defer rl.Close()
 
-	updates, err := callSub(x.Thread, []string{"text"})
+	updates, _, cancel, err := api.Subscribe(x.Thread, []string{"text"}, "")
 	if err != nil {
 		return err
 	}
+	defer cancel()
 
 	last := true
 	go func() {
@@ -108,7 +109,7 @@ func (x *chatCmd) Execute(args []string) error {
 
 func handleLine(line string, threadId string) error {
 	if strings.TrimSpace(line) != "" {
-		if _, err := callAddMessages(threadId, line); err != nil {
+		if _, err := api.AddMessage(threadId, line); err != nil {
 			return err
 		}
 	}
@@ -116,7 +117,7 @@ func handleLine(line string, threadId string) error {
 }
 
 func getContact() (*pb.Contact, error) {
-	_, c, err := callGetAccount()
+	c, err := api.Account()
 	if err != nil {
 		return nil, err
 	}
@@ -52,12 +52,11 @@ func (x *addCommentsCmd) Execute(args []string) error {
 		return errMissingCommentBody
 	}
 
-	res, err := executeJsonCmd(POST, "blocks/"+x.Block+"/comments", params{args: args}, nil)
+	comment, err := api.AddComment(x.Block, args[0])
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(comment)
 }
 
 type lsCommentsCmd struct {
@@ -74,12 +73,11 @@ Lists comments on a thread block.`
 func (x *lsCommentsCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(GET, "blocks/"+x.Block+"/comments", params{}, nil)
+	comments, err := api.Comments(x.Block)
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(comments)
 }
 
 type getCommentsCmd struct {
@@ -98,12 +96,11 @@ func (x *getCommentsCmd) Execute(args []string) error {
 		return errMissingCommentId
 	}
 
-	res, err := executeJsonCmd(GET, "blocks/"+util.TrimQuotes(args[0])+"/comment", params{}, nil)
+	comment, err := api.Comment(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(comment)
 }
 
 type rmCommentsCmd struct {
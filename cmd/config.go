@@ -1,11 +1,8 @@
 package cmd
 
 import (
-	"bytes"
+	"encoding/json"
 	"fmt"
-	"strings"
-
-	"github.com/textileio/go-textile/util"
 )
 
 func init() {
@@ -61,42 +58,28 @@ Set the value of the 'Addresses.API' key:
 func (x *configCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	patchFmt := `[
-  {"op": "replace", "path": "%s", "value": %s}
-]`
-
-	var path string
-	if len(args) > 0 {
-		path = "/" + strings.Replace(args[0], ".", "/", -1)
-	}
 	if len(args) > 1 {
-		patch := []byte(fmt.Sprintf(patchFmt, path, args[1]))
-
-		res, _, err := request(PATCH, "config", params{
-			payload: bytes.NewBuffer(patch),
-		})
-		if err != nil {
-			return err
+		if !json.Valid([]byte(args[1])) {
+			return fmt.Errorf("invalid JSON value: %s", args[1])
 		}
-		defer res.Body.Close()
-
-		if res.StatusCode >= 400 {
-			body, err := util.UnmarshalString(res.Body)
-			if err != nil {
-				return err
-			}
-			return fmt.Errorf(body)
+		if err := api.SetConfigValue(args[0], json.RawMessage(args[1])); err != nil {
+			return err
 		}
-
 		output("Updated! Restart daemon for changes to take effect.")
 		return nil
 	}
 
-	res, err := executeJsonCmd(GET, "config"+path, params{}, nil)
+	if len(args) > 0 {
+		value, err := api.ConfigValue(args[0])
+		if err != nil {
+			return err
+		}
+		return outputJson(value)
+	}
+
+	conf, err := api.Config()
 	if err != nil {
 		return err
 	}
-
-	output(res)
-	return nil
+	return outputJson(conf)
 }
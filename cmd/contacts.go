@@ -2,8 +2,6 @@ package cmd
 
 import (
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/textileio/go-textile/pb"
@@ -57,21 +55,20 @@ func (x *addContactsCmd) Execute(args []string) error {
 		return errMissingAddInfo
 	}
 
-	results := handleSearchStream("contacts/search", params{
-		opts: map[string]string{
-			"name":    x.Name,
-			"address": x.Address,
-			"limit":   strconv.Itoa(10),
-			"wait":    strconv.Itoa(x.Wait),
-		},
-	})
+	results := handleSearchStream(api.SearchContacts(&pb.ContactQuery{
+		Name:    x.Name,
+		Address: x.Address,
+	}, &pb.QueryOptions{
+		Limit: 10,
+		Wait:  int32(x.Wait),
+	}))
 
 	if len(results) == 0 {
 		output("No contacts were found")
 		return nil
 	}
 
-	remote := make(map[string]pb.QueryResult)
+	remote := make(map[string]*pb.QueryResult)
 	for _, res := range results {
 		if !res.Local {
 			remote[res.Id] = res // overwrite with newer / more complete result
@@ -95,22 +92,10 @@ func (x *addContactsCmd) Execute(args []string) error {
 		if err := ptypes.UnmarshalAny(result.Value, contact); err != nil {
 			return err
 		}
-		data, err := pbMarshaler.MarshalToString(result.Value)
-		if err != nil {
-			return err
-		}
-
-		res, err := executeStringCmd(PUT, "contacts/"+contact.Address, params{
-			payload: strings.NewReader(data),
-			ctype:   "application/json",
-		})
-		if err != nil {
-			return err
-		}
-		if res == "" {
-			output("added " + result.Id)
+		if err := api.AddContact(contact); err != nil {
+			output("error adding " + result.Id + ": " + err.Error())
 		} else {
-			output("error adding " + result.Id + ": " + res)
+			output("added " + result.Id)
 		}
 	}
 
@@ -130,12 +115,11 @@ Lists known contacts.`
 func (x *lsContactsCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(GET, "contacts", params{}, nil)
+	contacts, err := api.Contacts()
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(contacts)
 }
 
 type getContactsCmd struct {
@@ -154,21 +138,11 @@ func (x *getContactsCmd) Execute(args []string) error {
 		return errMissingAddress
 	}
 
-	_, res, err := callGetContacts(args[0])
+	contact, err := api.Contact(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
-}
-
-func callGetContacts(address string) (*pb.Contact, string, error) {
-	var contact pb.Contact
-	res, err := executeJsonPbCmd(GET, "contacts/"+address, params{}, &contact)
-	if err != nil {
-		return nil, "", err
-	}
-	return &contact, res, nil
+	return outputPb(contact)
 }
 
 type rmContactsCmd struct {
@@ -187,11 +161,10 @@ func (x *rmContactsCmd) Execute(args []string) error {
 		return errMissingAddress
 	}
 
-	res, err := executeStringCmd(DEL, "contacts/"+util.TrimQuotes(args[0]), params{})
-	if err != nil {
+	if err := api.RemoveContact(util.TrimQuotes(args[0])); err != nil {
 		return err
 	}
-	output(res)
+	output("ok")
 	return nil
 }
 
@@ -203,6 +176,7 @@ type searchContactsCmd struct {
 	RemoteOnly bool          `long:"only-remote" description:"Only search remote contacts."`
 	Limit      int           `long:"limit" description:"Stops searching after limit results are found." default:"5"`
 	Wait       int           `long:"wait" description:"Stops searching after 'wait' seconds have elapsed (max 30s)." default:"2"`
+	Federated  bool          `long:"federated" description:"Ask cafes to forward the search to their federated peer cafes instead of using pubsub."`
 }
 
 func (x *searchContactsCmd) Usage() string {
@@ -217,15 +191,15 @@ func (x *searchContactsCmd) Execute(args []string) error {
 		return errMissingSearchInfo
 	}
 
-	handleSearchStream("contacts/search", params{
-		opts: map[string]string{
-			"name":    x.Name,
-			"address": x.Address,
-			"local":   strconv.FormatBool(x.LocalOnly),
-			"remote":  strconv.FormatBool(x.RemoteOnly),
-			"limit":   strconv.Itoa(x.Limit),
-			"wait":    strconv.Itoa(x.Wait),
-		},
-	})
+	handleSearchStream(api.SearchContacts(&pb.ContactQuery{
+		Name:    x.Name,
+		Address: x.Address,
+	}, &pb.QueryOptions{
+		LocalOnly:  x.LocalOnly,
+		RemoteOnly: x.RemoteOnly,
+		Limit:      int32(x.Limit),
+		Wait:       int32(x.Wait),
+		Federated:  x.Federated,
+	}))
 	return nil
 }
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// CredentialsPath returns the path of the local credentials file, which holds
+// API keys keyed by API address. It defaults to ~/.textile/credentials.
+func CredentialsPath() (string, error) {
+	if pth := os.Getenv("TEXTILE_CREDENTIALS"); pth != "" {
+		return pth, nil
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".textile", "credentials"), nil
+}
+
+// ReadCredential returns the API key for an API address, if any
+func ReadCredential(addr string) string {
+	creds, err := readCredentials()
+	if err != nil {
+		return ""
+	}
+	return creds[credentialKey(addr)]
+}
+
+// WriteCredential saves the API key for an API address
+func WriteCredential(addr string, key string) error {
+	pth, err := CredentialsPath()
+	if err != nil {
+		return err
+	}
+	creds, err := readCredentials()
+	if err != nil {
+		return err
+	}
+	if creds[credentialKey(addr)] == key {
+		return nil
+	}
+	creds[credentialKey(addr)] = key
+
+	data, err := json.MarshalIndent(creds, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(pth), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(pth, data, 0600)
+}
+
+// readCredentials reads the local credentials file
+func readCredentials() (map[string]string, error) {
+	creds := make(map[string]string)
+	pth, err := CredentialsPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(pth)
+	if os.IsNotExist(err) {
+		return creds, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, err
+	}
+	return creds, nil
+}
+
+// credentialKey normalizes an API address to host:port
+func credentialKey(addr string) string {
+	if i := strings.Index(addr, "://"); i != -1 {
+		addr = addr[i+3:]
+	}
+	return strings.TrimRight(addr, "/")
+}
@@ -4,7 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
-	"strconv"
+	"strings"
 
 	"github.com/textileio/go-textile/pb"
 )
@@ -49,23 +49,29 @@ Specify "default" to use the default thread (if selected).`
 
 func (x *feedCmd) Execute(args []string) error {
 	setApi(x.Client)
-	opts := map[string]string{
-		"thread": x.Thread,
-		"offset": x.Offset,
-		"limit":  strconv.Itoa(x.Limit),
-		"mode":   x.Mode,
+	mode, ok := pb.FeedRequest_Mode_value[strings.ToUpper(x.Mode)]
+	if !ok {
+		return fmt.Errorf("invalid feed mode: %s", x.Mode)
 	}
-	return callLs(opts)
+
+	return callLs(&pb.FeedRequest{
+		Thread: x.Thread,
+		Offset: x.Offset,
+		Limit:  int32(x.Limit),
+		Mode:   pb.FeedRequest_Mode(mode),
+	})
 }
 
-func callLs(opts map[string]string) error {
-	var list pb.FeedItemList
-	res, err := executeJsonPbCmd(GET, "feed", params{opts: opts}, &list)
+func callLs(req *pb.FeedRequest) error {
+	list, err := api.Feed(req)
 	if err != nil {
 		return err
 	}
+
 	if list.Count > 0 {
-		output(res)
+		if err := outputPb(list); err != nil {
+			return err
+		}
 	}
 
 	if list.Next == "" {
@@ -78,10 +84,10 @@ func callLs(opts map[string]string) error {
 		return err
 	}
 
-	return callLs(map[string]string{
-		"thread": opts["thread"],
-		"offset": list.Next,
-		"limit":  opts["limit"],
-		"mode":   opts["mode"],
+	return callLs(&pb.FeedRequest{
+		Thread: req.Thread,
+		Offset: list.Next,
+		Limit:  req.Limit,
+		Mode:   req.Mode,
 	})
 }
@@ -143,8 +143,8 @@ func callAddFiles(args []string, opts map[string]string) error {
 	if threadId == "" {
 		threadId = "default"
 	}
-	var thrd pb.Thread
-	if _, err := executeJsonPbCmd(GET, "threads/"+threadId, params{}, &thrd); err != nil {
+	thrd, err := api.Thread(threadId)
+	if err != nil {
 		return err
 	}
 
@@ -252,23 +252,15 @@ func callAddFiles(args []string, opts map[string]string) error {
 }
 
 func add(dirs []*pb.Directory, threadId string, caption string, verbose bool) (*pb.Files, error) {
-	data, err := pbMarshaler.MarshalToString(&pb.DirectoryList{Items: dirs})
-	if err != nil {
-		return nil, err
-	}
-
-	files := new(pb.Files)
-	res, err := executeJsonPbCmd(POST, "threads/"+threadId+"/files", params{
-		opts:    map[string]string{"caption": caption},
-		payload: strings.NewReader(data),
-		ctype:   "application/json",
-	}, files)
+	files, err := api.AddFiles(threadId, &pb.DirectoryList{Items: dirs}, caption)
 	if err != nil {
 		return nil, err
 	}
 
 	if verbose {
-		output(res)
+		if err := outputPb(files); err != nil {
+			return nil, err
+		}
 	}
 	return files, nil
 }
@@ -310,9 +302,6 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 
 	// traverse the schema and collect generated files
 	if node.Mill != "" {
-		var res string
-		file := &pb.FileIndex{}
-
 		mopts := newMillOpts(node.Opts)
 		mopts.setPlaintext(node.Plaintext)
 
@@ -330,14 +319,15 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 			ctype = ct
 		}
 
-		var err error
-		res, file, err = handleStep(node.Mill, reader, mopts, ctype)
+		file, err := handleStep(node.Mill, reader, mopts, ctype)
 		if err != nil {
 			return nil, err
 		}
 
 		if verbose {
-			output(res)
+			if err := outputPb(file); err != nil {
+				return nil, err
+			}
 		}
 
 		dir.Files[schema.SingleFileTag] = file
@@ -352,9 +342,7 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 
 		// send each link
 		for _, step := range steps {
-			var res string
-			file := &pb.FileIndex{}
-
+			var file *pb.FileIndex
 			mopts := newMillOpts(step.Link.Opts)
 			mopts.setPlaintext(step.Link.Plaintext)
 
@@ -377,7 +365,7 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 					}
 				}
 
-				res, file, err = handleStep(step.Link.Mill, reader, mopts, ctype)
+				file, err = handleStep(step.Link.Mill, reader, mopts, ctype)
 				if err != nil {
 					return nil, err
 				}
@@ -388,16 +376,16 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 				}
 				mopts.setUse(dir.Files[step.Link.Use].Hash)
 
-				res, err = executeJsonPbCmd(POST, "mills"+step.Link.Mill, params{
-					opts: mopts.val,
-				}, file)
+				file, err = api.Mill(step.Link.Mill, mopts.val, nil, "")
 				if err != nil {
 					return nil, err
 				}
 			}
 
 			if verbose {
-				output(res)
+				if err := outputPb(file); err != nil {
+					return nil, err
+				}
 			}
 
 			dir.Files[step.Name] = file
@@ -447,19 +435,8 @@ func batchPaths(pths []string, size int) [][]string {
 	return batches
 }
 
-func handleStep(mil string, reader io.Reader, opts millOpts, ctype string) (string, *pb.FileIndex, error) {
-	var file pb.FileIndex
-
-	res, err := executeJsonPbCmd(POST, "mills"+mil, params{
-		opts:    opts.val,
-		payload: reader,
-		ctype:   ctype,
-	}, &file)
-	if err != nil {
-		return "", nil, err
-	}
-
-	return res, &file, nil
+func handleStep(mil string, reader io.Reader, opts millOpts, ctype string) (*pb.FileIndex, error) {
+	return api.Mill(mil, opts.val, reader, ctype)
 }
 
 func multipartReader(f *os.File) (io.ReadSeeker, string, error) {
@@ -493,29 +470,22 @@ Specify "default" to use the default thread (if selected).`
 
 func (x *lsFilesCmd) Execute(args []string) error {
 	setApi(x.Client)
-	opts := map[string]string{
-		"thread": x.Thread,
-		"offset": x.Offset,
-		"limit":  strconv.Itoa(x.Limit),
-	}
-	return callLsFiles(opts)
+	return callLsFiles(x.Thread, x.Offset, x.Limit)
 }
 
-func callLsFiles(opts map[string]string) error {
-	var list pb.FilesList
-	res, err := executeJsonPbCmd(GET, "files", params{opts: opts}, &list)
+func callLsFiles(thread string, offset string, limit int) error {
+	list, err := api.Files(thread, offset, limit)
 	if err != nil {
 		return err
 	}
+
 	if len(list.Items) > 0 {
-		output(res)
+		if err := outputPb(list); err != nil {
+			return err
+		}
 	}
 
-	limit, err := strconv.Atoi(opts["limit"])
-	if err != nil {
-		return err
-	}
-	if len(list.Items) < limit {
+	if list.Next == "" {
 		return nil
 	}
 
@@ -525,11 +495,7 @@ func callLsFiles(opts map[string]string) error {
 		return err
 	}
 
-	return callLsFiles(map[string]string{
-		"thread": opts["thread"],
-		"offset": list.Items[len(list.Items)-1].Block,
-		"limit":  opts["limit"],
-	})
+	return callLsFiles(thread, list.Next, limit)
 }
 
 type getFilesCmd struct {
@@ -548,13 +514,11 @@ func (x *getFilesCmd) Execute(args []string) error {
 		return errMissingFileId
 	}
 
-	res, err := executeJsonCmd(GET, "files/"+util.TrimQuotes(args[0]), params{}, nil)
+	files, err := api.File(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-
-	output(res)
-	return nil
+	return outputPb(files)
 }
 
 type rmFilesCmd struct {
@@ -590,10 +554,9 @@ func (x *keysCmd) Execute(args []string) error {
 		return errMissingTarget
 	}
 
-	res, err := executeJsonCmd(GET, "keys/"+util.TrimQuotes(args[0]), params{}, nil)
+	keys, err := api.FileKeys(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(keys)
 }
@@ -2,8 +2,6 @@ package cmd
 
 import (
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/textileio/go-textile/pb"
@@ -62,27 +60,26 @@ func (x *createInvitesCmd) Execute(args []string) error {
 	}
 
 	if x.Address != "" {
-		contact, _, _ := callGetContacts(x.Address)
+		contact, _ := api.Contact(x.Address)
 		if contact != nil {
 			return callCreateInvites(x.Thread, x.Address)
 		}
 
 		output("Could not find contact locally, searching network...")
 
-		results := handleSearchStream("contacts/search", params{
-			opts: map[string]string{
-				"address": x.Address,
-				"limit":   strconv.Itoa(10),
-				"wait":    strconv.Itoa(x.Wait),
-			},
-		})
+		results := handleSearchStream(api.SearchContacts(&pb.ContactQuery{
+			Address: x.Address,
+		}, &pb.QueryOptions{
+			Limit: 10,
+			Wait:  int32(x.Wait),
+		}))
 
 		if len(results) == 0 {
 			output("Could not find contact")
 			return nil
 		}
 
-		remote := make(map[string]pb.QueryResult)
+		remote := make(map[string]*pb.QueryResult)
 		for _, res := range results {
 			if !res.Local {
 				remote[res.Id] = res // overwrite with newer / more complete result
@@ -102,40 +99,29 @@ func (x *createInvitesCmd) Execute(args []string) error {
 		if err := ptypes.UnmarshalAny(result.Value, contact); err != nil {
 			return err
 		}
-		data, err := pbMarshaler.MarshalToString(result.Value)
-		if err != nil {
-			return err
-		}
-
-		res, err := executeStringCmd(PUT, "contacts/"+contact.Address, params{
-			payload: strings.NewReader(data),
-			ctype:   "application/json",
-		})
-		if err != nil {
-			return err
-		}
-		if res == "" {
-			output("added " + result.Id)
-		} else {
-			return fmt.Errorf("error adding %s: %s", result.Id, res)
+		if err := api.AddContact(contact); err != nil {
+			return fmt.Errorf("error adding %s: %s", result.Id, err)
 		}
+		output("added " + result.Id)
 	}
 
 	return callCreateInvites(x.Thread, x.Address)
 }
 
 func callCreateInvites(thread string, address string) error {
-	res, err := executeJsonCmd(POST, "invites", params{
-		opts: map[string]string{
-			"thread":  thread,
-			"address": address,
-		},
-	}, nil)
+	if address != "" {
+		if err := api.AddInvite(thread, address); err != nil {
+			return err
+		}
+		output("ok")
+		return nil
+	}
+
+	invite, err := api.AddExternalInvite(thread)
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(invite)
 }
 
 type lsInvitesCmd struct {
@@ -151,12 +137,11 @@ Lists all pending thread invites.`
 func (x *lsInvitesCmd) Execute(_ []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(GET, "invites", params{}, nil)
+	list, err := api.Invites()
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(list)
 }
 
 type acceptInvitesCmd struct {
@@ -177,17 +162,11 @@ func (x *acceptInvitesCmd) Execute(args []string) error {
 		return errMissingInviteId
 	}
 
-	res, err := executeJsonCmd(POST, "invites/"+util.TrimQuotes(args[0])+"/accept", params{
-		args: args,
-		opts: map[string]string{
-			"key": x.Key,
-		},
-	}, nil)
+	block, err := api.AcceptExternalInvite(util.TrimQuotes(args[0]), x.Key)
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(block)
 }
 
 type ignoreInvitesCmd struct {
@@ -206,12 +185,9 @@ func (x *ignoreInvitesCmd) Execute(args []string) error {
 		return errMissingInviteId
 	}
 
-	res, err := executeStringCmd(POST, "invites/"+util.TrimQuotes(args[0])+"/ignore", params{
-		args: args,
-	})
-	if err != nil {
+	if err := api.IgnoreInvite(util.TrimQuotes(args[0])); err != nil {
 		return err
 	}
-	output(res)
+	output("ok")
 	return nil
 }
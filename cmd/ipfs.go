@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"strconv"
 
 	"github.com/textileio/go-textile/util"
 )
@@ -46,11 +45,11 @@ Shows the local node's IPFS peer ID.`
 
 func (x *ipfsIdCmd) Execute(args []string) error {
 	setApi(x.Client)
-	res, err := executeStringCmd(GET, "ipfs/id", params{})
+	id, err := api.IpfsPeerId()
 	if err != nil {
 		return err
 	}
-	output(res)
+	output(id)
 	return nil
 }
 
@@ -84,14 +83,11 @@ func (x *ipfsSwarmConnectCmd) Execute(args []string) error {
 		return errMissingMultiAddress
 	}
 
-	res, err := executeJsonCmd(POST, "ipfs/swarm/connect", params{
-		args: args,
-	}, nil)
+	res, err := api.IpfsSwarmConnect(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputJson(res)
 }
 
 type ipfsSwarmPeersCmd struct {
@@ -111,19 +107,11 @@ Lists the set of peers this node is connected to.`
 func (x *ipfsSwarmPeersCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(GET, "ipfs/swarm/peers", params{
-		opts: map[string]string{
-			"verbose":   strconv.FormatBool(x.Verbose),
-			"streams":   strconv.FormatBool(x.Streams),
-			"latency":   strconv.FormatBool(x.Latency),
-			"direction": strconv.FormatBool(x.Direction),
-		},
-	}, nil)
+	peers, err := api.IpfsSwarmPeers(x.Verbose, x.Latency, x.Streams, x.Direction)
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputJson(peers)
 }
 
 type ipfsCatCmd struct {
@@ -143,23 +131,13 @@ func (x *ipfsCatCmd) Execute(args []string) error {
 		return errMissingCID
 	}
 
-	res, _, err := request(GET, "ipfs/cat/"+util.TrimQuotes(args[0]), params{
-		opts: map[string]string{"key": x.Key},
-	})
+	data, err := api.IpfsCat(util.TrimQuotes(args[0]), x.Key)
 	if err != nil {
 		return err
 	}
-	defer res.Body.Close()
-
-	if res.StatusCode >= 400 {
-		body, err := util.UnmarshalString(res.Body)
-		if err != nil {
-			return err
-		}
-		return fmt.Errorf(body)
-	}
+	defer data.Close()
 
-	if _, err := io.Copy(os.Stdout, res.Body); err != nil {
+	if _, err := io.Copy(os.Stdout, data); err != nil {
 		return err
 	}
 
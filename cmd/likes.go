@@ -48,12 +48,11 @@ Adds a like to a thread block.`
 func (x *addLikesCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(POST, "blocks/"+x.Block+"/likes", params{}, nil)
+	like, err := api.AddLike(x.Block)
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(like)
 }
 
 type lsLikesCmd struct {
@@ -70,12 +69,11 @@ Lists likes on a thread block.`
 func (x *lsLikesCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(GET, "blocks/"+x.Block+"/likes", params{}, nil)
+	likes, err := api.Likes(x.Block)
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(likes)
 }
 
 type getLikesCmd struct {
@@ -94,12 +92,11 @@ func (x *getLikesCmd) Execute(args []string) error {
 		return errMissingLikeId
 	}
 
-	res, err := executeJsonCmd(GET, "blocks/"+util.TrimQuotes(args[0])+"/like", params{}, nil)
+	like, err := api.Like(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(like)
 }
 
 type rmLikesCmd struct {
@@ -1,7 +1,5 @@
 package cmd
 
-import "strconv"
-
 func init() {
 	register(&logsCmd{})
 }
@@ -29,28 +27,19 @@ Textile logs piggyback on the IPFS event logs.`
 
 func (x *logsCmd) Execute(args []string) error {
 	setApi(x.Client)
-	opts := map[string]string{
-		"subsystem": x.Subsystem,
-		"level":     x.Level,
-		"tex-only":  strconv.FormatBool(x.TexOnly),
-	}
-	return callLogs(opts)
+	return callLogs(x.Subsystem, x.Level, x.TexOnly)
 }
 
-func callLogs(opts map[string]string) error {
-	subsystem := opts["subsystem"]
-	if subsystem != "" {
-		subsystem = "/" + subsystem
+func callLogs(subsystem string, level string, texOnly bool) error {
+	var levels map[string]string
+	var err error
+	if level != "" {
+		levels, err = api.SetLogLevel(subsystem, level, texOnly)
+	} else {
+		levels, err = api.Logs(subsystem, texOnly)
 	}
-	method := GET
-	if opts["level"] != "" {
-		method = POST
-	}
-
-	res, err := executeJsonCmd(method, "logs"+subsystem, params{opts: opts}, nil)
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputJson(levels)
 }
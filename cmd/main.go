@@ -2,14 +2,9 @@ package cmd
 
 import (
 	"bufio"
-	"bytes"
 	"encoding/json"
 	"fmt"
-	"io"
-	"io/ioutil"
 	"log"
-	"net/http"
-	"net/url"
 	"os"
 	"os/signal"
 	"strings"
@@ -17,13 +12,15 @@ import (
 	"github.com/fatih/color"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
+	"github.com/textileio/go-textile/client"
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/util"
 )
 
 type ClientOptions struct {
 	ApiAddr    string `long:"api" description:"API address to use" default:"http://127.0.0.1:40600"`
-	ApiVersion string `long:"api-version" description:"API version to use" default:"v0"`
+	ApiVersion string `long:"api-version" description:"API version to use (v0 or v1)" default:"v0"`
+	ApiKey     string `long:"api-key" description:"API key to use. Defaults to the key saved for the API address in the local credentials file."`
 }
 
 var (
@@ -33,19 +30,27 @@ var (
 	Yellow = color.New(color.FgHiYellow).SprintFunc()
 )
 
-var apiAddr, apiVersion string
+// api is the client used by commands, set by setApi
+var api *client.Client
 
 func setApi(opts ClientOptions) {
+	conf := client.Config{
+		Addr:    opts.ApiAddr,
+		Version: opts.ApiVersion,
+		ApiKey:  opts.ApiKey,
+	}
 	if os.Getenv("API") != "" {
-		apiAddr = os.Getenv("API")
-	} else {
-		apiAddr = opts.ApiAddr
+		conf.Addr = os.Getenv("API")
 	}
 	if os.Getenv("API_VERSION") != "" {
-		apiVersion = os.Getenv("API_VERSION")
-	} else {
-		apiVersion = opts.ApiVersion
+		conf.Version = os.Getenv("API_VERSION")
+	}
+	if os.Getenv("API_KEY") != "" {
+		conf.ApiKey = os.Getenv("API_KEY")
+	} else if conf.ApiKey == "" {
+		conf.ApiKey = ReadCredential(conf.Addr)
 	}
+	api = client.New(conf)
 }
 
 var cmds []Cmd
@@ -64,142 +69,6 @@ func register(cmd Cmd) {
 	cmds = append(cmds, cmd)
 }
 
-type method string
-
-const (
-	GET   method = "GET"
-	POST  method = "POST"
-	PUT   method = "PUT"
-	DEL   method = "DELETE"
-	PATCH method = "PATCH"
-)
-
-type params struct {
-	args    []string
-	opts    map[string]string
-	payload io.Reader
-	ctype   string
-}
-
-func executeStringCmd(meth method, pth string, pars params) (string, error) {
-	res, _, err := request(meth, pth, pars)
-	if err != nil {
-		return "", err
-	}
-	defer res.Body.Close()
-
-	body, err := util.UnmarshalString(res.Body)
-	if err != nil {
-		return "", err
-	}
-
-	return body, nil
-}
-
-func executeJsonCmd(meth method, pth string, pars params, target interface{}) (string, error) {
-	res, _, err := request(meth, pth, pars)
-	if err != nil {
-		return "", err
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode >= 400 {
-		body, err := util.UnmarshalString(res.Body)
-		if err != nil {
-			return "", err
-		}
-		return "", fmt.Errorf(body)
-	}
-
-	data, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return "", err
-	}
-	if len(data) == 0 {
-		return "", nil
-	}
-
-	if target == nil {
-		target = new(interface{})
-	}
-	if err := json.Unmarshal(data, target); err != nil {
-		return "", err
-	}
-	jsn, err := json.MarshalIndent(target, "", "    ")
-	if err != nil {
-		return "", err
-	}
-
-	return string(jsn), nil
-}
-
-func executeJsonPbCmd(meth method, pth string, pars params, target proto.Message) (string, error) {
-	res, _, err := request(meth, pth, pars)
-	if err != nil {
-		return "", err
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode >= 400 {
-		body, err := util.UnmarshalString(res.Body)
-		if err != nil {
-			return "", err
-		}
-		return "", fmt.Errorf(body)
-	}
-
-	data, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return "", err
-	}
-	if err := pbUnmarshaler.Unmarshal(bytes.NewReader(data), target); err != nil {
-		return "", err
-	}
-	jsn, err := pbMarshaler.MarshalToString(target)
-	if err != nil {
-		return "", err
-	}
-
-	return jsn, nil
-}
-
-func request(meth method, pth string, pars params) (*http.Response, func(), error) {
-	apiUrl := fmt.Sprintf("%s/api/%s/%s", apiAddr, apiVersion, pth)
-	req, err := http.NewRequest(string(meth), apiUrl, pars.payload)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if len(pars.args) > 0 {
-		var args []string
-		for _, arg := range pars.args {
-			args = append(args, url.PathEscape(arg))
-		}
-		req.Header.Set("X-Textile-Args", strings.Join(args, ","))
-	}
-
-	if len(pars.opts) > 0 {
-		var items []string
-		for k, v := range pars.opts {
-			items = append(items, k+"="+url.PathEscape(v))
-		}
-		req.Header.Set("X-Textile-Opts", strings.Join(items, ","))
-	}
-
-	if pars.ctype != "" {
-		req.Header.Set("Content-Type", pars.ctype)
-	}
-
-	tr := &http.Transport{}
-	client := &http.Client{Transport: tr}
-	res, err := client.Do(req)
-	cancel := func() {
-		tr.CancelRequest(req)
-	}
-
-	return res, cancel, err
-}
-
 var errMissingSearchInfo = fmt.Errorf("missing search info")
 
 var pbMarshaler = jsonpb.Marshaler{
@@ -207,80 +76,45 @@ var pbMarshaler = jsonpb.Marshaler{
 	Indent:   "    ",
 }
 
-var pbUnmarshaler = jsonpb.Unmarshaler{
-	AllowUnknownFields: true,
-}
-
-func handleSearchStream(pth string, param params) []pb.QueryResult {
-	var results []pb.QueryResult
-	outputCh := make(chan interface{})
+// handleSearchStream outputs search results as they arrive, returning them
+// once the search completes. An interrupt cancels the search and exits.
+func handleSearchStream(resultCh <-chan *pb.QueryResult, errCh <-chan error, cancel func(), err error) []*pb.QueryResult {
+	if err != nil {
+		output(err.Error())
+		return nil
+	}
+	defer cancel()
 
-	cancel := func() {}
-	done := make(chan struct{})
 	quit := make(chan os.Signal)
 	signal.Notify(quit, os.Interrupt)
 
-	go func() {
-		defer func() {
-			cancel()
-			done <- struct{}{}
-		}()
-
-		var res *http.Response
-		var err error
-		res, cancel, err = request(POST, pth, param)
-		if err != nil {
-			outputCh <- err.Error()
-			return
-		}
-		defer res.Body.Close()
-
-		if res.StatusCode >= 400 {
-			body, err := util.UnmarshalString(res.Body)
-			if err != nil {
-				outputCh <- err.Error()
-			} else {
-				outputCh <- body
-			}
-			return
-		}
-
-		decoder := json.NewDecoder(res.Body)
-		for decoder.More() {
-			var result pb.QueryResult
-			if err := pbUnmarshaler.UnmarshalNext(decoder, &result); err == io.EOF {
-				return
-			} else if err != nil {
-				outputCh <- err.Error()
-				return
+	var results []*pb.QueryResult
+	for {
+		select {
+		case result, ok := <-resultCh:
+			if !ok {
+				select {
+				case err := <-errCh:
+					output(err.Error())
+				default:
+				}
+				return results
 			}
 			results = append(results, result)
 
-			out, err := pbMarshaler.MarshalToString(&result)
+			out, err := pbMarshaler.MarshalToString(result)
 			if err != nil {
-				outputCh <- err.Error()
-				return
+				output(err.Error())
+				return results
 			}
-			outputCh <- out
-		}
-	}()
-
-	for {
-		select {
-		case val := <-outputCh:
-			output(val)
+			output(out)
 
 		case <-quit:
 			fmt.Println("Interrupted")
-			if cancel != nil {
-				fmt.Printf("Canceling...")
-				cancel()
-			}
+			fmt.Printf("Canceling...")
+			cancel()
 			fmt.Print("done\n")
 			os.Exit(1)
-
-		case <-done:
-			return results
 		}
 	}
 }
@@ -306,6 +140,34 @@ func confirm(q string) bool {
 	}
 }
 
+// optionalArg returns the first arg, or an empty string if there are none
+func optionalArg(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return util.TrimQuotes(args[0])
+}
+
+// outputPb outputs a protobuf message as indented JSON
+func outputPb(msg proto.Message) error {
+	out, err := pbMarshaler.MarshalToString(msg)
+	if err != nil {
+		return err
+	}
+	output(out)
+	return nil
+}
+
+// outputJson outputs a value as indented JSON
+func outputJson(val interface{}) error {
+	out, err := json.MarshalIndent(val, "", "    ")
+	if err != nil {
+		return err
+	}
+	output(string(out))
+	return nil
+}
+
 func output(val interface{}) {
 	if val.(string) == "" {
 		val = "ok"
@@ -4,9 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
-	"strconv"
 
-	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/util"
 )
 
@@ -61,22 +59,11 @@ func (x *addMessagesCmd) Execute(args []string) error {
 		x.Thread = "default"
 	}
 
-	res, err := callAddMessages(x.Thread, args[0])
+	msg, err := api.AddMessage(x.Thread, args[0])
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
-}
-
-func callAddMessages(threadId string, body string) (string, error) {
-	res, err := executeJsonCmd(POST, "threads/"+threadId+"/messages", params{
-		args: []string{body},
-	}, nil)
-	if err != nil {
-		return "", err
-	}
-	return res, nil
+	return outputPb(msg)
 }
 
 type lsMessagesCmd struct {
@@ -96,29 +83,22 @@ Specify "default" to use the default thread (if selected).`
 
 func (x *lsMessagesCmd) Execute(args []string) error {
 	setApi(x.Client)
-	opts := map[string]string{
-		"thread": x.Thread,
-		"offset": x.Offset,
-		"limit":  strconv.Itoa(x.Limit),
-	}
-	return callLsMessages(opts)
+	return callLsMessages(x.Thread, x.Offset, x.Limit)
 }
 
-func callLsMessages(opts map[string]string) error {
-	var list pb.TextList
-	res, err := executeJsonPbCmd(GET, "messages", params{opts: opts}, &list)
+func callLsMessages(thread string, offset string, limit int) error {
+	list, err := api.Messages(thread, offset, limit)
 	if err != nil {
 		return err
 	}
+
 	if len(list.Items) > 0 {
-		output(res)
+		if err := outputPb(list); err != nil {
+			return err
+		}
 	}
 
-	limit, err := strconv.Atoi(opts["limit"])
-	if err != nil {
-		return err
-	}
-	if len(list.Items) < limit {
+	if list.Next == "" {
 		return nil
 	}
 	reader := bufio.NewReader(os.Stdin)
@@ -127,11 +107,7 @@ func callLsMessages(opts map[string]string) error {
 		return err
 	}
 
-	return callLsMessages(map[string]string{
-		"thread": opts["thread"],
-		"offset": list.Items[len(list.Items)-1].Block,
-		"limit":  opts["limit"],
-	})
+	return callLsMessages(thread, list.Next, limit)
 }
 
 type getMessagesCmd struct {
@@ -150,12 +126,11 @@ func (x *getMessagesCmd) Execute(args []string) error {
 		return errMissingMessageId
 	}
 
-	res, err := executeJsonCmd(GET, "messages/"+util.TrimQuotes(args[0]), params{}, nil)
+	msg, err := api.Message(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(msg)
 }
 
 type rmMessagesCmd struct {
@@ -2,7 +2,11 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/util"
 )
 
@@ -13,8 +17,9 @@ func init() {
 }
 
 type notificationsCmd struct {
-	List lsNotificationsCmd   `command:"ls" description:"List notifications"`
-	Read readNotificationsCmd `command:"read" description:"Mark notification(s) as read"`
+	List   lsNotificationsCmd   `command:"ls" description:"List notifications"`
+	Read   readNotificationsCmd `command:"read" description:"Mark notification(s) as read"`
+	Remove rmNotificationsCmd   `command:"rm" description:"Remove notification(s)"`
 }
 
 func (x *notificationsCmd) Name() string {
@@ -28,39 +33,60 @@ func (x *notificationsCmd) Short() string {
 func (x *notificationsCmd) Long() string {
 	return `
 Notifications are generated by thread and account activity.
-Use this command to list, get, and mark notifications as read.`
+Use this command to list, get, mark as read, and remove notifications.
+
+Notifications outside of the retention policy in the node's config
+(Notifications.MaxAge and Notifications.MaxCount) are pruned automatically.`
 }
 
 type lsNotificationsCmd struct {
 	Client ClientOptions `group:"Client Options"`
+	Thread string        `short:"t" long:"thread" description:"Thread ID. Omit for all."`
+	Type   string        `long:"type" description:"Or'd list of notification types (e.g., FILES_ADDED|COMMENT_ADDED). Omit for all."`
+	State  string        `short:"s" long:"state" description:"Read state. One of: read, unread. Omit for both."`
+	Before string        `short:"b" long:"before" description:"Only list notifications older than this RFC3339 date."`
+	Offset string        `short:"o" long:"offset" description:"Offset ID to start listing from."`
+	Limit  int           `short:"l" long:"limit" description:"List page size." default:"-1"`
 }
 
 func (x *lsNotificationsCmd) Usage() string {
 	return `
 
-Lists notifications.`
+Lists notifications.
+Omit the --thread option to list notifications for all threads.
+Specify "default" to use the default thread (if selected).`
 }
 
 func (x *lsNotificationsCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(GET, "notifications", params{}, nil)
+	req, err := notificationRequest(x.Thread, x.Type, x.State, x.Before)
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	req.Offset = x.Offset
+	req.Limit = int32(x.Limit)
+
+	list, err := api.Notifications(req)
+	if err != nil {
+		return err
+	}
+	return outputPb(list)
 }
 
 type readNotificationsCmd struct {
 	Client ClientOptions `group:"Client Options"`
+	Thread string        `short:"t" long:"thread" description:"Thread ID. Only applies to 'all'."`
+	Type   string        `long:"type" description:"Or'd list of notification types (e.g., FILES_ADDED|COMMENT_ADDED). Only applies to 'all'."`
+	Before string        `short:"b" long:"before" description:"Only mark notifications older than this RFC3339 date. Only applies to 'all'."`
 }
 
 func (x *readNotificationsCmd) Usage() string {
 	return `
 
 Marks a notifiction as read by ID.
-"textile notifications read all" marks all as read.`
+"textile notifications read all" marks all as read.
+Use the --thread, --type, and --before options to only mark matching notifications as read.`
 }
 
 func (x *readNotificationsCmd) Execute(args []string) error {
@@ -69,10 +95,100 @@ func (x *readNotificationsCmd) Execute(args []string) error {
 	}
 	setApi(x.Client)
 
-	res, err := executeStringCmd(POST, "notifications/"+util.TrimQuotes(args[0])+"/read", params{})
-	if err != nil {
+	id := util.TrimQuotes(args[0])
+	if id == "all" {
+		req, err := notificationRequest(x.Thread, x.Type, "", x.Before)
+		if err != nil {
+			return err
+		}
+		if err := api.ReadNotifications(req); err != nil {
+			return err
+		}
+	} else if err := api.ReadNotification(id); err != nil {
 		return err
 	}
-	output(res)
+	output("ok")
 	return nil
 }
+
+type rmNotificationsCmd struct {
+	Client ClientOptions `group:"Client Options"`
+	Thread string        `short:"t" long:"thread" description:"Thread ID. Only applies to 'all'."`
+	Type   string        `long:"type" description:"Or'd list of notification types (e.g., FILES_ADDED|COMMENT_ADDED). Only applies to 'all'."`
+	State  string        `short:"s" long:"state" description:"Read state. One of: read, unread. Only applies to 'all'."`
+	Before string        `short:"b" long:"before" description:"Only remove notifications older than this RFC3339 date. Only applies to 'all'."`
+}
+
+func (x *rmNotificationsCmd) Usage() string {
+	return `
+
+Removes a notification by ID.
+"textile notifications rm all" removes all notifications.
+Use the --thread, --type, --state, and --before options to only remove matching notifications.`
+}
+
+func (x *rmNotificationsCmd) Execute(args []string) error {
+	if len(args) == 0 {
+		return errMissingNoteId
+	}
+	setApi(x.Client)
+
+	id := util.TrimQuotes(args[0])
+	if id == "all" {
+		req, err := notificationRequest(x.Thread, x.Type, x.State, x.Before)
+		if err != nil {
+			return err
+		}
+		if err := api.RemoveNotifications(req); err != nil {
+			return err
+		}
+	} else if err := api.RemoveNotification(id); err != nil {
+		return err
+	}
+	output("ok")
+	return nil
+}
+
+// notificationRequest builds a request from the common notification options
+func notificationRequest(thread string, types string, state string, before string) (*pb.NotificationRequest, error) {
+	req := &pb.NotificationRequest{Thread: thread}
+
+	for _, t := range util.SplitString(types, "|") {
+		typ, ok := pb.Notification_Type_value[strings.ToUpper(t)]
+		if !ok {
+			return nil, fmt.Errorf("invalid notification type: %s", t)
+		}
+		req.Types = append(req.Types, pb.Notification_Type(typ))
+	}
+
+	read, err := notificationReadOpt(state)
+	if err != nil {
+		return nil, err
+	}
+	req.Read = read
+
+	if before != "" {
+		t, err := time.Parse(time.RFC3339, before)
+		if err != nil {
+			return nil, err
+		}
+		req.Before, err = ptypes.TimestampProto(t)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+func notificationReadOpt(state string) (pb.NotificationRequest_ReadState, error) {
+	switch state {
+	case "":
+		return pb.NotificationRequest_ANY, nil
+	case "read":
+		return pb.NotificationRequest_READ, nil
+	case "unread":
+		return pb.NotificationRequest_UNREAD, nil
+	default:
+		return 0, fmt.Errorf("invalid read state: %s", state)
+	}
+}
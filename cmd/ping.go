@@ -22,7 +22,7 @@ func (x *pingCmd) Long() string {
 
 func (x *pingCmd) Execute(args []string) error {
 	setApi(x.Client)
-	res, err := executeStringCmd(GET, "ping", params{args: args})
+	res, err := api.Ping(optionalArg(args))
 	if err != nil {
 		return err
 	}
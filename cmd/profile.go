@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/textileio/go-textile/keypair"
-	"github.com/textileio/go-textile/pb"
 )
 
 var errMissingName = fmt.Errorf("missing name")
@@ -46,21 +45,11 @@ Gets the local peer profile.`
 
 func (x *getProfileCmd) Execute(args []string) error {
 	setApi(x.Client)
-	res, _, err := callGetProfile()
+	profile, err := api.Profile()
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
-}
-
-func callGetProfile() (string, *pb.Peer, error) {
-	var profile pb.Peer
-	res, err := executeJsonPbCmd(GET, "profile", params{}, &profile)
-	if err != nil {
-		return "", nil, err
-	}
-	return res, &profile, err
+	return outputPb(profile)
 }
 
 type setProfileCmd struct {
@@ -89,11 +78,10 @@ func (x *setNameCmd) Execute(args []string) error {
 	if len(args) == 0 {
 		return errMissingName
 	}
-	res, err := executeStringCmd(POST, "profile/name", params{args: args})
-	if err != nil {
+	if err := api.SetName(args[0]); err != nil {
 		return err
 	}
-	output(res)
+	output("ok")
 	return nil
 }
 
@@ -110,7 +98,7 @@ Sets the peer avatar from an image path (JPEG, PNG, or GIF).`
 func (x *setAvatarCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	_, contact, err := callGetAccount()
+	contact, err := api.Account()
 	if err != nil {
 		return err
 	}
@@ -130,10 +118,9 @@ func (x *setAvatarCmd) Execute(args []string) error {
 		return err
 	}
 
-	res, err := executeStringCmd(POST, "profile/avatar", params{})
-	if err != nil {
+	if err := api.SetAvatar(); err != nil {
 		return err
 	}
-	output(res)
+	output("ok")
 	return nil
 }
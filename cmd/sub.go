@@ -1,14 +1,5 @@
 package cmd
 
-import (
-	"encoding/json"
-	"io"
-	"strings"
-
-	"github.com/textileio/go-textile/pb"
-	"github.com/textileio/go-textile/util"
-)
-
 func init() {
 	register(&subscribeCmd{})
 }
@@ -17,6 +8,7 @@ type subscribeCmd struct {
 	Client ClientOptions `group:"Client Options"`
 	Thread string        `short:"t" long:"thread" description:"Thread ID. Omit for all."`
 	Type   []string      `short:"k" long:"type" description:"An update type to filter for. Omit for all."`
+	Since  string        `short:"s" long:"since" description:"Replay updates since this block ID or RFC3339 date before streaming new ones."`
 }
 
 func (x *subscribeCmd) Name() string {
@@ -46,76 +38,30 @@ There are several update types:
 -  LIKE
 
 Use the --thread option to subscribe to events emmitted from a specific thread.
-The --type option can be used multiple times, e.g., --type files --type comment.`
+The --type option can be used multiple times, e.g., --type files --type comment.
+Use the --since option with the block ID of the last update received to replay
+updates that were missed while disconnected.`
 }
 
 func (x *subscribeCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	updates, err := callSub(x.Thread, x.Type)
+	updates, errs, cancel, err := api.Subscribe(x.Thread, x.Type, x.Since)
 	if err != nil {
 		return err
 	}
+	defer cancel()
 
-	for {
-		select {
-		case update, ok := <-updates:
-			if !ok {
-				return nil
-			}
-
-			out, err := pbMarshaler.MarshalToString(update)
-			if err == io.EOF {
-				break
-			} else if err != nil {
-				return err
-			}
-			output(out)
+	for update := range updates {
+		if err := outputPb(update); err != nil {
+			return err
 		}
 	}
-}
 
-func callSub(threadId string, types []string) (<-chan *pb.FeedItem, error) {
-	if threadId != "" {
-		threadId = "/" + threadId
+	select {
+	case err := <-errs:
+		return err
+	default:
+		return nil
 	}
-
-	updates := make(chan *pb.FeedItem, 10)
-	go func() {
-		defer close(updates)
-
-		res, cancel, err := request(GET, "subscribe"+threadId, params{
-			opts: map[string]string{"type": strings.Join(types, "|")},
-		})
-		if err != nil {
-			output(err.Error())
-			return
-		}
-		defer res.Body.Close()
-		defer cancel()
-
-		if res.StatusCode >= 400 {
-			body, err := util.UnmarshalString(res.Body)
-			if err != nil {
-				output(err.Error())
-			} else {
-				output(body)
-			}
-			return
-		}
-
-		decoder := json.NewDecoder(res.Body)
-		for decoder.More() {
-			var update pb.FeedItem
-			if err := pbUnmarshaler.UnmarshalNext(decoder, &update); err == io.EOF {
-				return
-			} else if err != nil {
-				output(err.Error())
-				return
-			}
-			updates <- &update
-		}
-	}()
-
-	return updates, nil
 }
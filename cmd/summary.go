@@ -1,7 +1,5 @@
 package cmd
 
-import "github.com/textileio/go-textile/pb"
-
 func init() {
 	register(&summaryCmd{})
 }
@@ -24,11 +22,9 @@ func (x *summaryCmd) Long() string {
 
 func (x *summaryCmd) Execute(args []string) error {
 	setApi(x.Client)
-	var info pb.Summary
-	res, err := executeJsonPbCmd(GET, "summary", params{}, &info)
+	info, err := api.Summary()
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(info)
 }
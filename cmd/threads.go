@@ -1,18 +1,15 @@
 package cmd
 
 import (
-	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
-	"strconv"
 	"strings"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/jessevdk/go-flags"
 	"github.com/mitchellh/go-homedir"
 	"github.com/textileio/go-textile/pb"
-	"github.com/textileio/go-textile/schema/textile"
 	"github.com/textileio/go-textile/util"
 )
 
@@ -88,7 +85,16 @@ Adds and joins a new thread. See 'textile threads --help' for more about threads
 func (x *addThreadsCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	var body []byte
+	typ, ok := pb.Thread_Type_value[strings.ToUpper(x.Type)]
+	if !ok {
+		return fmt.Errorf("invalid thread type: %s", x.Type)
+	}
+	sharing, ok := pb.Thread_Sharing_value[strings.ToUpper(x.Sharing)]
+	if !ok {
+		return fmt.Errorf("invalid thread sharing: %s", x.Sharing)
+	}
+
+	schema := &pb.AddThreadConfig_Schema{Id: x.Schema}
 	if x.Schema == "" {
 		if x.SchemaFile != "" {
 			path, err := homedir.Expand(string(x.SchemaFile))
@@ -102,45 +108,32 @@ func (x *addThreadsCmd) Execute(args []string) error {
 			}
 			defer file.Close()
 
-			body, err = ioutil.ReadAll(file)
+			body, err := ioutil.ReadAll(file)
 			if err != nil {
 				return err
 			}
+			schema.Json = string(body)
 		} else if x.Blob {
-			body = []byte(textile.Blob)
+			schema.Preset = pb.AddThreadConfig_Schema_BLOB
 		} else if x.CameraRoll {
-			body = []byte(textile.CameraRoll)
+			schema.Preset = pb.AddThreadConfig_Schema_CAMERA_ROLL
 		} else if x.Media {
-			body = []byte(textile.Media)
-		}
-	}
-
-	if body != nil {
-		var schemaf pb.FileIndex
-		if _, err := executeJsonPbCmd(POST, "mills/schema", params{
-			payload: bytes.NewReader(body),
-			ctype:   "application/json",
-		}, &schemaf); err != nil {
-			return err
+			schema.Preset = pb.AddThreadConfig_Schema_MEDIA
 		}
-		x.Schema = schemaf.Hash
 	}
 
-	res, err := executeJsonCmd(POST, "threads", params{
-		args: args,
-		opts: map[string]string{
-			"key":       x.Key,
-			"type":      x.Type,
-			"sharing":   x.Sharing,
-			"whitelist": strings.Join(x.Whitelist, ","),
-			"schema":    x.Schema,
-		},
-	}, nil)
+	thrd, err := api.AddThread(&pb.AddThreadConfig{
+		Key:       x.Key,
+		Name:      optionalArg(args),
+		Schema:    schema,
+		Type:      pb.Thread_Type(typ),
+		Sharing:   pb.Thread_Sharing(sharing),
+		Whitelist: x.Whitelist,
+	})
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(thrd)
 }
 
 type lsThreadsCmd struct {
@@ -156,12 +149,11 @@ Lists info on all threads.`
 func (x *lsThreadsCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(GET, "threads", params{}, nil)
+	list, err := api.Threads()
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(list)
 }
 
 type getThreadsCmd struct {
@@ -180,12 +172,11 @@ func (x *getThreadsCmd) Execute(args []string) error {
 		return errMissingThreadId
 	}
 
-	res, err := executeJsonCmd(GET, "threads/"+util.TrimQuotes(args[0]), params{}, nil)
+	thrd, err := api.Thread(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(thrd)
 }
 
 type getDefaultThreadsCmd struct {
@@ -201,12 +192,11 @@ Gets and displays info about the default thread (if selected).`
 func (x *getDefaultThreadsCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(GET, "threads/default", params{}, nil)
+	thrd, err := api.Thread("default")
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(thrd)
 }
 
 type peersThreadsCmd struct {
@@ -227,12 +217,11 @@ func (x *peersThreadsCmd) Execute(args []string) error {
 		x.Thread = "default"
 	}
 
-	res, err := executeJsonCmd(GET, "threads/"+x.Thread+"/peers", params{}, nil)
+	peers, err := api.ThreadPeers(x.Thread)
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(peers)
 }
 
 type renameThreadsCmd struct {
@@ -256,11 +245,10 @@ func (x *renameThreadsCmd) Execute(args []string) error {
 		x.Thread = "default"
 	}
 
-	res, err := executeStringCmd(PUT, "threads/"+x.Thread+"/name", params{args: args})
-	if err != nil {
+	if err := api.RenameThread(x.Thread, args[0]); err != nil {
 		return err
 	}
-	output(res)
+	output("ok")
 	return nil
 }
 
@@ -280,11 +268,10 @@ func (x *rmThreadsCmd) Execute(args []string) error {
 		return errMissingThreadId
 	}
 
-	res, err := executeStringCmd(DEL, "threads/"+util.TrimQuotes(args[0]), params{})
-	if err != nil {
+	if err := api.RemoveThread(util.TrimQuotes(args[0])); err != nil {
 		return err
 	}
-	output(res)
+	output("ok")
 	return nil
 }
 
@@ -313,21 +300,17 @@ Snapshots all threads and pushes to registered cafes.`
 func (x *createSnapshotsThreadsCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := callCreateSnapshotsThreads()
-	if err != nil {
+	if err := api.CreateThreadSnapshots(); err != nil {
 		return err
 	}
-	output(res)
+	output("ok")
 	return nil
 }
 
-func callCreateSnapshotsThreads() (string, error) {
-	return executeStringCmd(POST, "snapshots", params{})
-}
-
 type searchSnapshotsThreadsCmd struct {
-	Client ClientOptions `group:"Client Options"`
-	Wait   int           `long:"wait" description:"Stops searching after 'wait' seconds have elapsed (max 30s)." default:"2"`
+	Client    ClientOptions `group:"Client Options"`
+	Wait      int           `long:"wait" description:"Stops searching after 'wait' seconds have elapsed (max 30s)." default:"2"`
+	Federated bool          `long:"federated" description:"Ask cafes to forward the search to their federated peer cafes instead of using pubsub."`
 }
 
 func (x *searchSnapshotsThreadsCmd) Usage() string {
@@ -339,11 +322,10 @@ Searches the network for thread snapshots.`
 func (x *searchSnapshotsThreadsCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	handleSearchStream("snapshots/search", params{
-		opts: map[string]string{
-			"wait": strconv.Itoa(x.Wait),
-		},
-	})
+	handleSearchStream(api.SearchThreadSnapshots(nil, &pb.QueryOptions{
+		Wait:      int32(x.Wait),
+		Federated: x.Federated,
+	}))
 	return nil
 }
 
@@ -365,16 +347,14 @@ func (x *applySnapshotsThreadsCmd) Execute(args []string) error {
 	}
 	id := args[0]
 
-	results := handleSearchStream("snapshots/search", params{
-		opts: map[string]string{
-			"wait": strconv.Itoa(x.Wait),
-		},
-	})
+	results := handleSearchStream(api.SearchThreadSnapshots(nil, &pb.QueryOptions{
+		Wait: int32(x.Wait),
+	}))
 
 	var result *pb.QueryResult
 	for _, r := range results {
 		if r.Id == id {
-			result = &r
+			result = r
 		}
 	}
 
@@ -383,34 +363,22 @@ func (x *applySnapshotsThreadsCmd) Execute(args []string) error {
 		return nil
 	}
 
-	if err := applySnapshot(result); err != nil {
+	if err := applySnapshot(result, false); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func applySnapshot(result *pb.QueryResult) error {
+func applySnapshot(result *pb.QueryResult, restore bool) error {
 	snap := new(pb.Thread)
 	if err := ptypes.UnmarshalAny(result.Value, snap); err != nil {
 		return err
 	}
-	data, err := pbMarshaler.MarshalToString(result.Value)
-	if err != nil {
-		return err
-	}
-
-	res, err := executeStringCmd(PUT, "threads/"+snap.Id, params{
-		payload: strings.NewReader(data),
-		ctype:   "application/json",
-	})
-	if err != nil {
-		return err
-	}
-	if res == "" {
-		output("applied " + result.Id)
+	if err := api.AddOrUpdateThread(snap, restore); err != nil {
+		output("error applying " + result.Id + ": " + err.Error())
 	} else {
-		output("error applying " + result.Id + ": " + res)
+		output("applied " + result.Id)
 	}
 	return nil
 }
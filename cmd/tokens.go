@@ -2,8 +2,9 @@ package cmd
 
 import (
 	"fmt"
-	"strconv"
+	"time"
 
+	"github.com/textileio/go-textile/client"
 	"github.com/textileio/go-textile/util"
 )
 
@@ -38,6 +39,10 @@ type createTokensCmd struct {
 	Client  ClientOptions `group:"Client Options"`
 	NoStore bool          `short:"n" long:"no-store" description:"Generate token only, do not store in local db."`
 	Token   string        `short:"t" long:"token" description:"Use existing token, rather than creating a new one."`
+	Scopes  []string      `short:"s" long:"scope" description:"A scope granted to the token, one of 'store', 'inbox', 'threads', or 'search'. Can be used multiple times. Omit for all."`
+	Plan    string        `short:"p" long:"plan" description:"The name of a quota plan from the cafe host config."`
+	Expires string        `short:"e" long:"expires" description:"A duration (e.g., 720h) or RFC3339 date after which the token can no longer be used."`
+	Label   string        `short:"l" long:"label" description:"A label for the token."`
 }
 
 func (x *createTokensCmd) Usage() string {
@@ -46,21 +51,33 @@ func (x *createTokensCmd) Usage() string {
 Generates an access token (44 random bytes) and saves a bcrypt hashed version for future lookup.
 The response contains a base58 encoded version of the random bytes token. If '--no-store' is used,
 the token is generated, but not stored in the local Cafe db. Alternatively, an existing token
-can be added using the '--token' flag.`
+can be added using the '--token' flag.
+
+Use '--scope', '--plan', and '--expires' to limit what clients registered with the token may do.`
 }
 
 func (x *createTokensCmd) Execute(args []string) error {
 	setApi(x.Client)
-	opts := map[string]string{
-		"token": x.Token,
-		"store": strconv.FormatBool(!x.NoStore),
+	conf := client.CafeTokenConfig{
+		Token:  x.Token,
+		Store:  !x.NoStore,
+		Scopes: x.Scopes,
+		Plan:   x.Plan,
+		Label:  x.Label,
+	}
+	if x.Expires != "" {
+		expires, err := parseExpiry(x.Expires)
+		if err != nil {
+			return err
+		}
+		conf.Expires = expires
 	}
 
-	res, err := executeStringCmd(POST, "tokens", params{opts: opts})
+	token, err := api.CreateCafeToken(conf)
 	if err != nil {
 		return err
 	}
-	output(res)
+	output(token)
 	return nil
 }
 
@@ -71,18 +88,18 @@ type lsTokensCmd struct {
 func (x *lsTokensCmd) Usage() string {
 	return `
 
-List info about all stored cafe tokens.`
+List info about all stored cafe tokens, including scopes, plan, expiry,
+and the combined usage of clients registered with each token.`
 }
 
 func (x *lsTokensCmd) Execute(args []string) error {
 	setApi(x.Client)
 
-	res, err := executeJsonCmd(GET, "tokens", params{}, nil)
+	list, err := api.CafeTokens()
 	if err != nil {
 		return err
 	}
-	output(res)
-	return nil
+	return outputPb(list)
 }
 
 type validateTokensCmd struct {
@@ -101,11 +118,14 @@ func (x *validateTokensCmd) Execute(args []string) error {
 		return errMissingToken
 	}
 
-	res, err := executeStringCmd(GET, "tokens/"+util.TrimQuotes(args[0]), params{})
+	valid, err := api.ValidateCafeToken(util.TrimQuotes(args[0]))
 	if err != nil {
 		return err
 	}
-	output(res)
+	if !valid {
+		return fmt.Errorf("invalid credentials")
+	}
+	output("ok")
 	return nil
 }
 
@@ -125,10 +145,17 @@ func (x *rmTokensCmd) Execute(args []string) error {
 		return errMissingToken
 	}
 
-	res, err := executeStringCmd(DEL, "tokens/"+util.TrimQuotes(args[0]), params{})
-	if err != nil {
+	if err := api.RemoveCafeToken(util.TrimQuotes(args[0])); err != nil {
 		return err
 	}
-	output(res)
+	output("ok")
 	return nil
 }
+
+// parseExpiry parses a duration from now or an RFC3339 date
+func parseExpiry(val string) (time.Time, error) {
+	if d, err := time.ParseDuration(val); err == nil {
+		return time.Now().Add(d), nil
+	}
+	return time.Parse(time.RFC3339, val)
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/textileio/go-textile/util"
+)
+
+var errMissingWebhookUrl = fmt.Errorf("missing webhook URL")
+var errMissingWebhookId = fmt.Errorf("missing webhook ID")
+
+func init() {
+	register(&webhooksCmd{})
+}
+
+type webhooksCmd struct {
+	Add    addWebhooksCmd `command:"add" description:"Add a webhook"`
+	List   lsWebhooksCmd  `command:"ls" description:"List webhooks"`
+	Remove rmWebhooksCmd  `command:"rm" description:"Remove a webhook"`
+}
+
+func (x *webhooksCmd) Name() string {
+	return "webhooks"
+}
+
+func (x *webhooksCmd) Short() string {
+	return "Manage webhooks"
+}
+
+func (x *webhooksCmd) Long() string {
+	return `
+Webhooks receive thread updates without an open subscription.
+Updates matching a webhook's thread and type filters are POSTed to its URL as JSON feed items.
+Each request body is signed with the webhook's secret (HMAC-SHA256). The signature is sent
+in the X-Textile-Signature header as "sha256=<hex>".
+Failed deliveries are retried.
+Use this command to add, list, and remove webhooks.`
+}
+
+type addWebhooksCmd struct {
+	Client ClientOptions `group:"Client Options"`
+	Thread string        `short:"t" long:"thread" description:"Comma-separated list of thread IDs. Omit for all."`
+	Type   string        `long:"type" description:"Or'd list of block types (e.g., FILES|TEXT). Omit for all."`
+	Secret string        `short:"s" long:"secret" description:"Key used to sign request bodies. Omit to generate one."`
+}
+
+func (x *addWebhooksCmd) Usage() string {
+	return `
+
+Adds a webhook for the given URL.
+Specify "default" in the --thread option to use the default thread (if selected).`
+}
+
+func (x *addWebhooksCmd) Execute(args []string) error {
+	if len(args) == 0 {
+		return errMissingWebhookUrl
+	}
+	setApi(x.Client)
+
+	hook, err := api.AddWebhook(util.TrimQuotes(args[0]), util.SplitString(x.Thread, ","),
+		util.SplitString(x.Type, "|"), x.Secret)
+	if err != nil {
+		return err
+	}
+	return outputJson(hook)
+}
+
+type lsWebhooksCmd struct {
+	Client ClientOptions `group:"Client Options"`
+}
+
+func (x *lsWebhooksCmd) Usage() string {
+	return `
+
+Lists webhooks.`
+}
+
+func (x *lsWebhooksCmd) Execute(args []string) error {
+	setApi(x.Client)
+
+	hooks, err := api.Webhooks()
+	if err != nil {
+		return err
+	}
+	return outputJson(hooks)
+}
+
+type rmWebhooksCmd struct {
+	Client ClientOptions `group:"Client Options"`
+}
+
+func (x *rmWebhooksCmd) Usage() string {
+	return `
+
+Removes a webhook by ID, along with its pending deliveries.`
+}
+
+func (x *rmWebhooksCmd) Execute(args []string) error {
+	if len(args) == 0 {
+		return errMissingWebhookId
+	}
+	setApi(x.Client)
+
+	if err := api.RemoveWebhook(util.TrimQuotes(args[0])); err != nil {
+		return err
+	}
+	output("ok")
+	return nil
+}
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -70,7 +72,7 @@ func (t *Textile) ApiAddr() string {
 }
 
 // @title Textile REST API
-// @version 0
+// @version 1
 // @description Textile's HTTP REST API Documentation
 // @termsOfService https://github.com/textileio/go-textile/blob/master/TERMS
 
@@ -81,7 +83,7 @@ func (t *Textile) ApiAddr() string {
 // @license.name MIT License
 // @license.url https://github.com/textileio/go-textile/blob/master/LICENSE
 
-// @BasePath /api/v0
+// @BasePath /api/v1
 func (a *api) Start() {
 	// Dynamically set the swagger 'host' value
 	docs.SwaggerInfo.Host = a.addr
@@ -101,6 +103,9 @@ func (a *api) Start() {
 		router.Use(limit.RequestSizeLimiter(conf.API.SizeLimit))
 	}
 
+	// Record request metrics
+	router.Use(instrument(apiRequests, apiSeconds))
+
 	router.GET("/", func(g *gin.Context) {
 		g.JSON(http.StatusOK, gin.H{
 			"cafe_version": apiVersion,
@@ -110,214 +115,256 @@ func (a *api) Start() {
 	router.GET("/health", func(g *gin.Context) {
 		g.Writer.WriteHeader(http.StatusNoContent)
 	})
+	router.GET("/metrics", a.authorize, a.getMetrics)
 
 	// API docs
 	if a.docs {
 		router.GET("/docs/*any", swagger.WrapHandler(sfiles.Handler))
 	}
 
-	// v0 routes
-	v0 := router.Group("/api/v0")
-	{
-		v0.GET("/summary", a.nodeSummary)
+	// v0 reads options and args from the X-Textile-Opts and X-Textile-Args headers
+	a.routes(router.Group("/api/v0", a.authorize))
 
-		v0.GET("/ping", a.ping)
+	// v1 reads options from query params and args from query params or a JSON body
+	a.routes(router.Group("/api/v1", a.v1, a.authorize))
 
-		account := v0.Group("/account")
-		{
-			account.GET("", a.accountGet)
-			account.GET("/seed", a.accountSeed)
-			account.GET("/address", a.accountAddress)
-		}
+	a.server = &http.Server{
+		Addr:    a.addr,
+		Handler: router,
+	}
 
-		profile := v0.Group("/profile")
-		{
-			profile.GET("", a.getProfile)
-			profile.POST("/name", a.setName)
-			profile.POST("/avatar", a.setAvatar)
+	// start listening
+	errc := make(chan error)
+	go func() {
+		errc <- a.server.ListenAndServe()
+		close(errc)
+	}()
+	go func() {
+		for {
+			select {
+			case err, ok := <-errc:
+				if err != nil && err != http.ErrServerClosed {
+					log.Errorf("api error: %s", err)
+				}
+				if !ok {
+					log.Info("api was shutdown")
+					return
+				}
+			}
 		}
+	}()
+	log.Infof("api listening at %s", a.server.Addr)
+}
 
-		contacts := v0.Group("/contacts")
-		{
-			contacts.PUT(":address", a.addContacts)
-			contacts.GET("", a.lsContacts)
-			contacts.GET("/:address", a.getContacts)
-			contacts.DELETE("/:address", a.rmContacts)
-			contacts.POST("/search", a.searchContacts)
-		}
+// routes adds the api routes to a version group
+func (a *api) routes(grp *gin.RouterGroup) {
+	grp.GET("/summary", a.nodeSummary)
 
-		mills := v0.Group("/mills")
-		{
-			mills.POST("/schema", a.schemaMill)
-			mills.POST("/blob", a.blobMill)
-			mills.POST("/image/resize", a.imageResizeMill)
-			mills.POST("/image/exif", a.imageExifMill)
-			mills.POST("/json", a.jsonMill)
-		}
+	grp.GET("/ping", a.ping)
 
-		threads := v0.Group("/threads")
-		{
-			threads.POST("", a.addThreads)
-			threads.PUT(":id", a.addOrUpdateThreads)
-			threads.PUT(":id/name", a.renameThreads)
-			threads.GET("", a.lsThreads)
-			threads.GET("/:id", a.getThreads)
-			threads.GET("/:id/peers", a.peersThreads)
-			threads.DELETE("/:id", a.rmThreads)
-			threads.POST("/:id/messages", a.addThreadMessages)
-			threads.POST("/:id/files", a.addThreadFiles)
-		}
+	grp.POST("/batch", a.batch)
 
-		snapshots := v0.Group("/snapshots")
-		{
-			snapshots.POST("", a.createThreadSnapshots)
-			snapshots.POST("/search", a.searchThreadSnapshots)
-		}
+	account := grp.Group("/account")
+	{
+		account.GET("", a.accountGet)
+		account.GET("/seed", a.requireScope(apiScopeSeed), a.accountSeed)
+		account.GET("/address", a.accountAddress)
+	}
+
+	profile := grp.Group("/profile")
+	{
+		profile.GET("", a.getProfile)
+		profile.POST("/name", a.setName)
+		profile.POST("/avatar", a.setAvatar)
+	}
+
+	contacts := grp.Group("/contacts")
+	{
+		contacts.PUT(":address", a.addContacts)
+		contacts.GET("", a.lsContacts)
+		contacts.GET("/:address", a.getContacts)
+		contacts.DELETE("/:address", a.rmContacts)
+		contacts.POST("/search", a.searchContacts)
+	}
+
+	mills := grp.Group("/mills")
+	{
+		mills.POST("/schema", a.schemaMill)
+		mills.POST("/blob", a.blobMill)
+		mills.POST("/image/resize", a.imageResizeMill)
+		mills.POST("/image/exif", a.imageExifMill)
+		mills.POST("/json", a.jsonMill)
+	}
+
+	threads := grp.Group("/threads")
+	{
+		threads.POST("", a.addThreads)
+		threads.PUT(":id", a.addOrUpdateThreads)
+		threads.PUT(":id/name", a.renameThreads)
+		threads.GET("", a.lsThreads)
+		threads.GET("/:id", a.getThreads)
+		threads.GET("/:id/peers", a.peersThreads)
+		threads.DELETE("/:id", a.rmThreads)
+		threads.POST("/:id/messages", a.addThreadMessages)
+		threads.POST("/:id/files", a.addThreadFiles)
+	}
+
+	snapshots := grp.Group("/snapshots")
+	{
+		snapshots.POST("", a.createThreadSnapshots)
+		snapshots.POST("/search", a.searchThreadSnapshots)
+	}
 
-		blocks := v0.Group("/blocks")
+	blocks := grp.Group("/blocks")
+	{
+		blocks.GET("", a.lsBlocks)
+
+		block := blocks.Group("/:id")
 		{
-			blocks.GET("", a.lsBlocks)
+			block.GET("", a.getBlocks)
+			block.DELETE("", a.rmBlocks)
 
-			block := blocks.Group("/:id")
+			block.GET("/comment", a.getBlockComment)
+			comments := block.Group("/comments")
 			{
-				block.GET("", a.getBlocks)
-				block.DELETE("", a.rmBlocks)
-
-				block.GET("/comment", a.getBlockComment)
-				comments := block.Group("/comments")
-				{
-					comments.POST("", a.addBlockComments)
-					comments.GET("", a.lsBlockComments)
-				}
+				comments.POST("", a.addBlockComments)
+				comments.GET("", a.lsBlockComments)
+			}
 
-				block.GET("/like", a.getBlockLike)
-				likes := block.Group("/likes")
-				{
-					likes.POST("", a.addBlockLikes)
-					likes.GET("", a.lsBlockLikes)
-				}
+			block.GET("/like", a.getBlockLike)
+			likes := block.Group("/likes")
+			{
+				likes.POST("", a.addBlockLikes)
+				likes.GET("", a.lsBlockLikes)
 			}
 		}
+	}
 
-		messages := v0.Group("/messages")
-		{
-			messages.GET("", a.lsThreadMessages)
-			messages.GET("/:block", a.getThreadMessages)
-		}
+	messages := grp.Group("/messages")
+	{
+		messages.GET("", a.lsThreadMessages)
+		messages.GET("/:block", a.getThreadMessages)
+	}
 
-		files := v0.Group("/files")
-		{
-			files.GET("", a.lsThreadFiles)
-			files.GET("/:block", a.getThreadFiles)
-		}
+	files := grp.Group("/files")
+	{
+		files.GET("", a.lsThreadFiles)
+		files.GET("/:block", a.getThreadFiles)
+	}
 
-		file := v0.Group("/file")
-		{
-			file.GET("/:hash/data", a.getFileData)
-		}
+	file := grp.Group("/file")
+	{
+		file.GET("/:hash/data", a.getFileData)
+	}
 
-		feed := v0.Group("/feed")
-		{
-			feed.GET("", a.lsThreadFeed)
-		}
+	feed := grp.Group("/feed")
+	{
+		feed.GET("", a.lsThreadFeed)
+	}
 
-		keys := v0.Group("/keys")
-		{
-			keys.GET("/:target", a.lsThreadFileTargetKeys)
-		}
+	keys := grp.Group("/keys")
+	{
+		keys.GET("/:target", a.lsThreadFileTargetKeys)
+	}
 
-		subscribe := v0.Group("/subscribe")
-		{
-			subscribe.GET("", a.getThreadsSubscribe)
-			subscribe.GET("/:id", a.getThreadsSubscribe)
-		}
+	subscribe := grp.Group("/subscribe")
+	{
+		subscribe.GET("", a.getThreadsSubscribe)
+		subscribe.GET("/:id", a.getThreadsSubscribe)
+	}
 
-		invites := v0.Group("/invites")
-		{
-			invites.POST("", a.createInvites)
-			invites.GET("", a.lsInvites)
-			invites.POST("/:id/accept", a.acceptInvites)
-			invites.POST("/:id/ignore", a.ignoreInvites)
-		}
+	grp.GET("/ws", a.subscribeWebsocket)
 
-		notifs := v0.Group("/notifications")
-		{
-			notifs.GET("", a.lsNotifications)
-			notifs.POST("/:id/read", a.readNotifications)
-		}
+	invites := grp.Group("/invites")
+	{
+		invites.POST("", a.createInvites)
+		invites.GET("", a.lsInvites)
+		invites.POST("/:id/accept", a.acceptInvites)
+		invites.POST("/:id/ignore", a.ignoreInvites)
+	}
 
-		cafes := v0.Group("/cafes")
-		{
-			cafes.POST("", a.addCafes)
-			cafes.GET("", a.lsCafes)
-			cafes.GET("/:id", a.getCafes)
-			cafes.DELETE("/:id", a.rmCafes)
-			cafes.POST("/messages", a.checkCafeMessages)
-		}
+	notifs := grp.Group("/notifications")
+	{
+		notifs.GET("", a.lsNotifications)
+		notifs.POST("/:id/read", a.readNotifications)
+		notifs.DELETE("/:id", a.rmNotifications)
+	}
 
-		tokens := v0.Group("/tokens")
-		{
-			tokens.POST("", a.createTokens)
-			tokens.GET("", a.lsTokens)
-			tokens.GET("/:token", a.validateTokens)
-			tokens.DELETE("/:token", a.rmTokens)
-		}
+	webhooks := grp.Group("/webhooks", a.requireScope(apiScopeAdmin))
+	{
+		webhooks.POST("", a.addWebhooks)
+		webhooks.GET("", a.lsWebhooks)
+		webhooks.DELETE("/:id", a.rmWebhooks)
+	}
 
-		ipfs := v0.Group("/ipfs")
-		{
-			ipfs.GET("/id", a.ipfsId)
-			ipfs.GET("/cat/*path", a.ipfsCat)
+	cafes := grp.Group("/cafes")
+	{
+		cafes.POST("", a.addCafes)
+		cafes.GET("", a.lsCafes)
+		cafes.GET("/:id", a.getCafes)
+		cafes.PUT("/:id", a.updateCafes)
+		cafes.DELETE("/:id", a.rmCafes)
+		cafes.POST("/messages", a.checkCafeMessages)
+	}
 
-			swarm := ipfs.Group("/swarm")
-			{
-				swarm.POST("/connect", a.ipfsSwarmConnect)
-				swarm.GET("/peers", a.ipfsSwarmPeers)
-			}
-		}
+	requests := grp.Group("/requests")
+	{
+		requests.GET("", a.lsCafeRequests)
+		requests.POST("/retry", a.retryCafeRequests)
+		requests.DELETE("", a.purgeCafeRequests)
+	}
 
-		logs := v0.Group("/logs")
-		{
-			logs.POST("", a.logsCall)
-			logs.GET("", a.logsCall)
-			logs.POST("/:subsystem", a.logsCall)
-			logs.GET("/:subsystem", a.logsCall)
-		}
+	apiKeys := grp.Group("/api-keys", a.requireScope(apiScopeAdmin))
+	{
+		apiKeys.POST("", a.createApiKeys)
+		apiKeys.GET("", a.lsApiKeys)
+		apiKeys.DELETE("/:id", a.rmApiKeys)
+	}
+
+	tokens := grp.Group("/tokens", a.requireScope(apiScopeAdmin))
+	{
+		tokens.POST("", a.createTokens)
+		tokens.GET("", a.lsTokens)
+		tokens.GET("/:token", a.validateTokens)
+		tokens.DELETE("/:token", a.rmTokens)
+	}
 
-		conf := v0.Group("/config")
+	admin := grp.Group("/admin", a.requireScope(apiScopeAdmin))
+	{
+		admin.GET("/clients", a.lsAdminClients)
+		admin.DELETE("/clients/:id", a.evictAdminClients)
+		admin.GET("/usage", a.getAdminUsage)
+		admin.GET("/usage/:id", a.getAdminUsage)
+		admin.GET("/replication", a.getAdminReplication)
+	}
+
+	ipfs := grp.Group("/ipfs")
+	{
+		ipfs.GET("/id", a.ipfsId)
+		ipfs.GET("/cat/*path", a.ipfsCat)
+
+		swarm := ipfs.Group("/swarm")
 		{
-			conf.GET("", a.getConfig)
-			conf.PUT("", a.setConfig)
-			conf.GET("/*path", a.getConfig)
-			conf.PATCH("", a.patchConfig)
+			swarm.POST("/connect", a.ipfsSwarmConnect)
+			swarm.GET("/peers", a.ipfsSwarmPeers)
 		}
 	}
 
-	a.server = &http.Server{
-		Addr:    a.addr,
-		Handler: router,
+	logs := grp.Group("/logs", a.requireScope(apiScopeAdmin))
+	{
+		logs.POST("", a.logsCall)
+		logs.GET("", a.logsCall)
+		logs.POST("/:subsystem", a.logsCall)
+		logs.GET("/:subsystem", a.logsCall)
 	}
 
-	// start listening
-	errc := make(chan error)
-	go func() {
-		errc <- a.server.ListenAndServe()
-		close(errc)
-	}()
-	go func() {
-		for {
-			select {
-			case err, ok := <-errc:
-				if err != nil && err != http.ErrServerClosed {
-					log.Errorf("api error: %s", err)
-				}
-				if !ok {
-					log.Info("api was shutdown")
-					return
-				}
-			}
-		}
-	}()
-	log.Infof("api listening at %s", a.server.Addr)
+	conf := grp.Group("/config", a.requireScope(apiScopeAdmin))
+	{
+		conf.GET("", a.getConfig)
+		conf.PUT("", a.setConfig)
+		conf.GET("/*path", a.getConfig)
+		conf.PATCH("", a.patchConfig)
+	}
 }
 
 // Stop stops the http api
@@ -346,6 +393,9 @@ func (a *api) abort500(g *gin.Context, err error) {
 }
 
 func (a *api) readArgs(g *gin.Context) ([]string, error) {
+	if isApiV1(g) {
+		return readV1Args(g)
+	}
 	header := g.Request.Header.Get("X-Textile-Args")
 	var args []string
 	for _, a := range strings.Split(header, ",") {
@@ -361,6 +411,9 @@ func (a *api) readArgs(g *gin.Context) ([]string, error) {
 }
 
 func (a *api) readOpts(g *gin.Context) (map[string]string, error) {
+	if isApiV1(g) {
+		return readV1Opts(g), nil
+	}
 	header := g.Request.Header.Get("X-Textile-Opts")
 	opts := make(map[string]string)
 	for _, o := range strings.Split(header, ",") {
@@ -395,6 +448,63 @@ func (a *api) openFile(g *gin.Context) (multipart.File, string, error) {
 	return file, header.Filename, nil
 }
 
+// openFileStream returns the file part of a multipart body without buffering it,
+// skipping any parts before it
+func (a *api) openFileStream(g *gin.Context) (io.Reader, string, error) {
+	reader, err := g.Request.MultipartReader()
+	if err != nil {
+		return nil, "", err
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil, "", fmt.Errorf("no file attached")
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if part.FormName() == "file" {
+			return part, part.FileName(), nil
+		}
+	}
+}
+
+// getFileStreamConfig is like getFileConfig, but streams an uploaded file through a StreamMill
+func (a *api) getFileStreamConfig(g *gin.Context, mill m.StreamMill, use string, plaintext bool) (*AddFileConfig, error) {
+	if use != "" {
+		conf, err := a.getFileConfig(g, mill, use, plaintext)
+		if err != nil {
+			return nil, err
+		}
+		conf.Reader = bytes.NewReader(conf.Input)
+		conf.Input = nil
+		return conf, nil
+	}
+
+	f, fn, err := a.openFileStream(g)
+	if err != nil {
+		return nil, err
+	}
+
+	// peek at the first 512 bytes for media detection
+	reader := bufio.NewReaderSize(f, 512)
+	head, err := reader.Peek(512)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	media, err := a.node.GetMedia(bytes.NewReader(head), mill)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddFileConfig{
+		Reader:    reader,
+		Name:      fn,
+		Media:     media,
+		Plaintext: plaintext,
+	}, nil
+}
+
 func (a *api) getFileConfig(g *gin.Context, mill m.Mill, use string, plaintext bool) (*AddFileConfig, error) {
 	var reader io.ReadSeeker
 	conf := &AddFileConfig{}
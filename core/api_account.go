@@ -12,7 +12,7 @@ import (
 // @Tags account
 // @Produce application/json
 // @Success 200 {object} pb.Contact "contact"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /account [get]
 func (a *api) accountGet(g *gin.Context) {
 	pbJSON(g, http.StatusOK, a.node.AccountContact())
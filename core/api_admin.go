@@ -0,0 +1,81 @@
+package core
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// lsAdminClients godoc
+// @Summary List cafe clients
+// @Description Lists all clients registered with this cafe, ordered by last seen
+// @Tags admin
+// @Produce application/json
+// @Success 200 {object} pb.CafeClientList "clients"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /admin/clients [get]
+func (a *api) lsAdminClients(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.node.CafeClients())
+}
+
+// evictAdminClients godoc
+// @Summary Evict a cafe client
+// @Description Removes a registered client along with its stored threads, inbox messages,
+// @Description and pin records
+// @Tags admin
+// @Param id path string true "client id"
+// @Success 204 {string} string "ok"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /admin/clients/{id} [delete]
+func (a *api) evictAdminClients(g *gin.Context) {
+	if err := a.node.EvictCafeClient(g.Param("id")); err != nil {
+		if err == ErrCafeClientNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// getAdminUsage godoc
+// @Summary Show cafe client usage
+// @Description Shows stored thread count, inbox depth, and pinned content for all
+// @Description registered clients, or a single client if an ID is given
+// @Tags admin
+// @Produce application/json
+// @Param id path string false "client id"
+// @Success 200 {object} pb.CafeClientUsageList "usage"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /admin/usage/{id} [get]
+func (a *api) getAdminUsage(g *gin.Context) {
+	id := g.Param("id")
+	if id == "" {
+		pbJSON(g, http.StatusOK, a.node.CafeClientsUsage())
+		return
+	}
+
+	usage, err := a.node.CafeClientUsage(id)
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, usage)
+}
+
+// getAdminReplication godoc
+// @Summary Show cafe replication status
+// @Description Shows the status of client record replication to each neighbor cafe,
+// @Description including pending replications and the last error, if any
+// @Tags admin
+// @Produce application/json
+// @Success 200 {object} pb.CafeReplicationStatus "status"
+// @Router /admin/replication [get]
+func (a *api) getAdminReplication(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.node.CafeReplicationStatus())
+}
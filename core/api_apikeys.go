@@ -0,0 +1,150 @@
+package core
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// apiKeyContextKey is where the request's api key is kept in the gin context
+const apiKeyContextKey = "apiKey"
+
+// createApiKeys godoc
+// @Summary Create an API key
+// @Description Creates a local API key signed by the account key. The response contains the key's
+// @Description token, which is only returned once and should be sent as a bearer token
+// @Description (Authorization: Bearer <token>). Scopes: read (GET requests), write (other
+// @Description requests), admin (config, logs, tokens, webhooks, cafe admin, and API keys; implies
+// @Description read and write), and seed (account seed).
+// @Tags api-keys
+// @Produce application/json
+// @Param name query string false "A name for the key"
+// @Param scopes query string false "Comma-separated list of scopes (read, write, admin, seed)" default(read,write)
+// @Param expires query string false "RFC3339 date after which the key can no longer be used"
+// @Success 201 {object} pb.ApiKey "key"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /api-keys [post]
+func (a *api) createApiKeys(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	conf := ApiKeyConfig{
+		Name:   opts["name"],
+		Scopes: util.SplitString(opts["scopes"], ","),
+	}
+	if opts["expires"] != "" {
+		conf.Expires, err = time.Parse(time.RFC3339, opts["expires"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	key, err := a.node.CreateApiKey(conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	pbJSON(g, http.StatusCreated, key)
+}
+
+// lsApiKeys godoc
+// @Summary List API keys
+// @Description Lists info about all local API keys. Tokens are not included.
+// @Tags api-keys
+// @Produce application/json
+// @Success 200 {object} pb.ApiKeyList "keys"
+// @Router /api-keys [get]
+func (a *api) lsApiKeys(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.node.ApiKeys())
+}
+
+// rmApiKeys godoc
+// @Summary Remove an API key
+// @Description Revokes a local API key
+// @Tags api-keys
+// @Param id path string true "key id"
+// @Success 204 {string} string "ok"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /api-keys/{id} [delete]
+func (a *api) rmApiKeys(g *gin.Context) {
+	if err := a.node.RemoveApiKey(g.Param("id")); err != nil {
+		if err == ErrApiKeyNotFound {
+			g.String(http.StatusNotFound, err.Error())
+			return
+		}
+		a.abort500(g, err)
+		return
+	}
+	g.Status(http.StatusNoContent)
+}
+
+// authorize requires a valid API key when auth is enabled, which must have
+// the read scope for GET and HEAD requests and the write scope for others
+func (a *api) authorize(g *gin.Context) {
+	if !a.node.Config().API.Auth {
+		return
+	}
+
+	token := requestApiKey(g)
+	if token == "" {
+		g.String(http.StatusUnauthorized, "missing api key")
+		g.Abort()
+		return
+	}
+	key, err := a.node.ValidateApiKey(token)
+	if err != nil {
+		g.String(http.StatusUnauthorized, "invalid api key: "+err.Error())
+		g.Abort()
+		return
+	}
+	g.Set(apiKeyContextKey, key)
+
+	scope := apiScopeWrite
+	switch g.Request.Method {
+	case http.MethodGet, http.MethodHead:
+		scope = apiScopeRead
+	}
+	a.checkScope(g, scope)
+}
+
+// requestApiKey returns the bearer token of a request. Browsers cannot set headers
+// on websocket requests, which may instead use the api_key query param.
+func requestApiKey(g *gin.Context) string {
+	auth := strings.Split(g.Request.Header.Get("Authorization"), " ")
+	if len(auth) >= 2 && auth[0] == "Bearer" {
+		return auth[1]
+	}
+	if websocket.IsWebSocketUpgrade(g.Request) {
+		return g.Query("api_key")
+	}
+	return ""
+}
+
+// requireScope returns a middleware that checks the request's API key grants scope
+func (a *api) requireScope(scope string) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		if !a.node.Config().API.Auth {
+			return
+		}
+		a.checkScope(g, scope)
+	}
+}
+
+// checkScope aborts the request if its API key does not grant scope
+func (a *api) checkScope(g *gin.Context, scope string) {
+	val, ok := g.Get(apiKeyContextKey)
+	key, _ := val.(*pb.ApiKey)
+	if !ok || key == nil || !apiKeyAllows(key, scope) {
+		g.String(http.StatusForbidden, "api key is missing the "+scope+" scope")
+		g.Abort()
+	}
+}
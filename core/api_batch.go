@@ -0,0 +1,451 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/proto"
+	ipld "github.com/ipfs/go-ipld-format"
+	m "github.com/textileio/go-textile/mill"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/schema"
+)
+
+// batch operation types
+const (
+	batchMill    = "mill"
+	batchFiles   = "files"
+	batchMessage = "message"
+	batchComment = "comment"
+	batchLike    = "like"
+)
+
+// batchRefPrefix marks a value as a reference to the result of an earlier operation
+const batchRefPrefix = "$"
+
+// batchRequest is an ordered list of operations
+type batchRequest struct {
+	Ops         []*batchOp `json:"ops"`
+	StopOnError bool       `json:"stop_on_error,omitempty"`
+}
+
+// batchOp is a single operation in a batch. Mill ops use mill, opts, and either data (base64)
+// and name, or use. Files ops use thread, caption, and files. Message ops use thread and body,
+// comment ops use block and body, and like ops use block.
+type batchOp struct {
+	Id      string            `json:"id,omitempty"`
+	Type    string            `json:"type"`
+	Thread  string            `json:"thread,omitempty"`
+	Block   string            `json:"block,omitempty"`
+	Body    string            `json:"body,omitempty"`
+	Caption string            `json:"caption,omitempty"`
+	Mill    string            `json:"mill,omitempty"`
+	Opts    map[string]string `json:"opts,omitempty"`
+	Data    []byte            `json:"data,omitempty"`
+	Name    string            `json:"name,omitempty"`
+	Use     string            `json:"use,omitempty"`
+	Files   []*batchFile      `json:"files,omitempty"`
+}
+
+// batchFile is a file added by a files operation. Data (base64) is milled with the thread
+// schema. Otherwise, use or dir reference already milled files, for single file and
+// multi-link schemas respectively.
+type batchFile struct {
+	Data []byte            `json:"data,omitempty"`
+	Name string            `json:"name,omitempty"`
+	Use  string            `json:"use,omitempty"`
+	Dir  map[string]string `json:"dir,omitempty"`
+}
+
+// batchResult is the outcome of a single operation
+type batchResult struct {
+	Id    string          `json:"id,omitempty"`
+	Type  string          `json:"type"`
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// batchResponse lists operation results in request order
+type batchResponse struct {
+	Results []*batchResult `json:"results"`
+	Failed  int            `json:"failed"`
+}
+
+// batchOutput is what later operations may reference by an operation's id
+type batchOutput struct {
+	ref  string
+	file *pb.FileIndex
+}
+
+// batch godoc
+// @Summary Runs a batch of operations
+// @Description Runs an ordered list of operations (mill, files, message, comment, like) in a
+// @Description single request. Operations may reference the result of an earlier operation
+// @Description by its id with a '$' prefix, e.g., a mill's file hash in use, a file index
+// @Description in a files dir, or a files or message block in a comment or like. A failed
+// @Description operation does not stop the batch unless stop_on_error is set.
+// @Tags batch
+// @Accept application/json
+// @Produce application/json
+// @Param ops body core.batchRequest true "operations"
+// @Success 200 {object} core.batchResponse "results"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Router /batch [post]
+func (a *api) batch(g *gin.Context) {
+	var req batchRequest
+	if err := json.NewDecoder(g.Request.Body).Decode(&req); err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(req.Ops) == 0 {
+		g.String(http.StatusBadRequest, "no operations found")
+		return
+	}
+
+	ids := make(map[string]bool)
+	for _, op := range req.Ops {
+		if op.Id == "" {
+			continue
+		}
+		if ids[op.Id] {
+			g.String(http.StatusBadRequest, "duplicate operation id: "+op.Id)
+			return
+		}
+		ids[op.Id] = true
+	}
+
+	res := &batchResponse{}
+	outputs := make(map[string]*batchOutput)
+	var stopped bool
+	for _, op := range req.Ops {
+		result := &batchResult{Id: op.Id, Type: op.Type}
+		res.Results = append(res.Results, result)
+
+		if stopped {
+			result.Error = "skipped"
+			res.Failed++
+			continue
+		}
+
+		out, msg, err := a.batchOp(op, outputs)
+		if err == nil {
+			var data string
+			data, err = pbMarshaler.MarshalToString(msg)
+			result.Data = json.RawMessage(data)
+		}
+		if err != nil {
+			result.Error = err.Error()
+			res.Failed++
+			stopped = req.StopOnError
+			continue
+		}
+		if op.Id != "" {
+			outputs[op.Id] = out
+		}
+	}
+
+	g.JSON(http.StatusOK, res)
+}
+
+// batchOp runs a single operation
+func (a *api) batchOp(op *batchOp, outputs map[string]*batchOutput) (*batchOutput, proto.Message, error) {
+	switch op.Type {
+	case batchMill:
+		file, err := a.batchMill(op, outputs)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &batchOutput{ref: file.Hash, file: file}, file, nil
+
+	case batchFiles:
+		files, err := a.batchFiles(op, outputs)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &batchOutput{ref: files.Block}, files, nil
+
+	case batchMessage:
+		if op.Body == "" {
+			return nil, nil, fmt.Errorf("missing message body")
+		}
+		thrd, err := a.batchThread(op.Thread)
+		if err != nil {
+			return nil, nil, err
+		}
+		hash, err := thrd.AddMessage(op.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+		msg, err := a.node.Message(hash.B58String())
+		if err != nil {
+			return nil, nil, err
+		}
+		return &batchOutput{ref: msg.Block}, msg, nil
+
+	case batchComment:
+		if op.Body == "" {
+			return nil, nil, fmt.Errorf("missing comment body")
+		}
+		id, thrd, err := a.batchBlockThread(op.Block, outputs)
+		if err != nil {
+			return nil, nil, err
+		}
+		hash, err := thrd.AddComment(id, op.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+		comment, err := a.node.Comment(hash.B58String())
+		if err != nil {
+			return nil, nil, err
+		}
+		return &batchOutput{ref: comment.Id}, comment, nil
+
+	case batchLike:
+		id, thrd, err := a.batchBlockThread(op.Block, outputs)
+		if err != nil {
+			return nil, nil, err
+		}
+		hash, err := thrd.AddLike(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		like, err := a.node.Like(hash.B58String())
+		if err != nil {
+			return nil, nil, err
+		}
+		return &batchOutput{ref: like.Id}, like, nil
+
+	default:
+		return nil, nil, fmt.Errorf("invalid operation type: %s", op.Type)
+	}
+}
+
+// batchMill processes op data, or an existing file, with a mill
+func (a *api) batchMill(op *batchOp, outputs map[string]*batchOutput) (*pb.FileIndex, error) {
+	mill, err := millForId(op.Mill, op.Opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if op.Use != "" {
+		use, err := resolveBatchRef(op.Use, outputs)
+		if err != nil {
+			return nil, err
+		}
+		return a.node.millData(mill, nil, "", use, op.Opts["plaintext"] == "true")
+	}
+	if len(op.Data) == 0 {
+		return nil, fmt.Errorf("missing data")
+	}
+	return a.node.millData(mill, op.Data, op.Name, "", op.Opts["plaintext"] == "true")
+}
+
+// batchFiles adds files to a thread, milling raw data with the thread schema
+func (a *api) batchFiles(op *batchOp, outputs map[string]*batchOutput) (*pb.Files, error) {
+	if len(op.Files) == 0 {
+		return nil, fmt.Errorf("no files found")
+	}
+	thrd, err := a.batchThread(op.Thread)
+	if err != nil {
+		return nil, err
+	}
+	if thrd.Schema == nil {
+		return nil, ErrThreadSchemaRequired
+	}
+
+	dirs := &pb.DirectoryList{}
+	for _, file := range op.Files {
+		dir, err := a.batchDirectory(thrd.Schema, file, outputs)
+		if err != nil {
+			return nil, err
+		}
+		dirs.Items = append(dirs.Items, dir)
+	}
+
+	var node ipld.Node
+	var keys *pb.Keys
+	if dirs.Items[0].Files[schema.SingleFileTag] != nil {
+		var files []*pb.FileIndex
+		for _, dir := range dirs.Items {
+			if dir.Files[schema.SingleFileTag] == nil {
+				return nil, fmt.Errorf("mixed single file and directory items")
+			}
+			files = append(files, dir.Files[schema.SingleFileTag])
+		}
+		node, keys, err = a.node.AddNodeFromFiles(files)
+	} else {
+		node, keys, err = a.node.AddNodeFromDirs(dirs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, fmt.Errorf("no files found")
+	}
+
+	hash, err := thrd.AddFiles(node, op.Caption, keys.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.node.File(hash.B58String())
+}
+
+// batchDirectory returns the directory for a single file of a files operation
+func (a *api) batchDirectory(node *pb.Node, file *batchFile, outputs map[string]*batchOutput) (*pb.Directory, error) {
+	dir := &pb.Directory{Files: make(map[string]*pb.FileIndex)}
+
+	switch {
+	case file.Use != "":
+		index, err := resolveBatchFile(file.Use, outputs)
+		if err != nil {
+			return nil, err
+		}
+		dir.Files[schema.SingleFileTag] = index
+		return dir, nil
+
+	case len(file.Dir) > 0:
+		for name, ref := range file.Dir {
+			index, err := resolveBatchFile(ref, outputs)
+			if err != nil {
+				return nil, err
+			}
+			dir.Files[name] = index
+		}
+		return dir, nil
+
+	case len(file.Data) == 0:
+		return nil, fmt.Errorf("missing file data")
+	}
+
+	if node.Mill != "" {
+		mill, err := millForId(node.Mill, node.Opts)
+		if err != nil {
+			return nil, err
+		}
+		added, err := a.node.millData(mill, file.Data, file.Name, "", node.Plaintext)
+		if err != nil {
+			return nil, err
+		}
+		dir.Files[schema.SingleFileTag] = added
+		return dir, nil
+	}
+	if len(node.Links) == 0 {
+		return nil, schema.ErrEmptySchema
+	}
+
+	steps, err := schema.Steps(node.Links)
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range steps {
+		mill, err := millForId(step.Link.Mill, step.Link.Opts)
+		if err != nil {
+			return nil, err
+		}
+
+		var added *pb.FileIndex
+		if step.Link.Use == schema.FileTag {
+			added, err = a.node.millData(mill, file.Data, file.Name, "", step.Link.Plaintext)
+		} else {
+			if dir.Files[step.Link.Use] == nil {
+				return nil, fmt.Errorf(step.Link.Use + " not found")
+			}
+			added, err = a.node.millData(mill, nil, "", dir.Files[step.Link.Use].Hash, step.Link.Plaintext)
+		}
+		if err != nil {
+			return nil, err
+		}
+		dir.Files[step.Name] = added
+	}
+	return dir, nil
+}
+
+// batchThread returns a thread by id, which can also be 'default'
+func (a *api) batchThread(id string) (*Thread, error) {
+	if id == "" || id == "default" {
+		id = a.node.config.Threads.Defaults.ID
+	}
+	thrd := a.node.Thread(id)
+	if thrd == nil {
+		return nil, ErrThreadNotFound
+	}
+	return thrd, nil
+}
+
+// batchBlockThread resolves a block reference, returning the block id and its thread
+func (a *api) batchBlockThread(ref string, outputs map[string]*batchOutput) (string, *Thread, error) {
+	if ref == "" {
+		return "", nil, fmt.Errorf("missing block id")
+	}
+	id, err := resolveBatchRef(ref, outputs)
+	if err != nil {
+		return "", nil, err
+	}
+	block, err := a.node.Block(id)
+	if err != nil {
+		return "", nil, fmt.Errorf("block not found")
+	}
+	thrd := a.node.Thread(block.Thread)
+	if thrd == nil {
+		return "", nil, ErrThreadNotFound
+	}
+	return id, thrd, nil
+}
+
+// resolveBatchRef returns the value of a reference to an earlier operation, or the value itself
+func resolveBatchRef(val string, outputs map[string]*batchOutput) (string, error) {
+	if !strings.HasPrefix(val, batchRefPrefix) {
+		return val, nil
+	}
+	out := outputs[strings.TrimPrefix(val, batchRefPrefix)]
+	if out == nil {
+		return "", fmt.Errorf("operation not found or failed: %s", val)
+	}
+	return out.ref, nil
+}
+
+// resolveBatchFile returns the file index from an earlier mill operation
+func resolveBatchFile(ref string, outputs map[string]*batchOutput) (*pb.FileIndex, error) {
+	if !strings.HasPrefix(ref, batchRefPrefix) {
+		return nil, fmt.Errorf("file must reference a mill operation: %s", ref)
+	}
+	out := outputs[strings.TrimPrefix(ref, batchRefPrefix)]
+	if out == nil || out.file == nil {
+		return nil, fmt.Errorf("mill operation not found or failed: %s", ref)
+	}
+	return out.file, nil
+}
+
+// millForId returns a mill by id, applying the same option defaults as the mill endpoints
+func millForId(id string, opts map[string]string) (m.Mill, error) {
+	switch id {
+	case "/schema":
+		return &m.Schema{}, nil
+	case "/blob":
+		return &m.Blob{}, nil
+	case "/image/resize":
+		if opts["width"] == "" {
+			return nil, fmt.Errorf("missing width")
+		}
+		quality := opts["quality"]
+		if quality == "" {
+			quality = "75"
+		}
+		return &m.ImageResize{
+			Opts: m.ImageResizeOpts{
+				Width:   opts["width"],
+				Quality: quality,
+			},
+		}, nil
+	case "/image/exif":
+		return &m.ImageExif{}, nil
+	case "/json":
+		return &m.Json{}, nil
+	default:
+		return nil, fmt.Errorf("invalid mill: %s", id)
+	}
+}
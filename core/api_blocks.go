@@ -4,10 +4,9 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/gin-gonic/gin"
-	"github.com/textileio/go-textile/ipfs"
+
 	"github.com/textileio/go-textile/pb"
 )
 
@@ -19,11 +18,13 @@ import (
 // @Description traversing the hash tree.
 // @Tags blocks
 // @Produce application/json
-// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default'), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5)" default(thread=,offset=,limit=5)
+// @Param thread query string false "Thread ID (can also use 'default')"
+// @Param offset query string false "Offset ID to start listing from (omit for latest)"
+// @Param limit query integer false "List page size (default: 5)" default(5)
 // @Success 200 {object} pb.BlockList "blocks"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /blocks [get]
 func (a *api) lsBlocks(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -61,6 +62,8 @@ func (a *api) lsBlocks(g *gin.Context) {
 	for _, block := range blocks.Items {
 		block.User = a.node.PeerUser(block.Author)
 	}
+	blocks.Count = int32(len(blocks.Items))
+	blocks.Next = a.node.nextBlockOffset(blocks, query)
 
 	var dots bool
 	if opts["dots"] != "" {
@@ -76,17 +79,7 @@ func (a *api) lsBlocks(g *gin.Context) {
 		return
 	}
 
-	var nextOffset string
-	if len(blocks.Items) > 0 {
-		nextOffset = blocks.Items[len(blocks.Items)-1].Id
-
-		// see if there's actually more
-		if len(a.node.datastore.Blocks().List(nextOffset, 1, query).Items) == 0 {
-			nextOffset = ""
-		}
-	}
-
-	dotsf, err := a.toDots(blocks)
+	dotsf, err := a.node.toDots(blocks)
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -94,8 +87,8 @@ func (a *api) lsBlocks(g *gin.Context) {
 
 	viz := &pb.BlockViz{
 		Dots:  dotsf,
-		Count: int32(len(blocks.Items)),
-		Next:  nextOffset,
+		Count: blocks.Count,
+		Next:  blocks.Next,
 	}
 
 	pbJSON(g, http.StatusOK, viz)
@@ -108,7 +101,7 @@ func (a *api) lsBlocks(g *gin.Context) {
 // @Produce application/json
 // @Param id path string true "block id"
 // @Success 200 {object} pb.Block "block"
-// @Failure 404 {string} string "Not Found"
+// @Failure 404 {object} core.apiError "Not Found"
 // @Router /blocks/{id} [get]
 func (a *api) getBlocks(g *gin.Context) {
 	id := g.Param("id")
@@ -129,9 +122,9 @@ func (a *api) getBlocks(g *gin.Context) {
 // @Produce application/json
 // @Param id path string true "block id"
 // @Success 201 {object} pb.Block "block"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /blocks/{id} [delete]
 func (a *api) rmBlocks(g *gin.Context) {
 	id := g.Param("id")
@@ -169,43 +162,3 @@ func (a *api) getBlockThread(g *gin.Context, id string) *Thread {
 	}
 	return thrd
 }
-
-func (a *api) toDots(blocks *pb.BlockList) (string, error) {
-	dots := `digraph {
-    rankdir="BT";`
-
-	for _, b := range blocks.Items {
-		dot := toDot(b)
-
-		for _, p := range b.Parents {
-			if strings.TrimSpace(p) == "" {
-				continue
-			}
-			pp, err := a.node.Block(p)
-			if err != nil {
-				log.Warningf("block %s: %s", p, err)
-				dots += "\n    " + dot + " -> MISSING_" + pre(p) + ";"
-				continue
-			}
-			dots += "\n    " + dot + " -> " + toDot(pp) + ";"
-		}
-	}
-
-	return dots + "\n}", nil
-}
-
-func toDot(block *pb.Block) string {
-	t := block.Type.String()
-	var a string
-	if block.Type != pb.Block_MERGE {
-		a = "_" + ipfs.ShortenID(block.Author)
-	}
-	return t + a + "_" + pre(block.Id)
-}
-
-func pre(hash string) string {
-	if len(hash) < 7 {
-		return hash
-	}
-	return hash[:7]
-}
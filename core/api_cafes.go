@@ -2,6 +2,7 @@ package core
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -13,11 +14,13 @@ import (
 // @Description Cafe
 // @Tags cafes
 // @Produce application/json
-// @Param X-Textile-Args header string true "cafe host"
-// @Param X-Textile-Opts header string false "token: An access token supplied by the Cafe" default(token=)
+// @Accept application/json
+// @Param arg query string true "cafe host"
+// @Param args body core.apiArgs false "JSON alternative to arg query params"
+// @Param token query string false "An access token supplied by the Cafe"
 // @Success 201 {object} pb.CafeSession "cafe session"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /cafes [post]
 func (a *api) addCafes(g *gin.Context) {
 	args, err := a.readArgs(g)
@@ -58,7 +61,7 @@ func (a *api) addCafes(g *gin.Context) {
 // @Tags cafes
 // @Produce application/json
 // @Success 200 {object} pb.CafeSessionList "cafe sessions"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /cafes [get]
 func (a *api) lsCafes(g *gin.Context) {
 	pbJSON(g, http.StatusOK, a.node.CafeSessions())
@@ -72,8 +75,8 @@ func (a *api) lsCafes(g *gin.Context) {
 // @Produce application/json
 // @Param id path string true "cafe id"
 // @Success 200 {object} pb.CafeSession "cafe session"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /cafes/{id} [get]
 func (a *api) getCafes(g *gin.Context) {
 	id := g.Param("id")
@@ -91,13 +94,55 @@ func (a *api) getCafes(g *gin.Context) {
 	pbJSON(g, http.StatusOK, session)
 }
 
+// updateCafes godoc
+// @Summary Updates a cafe session
+// @Description Updates a cafe session's routing priority. Higher priority cafes are preferred
+// @Description for new requests and are listed first as inboxes
+// @Tags cafes
+// @Produce application/json
+// @Param id path string true "cafe id"
+// @Param priority query integer true "Routing priority" default(0)
+// @Success 200 {object} pb.CafeSession "cafe session"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /cafes/{id} [put]
+func (a *api) updateCafes(g *gin.Context) {
+	id := g.Param("id")
+
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	priority, err := strconv.Atoi(opts["priority"])
+	if err != nil {
+		g.String(http.StatusBadRequest, "invalid priority")
+		return
+	}
+
+	if session, _ := a.node.CafeSession(id); session == nil {
+		g.String(http.StatusNotFound, "cafe not found")
+		return
+	}
+
+	session, err := a.node.UpdateCafePriority(id, priority)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, session)
+}
+
 // rmCafes godoc
 // @Summary Deregisters a cafe
 // @Description Deregisters with a cafe (content will expire based on the cafe's service rules)
 // @Tags cafes
 // @Param id path string true "cafe id"
 // @Success 204 {string} string "ok"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /cafes/{id} [delete]
 func (a *api) rmCafes(g *gin.Context) {
 	id := g.Param("id")
@@ -116,8 +161,8 @@ func (a *api) rmCafes(g *gin.Context) {
 // @Description opportunistically.
 // @Tags cafes
 // @Produce text/plain
-// @Success 200 {string} string "ok"
-// @Failure 500 {string} string "Internal Server Error"
+// @Success 204 {string} string "ok"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /cafes/messages [post]
 func (a *api) checkCafeMessages(g *gin.Context) {
 	if err := a.node.CheckCafeMessages(); err != nil {
@@ -125,5 +170,9 @@ func (a *api) checkCafeMessages(g *gin.Context) {
 		return
 	}
 
+	if isApiV1(g) {
+		g.Status(http.StatusNoContent)
+		return
+	}
 	g.String(http.StatusOK, "ok")
 }
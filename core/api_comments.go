@@ -12,11 +12,13 @@ import (
 // @Tags blocks
 // @Produce application/json
 // @Param id path string true "block id"
-// @Param X-Textile-Args header string true "urlescaped comment body"
+// @Accept application/json
+// @Param arg query string true "urlescaped comment body"
+// @Param args body core.apiArgs false "JSON alternative to arg query params"
 // @Success 201 {object} pb.Comment "comment"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /blocks/{id}/comments [post]
 func (a *api) addBlockComments(g *gin.Context) {
 	id := g.Param("id")
@@ -58,7 +60,7 @@ func (a *api) addBlockComments(g *gin.Context) {
 // @Produce application/json
 // @Param id path string true "block id"
 // @Success 200 {object} pb.CommentList "comments"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /blocks/{id}/comments [get]
 func (a *api) lsBlockComments(g *gin.Context) {
 	id := g.Param("id")
@@ -79,7 +81,7 @@ func (a *api) lsBlockComments(g *gin.Context) {
 // @Produce application/json
 // @Param id path string true "block id"
 // @Success 200 {object} pb.Comment "comment"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /blocks/{id}/comment [get]
 func (a *api) getBlockComment(g *gin.Context) {
 	info, err := a.node.Comment(g.Param("id"))
@@ -41,7 +41,7 @@ func getKeyValue(path string, object interface{}) (interface{}, error) {
 // @Produce application/json
 // @Param path path string false "config path (e.g., Addresses/API)"
 // @Success 200 {object} mill.Json "new config value"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /config/{path} [get]
 func (a *api) getConfig(g *gin.Context) {
 	pth := g.Param("path")
@@ -70,7 +70,7 @@ func (a *api) getConfig(g *gin.Context) {
 // @Accept application/json
 // @Param patch body mill.Json true "An RFC6902 JSON patch (array of ops)"
 // @Success 204 {string} string "No Content"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /config [patch]
 func (a *api) patchConfig(g *gin.Context) {
 	body, err := ioutil.ReadAll(g.Request.Body)
@@ -80,43 +80,7 @@ func (a *api) patchConfig(g *gin.Context) {
 	}
 	defer g.Request.Body.Close()
 
-	// decode request body into a RFC 6902 patch (array of ops)
-	patch, err := jsonpatch.DecodePatch(body)
-	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
-	}
-
-	configPath := path.Join(a.node.repoPath, "textile")
-
-	original, err := ioutil.ReadFile(configPath)
-	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
-	}
-
-	// apply json patch to config
-	modified, err := patch.Apply(original)
-	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
-	}
-
-	// make sure our config is still valid
-	conf := config.Config{}
-	err = json.Unmarshal(modified, &conf)
-	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
-	}
-
-	jsn, err := json.MarshalIndent(conf, "", "    ")
-	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
-	}
-
-	if err := ioutil.WriteFile(configPath, jsn, 0666); err != nil {
+	if err := a.node.patchConfigFile(body); err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
 	}
@@ -133,11 +97,9 @@ func (a *api) patchConfig(g *gin.Context) {
 // @Accept application/json
 // @Param config body mill.Json true "JSON document"
 // @Success 204 {string} string "No Content"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /config [put]
 func (a *api) setConfig(g *gin.Context) {
-	configPath := path.Join(a.node.repoPath, "textile")
-
 	body, err := ioutil.ReadAll(g.Request.Body)
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
@@ -145,23 +107,57 @@ func (a *api) setConfig(g *gin.Context) {
 	}
 	defer g.Request.Body.Close()
 
-	conf := config.Config{}
-	err = json.Unmarshal(body, &conf)
-	if err != nil {
+	if err := a.node.setConfigFile(body); err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
 	}
 
-	jsn, err := json.MarshalIndent(conf, "", "    ")
+	g.Writer.WriteHeader(http.StatusNoContent)
+}
+
+// patchConfigFile applies a RFC 6902 patch (array of ops) to the config file
+func (t *Textile) patchConfigFile(body []byte) error {
+	patch, err := jsonpatch.DecodePatch(body)
 	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
+		return err
 	}
 
-	if err := ioutil.WriteFile(configPath, jsn, 0666); err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
+	t.configMux.Lock()
+	defer t.configMux.Unlock()
+
+	original, err := ioutil.ReadFile(path.Join(t.repoPath, "textile"))
+	if err != nil {
+		return err
 	}
 
-	g.Writer.WriteHeader(http.StatusNoContent)
+	// apply json patch to config
+	modified, err := patch.Apply(original)
+	if err != nil {
+		return err
+	}
+
+	return writeConfigFile(t.repoPath, modified)
+}
+
+// setConfigFile replaces the config file, which takes effect on restart
+func (t *Textile) setConfigFile(body []byte) error {
+	t.configMux.Lock()
+	defer t.configMux.Unlock()
+	return writeConfigFile(t.repoPath, body)
+}
+
+// writeConfigFile validates and writes a config file, callers must hold the config lock
+func writeConfigFile(repoPath string, body []byte) error {
+	// make sure our config is still valid
+	conf := config.Config{}
+	if err := json.Unmarshal(body, &conf); err != nil {
+		return err
+	}
+
+	jsn, err := json.MarshalIndent(conf, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path.Join(repoPath, "textile"), jsn, 0666)
 }
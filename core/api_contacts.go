@@ -16,7 +16,7 @@ import (
 // @Param address path string true "address"
 // @Param contact body pb.Contact true "contact"
 // @Success 204 {string} string "ok"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /contacts/{address} [put]
 func (a *api) addContacts(g *gin.Context) {
 	var contact pb.Contact
@@ -47,8 +47,8 @@ func (a *api) addContacts(g *gin.Context) {
 // @Tags contacts
 // @Produce application/json
 // @Success 200 {object} pb.ContactList "contacts"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /contacts [get]
 func (a *api) lsContacts(g *gin.Context) {
 	pbJSON(g, http.StatusOK, a.node.Contacts())
@@ -61,7 +61,7 @@ func (a *api) lsContacts(g *gin.Context) {
 // @Produce application/json
 // @Param address path string true "address"
 // @Success 200 {object} pb.Contact "contact"
-// @Failure 404 {string} string "Not Found"
+// @Failure 404 {object} core.apiError "Not Found"
 // @Router /contacts/{address} [get]
 func (a *api) getContacts(g *gin.Context) {
 	contact := a.node.Contact(g.Param("address"))
@@ -79,8 +79,8 @@ func (a *api) getContacts(g *gin.Context) {
 // @Tags contacts
 // @Param address path string true "address"
 // @Success 204 {string} string "ok"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /contacts/{address} [delete]
 func (a *api) rmContacts(g *gin.Context) {
 	address := g.Param("address")
@@ -104,10 +104,17 @@ func (a *api) rmContacts(g *gin.Context) {
 // @Description Search for contacts known locally and on the network
 // @Tags contacts
 // @Produce application/json
-// @Param X-Textile-Opts header string false "local: Whether to only search local contacts, remote: Whether to only search remote contacts, limit: Stops searching after limit results are found, wait: Stops searching after 'wait' seconds have elapsed (max 30s), username: search by username string, address: search by account address string, events: Whether to emit Server-Sent Events (SSEvent) or plain JSON" default(local="false",limit=5,wait=5,address=,username=,events="false")
+// @Param local query boolean false "Whether to only search local contacts" default(false)
+// @Param remote query string false "Whether to only search remote contacts"
+// @Param limit query integer false "Stops searching after limit results are found" default(5)
+// @Param wait query integer false "Stops searching after 'wait' seconds have elapsed (max 30s)" default(5)
+// @Param username query string false "Search by username string"
+// @Param address query string false "Search by account address string"
+// @Param federated query boolean false "Whether cafes should forward the search to their federated peer cafes instead of using pubsub" default(false)
+// @Param events query boolean false "Whether to emit Server-Sent Events (SSEvent) or plain JSON" default(false)
 // @Success 200 {object} pb.QueryResult "results stream"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /contacts/search [post]
 func (a *api) searchContacts(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -142,6 +149,7 @@ func (a *api) searchContacts(g *gin.Context) {
 		RemoteOnly: remoteOnly,
 		Limit:      int32(limit),
 		Wait:       int32(wait),
+		Federated:  opts["federated"] == "true",
 	}
 
 	resCh, errCh, cancel, err := a.node.SearchContacts(query, options)
@@ -27,11 +27,14 @@ import (
 // @Description Newer annotations may have already been listed in the case as well.
 // @Tags feed
 // @Produce application/json
-// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default'), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5), mode: Feed mode (one of 'chrono', 'annotated', or 'stacks')" default(thread=,offset=,limit=5,mode="chrono")
+// @Param thread query string false "Thread ID (can also use 'default')"
+// @Param offset query string false "Offset ID to start listing from (omit for latest)"
+// @Param limit query integer false "List page size (default: 5)" default(5)
+// @Param mode query string false "Feed mode (one of 'chrono', 'annotated', or 'stacks')" default(chrono)
 // @Success 200 {object} pb.FeedItemList "feed"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /feed [get]
 func (a *api) lsThreadFeed(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -1,14 +1,16 @@
 package core
 
 import (
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/textileio/go-textile/ipfs"
 	"github.com/textileio/go-textile/pb"
-	"github.com/textileio/go-textile/schema"
 )
 
 // addThreadFiles godoc
@@ -20,11 +22,11 @@ import (
 // @Accept application/json
 // @Produce application/json
 // @Param dir body pb.DirectoryList true "list of milled dirs (output from mill endpoint)"
-// @Param X-Textile-Opts header string false "caption: Caption to add to file(s)" default(caption=)
+// @Param caption query string false "Caption to add to file(s)"
 // @Success 201 {object} pb.Files "file"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /threads/{id}/files [post]
 func (a *api) addThreadFiles(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -43,51 +45,13 @@ func (a *api) addThreadFiles(g *gin.Context) {
 		return
 	}
 
-	var node ipld.Node
-	var keys *pb.Keys
-
 	dirs := new(pb.DirectoryList)
 	if err := pbUnmarshaler.Unmarshal(g.Request.Body, dirs); err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
 	}
-	if len(dirs.Items) == 0 {
-		g.String(http.StatusBadRequest, "no files found")
-		return
-	}
 
-	if dirs.Items[0].Files[schema.SingleFileTag] != nil {
-		var files []*pb.FileIndex
-		for _, dir := range dirs.Items {
-			if len(dir.Files) > 0 && dir.Files[schema.SingleFileTag].Hash != "" {
-				files = append(files, dir.Files[schema.SingleFileTag])
-			}
-		}
-		node, keys, err = a.node.AddNodeFromFiles(files)
-		if err != nil {
-			g.String(http.StatusBadRequest, err.Error())
-			return
-		}
-	} else {
-		node, keys, err = a.node.AddNodeFromDirs(dirs)
-		if err != nil {
-			g.String(http.StatusBadRequest, err.Error())
-			return
-		}
-	}
-
-	if node == nil {
-		g.String(http.StatusBadRequest, "no files found")
-		return
-	}
-
-	hash, err := thrd.AddFiles(node, opts["caption"], keys.Files)
-	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
-	}
-
-	files, err := a.node.File(hash.B58String())
+	files, err := a.node.addThreadDirs(thrd, dirs, opts["caption"])
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -102,11 +66,13 @@ func (a *api) addThreadFiles(g *gin.Context) {
 // @Description "default" to use the default thread (if set).
 // @Tags files
 // @Produce application/json
-// @Param X-Textile-Opts header string false "thread: Thread ID. Omit for all, offset: Offset ID to start listing from. Omit for latest, limit: List page size. (default: 5)" default(thread=,offset=,limit=5)
+// @Param thread query string false "Thread ID. Omit for all"
+// @Param offset query string false "Offset ID to start listing from. Omit for latest"
+// @Param limit query integer false "List page size. (default: 5)" default(5)
 // @Success 200 {object} pb.FilesList "files"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /files [get]
 func (a *api) lsThreadFiles(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -152,7 +118,7 @@ func (a *api) lsThreadFiles(g *gin.Context) {
 // @Produce application/json
 // @Param block path string true "block id"
 // @Success 200 {object} pb.Files "file"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /files/{block} [get]
 func (a *api) getThreadFiles(g *gin.Context) {
 	files, err := a.node.File(g.Param("block"))
@@ -171,7 +137,7 @@ func (a *api) getThreadFiles(g *gin.Context) {
 // @Produce application/json
 // @Param target path string true "target id"
 // @Success 200 {object} pb.Keys "keys"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /keys/{target} [get]
 func (a *api) lsThreadFileTargetKeys(g *gin.Context) {
 	target := g.Param("target")
@@ -193,19 +159,66 @@ func (a *api) lsThreadFileTargetKeys(g *gin.Context) {
 
 // getFileData godoc
 // @Summary File data at hash
-// @Description Returns raw data for file
+// @Description Returns raw data for file. Range requests are supported for seeking and
+// @Description resuming downloads. The file hash is used as an ETag for conditional requests.
 // @Tags files
 // @Produce application/octet-stream
 // @Param hash path string true "file hash"
+// @Param Range header string false "byte range, e.g., bytes=0-1023"
+// @Param If-None-Match header string false "file hash ETag from a previous response"
 // @Success 200 {string} byte
-// @Failure 404 {string} string "Not Found"
+// @Success 206 {string} byte
+// @Success 304 {string} string "Not Modified"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 416 {object} core.apiError "Requested Range Not Satisfiable"
 // @Router /file/{hash}/data [get]
 func (a *api) getFileData(g *gin.Context) {
-	reader, file, err := a.node.FileData(g.Param("hash"))
+	file := a.node.datastore.Files().Get(g.Param("hash"))
+	if file == nil {
+		g.String(http.StatusNotFound, ErrFileNotFound.Error())
+		return
+	}
+
+	// file data is immutable, so its hash is a strong validator, which is checked
+	// before any data is fetched
+	etag := `"` + file.Hash + `"`
+	g.Header("ETag", etag)
+	if etagMatch(g.GetHeader("If-None-Match"), etag) {
+		g.Status(http.StatusNotModified)
+		return
+	}
+
+	reader, _, err := a.node.OpenFileData(g.Request.Context(), file.Hash)
 	if err != nil {
 		g.String(http.StatusNotFound, err.Error())
 		return
 	}
+	defer reader.Close()
 
-	g.DataFromReader(http.StatusOK, file.Size, file.Media, reader, map[string]string{})
+	if file.Media != "" {
+		g.Header("Content-Type", file.Media)
+	}
+	disposition := mime.FormatMediaType("inline", map[string]string{"filename": file.Name})
+	if file.Name != "" && disposition != "" {
+		g.Header("Content-Disposition", disposition)
+	}
+
+	var modified time.Time
+	if file.Added != nil {
+		modified, _ = ptypes.Timestamp(file.Added)
+	}
+
+	// handles range and conditional requests
+	http.ServeContent(g.Writer, g.Request, "", modified, reader)
+}
+
+// etagMatch returns whether an If-None-Match header lists etag, using weak comparison
+func etagMatch(header string, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
 }
@@ -13,11 +13,12 @@ import (
 // @Description Creates a direct account-to-account or external invite to a thread
 // @Tags invites
 // @Produce application/json
-// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default'), address: Account Address (omit to create an external invite)" default(thread=,address=)
+// @Param thread query string false "Thread ID (can also use 'default')"
+// @Param address query string false "Account Address (omit to create an external invite)"
 // @Success 201 {object} pb.ExternalInvite "invite"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /invites [post]
 func (a *api) createInvites(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -67,11 +68,11 @@ func (a *api) lsInvites(g *gin.Context) {
 // @Tags invites
 // @Produce application/json
 // @Param id path string true "invite id"
-// @Param X-Textile-Opts header string false "key: key for an external invite" default(key=)
+// @Param key query string false "Key for an external invite"
 // @Success 201 {object} pb.Block "join block"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 409 {string} string "Conflict"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 409 {object} core.apiError "Conflict"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /invites/{id}/accept [post]
 func (a *api) acceptInvites(g *gin.Context) {
 	id := g.Param("id")
@@ -120,8 +121,8 @@ func (a *api) acceptInvites(g *gin.Context) {
 // @Tags invites
 // @Produce application/json
 // @Param id path string true "invite id"
-// @Success 200 {string} string "ok"
-// @Failure 400 {string} string "Bad Request"
+// @Success 204 {string} string "ok"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /invites/{id}/ignore [post]
 func (a *api) ignoreInvites(g *gin.Context) {
 	id := g.Param("id")
@@ -131,5 +132,9 @@ func (a *api) ignoreInvites(g *gin.Context) {
 		return
 	}
 
+	if isApiV1(g) {
+		g.Status(http.StatusNoContent)
+		return
+	}
 	g.String(http.StatusOK, "ok")
 }
@@ -15,7 +15,7 @@ import (
 // @Tags ipfs
 // @Produce text/plain
 // @Success 200 {string} string "peer id"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /ipfs/id [get]
 func (a *api) ipfsId(g *gin.Context) {
 	pid, err := a.node.PeerId()
@@ -31,10 +31,12 @@ func (a *api) ipfsId(g *gin.Context) {
 // @Description Opens a new direct connection to a peer using an IPFS multiaddr
 // @Tags ipfs
 // @Produce application/json
-// @Param X-Textile-Args header string true "peer address"
+// @Accept application/json
+// @Param arg query string true "peer address"
+// @Param args body core.apiArgs false "JSON alternative to arg query params"
 // @Success 200 {array} string "ok"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /ipfs/swarm/connect [post]
 func (a *api) ipfsSwarmConnect(g *gin.Context) {
 	args, err := a.readArgs(g)
@@ -61,10 +63,13 @@ func (a *api) ipfsSwarmConnect(g *gin.Context) {
 // @Description Lists the set of peers this node is connected to
 // @Tags ipfs
 // @Produce application/json
-// @Param X-Textile-Opts header string false "verbose: Display all extra information, latency: Also list information about latency to each peer, streams: Also list information about open streams for each peer, direction: Also list information about the direction of connection" default(verbose="false",latency="false",streams="false",direction="false")
+// @Param verbose query boolean false "Display all extra information" default(false)
+// @Param latency query boolean false "Also list information about latency to each peer" default(false)
+// @Param streams query boolean false "Also list information about open streams for each peer" default(false)
+// @Param direction query boolean false "Also list information about the direction of connection" default(false)
 // @Success 200 {object} ipfs.ConnInfos "connection"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /ipfs/swarm/peers [get]
 func (a *api) ipfsSwarmPeers(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -92,12 +97,12 @@ func (a *api) ipfsSwarmPeers(g *gin.Context) {
 // @Tags ipfs
 // @Produce application/octet-stream
 // @Param path path string true "ipfs/ipns cid"
-// @Param X-Textile-Opts header string false "key: Key to decrypt data on-the-fly" default(key=)
+// @Param key query string false "Key to decrypt data on-the-fly"
 // @Success 200 {array} byte "data"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 401 {string} string "Unauthorized"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 401 {object} core.apiError "Unauthorized"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /ipfs/cat/{path} [get]
 func (a *api) ipfsCat(g *gin.Context) {
 	pth := g.Param("path")
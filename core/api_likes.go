@@ -13,9 +13,9 @@ import (
 // @Produce application/json
 // @Param id path string true "block id"
 // @Success 201 {object} pb.Like "like"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /blocks/{id}/likes [post]
 func (a *api) addBlockLikes(g *gin.Context) {
 	id := g.Param("id")
@@ -47,7 +47,7 @@ func (a *api) addBlockLikes(g *gin.Context) {
 // @Produce application/json
 // @Param id path string true "block id"
 // @Success 200 {object} pb.LikeList "likes"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /blocks/{id}/likes [get]
 func (a *api) lsBlockLikes(g *gin.Context) {
 	id := g.Param("id")
@@ -68,7 +68,7 @@ func (a *api) lsBlockLikes(g *gin.Context) {
 // @Produce application/json
 // @Param id path string true "block id"
 // @Success 200 {object} pb.Like "like"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /blocks/{id}/like [get]
 func (a *api) getBlockLike(g *gin.Context) {
 	info, err := a.node.Like(g.Param("id"))
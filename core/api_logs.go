@@ -18,28 +18,41 @@ type SubsystemInfo map[string]string
 // @Tags utils
 // @Produce application/json
 // @Param subsystem path string false "subsystem logging identifier (omit for all)"
-// @Param X-Textile-Opts header string false "level: Log-level (one of: debug, info, warning, error, critical, or "" to get current), tex-only: Whether to list/change only Textile subsystems, or all available subsystems" default(level=,tex-only="false")
+// @Param level query string false "Log-level (one of: debug, info, warning, error, critical, or "" to get current)"
+// @Param tex-only query boolean false "Whether to list/change only Textile subsystems, or all available subsystems" default(false)
 // @Success 200 {object} core.SubsystemInfo "subsystems"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /logs/{subsystem} [post]
 func (a *api) logsCall(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	var level string
+	if g.Request.Method == "POST" {
+		level = opts["level"]
+	}
 
-	subsystem := g.Param("subsystem")
+	result, err := logLevels(g.Param("subsystem"), level, opts["tex-only"] == "true")
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	g.JSON(http.StatusOK, result)
+}
+
+// logLevels returns the log level of subsystem, or all subsystems if empty,
+// first setting it to level if not empty
+func logLevels(subsystem string, level string, texOnly bool) (map[string]string, error) {
 	var subsystems []string
 	if subsystem == "" {
 		subsystems = logging.GetSubsystems()
 	} else {
 		subsystems = []string{subsystem}
 	}
-
-	opts, err := a.readOpts(g)
-	if err != nil {
-		a.abort500(g, err)
-		return
-	}
-	level := strings.ToUpper(opts["level"])
-	texOnly := opts["tex-only"] == "true"
+	level = strings.ToUpper(level)
 
 	result := make(map[string]string)
 	for _, system := range subsystems {
@@ -47,23 +60,21 @@ func (a *api) logsCall(g *gin.Context) {
 			continue
 		}
 		var llevel logger.Level
-		if level != "" && g.Request.Method == "POST" {
+		var err error
+		if level != "" {
 			// validate log level
 			llevel, err = logger.LogLevel(level)
 			if err != nil {
-				g.String(http.StatusBadRequest, err.Error())
-				return
+				return nil, err
 			}
 		} else {
 			llevel = logger.GetLevel(system)
 		}
 		// validate subsystem + set log level
-		err = logging.SetLogLevel(system, llevel.String())
-		if err != nil {
-			g.String(http.StatusBadRequest, err.Error())
-			return
+		if err := logging.SetLogLevel(system, llevel.String()); err != nil {
+			return nil, err
 		}
 		result[system] = llevel.String()
 	}
-	g.JSON(http.StatusOK, result)
+	return result, nil
 }
@@ -12,11 +12,13 @@ import (
 // @Description Adds a message to a thread
 // @Tags threads
 // @Produce application/json
-// @Param X-Textile-Args header string true "urlescaped message body"
+// @Accept application/json
+// @Param arg query string true "urlescaped message body"
+// @Param args body core.apiArgs false "JSON alternative to arg query params"
 // @Success 200 {object} pb.Text "message"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /threads/{id}/messages [post]
 func (a *api) addThreadMessages(g *gin.Context) {
 	args, err := a.readArgs(g)
@@ -59,11 +61,13 @@ func (a *api) addThreadMessages(g *gin.Context) {
 // @Description Paginates thread messages
 // @Tags messages
 // @Produce application/json
-// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default', omit for all), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5)" default(thread=,offset=,limit=10)
+// @Param thread query string false "Thread ID (can also use 'default', omit for all)"
+// @Param offset query string false "Offset ID to start listing from (omit for latest)"
+// @Param limit query integer false "List page size (default: 5)" default(10)
 // @Success 200 {object} pb.TextList "messages"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /messages [get]
 func (a *api) lsThreadMessages(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -109,7 +113,7 @@ func (a *api) lsThreadMessages(g *gin.Context) {
 // @Produce application/json
 // @Param block path string true "block id"
 // @Success 200 {object} pb.Text "message"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /messages/{block} [get]
 func (a *api) getThreadMessages(g *gin.Context) {
 	info, err := a.node.Message(g.Param("block"))
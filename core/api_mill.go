@@ -17,7 +17,7 @@ import (
 // @Produce application/json
 // @Param schema body pb.Node true "schema"
 // @Success 201 {object} pb.FileIndex "file"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /mills/schema [post]
 func (a *api) schemaMill(g *gin.Context) {
 	body, err := ioutil.ReadAll(g.Request.Body)
@@ -46,15 +46,16 @@ func (a *api) schemaMill(g *gin.Context) {
 // blobMill godoc
 // @Summary Process raw data blobs
 // @Description Takes a binary data blob, and optionally encrypts it, before adding to IPFS,
-// @Description and returns a file object
+// @Description and returns a file object. The blob is streamed rather than held in memory.
 // @Tags mills
 // @Accept multipart/form-data
 // @Produce application/json
 // @Param file formData file false "multipart/form-data file"
-// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted), use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext=false,use="")
+// @Param plaintext query boolean false "Whether to leave unencrypted" default(false)
+// @Param use query string false "If empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS"
 // @Success 201 {object} pb.FileIndex "file"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /mills/blob [post]
 func (a *api) blobMill(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -66,7 +67,7 @@ func (a *api) blobMill(g *gin.Context) {
 
 	plaintext := opts["plaintext"] == "true"
 
-	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	conf, err := a.getFileStreamConfig(g, mill, opts["use"], plaintext)
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -89,10 +90,13 @@ func (a *api) blobMill(g *gin.Context) {
 // @Accept multipart/form-data
 // @Produce application/json
 // @Param file formData file false "multipart/form-data file"
-// @Param X-Textile-Opts header string true "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, width: the requested image width (required), quality: the requested JPEG image quality" default(plaintext=false,use="",quality=75,width=100)
+// @Param plaintext query boolean false "Whether to leave unencrypted" default(false)
+// @Param use query string false "If empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS"
+// @Param width query integer true "The requested image width" default(100)
+// @Param quality query integer false "The requested JPEG image quality" default(75)
 // @Success 201 {object} pb.FileIndex "file"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /mills/image/resize [post]
 func (a *api) imageResizeMill(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -143,10 +147,11 @@ func (a *api) imageResizeMill(g *gin.Context) {
 // @Accept multipart/form-data
 // @Produce application/json
 // @Param file formData file false "multipart/form-data file"
-// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext=false,use="")
+// @Param plaintext query boolean false "Whether to leave unencrypted" default(false)
+// @Param use query string false "If empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS"
 // @Success 201 {object} pb.FileIndex "file"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /mills/image/exif [post]
 func (a *api) imageExifMill(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -182,10 +187,11 @@ func (a *api) imageExifMill(g *gin.Context) {
 // @Accept multipart/form-data
 // @Produce application/json
 // @Param file formData file false "multipart/form-data file"
-// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext="false",use="")
+// @Param plaintext query boolean false "Whether to leave unencrypted" default(false)
+// @Param use query string false "If empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS"
 // @Success 201 {object} pb.FileIndex "file"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /mills/json [post]
 func (a *api) jsonMill(g *gin.Context) {
 	opts, err := a.readOpts(g)
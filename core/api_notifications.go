@@ -1,44 +1,196 @@
 package core
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
 )
 
 // lsNotifications godoc
 // @Summary List notifications
-// @Description Lists all notifications generated by thread and account activity.
+// @Description Lists notifications generated by thread and account activity.
+// @Description Notifications can be filtered by thread, type, read state, and date.
 // @Tags notifications
 // @Produce application/json
+// @Param thread query string false "Thread ID (can also use 'default')"
+// @Param type query string false "Or'd list of notification types (e.g., FILES_ADDED|COMMENT_ADDED) or empty to include all types"
+// @Param read query string false "Whether to list only read (true) or unread (false) notifications, omit for both"
+// @Param before query string false "Only list notifications older than this RFC3339 date"
+// @Param offset query string false "Offset ID to start listing from (omit for latest)"
+// @Param limit query integer false "List page size (default: all)" default(-1)
 // @Success 200 {object} pb.NotificationList "notifications"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /notifications [get]
 func (a *api) lsNotifications(g *gin.Context) {
-	pbJSON(g, http.StatusOK, a.node.Notifications("", -1))
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	req, err := a.notificationRequest(opts)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, a.node.FilterNotifications(req))
 }
 
 // readNotifications godoc
 // @Summary Mark notifiction as read
 // @Description Marks a notifiction as read by ID. Use 'all' to mark all as read.
+// @Description When using 'all', notifications can be filtered by thread, type, and date.
 // @Tags notifications
 // @Produce application/json
 // @Param id path string true "notification id"
-// @Success 200 {string} string "ok"
-// @Failure 400 {string} string "Bad Request"
+// @Param thread query string false "Thread ID (can also use 'default')"
+// @Param type query string false "Or'd list of notification types (e.g., FILES_ADDED|COMMENT_ADDED)"
+// @Param before query string false "Only mark notifications older than this RFC3339 date"
+// @Success 204 {string} string "ok"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /notifications/{id}/read [post]
 func (a *api) readNotifications(g *gin.Context) {
 	id := g.Param("id")
 
-	var err error
 	if id == "all" {
-		err = a.node.ReadAllNotifications()
+		opts, err := a.readOpts(g)
+		if err != nil {
+			a.abort500(g, err)
+			return
+		}
+		req, err := a.notificationRequest(opts)
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := a.node.ReadNotifications(req); err != nil {
+			a.abort500(g, err)
+			return
+		}
 	} else {
-		err = a.node.ReadNotification(id)
+		if err := a.node.ReadNotification(id); err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
 	}
-	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
+
+	if isApiV1(g) {
+		g.Status(http.StatusNoContent)
 		return
 	}
+	g.JSON(http.StatusOK, "ok")
+}
+
+// rmNotifications godoc
+// @Summary Remove notifications
+// @Description Removes a notification by ID. Use 'all' to remove all notifications.
+// @Description When using 'all', notifications can be filtered by thread, type, read state, and date.
+// @Tags notifications
+// @Produce application/json
+// @Param id path string true "notification id"
+// @Param thread query string false "Thread ID (can also use 'default')"
+// @Param type query string false "Or'd list of notification types (e.g., FILES_ADDED|COMMENT_ADDED)"
+// @Param read query string false "Whether to remove only read (true) or unread (false) notifications, omit for both"
+// @Param before query string false "Only remove notifications older than this RFC3339 date"
+// @Success 204 {string} string "ok"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /notifications/{id} [delete]
+func (a *api) rmNotifications(g *gin.Context) {
+	id := g.Param("id")
+
+	if id == "all" {
+		opts, err := a.readOpts(g)
+		if err != nil {
+			a.abort500(g, err)
+			return
+		}
+		req, err := a.notificationRequest(opts)
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := a.node.RemoveNotifications(req); err != nil {
+			a.abort500(g, err)
+			return
+		}
+	} else {
+		if err := a.node.RemoveNotification(id); err != nil {
+			g.String(http.StatusNotFound, err.Error())
+			return
+		}
+	}
 
+	if isApiV1(g) {
+		g.Status(http.StatusNoContent)
+		return
+	}
 	g.JSON(http.StatusOK, "ok")
 }
+
+// notificationRequest builds a notification request from the given options
+func (a *api) notificationRequest(opts map[string]string) (*pb.NotificationRequest, error) {
+	req := &pb.NotificationRequest{
+		Offset: opts["offset"],
+		Thread: opts["thread"],
+		Limit:  -1,
+	}
+	if req.Thread == "default" {
+		req.Thread = a.node.config.Threads.Defaults.ID
+	}
+
+	if opts["limit"] != "" {
+		limit, err := strconv.Atoi(opts["limit"])
+		if err != nil {
+			return nil, err
+		}
+		req.Limit = int32(limit)
+	}
+
+	// Expects or'd list of notification types (e.g., FILES_ADDED|COMMENT_ADDED).
+	for _, t := range strings.Split(strings.TrimSpace(strings.ToUpper(opts["type"])), "|") {
+		if t == "" {
+			continue
+		}
+		val, ok := pb.Notification_Type_value[t]
+		if !ok {
+			return nil, fmt.Errorf("invalid notification type: %s", t)
+		}
+		req.Types = append(req.Types, pb.Notification_Type(val))
+	}
+
+	if opts["read"] != "" {
+		read, err := strconv.ParseBool(opts["read"])
+		if err != nil {
+			return nil, err
+		}
+		if read {
+			req.Read = pb.NotificationRequest_READ
+		} else {
+			req.Read = pb.NotificationRequest_UNREAD
+		}
+	}
+
+	if opts["before"] != "" {
+		before, err := time.Parse(time.RFC3339, opts["before"])
+		if err != nil {
+			return nil, err
+		}
+		req.Before, err = ptypes.TimestampProto(before)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
@@ -12,10 +12,12 @@ import (
 // @Description Pings another peer on the network, returning online|offline.
 // @Tags utils
 // @Produce text/plain
-// @Param X-Textile-Args header string true "peerid"
+// @Accept application/json
+// @Param arg query string true "peerid"
+// @Param args body core.apiArgs false "JSON alternative to arg query params"
 // @Success 200 {string} string "One of online|offline"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /ping [get]
 func (a *api) ping(g *gin.Context) {
 	args, err := a.readArgs(g)
@@ -12,7 +12,7 @@ import (
 // @Tags profile
 // @Produce application/json
 // @Success 200 {object} pb.Peer "peer"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /profile [get]
 func (a *api) getProfile(g *gin.Context) {
 	profile := a.node.Profile()
@@ -28,10 +28,12 @@ func (a *api) getProfile(g *gin.Context) {
 // @Description Sets public profile display name to given string
 // @Tags profile
 // @Produce text/plain
-// @Param X-Textile-Args header string true "name"
-// @Success 201 {string} string "ok"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Accept application/json
+// @Param arg query string true "name"
+// @Param args body core.apiArgs false "JSON alternative to arg query params"
+// @Success 204 {string} string "ok"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /profile/name [post]
 func (a *api) setName(g *gin.Context) {
 	args, err := a.readArgs(g)
@@ -47,6 +49,10 @@ func (a *api) setName(g *gin.Context) {
 		a.abort500(g, err)
 		return
 	}
+	if isApiV1(g) {
+		g.Status(http.StatusNoContent)
+		return
+	}
 	g.JSON(http.StatusCreated, "ok")
 }
 
@@ -55,14 +61,18 @@ func (a *api) setName(g *gin.Context) {
 // @Description Forces local node to update avatar image to latest image added to 'account' thread
 // @Tags profile
 // @Produce text/plain
-// @Success 201 {string} string "ok"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Success 204 {string} string "ok"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /profile/avatar [post]
 func (a *api) setAvatar(g *gin.Context) {
 	if err := a.node.SetAvatar(); err != nil {
 		a.abort500(g, err)
 		return
 	}
+	if isApiV1(g) {
+		g.Status(http.StatusNoContent)
+		return
+	}
 	g.JSON(http.StatusCreated, "ok")
 }
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// lsCafeRequests godoc
+// @Summary List cafe requests
+// @Description Lists queued cafe requests along with their attempt counts and next attempt
+// @Description dates. Use the failed option to list requests that have been given up on.
+// @Tags cafes
+// @Produce application/json
+// @Param failed query boolean false "Whether to list failed requests" default(false)
+// @Param offset query string false "Offset ID to start listing from"
+// @Param limit query integer false "List page size (default: all)" default(-1)
+// @Success 200 {object} pb.CafeRequestList "requests"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /requests [get]
+func (a *api) lsCafeRequests(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	limit := -1
+	if opts["limit"] != "" {
+		limit, err = strconv.Atoi(opts["limit"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	if opts["failed"] == "true" {
+		pbJSON(g, http.StatusOK, a.node.FailedCafeRequests(opts["offset"], limit))
+	} else {
+		pbJSON(g, http.StatusOK, a.node.CafeRequests(opts["offset"], limit))
+	}
+}
+
+// retryCafeRequests godoc
+// @Summary Retry failed cafe requests
+// @Description Queues a failed cafe request to be handled again with a fresh attempt count.
+// @Description Omit the request ID to retry all failed requests.
+// @Tags cafes
+// @Produce text/plain
+// @Accept application/json
+// @Param arg query string false "request id"
+// @Param args body core.apiArgs false "JSON alternative to arg query params"
+// @Success 200 {string} string "number of retried requests"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /requests/retry [post]
+func (a *api) retryCafeRequests(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	var id string
+	if len(args) > 0 {
+		id = args[0]
+	}
+
+	n, err := a.node.RetryCafeRequests(id)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	g.String(http.StatusOK, strconv.Itoa(n))
+}
+
+// purgeCafeRequests godoc
+// @Summary Purge failed cafe requests
+// @Description Deletes a failed cafe request. Omit the request ID to delete all failed requests.
+// @Tags cafes
+// @Produce text/plain
+// @Accept application/json
+// @Param arg query string false "request id"
+// @Param args body core.apiArgs false "JSON alternative to arg query params"
+// @Success 200 {string} string "number of purged requests"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /requests [delete]
+func (a *api) purgeCafeRequests(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	var id string
+	if len(args) > 0 {
+		id = args[0]
+	}
+
+	n, err := a.node.PurgeCafeRequests(id)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	g.String(http.StatusOK, strconv.Itoa(n))
+}
@@ -3,8 +3,10 @@ package core
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/textileio/go-textile/pb"
 )
 
@@ -13,9 +15,9 @@ import (
 // @Description Snapshots all threads and pushes to registered cafes
 // @Tags threads
 // @Produce application/json
-// @Success 201 {string} string "ok"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Success 204 {string} string "ok"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /snapshots [post]
 func (a *api) createThreadSnapshots(g *gin.Context) {
 	if err := a.node.SnapshotThreads(); err != nil {
@@ -23,18 +25,27 @@ func (a *api) createThreadSnapshots(g *gin.Context) {
 		return
 	}
 
+	if isApiV1(g) {
+		g.Status(http.StatusNoContent)
+		return
+	}
 	g.String(http.StatusCreated, "ok")
 }
 
 // searchThreadSnapshots godoc
 // @Summary Search for thread snapshots
-// @Description Searches the network for thread snapshots
+// @Description Searches the network for thread snapshots, optionally for an earlier version
 // @Tags threads
 // @Produce application/json
-// @Param X-Textile-Opts header string false "wait: Stops searching after 'wait' seconds have elapsed (max 30s), events: Whether to emit Server-Sent Events (SSEvent) or plain JSON" default(wait=5,events="false")
+// @Param wait query integer false "Stops searching after 'wait' seconds have elapsed (max 30s)" default(5)
+// @Param events query boolean false "Whether to emit Server-Sent Events (SSEvent) or plain JSON" default(false)
+// @Param thread query string false "Only search for snapshots of this thread"
+// @Param version query string false "Search for a specific snapshot version"
+// @Param as_of query string false "Search for the latest snapshots stored at or before this RFC3339 date"
+// @Param federated query boolean false "Whether cafes should forward the search to their federated peer cafes instead of using pubsub" default(false)
 // @Success 200 {object} pb.QueryResult "results stream"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /snapshots/search [post]
 func (a *api) searchThreadSnapshots(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -50,10 +61,32 @@ func (a *api) searchThreadSnapshots(g *gin.Context) {
 
 	query := &pb.ThreadSnapshotQuery{
 		Address: a.node.account.Address(),
+		Thread:  opts["thread"],
+	}
+	if opts["version"] != "" {
+		version, err := strconv.Atoi(opts["version"])
+		if err != nil || version < 1 {
+			g.String(http.StatusBadRequest, "invalid version")
+			return
+		}
+		query.Version = int32(version)
+	}
+	if opts["as_of"] != "" {
+		asOf, err := time.Parse(time.RFC3339, opts["as_of"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		query.AsOf, err = ptypes.TimestampProto(asOf)
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
 	}
 	options := &pb.QueryOptions{
-		Limit: -1,
-		Wait:  int32(wait),
+		Limit:     -1,
+		Wait:      int32(wait),
+		Federated: opts["federated"] == "true",
 	}
 
 	resCh, errCh, cancel, err := a.node.SearchThreadSnapshots(query, options)
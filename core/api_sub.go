@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/gin-contrib/sse"
 	"github.com/gin-gonic/gin"
 	"github.com/textileio/go-textile/pb"
 )
@@ -14,12 +15,18 @@ import (
 // @Description Subscribes to updates in a thread or all threads. An update is generated
 // @Description when a new block is added to a thread. There are several update types:
 // @Description MERGE, IGNORE, FLAG, JOIN, ANNOUNCE, LEAVE, TEXT, FILES, COMMENT, LIKE
+// @Description Use since to first replay updates missed while disconnected. Since is the block
+// @Description ID of the last update received, or an RFC3339 date. Server-Sent Events include
+// @Description the block ID as the event ID, so the Last-Event-ID header can be used instead.
 // @Tags subscribe
 // @Produce application/json
 // @Param id path string false "thread id, omit to stream all events"
-// @Param X-Textile-Opts header string false "type: Or'd list of event types (e.g., FILES|COMMENTS|LIKES) or empty to include all types, events: Whether to emit Server-Sent Events (SSEvent) or plain JSON" default(type=,events="false")
+// @Param type query string false "Or'd list of event types (e.g., FILES|COMMENTS|LIKES) or empty to include all types"
+// @Param events query boolean false "Whether to emit Server-Sent Events (SSEvent) or plain JSON" default(false)
+// @Param since query string false "Block ID or RFC3339 date to replay updates from"
 // @Success 200 {object} pb.FeedItem "stream of updates"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /subscribe/{id} [get]
 func (a *api) getThreadsSubscribe(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -34,9 +41,39 @@ func (a *api) getThreadsSubscribe(g *gin.Context) {
 	if threadId == "default" {
 		threadId = a.node.config.Threads.Defaults.ID
 	}
+	events := opts["events"] == "true"
 
+	since := opts["since"]
+	if since == "" && events {
+		since = g.GetHeader("Last-Event-ID")
+	}
+
+	// listen before replaying so that updates aren't missed in between
 	listener := a.node.ThreadUpdateListener()
+	defer listener.Close()
+
+	var replay []*pb.FeedItem
+	if since != "" {
+		replay, err = a.node.ThreadUpdatesSince(since)
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	// live updates may repeat the end of the replay
+	replayed := make(map[string]struct{})
+	for _, update := range replay {
+		replayed[update.Block] = struct{}{}
+	}
+
 	g.Stream(func(w io.Writer) bool {
+		if len(replay) > 0 {
+			a.writeThreadUpdate(g, replay[0], threadId, types, events)
+			replay = replay[1:]
+			return true
+		}
+
 		select {
 		case <-g.Request.Context().Done():
 			return false
@@ -46,39 +83,48 @@ func (a *api) getThreadsSubscribe(g *gin.Context) {
 				return false
 			}
 			if update, ok := value.(*pb.FeedItem); ok {
-				if threadId != "" && update.Thread != threadId {
-					break
+				if _, ok := replayed[update.Block]; !ok {
+					a.writeThreadUpdate(g, update, threadId, types, events)
 				}
+			}
+		}
+		return true
+	})
+}
 
-				btype, err := FeedItemType(update)
-				if err != nil {
-					log.Error(err.Error())
-					break
-				}
+// writeThreadUpdate writes an update to a subscription stream if it matches the thread and types
+func (a *api) writeThreadUpdate(g *gin.Context, update *pb.FeedItem, threadId string, types []string, events bool) {
+	if threadId != "" && update.Thread != threadId {
+		return
+	}
 
-				for _, t := range types {
-					if t == "" || btype.String() == t {
+	btype, err := FeedItemType(update)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
 
-						str, err := pbMarshaler.MarshalToString(update)
-						if err != nil {
-							g.String(http.StatusBadRequest, err.Error())
-							break
-						}
+	for _, t := range types {
+		if t == "" || btype.String() == t {
 
-						if opts["events"] == "true" {
-							g.SSEvent("update", str)
-						} else {
-							g.Data(http.StatusOK, "application/json", []byte(str))
-							g.Writer.Write([]byte("\n"))
-						}
+			str, err := pbMarshaler.MarshalToString(update)
+			if err != nil {
+				g.String(http.StatusBadRequest, err.Error())
+				break
+			}
 
-						break
-					}
-				}
+			if events {
+				g.Render(-1, sse.Event{
+					Id:    update.Block,
+					Event: "update",
+					Data:  str,
+				})
+			} else {
+				g.Data(http.StatusOK, "application/json", []byte(str))
+				g.Writer.Write([]byte("\n"))
 			}
-		}
-		return true
-	})
 
-	listener.Close()
+			break
+		}
+	}
 }
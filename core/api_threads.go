@@ -17,11 +17,17 @@ import (
 // @Description a Thread object
 // @Tags threads
 // @Produce application/json
-// @Param X-Textile-Args header string true "name"
-// @Param X-Textile-Opts header string false "key: A locally unique key used by an app to identify this thread on recovery, schema: Existing Thread Schema IPFS CID, type: Set the thread type to one of 'private', 'read_only', 'public', or 'open', sharing: Set the thread sharing style to one of 'not_shared','invite_only', or 'shared', whitelist: An array of contact addresses. When supplied, the thread will not allow additional peers beyond those in array, useful for 1-1 chat/file sharing" default(type=private,sharing=not_shared,whitelist=)
+// @Accept application/json
+// @Param arg query string true "name"
+// @Param args body core.apiArgs false "JSON alternative to arg query params"
+// @Param key query string false "A locally unique key used by an app to identify this thread on recovery"
+// @Param schema query string false "Existing Thread Schema IPFS CID"
+// @Param type query string false "Set the thread type to one of 'private', 'read_only', 'public', or 'open'" default(private)
+// @Param sharing query string false "Set the thread sharing style to one of 'not_shared','invite_only', or 'shared'" default(not_shared)
+// @Param whitelist query string false "An array of contact addresses. When supplied, the thread will not allow additional peers beyond those in array, useful for 1-1 chat/file sharing"
 // @Success 201 {object} pb.Thread "thread"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /threads [post]
 func (a *api) addThreads(g *gin.Context) {
 	args, err := a.readArgs(g)
@@ -86,8 +92,9 @@ func (a *api) addThreads(g *gin.Context) {
 // @Tags threads
 // @Param id path string true "id"
 // @Param thread body pb.Thread true "thread"
+// @Param restore query boolean false "Whether to reset the thread to the snapshot head, even if it is older than the current head" default(false)
 // @Success 204 {string} string "ok"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /threads/{id} [put]
 func (a *api) addOrUpdateThreads(g *gin.Context) {
 	var thrd pb.Thread
@@ -105,7 +112,18 @@ func (a *api) addOrUpdateThreads(g *gin.Context) {
 		return
 	}
 
-	if err := a.node.AddOrUpdateThread(&thrd); err != nil {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	if opts["restore"] == "true" {
+		err = a.node.RestoreThread(&thrd)
+	} else {
+		err = a.node.AddOrUpdateThread(&thrd)
+	}
+	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
 	}
@@ -118,9 +136,11 @@ func (a *api) addOrUpdateThreads(g *gin.Context) {
 // @Description Renames a thread. Only initiators can rename a thread.
 // @Tags threads
 // @Param id path string true "id"
-// @Param X-Textile-Args header string true "name"
+// @Accept application/json
+// @Param arg query string true "name"
+// @Param args body core.apiArgs false "JSON alternative to arg query params"
 // @Success 204 {string} string "ok"
-// @Failure 400 {string} string "Bad Request"
+// @Failure 400 {object} core.apiError "Bad Request"
 // @Router /threads/{id}/name [put]
 func (a *api) renameThreads(g *gin.Context) {
 	args, err := a.readArgs(g)
@@ -147,8 +167,8 @@ func (a *api) renameThreads(g *gin.Context) {
 // @Tags threads
 // @Produce application/json
 // @Success 200 {object} pb.ThreadList "threads"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /threads [get]
 func (a *api) lsThreads(g *gin.Context) {
 	views := &pb.ThreadList{
@@ -173,8 +193,8 @@ func (a *api) lsThreads(g *gin.Context) {
 // @Produce application/json
 // @Param id path string true "thread id"
 // @Success 200 {object} pb.Thread "thread"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /threads/{id} [get]
 func (a *api) getThreads(g *gin.Context) {
 	id := g.Param("id")
@@ -198,7 +218,7 @@ func (a *api) getThreads(g *gin.Context) {
 // @Produce application/json
 // @Param id path string true "thread id"
 // @Success 200 {object} pb.ContactList "contacts"
-// @Failure 404 {string} string "Not Found"
+// @Failure 404 {object} core.apiError "Not Found"
 // @Router /threads/{id}/peers [get]
 func (a *api) peersThreads(g *gin.Context) {
 	id := g.Param("id")
@@ -221,8 +241,8 @@ func (a *api) peersThreads(g *gin.Context) {
 // @Tags threads
 // @Param id path string true "thread id"
 // @Success 204 {string} string "ok"
-// @Failure 404 {string} string "Not Found"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /threads/{id} [delete]
 func (a *api) rmThreads(g *gin.Context) {
 	id := g.Param("id")
@@ -2,8 +2,10 @@ package core
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/util"
 )
 
 // createTokens godoc
@@ -16,10 +18,15 @@ import (
 // @Description Tokens allow other peers to register with a Cafe peer.
 // @Tags tokens
 // @Produce application/json
-// @Param X-Textile-Opts header string false "token: Use existing token, rather than creating a new one, store: Whether to store the added/generated token to the local db" default(token=,store="true")
+// @Param token query string false "Use existing token, rather than creating a new one"
+// @Param store query boolean false "Whether to store the added/generated token to the local db" default(true)
+// @Param scopes query string false "Comma-separated list of allowed scopes (store, inbox, threads, search), or empty for all"
+// @Param plan query string false "Name of a quota plan from the cafe host config"
+// @Param expires query string false "RFC3339 date after which the token can no longer be used"
+// @Param label query string false "A label for the token"
 // @Success 201 {string} string "token"
-// @Failure 400 {string} string "Bad Request"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /tokens [post]
 func (a *api) createTokens(g *gin.Context) {
 	opts, err := a.readOpts(g)
@@ -27,7 +34,19 @@ func (a *api) createTokens(g *gin.Context) {
 		a.abort500(g, err)
 		return
 	}
-	token, err := a.node.CreateCafeToken(opts["token"], opts["store"] == "true")
+	conf := CafeTokenConfig{
+		Scopes: util.SplitString(opts["scopes"], ","),
+		Plan:   opts["plan"],
+		Label:  opts["label"],
+	}
+	if opts["expires"] != "" {
+		conf.Expires, err = time.Parse(time.RFC3339, opts["expires"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	token, err := a.node.CreateCafeTokenWithConfig(opts["token"], opts["store"] == "true", conf)
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -37,22 +56,15 @@ func (a *api) createTokens(g *gin.Context) {
 
 // lsTokens godoc
 // @Summary List local tokens
-// @Description List info about all stored cafe tokens
+// @Description List info about all stored cafe tokens, including scopes, plan, expiry, and the
+// @Description combined usage of clients registered with each token
 // @Tags tokens
 // @Produce application/json
-// @Success 200 {array} string "tokens"
-// @Failure 500 {string} string "Internal Server Error"
+// @Success 200 {object} pb.CafeTokenUsageList "tokens"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /tokens [get]
 func (a *api) lsTokens(g *gin.Context) {
-	tokens, err := a.node.CafeTokens()
-	if err != nil {
-		a.abort500(g, err)
-		return
-	}
-	if len(tokens) == 0 {
-		tokens = make([]string, 0)
-	}
-	g.JSON(http.StatusOK, tokens)
+	pbJSON(g, http.StatusOK, a.node.CafeTokensUsage())
 }
 
 // validateTokens godoc
@@ -61,8 +73,8 @@ func (a *api) lsTokens(g *gin.Context) {
 // @Tags tokens
 // @Produce text/plain
 // @Param token path string true "invite id"
-// @Success 200 {string} string "ok"
-// @Failure 401 {string} string "Unauthorized"
+// @Success 204 {string} string "ok"
+// @Failure 401 {object} core.apiError "Unauthorized"
 // @Router /tokens/{id} [get]
 func (a *api) validateTokens(g *gin.Context) {
 	token := g.Param("token")
@@ -71,6 +83,10 @@ func (a *api) validateTokens(g *gin.Context) {
 		g.String(http.StatusUnauthorized, "invalid credentials")
 		return
 	}
+	if isApiV1(g) {
+		g.Status(http.StatusNoContent)
+		return
+	}
 	g.String(http.StatusOK, "ok")
 }
 
@@ -80,7 +96,7 @@ func (a *api) validateTokens(g *gin.Context) {
 // @Tags tokens
 // @Param token path string true "token"
 // @Success 204 {string} string "ok"
-// @Failure 500 {string} string "Internal Server Error"
+// @Failure 500 {object} core.apiError "Internal Server Error"
 // @Router /tokens/{id} [delete]
 func (a *api) rmTokens(g *gin.Context) {
 	token := g.Param("token")
@@ -0,0 +1,96 @@
+package core
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// apiVersionV1 is the api version that reads query params and JSON bodies
+const apiVersionV1 = "v1"
+
+// apiVersionContextKey is where the request's api version is kept in the gin context
+const apiVersionContextKey = "apiVersion"
+
+// apiError is the body of v1 error responses
+type apiError struct {
+	Error string `json:"error"`
+}
+
+// apiArgs is the JSON body v1 requests may use for positional args
+type apiArgs struct {
+	Args []string `json:"args"`
+}
+
+// v1 marks a request as using the v1 api
+func (a *api) v1(g *gin.Context) {
+	g.Set(apiVersionContextKey, apiVersionV1)
+	g.Writer = &apiV1Writer{ResponseWriter: g.Writer}
+}
+
+// isApiV1 returns whether or not a request uses the v1 api
+func isApiV1(g *gin.Context) bool {
+	return g.GetString(apiVersionContextKey) == apiVersionV1
+}
+
+// readV1Opts reads options from query params, joining repeated values with commas
+func readV1Opts(g *gin.Context) map[string]string {
+	opts := make(map[string]string)
+	for k, v := range g.Request.URL.Query() {
+		if k != "arg" {
+			opts[k] = strings.Join(v, ",")
+		}
+	}
+	return opts
+}
+
+// readV1Args reads args from "arg" query params, followed by those in a JSON body
+func readV1Args(g *gin.Context) ([]string, error) {
+	var args []string
+	for _, arg := range g.Request.URL.Query()["arg"] {
+		if arg != "" {
+			args = append(args, arg)
+		}
+	}
+
+	if g.ContentType() == "application/json" {
+		var body apiArgs
+		if err := json.NewDecoder(g.Request.Body).Decode(&body); err != nil && err != io.EOF {
+			return nil, err
+		}
+		for _, arg := range body.Args {
+			if arg != "" {
+				args = append(args, arg)
+			}
+		}
+	}
+	return args, nil
+}
+
+// apiV1Writer rewrites plain text error responses as JSON error objects
+type apiV1Writer struct {
+	gin.ResponseWriter
+}
+
+func (w *apiV1Writer) Write(data []byte) (int, error) {
+	if w.Status() < http.StatusBadRequest || !strings.HasPrefix(w.Header().Get("Content-Type"), "text/plain") {
+		return w.ResponseWriter.Write(data)
+	}
+
+	body, err := json.Marshal(apiError{Error: strings.TrimSpace(string(data))})
+	if err != nil {
+		return 0, err
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if _, err := w.ResponseWriter.Write(body); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func (w *apiV1Writer) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
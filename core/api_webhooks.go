@@ -0,0 +1,106 @@
+package core
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/util"
+)
+
+// addWebhooks godoc
+// @Summary Add a webhook
+// @Description Adds a webhook to the config. Thread updates matching the webhook's thread and
+// @Description type filters are POSTed to the URL as JSON feed items. Each request body is
+// @Description signed with the webhook's secret (HMAC-SHA256), which is sent in the
+// @Description X-Textile-Signature header. A random secret is generated if one is not provided.
+// @Description Failed deliveries are retried.
+// @Tags webhooks
+// @Produce application/json
+// @Accept application/json
+// @Param arg query string true "url"
+// @Param args body core.apiArgs false "JSON alternative to arg query params"
+// @Param thread query string false "Comma-separated list of thread IDs (can also use 'default') or empty to include all threads"
+// @Param type query string false "Or'd list of block types (e.g., FILES|TEXT) or empty to include all types"
+// @Param secret query string false "Key used to sign request bodies"
+// @Success 201 {object} config.Webhook "webhook"
+// @Failure 400 {object} core.apiError "Bad Request"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /webhooks [post]
+func (a *api) addWebhooks(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing webhook URL")
+		return
+	}
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	threads := util.SplitString(opts["thread"], ",")
+	for i, id := range threads {
+		if id == "default" {
+			threads[i] = a.node.config.Threads.Defaults.ID
+		}
+	}
+
+	hook, err := a.node.AddWebhook(config.Webhook{
+		URL:     args[0],
+		Secret:  opts["secret"],
+		Threads: threads,
+		Types:   util.SplitString(opts["type"], "|"),
+	})
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	g.JSON(http.StatusCreated, hook)
+}
+
+// lsWebhooks godoc
+// @Summary List webhooks
+// @Description Lists webhooks in the config
+// @Tags webhooks
+// @Produce application/json
+// @Success 200 {array} config.Webhook "webhooks"
+// @Router /webhooks [get]
+func (a *api) lsWebhooks(g *gin.Context) {
+	hooks := a.node.Webhooks()
+	if len(hooks) == 0 {
+		hooks = make([]config.Webhook, 0)
+	}
+	g.JSON(http.StatusOK, hooks)
+}
+
+// rmWebhooks godoc
+// @Summary Remove a webhook
+// @Description Removes a webhook from the config along with its pending deliveries
+// @Tags webhooks
+// @Param id path string true "webhook id"
+// @Success 204 {string} string "ok"
+// @Failure 404 {object} core.apiError "Not Found"
+// @Failure 500 {object} core.apiError "Internal Server Error"
+// @Router /webhooks/{id} [delete]
+func (a *api) rmWebhooks(g *gin.Context) {
+	if err := a.node.RemoveWebhook(g.Param("id")); err != nil {
+		if err == ErrWebhookNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	if isApiV1(g) {
+		g.Status(http.StatusNoContent)
+		return
+	}
+	g.String(http.StatusOK, "ok")
+}
@@ -0,0 +1,368 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/textileio/go-textile/pb"
+)
+
+// wsWriteWait is the time allowed to write a message to a websocket client
+const wsWriteWait = time.Second * 10
+
+// wsPongWait is the time allowed to read the next pong from a websocket client
+const wsPongWait = time.Second * 60
+
+// wsPingPeriod is how often websocket clients are pinged, which must be less than wsPongWait
+const wsPingPeriod = wsPongWait * 9 / 10
+
+// websocket message types
+const (
+	wsAck           = "ack"
+	wsError         = "error"
+	wsThreadUpdate  = "thread_update"
+	wsWalletUpdate  = "wallet_update"
+	wsNotification  = "notification"
+	wsSubscribe     = "subscribe"
+	wsUnsubscribe   = "unsubscribe"
+	wsAllThreads    = "*"
+	wsDefaultThread = "default"
+)
+
+// wsRequest is a request from a websocket client
+type wsRequest struct {
+	Id            string   `json:"id,omitempty"`
+	Action        string   `json:"action"`
+	Threads       []string `json:"threads,omitempty"`
+	Types         []string `json:"types,omitempty"`
+	Wallet        bool     `json:"wallet,omitempty"`
+	Notifications bool     `json:"notifications,omitempty"`
+	Since         string   `json:"since,omitempty"`
+}
+
+// wsMessage is a message to a websocket client
+type wsMessage struct {
+	Type  string          `json:"type"`
+	Id    string          `json:"id,omitempty"`
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// wsSubscription is the set of updates a websocket client has subscribed to
+type wsSubscription struct {
+	threads       map[string]bool
+	types         map[string]bool
+	wallet        bool
+	notifications bool
+}
+
+// matches returns whether or not a thread update is included in the subscription
+func (s *wsSubscription) matches(update *pb.FeedItem) bool {
+	if !s.threads[wsAllThreads] && !s.threads[update.Thread] {
+		return false
+	}
+	if len(s.types) == 0 {
+		return true
+	}
+	btype, err := FeedItemType(update)
+	if err != nil {
+		log.Error(err.Error())
+		return false
+	}
+	return s.types[btype.String()]
+}
+
+// wsReplay streams thread updates since a cursor to the write loop, which keeps
+// draining the update listeners between replayed messages
+type wsReplay struct {
+	id      string
+	updates chan *pb.FeedItem
+	err     error // set before updates is closed
+}
+
+// replayWebsocket starts a replay of thread updates since a block ID or date
+func (a *api) replayWebsocket(id string, since string, done <-chan struct{}) *wsReplay {
+	replay := &wsReplay{id: id, updates: make(chan *pb.FeedItem)}
+	go func() {
+		defer close(replay.updates)
+		updates, err := a.node.ThreadUpdatesSince(since)
+		if err != nil {
+			replay.err = err
+			return
+		}
+		for _, update := range updates {
+			select {
+			case replay.updates <- update:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return replay
+}
+
+// subscribeWebsocket godoc
+// @Summary Subscribe to updates over a websocket
+// @Description Upgrades to a websocket on which clients can dynamically subscribe to thread updates,
+// @Description wallet updates, and notifications. Browsers may send an API key with the api_key
+// @Description query param. Requests are JSON objects with an action (subscribe or unsubscribe),
+// @Description threads (thread IDs, 'default', or '*' for all), types (block types, e.g., FILES,
+// @Description or empty for all), and wallet and notifications flags. Subscribe requests may
+// @Description include since, a block ID or RFC3339 date after which thread updates are replayed,
+// @Description interleaved with live updates, ahead of the request's ack. Each request is answered
+// @Description with an ack or error message with the same id.
+// @Description Updates are sent as thread_update, wallet_update, and notification messages.
+// @Tags subscribe
+// @Param api_key query string false "api key, if not set in the Authorization header"
+// @Success 101 {string} string "switching protocols"
+// @Failure 401 {object} core.apiError "Unauthorized"
+// @Router /ws [get]
+func (a *api) subscribeWebsocket(g *gin.Context) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: a.checkWebsocketOrigin,
+	}
+	conn, err := upgrader.Upgrade(g.Writer, g.Request, nil)
+	if err != nil {
+		log.Errorf("websocket upgrade failed: %s", err)
+		return
+	}
+	defer conn.Close()
+
+	// listen before reading any requests so that replays don't miss updates
+	threadListener := a.node.ThreadUpdateListener()
+	defer threadListener.Close()
+	walletListener := a.node.WalletUpdateListener()
+	defer walletListener.Close()
+	noteListener := a.node.NotificationListener()
+	defer noteListener.Close()
+
+	conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	requests := make(chan *wsRequest)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(requests)
+		for {
+			req := new(wsRequest)
+			if err := conn.ReadJSON(req); err != nil {
+				if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+					log.Debugf("websocket read error: %s", err)
+				}
+				return
+			}
+			select {
+			case requests <- req:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(wsPingPeriod)
+	defer ping.Stop()
+
+	sub := &wsSubscription{
+		threads: make(map[string]bool),
+		types:   make(map[string]bool),
+	}
+
+	// requests wait while a replay is written, and blocks sent both live and
+	// in the latest replay are only written once
+	var replay *wsReplay
+	replayed := make(map[string]bool)
+	for {
+		reqs, replays := requests, (chan *pb.FeedItem)(nil)
+		if replay != nil {
+			reqs, replays = nil, replay.updates
+		}
+
+		var err error
+		select {
+		case req, ok := <-reqs:
+			if !ok {
+				return
+			}
+			err = a.handleWebsocketRequest(conn, sub, req)
+			if err == nil && req.Action == wsSubscribe && req.Since != "" {
+				replay = a.replayWebsocket(req.Id, req.Since, done)
+				replayed = make(map[string]bool)
+			}
+
+		case update, ok := <-replays:
+			if !ok {
+				err = finishWebsocketReplay(conn, replay)
+				replay = nil
+				break
+			}
+			if sub.matches(update) && !replayed[update.Block] {
+				replayed[update.Block] = true
+				err = writeWebsocketPb(conn, wsThreadUpdate, replay.id, update)
+			}
+
+		case value, ok := <-threadListener.Ch:
+			if !ok {
+				return
+			}
+			if update, ok := value.(*pb.FeedItem); ok && sub.matches(update) && !replayed[update.Block] {
+				if replay != nil {
+					replayed[update.Block] = true
+				}
+				err = writeWebsocketPb(conn, wsThreadUpdate, "", update)
+			}
+
+		case value, ok := <-walletListener.Ch:
+			if !ok {
+				return
+			}
+			if update, ok := value.(*pb.WalletUpdate); ok && sub.wallet {
+				err = writeWebsocketPb(conn, wsWalletUpdate, "", update)
+			}
+
+		case value, ok := <-noteListener.Ch:
+			if !ok {
+				return
+			}
+			if note, ok := value.(*pb.Notification); ok && sub.notifications {
+				err = writeWebsocketPb(conn, wsNotification, "", note)
+			}
+
+		case <-ping.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait))
+		}
+		if err != nil {
+			log.Debugf("websocket write error: %s", err)
+			return
+		}
+	}
+}
+
+// handleWebsocketRequest updates a subscription and acks the request, leaving the ack of
+// a subscribe request with since to the end of its replay. Only write errors are returned.
+func (a *api) handleWebsocketRequest(conn *websocket.Conn, sub *wsSubscription, req *wsRequest) error {
+	var types []string
+	for _, t := range req.Types {
+		t = strings.ToUpper(strings.TrimSpace(t))
+		if _, ok := pb.Block_BlockType_value[t]; !ok {
+			return writeWebsocket(conn, &wsMessage{
+				Type:  wsError,
+				Id:    req.Id,
+				Error: fmt.Sprintf("invalid block type: %s", t),
+			})
+		}
+		types = append(types, t)
+	}
+
+	var threads []string
+	for _, id := range req.Threads {
+		if id == wsDefaultThread {
+			id = a.node.config.Threads.Defaults.ID
+		}
+		if id != "" {
+			threads = append(threads, id)
+		}
+	}
+
+	switch req.Action {
+	case wsSubscribe:
+		for _, id := range threads {
+			sub.threads[id] = true
+		}
+		for _, t := range types {
+			sub.types[t] = true
+		}
+		sub.wallet = sub.wallet || req.Wallet
+		sub.notifications = sub.notifications || req.Notifications
+
+		if req.Since != "" {
+			return nil
+		}
+
+	case wsUnsubscribe:
+		for _, id := range threads {
+			delete(sub.threads, id)
+		}
+		for _, t := range types {
+			delete(sub.types, t)
+		}
+		sub.wallet = sub.wallet && !req.Wallet
+		sub.notifications = sub.notifications && !req.Notifications
+
+	default:
+		return writeWebsocket(conn, &wsMessage{
+			Type:  wsError,
+			Id:    req.Id,
+			Error: fmt.Sprintf("invalid action: %s", req.Action),
+		})
+	}
+
+	return writeWebsocket(conn, &wsMessage{Type: wsAck, Id: req.Id})
+}
+
+// finishWebsocketReplay acks a completed replay, or reports why it failed
+func finishWebsocketReplay(conn *websocket.Conn, replay *wsReplay) error {
+	if replay.err != nil {
+		return writeWebsocket(conn, &wsMessage{Type: wsError, Id: replay.id, Error: replay.err.Error()})
+	}
+	return writeWebsocket(conn, &wsMessage{Type: wsAck, Id: replay.id})
+}
+
+// checkWebsocketOrigin allows same-origin requests, requests without an origin (non-browser
+// clients), and origins allowed by the API's CORS headers
+func (a *api) checkWebsocketOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if u, err := url.Parse(origin); err == nil && u.Host == r.Host {
+		return true
+	}
+	return originAllowed(a.node.Config().API.HTTPHeaders["Access-Control-Allow-Origin"], origin)
+}
+
+// originAllowed matches an origin the same way the CORS middleware does: case-insensitively,
+// with a single '*' in an allowed origin standing in for zero or more characters
+func originAllowed(allowed []string, origin string) bool {
+	origin = strings.ToLower(origin)
+	for _, a := range allowed {
+		a = strings.ToLower(a)
+		if a == "*" || a == origin {
+			return true
+		}
+		if i := strings.IndexByte(a, '*'); i >= 0 {
+			prefix, suffix := a[:i], a[i+1:]
+			if len(origin) >= len(prefix)+len(suffix) &&
+				strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeWebsocketPb writes a protobuf message to a websocket client
+func writeWebsocketPb(conn *websocket.Conn, typ string, id string, msg proto.Message) error {
+	data, err := pbMarshaler.MarshalToString(msg)
+	if err != nil {
+		return err
+	}
+	return writeWebsocket(conn, &wsMessage{Type: typ, Id: id, Data: json.RawMessage(data)})
+}
+
+// writeWebsocket writes a message to a websocket client
+func writeWebsocket(conn *websocket.Conn, msg *wsMessage) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(wsWriteWait)); err != nil {
+		return err
+	}
+	return conn.WriteJSON(msg)
+}
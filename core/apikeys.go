@@ -0,0 +1,162 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/jwt"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// api key scopes
+const (
+	apiScopeRead  = "read"
+	apiScopeWrite = "write"
+	apiScopeAdmin = "admin"
+	apiScopeSeed  = "seed"
+)
+
+// apiScopes lists all valid api key scopes
+var apiScopes = []string{apiScopeRead, apiScopeWrite, apiScopeAdmin, apiScopeSeed}
+
+// defaultApiKeyName names the key made for local clients
+const defaultApiKeyName = "default"
+
+// ErrApiKeyNotFound indicates an api key was not found
+var ErrApiKeyNotFound = fmt.Errorf("api key not found")
+
+// ApiKeyConfig describes the rights granted to an API key
+type ApiKeyConfig struct {
+	Name    string
+	Scopes  []string  // read, write, admin, seed
+	Expires time.Time // zero never expires
+}
+
+// CreateApiKey creates a local API key signed by the account key,
+// returning it with its token, which is not stored
+func (t *Textile) CreateApiKey(conf ApiKeyConfig) (*pb.ApiKey, error) {
+	if len(conf.Scopes) == 0 {
+		return nil, fmt.Errorf("at least one scope is required")
+	}
+	for _, scope := range conf.Scopes {
+		if !util.ListContainsString(apiScopes, scope) {
+			return nil, fmt.Errorf("invalid scope: %s", scope)
+		}
+	}
+	var expires *timestamp.Timestamp
+	if !conf.Expires.IsZero() {
+		if conf.Expires.Before(time.Now()) {
+			return nil, fmt.Errorf("expiry is in the past")
+		}
+		expires = util.ProtoTs(conf.Expires.UnixNano())
+	}
+
+	sk, err := t.account.LibP2PPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	id := ksuid.New().String()
+	token, err := jwt.NewApiKey(sk, id, conf.Expires)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &pb.ApiKey{
+		Id:      id,
+		Name:    conf.Name,
+		Scopes:  conf.Scopes,
+		Date:    ptypes.TimestampNow(),
+		Expires: expires,
+	}
+	if err := t.datastore.ApiKeys().Add(key); err != nil {
+		return nil, err
+	}
+	key.Token = token
+	return key, nil
+}
+
+// ApiKeys lists all local API keys
+func (t *Textile) ApiKeys() *pb.ApiKeyList {
+	return t.datastore.ApiKeys().List()
+}
+
+// RemoveApiKey revokes a local API key
+func (t *Textile) RemoveApiKey(id string) error {
+	if t.datastore.ApiKeys().Get(id) == nil {
+		return ErrApiKeyNotFound
+	}
+	return t.datastore.ApiKeys().Delete(id)
+}
+
+// ValidateApiKey returns the local API key for a token, if it is valid and not revoked
+func (t *Textile) ValidateApiKey(token string) (*pb.ApiKey, error) {
+	pk, err := t.account.LibP2PPubKey()
+	if err != nil {
+		return nil, err
+	}
+	id, err := jwt.ParseApiKey(token, pk)
+	if err != nil {
+		return nil, err
+	}
+	key := t.datastore.ApiKeys().Get(id)
+	if key == nil {
+		return nil, jwt.ErrInvalid
+	}
+	return key, nil
+}
+
+// EnsureApiKey returns token if it's a valid API key with all scopes,
+// otherwise it rotates the default key for local clients.
+// Any other default keys are revoked, since their tokens are no longer held.
+func (t *Textile) EnsureApiKey(token string) (string, error) {
+	if token != "" {
+		key, err := t.ValidateApiKey(token)
+		if err == nil && len(key.Scopes) == len(apiScopes) && key.Expires == nil {
+			return token, t.revokeDefaultApiKeys(key.Id)
+		}
+	}
+
+	key, err := t.CreateApiKey(ApiKeyConfig{
+		Name:   defaultApiKeyName,
+		Scopes: apiScopes,
+	})
+	if err != nil {
+		return "", err
+	}
+	log.Infof("created default api key %s", key.Id)
+	if err := t.revokeDefaultApiKeys(key.Id); err != nil {
+		return "", err
+	}
+	return key.Token, nil
+}
+
+// revokeDefaultApiKeys removes all default API keys except keep
+func (t *Textile) revokeDefaultApiKeys(keep string) error {
+	for _, key := range t.ApiKeys().Items {
+		if key.Name != defaultApiKeyName || key.Id == keep {
+			continue
+		}
+		if err := t.datastore.ApiKeys().Delete(key.Id); err != nil {
+			return err
+		}
+		log.Infof("revoked default api key %s", key.Id)
+	}
+	return nil
+}
+
+// apiKeyAllows returns whether or not a key has a scope, admin keys may also read and write
+func apiKeyAllows(key *pb.ApiKey, scope string) bool {
+	if util.ListContainsString(key.Scopes, scope) {
+		return true
+	}
+	switch scope {
+	case apiScopeRead, apiScopeWrite:
+		return util.ListContainsString(key.Scopes, apiScopeAdmin)
+	default:
+		return false
+	}
+}
@@ -92,6 +92,7 @@ func (q *BlockOutbox) batch(msgs []pb.BlockMessage) error {
 		go func(pid peer.ID, msgs []pb.BlockMessage) {
 			for _, msg := range msgs {
 				if err := q.handle(pid, msg); err != nil {
+					blockOutboxMessages.Inc(resultError)
 					berr = err
 					return
 				}
@@ -142,6 +143,9 @@ func (q *BlockOutbox) handle(pid peer.ID, msg pb.BlockMessage) error {
 	var err error
 	if sendable {
 		err = q.service().SendMessage(nil, pid, msg.Env)
+		if err == nil {
+			blockOutboxMessages.Inc("direct")
+		}
 	}
 	if !sendable || err != nil {
 		if err != nil {
@@ -157,6 +161,9 @@ func (q *BlockOutbox) handle(pid peer.ID, msg pb.BlockMessage) error {
 			if err := q.cafeOutbox.AddForInbox(pid, msg.Env, contact.Inboxes); err != nil {
 				return err
 			}
+			blockOutboxMessages.Inc("inbox")
+		} else {
+			blockOutboxMessages.Inc("undeliverable")
 		}
 	}
 	return nil
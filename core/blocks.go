@@ -2,7 +2,9 @@ package core
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/textileio/go-textile/ipfs"
 	"github.com/textileio/go-textile/pb"
 )
 
@@ -23,6 +25,21 @@ func (t *Textile) Blocks(offset string, limit int, query string) *pb.BlockList {
 	return filtered
 }
 
+// nextBlockOffset returns the offset of the page of blocks matching query
+// after blocks, or an empty string if there are no more
+func (t *Textile) nextBlockOffset(blocks *pb.BlockList, query string) string {
+	if len(blocks.Items) == 0 {
+		return ""
+	}
+	next := blocks.Items[len(blocks.Items)-1].Id
+
+	// see if there's actually more
+	if len(t.datastore.Blocks().List(next, 1, query).Items) == 0 {
+		return ""
+	}
+	return next
+}
+
 // Block returns block with id
 func (t *Textile) Block(id string) (*pb.Block, error) {
 	block := t.datastore.Blocks().Get(id)
@@ -47,3 +64,44 @@ func (t *Textile) BlockView(id string) (*pb.Block, error) {
 	block.User = t.PeerUser(block.Author)
 	return block, nil
 }
+
+// toDots renders blocks and their parents as a graphviz digraph
+func (t *Textile) toDots(blocks *pb.BlockList) (string, error) {
+	dots := `digraph {
+    rankdir="BT";`
+
+	for _, b := range blocks.Items {
+		dot := toDot(b)
+
+		for _, p := range b.Parents {
+			if strings.TrimSpace(p) == "" {
+				continue
+			}
+			pp, err := t.Block(p)
+			if err != nil {
+				log.Warningf("block %s: %s", p, err)
+				dots += "\n    " + dot + " -> MISSING_" + pre(p) + ";"
+				continue
+			}
+			dots += "\n    " + dot + " -> " + toDot(pp) + ";"
+		}
+	}
+
+	return dots + "\n}", nil
+}
+
+func toDot(block *pb.Block) string {
+	t := block.Type.String()
+	var a string
+	if block.Type != pb.Block_MERGE {
+		a = "_" + ipfs.ShortenID(block.Author)
+	}
+	return t + a + "_" + pre(block.Id)
+}
+
+func pre(hash string) string {
+	if len(hash) < 7 {
+		return hash
+	}
+	return hash[:7]
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"fmt"
+
+	cid "github.com/ipfs/go-cid"
+	"github.com/textileio/go-textile/pb"
+)
+
+// ErrCafeClientNotFound indicates a cafe client is not registered with this cafe
+var ErrCafeClientNotFound = fmt.Errorf("cafe client not found")
+
+// CafeClients lists all clients registered with this cafe
+func (t *Textile) CafeClients() *pb.CafeClientList {
+	list := &pb.CafeClientList{Items: make([]*pb.CafeClient, 0)}
+	for _, client := range t.datastore.CafeClients().List() {
+		c := client
+		list.Items = append(list.Items, &c)
+	}
+	return list
+}
+
+// CafeClientUsage returns storage and inbox usage for a registered client
+func (t *Textile) CafeClientUsage(id string) (*pb.CafeClientUsage, error) {
+	client := t.datastore.CafeClients().Get(id)
+	if client == nil {
+		return nil, ErrCafeClientNotFound
+	}
+	return t.cafeClientUsage(client), nil
+}
+
+// CafeClientsUsage returns storage and inbox usage for all registered clients
+func (t *Textile) CafeClientsUsage() *pb.CafeClientUsageList {
+	list := &pb.CafeClientUsageList{Items: make([]*pb.CafeClientUsage, 0)}
+	for _, client := range t.datastore.CafeClients().List() {
+		c := client
+		list.Items = append(list.Items, t.cafeClientUsage(&c))
+	}
+	return list
+}
+
+// EvictCafeClient removes a registered client along with its threads,
+// inbox messages, and pin records, unpinning the client's content
+func (t *Textile) EvictCafeClient(id string) error {
+	if t.datastore.CafeClients().Get(id) == nil {
+		return ErrCafeClientNotFound
+	}
+	if err := t.cafe.deleteClient(id); err != nil {
+		return err
+	}
+
+	log.Infof("evicted cafe client %s", id)
+	return nil
+}
+
+// cafeClientUsage collects usage for a client
+func (t *Textile) cafeClientUsage(client *pb.CafeClient) *pb.CafeClientUsage {
+	return &pb.CafeClientUsage{
+		Client:      client,
+		ThreadCount: int32(t.datastore.CafeClientThreads().CountByClient(client.Id)),
+		InboxCount:  int32(t.datastore.CafeClientMessages().CountByClient(client.Id)),
+		PinCount:    int32(t.datastore.CafeClientPins().CountByClient(client.Id)),
+		PinSize:     t.datastore.CafeClientPins().SizeByClient(client.Id),
+	}
+}
+
+// deleteClient removes a client and all of its stored data records, unpinning its content
+func (h *CafeService) deleteClient(id string) error {
+	if err := h.datastore.CafeClientThreads().DeleteByClient(id); err != nil {
+		return fmt.Errorf("delete client threads failed")
+	}
+	if err := h.datastore.CafeClientMessages().DeleteByClient(id, -1); err != nil {
+		return fmt.Errorf("delete client messages failed")
+	}
+	pins := h.datastore.CafeClientPins().ListByClient(id)
+	if err := h.datastore.CafeClientPins().DeleteByClient(id); err != nil {
+		return fmt.Errorf("delete client pins failed")
+	}
+	for _, p := range pins {
+		pcid, err := cid.Decode(p.Id)
+		if err != nil {
+			log.Errorf("error decoding client pin %s: %s", p.Id, err)
+			continue
+		}
+		if err := h.releasePin(pcid); err != nil {
+			log.Warningf("error unpinning client pin %s: %s", p.Id, err)
+		}
+	}
+	if err := h.datastore.CafeClients().Delete(id); err != nil {
+		return fmt.Errorf("delete client failed")
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
 	"sync"
@@ -18,6 +19,9 @@ import (
 // cafeApiVersion is the cafe api version
 const cafeApiVersion = "v0"
 
+// cafeTokenSubjectKey is where the peer id of a validated token is kept in the gin context
+const cafeTokenSubjectKey = "cafeTokenSubject"
+
 // cafeApiHost is the instance used by the core instance
 var cafeApiHost *cafeApi
 
@@ -33,6 +37,11 @@ type cafeApi struct {
 	addr   string
 	server *http.Server
 	node   *Textile
+
+	uploads map[string]*cafeUploadLock
+	umux    sync.Mutex
+
+	routes map[string]string
 }
 
 // CafeApiAddr returns the cafe api address
@@ -44,11 +53,11 @@ func (t *Textile) CafeApiAddr() string {
 }
 
 // startCafeApi starts the host instance
-func (t *Textile) startCafeApi(addr string) {
+func (t *Textile) startCafeApi(addr string) error {
 	gin.SetMode(gin.ReleaseMode)
 	gin.DefaultWriter = t.writer
 	cafeApiHost = &cafeApi{addr: addr, node: t}
-	cafeApiHost.start()
+	return cafeApiHost.start()
 }
 
 // StopCafeApi stops the host instance
@@ -65,16 +74,21 @@ func (t *Textile) CafeInfo() *pb.Cafe {
 }
 
 // start starts the cafe api
-func (c *cafeApi) start() {
+func (c *cafeApi) start() error {
+	conf := c.node.Config()
+
 	router := gin.Default()
+	router.ForwardedByClientIP = conf.Cafe.Host.TrustProxyHeaders
+	router.Use(instrument(cafeApiRequests, cafeApiSeconds))
+	router.Use(c.limit)
 	router.GET("/", func(g *gin.Context) {
 		g.JSON(http.StatusOK, c.node.CafeInfo())
 	})
 	router.GET("/health", func(g *gin.Context) {
 		g.Writer.WriteHeader(http.StatusNoContent)
 	})
+	router.GET("/metrics", c.getMetrics)
 
-	conf := c.node.Config()
 	if conf.Cafe.Host.SizeLimit > 0 {
 		router.Use(limit.RequestSizeLimiter(conf.Cafe.Host.SizeLimit))
 	}
@@ -82,20 +96,23 @@ func (c *cafeApi) start() {
 	// v0 routes
 	v0 := router.Group("/cafe/v0")
 	{
-		v0.POST("/pin", c.validateToken, c.pin)
+		v0.POST("/pin", c.validateToken, c.requireScope(cafeScopeStore), c.pin)
 		v0.POST("/service", c.service)
 	}
 
 	// v1 routes
 	v1 := router.Group("/api/v1")
 
-	store := v1.Group("/store", c.validateToken)
+	store := v1.Group("/store", c.validateToken, c.requireScope(cafeScopeStore))
 	{
 		store.PUT("/:cid", c.store)
+		store.POST("/:cid", c.createUpload)
+		store.HEAD("/:cid", c.headUpload)
+		store.PATCH("/:cid", c.patchUpload)
 		store.DELETE("/:cid", c.unstore)
 	}
 
-	threads := v1.Group("/threads", c.validateToken)
+	threads := v1.Group("/threads", c.validateToken, c.requireScope(cafeScopeThreads))
 	{
 		threads.PUT("/:id", c.storeThread)
 		threads.DELETE("/:id", c.unstoreThread)
@@ -106,15 +123,35 @@ func (c *cafeApi) start() {
 		inbox.POST("/:pid", c.deliverMessage)
 	}
 
+	admin := v1.Group("/admin", c.validateAdmin)
+	{
+		admin.GET("/clients", c.lsClients)
+		admin.DELETE("/clients/:id", c.evictClient)
+		admin.GET("/usage", c.getClientUsage)
+		admin.GET("/usage/:id", c.getClientUsage)
+		admin.GET("/replication", c.getReplication)
+	}
+
+	c.indexRoutes(router)
+
+	tconf, err := c.node.TLSConfig()
+	if err != nil {
+		return fmt.Errorf("error loading cafe api tls config: %s", err)
+	}
 	c.server = &http.Server{
-		Addr:    c.addr,
-		Handler: router,
+		Addr:      c.addr,
+		Handler:   router,
+		TLSConfig: tconf,
 	}
 
 	// start listening
 	errc := make(chan error)
 	go func() {
-		errc <- c.server.ListenAndServe()
+		if tconf != nil {
+			errc <- c.server.ListenAndServeTLS("", "")
+		} else {
+			errc <- c.server.ListenAndServe()
+		}
 		close(errc)
 	}()
 	go func() {
@@ -132,6 +169,7 @@ func (c *cafeApi) start() {
 		}
 	}()
 	log.Infof("cafe api listening at %s\n", c.server.Addr)
+	return nil
 }
 
 // stop stops the cafe api
@@ -159,9 +197,46 @@ func (c *cafeApi) validateToken(g *gin.Context) {
 		switch err {
 		case jwt.ErrNoToken, jwt.ErrExpired:
 			c.abort(g, http.StatusUnauthorized, nil)
-		case jwt.ErrInvalid:
+		default:
 			c.abort(g, http.StatusForbidden, nil)
 		}
+		return
+	}
+
+	// the token was verified above, so its subject identifies the client
+	parsed, err := njwt.Parse(token, c.verifyKeyFunc)
+	if err != nil {
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+	claims, err := jwt.ParseClaims(parsed.Claims)
+	if err != nil || claims.Subject == "" {
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+	g.Set(cafeTokenSubjectKey, claims.Subject)
+}
+
+// tokenClient returns the registered client that owns the request's validated token, if any
+func (c *cafeApi) tokenClient(g *gin.Context) *pb.CafeClient {
+	subject := g.GetString(cafeTokenSubjectKey)
+	if subject == "" {
+		return nil
+	}
+	return c.node.datastore.CafeClients().Get(subject)
+}
+
+// requireScope returns a middleware that checks the requesting client's token grants scope
+func (c *cafeApi) requireScope(scope string) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		client := c.tokenClient(g)
+		if client == nil {
+			c.abort(g, http.StatusForbidden, fmt.Errorf(errForbidden))
+			return
+		}
+		if code, msg := c.node.cafe.scopeError(client, scope); code != 0 {
+			c.abort(g, code, fmt.Errorf(msg))
+		}
 	}
 }
 
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// validateAdmin aborts the request if the admin token is missing or invalid
+func (c *cafeApi) validateAdmin(g *gin.Context) {
+	admin := c.node.Config().Cafe.Host.AdminToken
+	if admin == "" {
+		c.abort(g, http.StatusNotFound, nil)
+		return
+	}
+	c.validateBearer(g, admin)
+}
+
+// validateBearer aborts the request if it does not carry the given bearer token
+func (c *cafeApi) validateBearer(g *gin.Context, token string) {
+	auth := strings.Split(g.Request.Header.Get("Authorization"), " ")
+	if len(auth) < 2 {
+		c.abort(g, http.StatusUnauthorized, nil)
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(auth[1]), []byte(token)) != 1 {
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+}
+
+func (c *cafeApi) lsClients(g *gin.Context) {
+	pbJSON(g, http.StatusOK, c.node.CafeClients())
+}
+
+func (c *cafeApi) getClientUsage(g *gin.Context) {
+	id := g.Param("id")
+	if id == "" {
+		pbJSON(g, http.StatusOK, c.node.CafeClientsUsage())
+		return
+	}
+
+	usage, err := c.node.CafeClientUsage(id)
+	if err != nil {
+		c.abort(g, http.StatusNotFound, err)
+		return
+	}
+	pbJSON(g, http.StatusOK, usage)
+}
+
+func (c *cafeApi) evictClient(g *gin.Context) {
+	if err := c.node.EvictCafeClient(g.Param("id")); err != nil {
+		if err == ErrCafeClientNotFound {
+			c.abort(g, http.StatusNotFound, err)
+		} else {
+			c.abort(g, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	g.Status(http.StatusNoContent)
+}
+
+func (c *cafeApi) getReplication(g *gin.Context) {
+	pbJSON(g, http.StatusOK, c.node.CafeReplicationStatus())
+}
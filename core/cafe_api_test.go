@@ -1,23 +1,35 @@
 package core_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	cid "github.com/ipfs/go-cid"
 	. "github.com/textileio/go-textile/core"
 	"github.com/textileio/go-textile/keypair"
 	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
 )
 
 var repoPath1 = "testdata/.textile1"
 var node1 *Textile
 var repoPath2 = "testdata/.textile2"
 var node2 *Textile
+var repoPath3 = "testdata/.textile3"
 
 var session *pb.CafeSession
 var blockHash = "QmbQ4K3vXNJ3DjCNdG2urCXs7BuHqWQG1iSjZ8fbnF8NMs"
@@ -164,6 +176,679 @@ func TestCafeApi_PinArchive(t *testing.T) {
 	}
 }
 
+func TestCafeApi_Admin(t *testing.T) {
+	url := session.Cafe.Url + "/api/v1/admin/clients"
+
+	// admin routes are disabled without a token
+	res, err := admin("GET", url, "secret")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+
+	node2.Config().Cafe.Host.AdminToken = "secret"
+	defer func() {
+		node2.Config().Cafe.Host.AdminToken = ""
+	}()
+
+	res, err = admin("GET", url, "wrong")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusForbidden {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+
+	res, err = admin("GET", url, "secret")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+	list := &pb.CafeClientList{}
+	if err := jsonpb.Unmarshal(res.Body, list); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(list.Items) != 1 || list.Items[0].Id != node1.Ipfs().Identity.Pretty() {
+		t.Error("client list should contain node1")
+		return
+	}
+
+	usage, err := node2.CafeClientUsage(node1.Ipfs().Identity.Pretty())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if usage.Client.Id != node1.Ipfs().Identity.Pretty() {
+		t.Error("usage should be for node1")
+	}
+}
+
+func TestCafeApi_Replication(t *testing.T) {
+	status := node2.CafeReplicationStatus()
+	if status.Enabled || status.Pending != 0 {
+		t.Error("replication should be disabled by default")
+		return
+	}
+
+	host := &node2.Config().Cafe.Host
+	host.ReplicateToNeighbors = true
+	host.NeighborURL = "http://127.0.0.1:1"
+	host.NeighborURLs = []string{"http://127.0.0.1:2"}
+	defer func() {
+		host.ReplicateToNeighbors = false
+		host.NeighborURL = ""
+		host.NeighborURLs = []string{}
+	}()
+
+	url := session.Cafe.Url + "/api/v1/threads/thread"
+	req, err := http.NewRequest("PUT", url, strings.NewReader("ciphertext"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", session.Access))
+	req.Header.Set("X-Textile-Peer", node1.Ipfs().Identity.Pretty())
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+
+	// unreachable neighbors leave the replication queued with an error for each,
+	// node1's own cafe requests may be replicated alongside it
+	failed := func(status *pb.CafeReplicationStatus) bool {
+		if len(status.Neighbors) != 2 {
+			return false
+		}
+		var pending int32
+		for _, n := range status.Neighbors {
+			if n.LastError == "" || n.Pending < 1 || n.Pending != status.Neighbors[0].Pending {
+				return false
+			}
+			pending += n.Pending
+		}
+		return pending == status.Pending
+	}
+	for i := 0; i < 50; i++ {
+		status = node2.CafeReplicationStatus()
+		if failed(status) {
+			break
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	if !status.Enabled || !failed(status) {
+		t.Errorf("bad replication status: %v", status)
+	}
+}
+
+func TestCafeApi_Priority(t *testing.T) {
+	updated, err := node1.UpdateCafePriority(session.Id, 5)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if updated.Priority != 5 || updated.Access != session.Access {
+		t.Error("update cafe priority failed")
+		return
+	}
+
+	if _, err := node1.UpdateCafePriority("unknown", 1); err == nil {
+		t.Error("updating an unknown cafe should fail")
+	}
+}
+
+func TestCafeApi_Upload(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/" + blockHash)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	headers := map[string]string{
+		"Upload-Length":        fmt.Sprintf("%d", len(data)),
+		"X-Textile-Store-Type": "data",
+	}
+
+	// lengths over the size limit are rejected up front
+	host := &node2.Config().Cafe.Host
+	host.SizeLimit = int64(len(data) - 1)
+	res, err := upload("POST", blockHash, headers, nil)
+	host.SizeLimit = 0
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if res.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("bad size limit response: %d", res.StatusCode)
+		return
+	}
+
+	// create
+	res, err = upload("POST", blockHash, headers, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if res.StatusCode != http.StatusCreated || res.Header.Get("Upload-Offset") != "0" {
+		t.Errorf("bad create response: %d, offset %s", res.StatusCode, res.Header.Get("Upload-Offset"))
+		return
+	}
+
+	// first chunk
+	res, err = upload("PATCH", blockHash, chunkHeaders(0), data[:300])
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if res.StatusCode != http.StatusNoContent || res.Header.Get("Upload-Offset") != "300" {
+		t.Errorf("bad chunk response: %d, offset %s", res.StatusCode, res.Header.Get("Upload-Offset"))
+		return
+	}
+
+	// wrong offset
+	res, err = upload("PATCH", blockHash, chunkHeaders(0), data[:300])
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if res.StatusCode != http.StatusConflict || res.Header.Get("Upload-Offset") != "300" {
+		t.Errorf("bad conflict response: %d, offset %s", res.StatusCode, res.Header.Get("Upload-Offset"))
+		return
+	}
+
+	// resume
+	res, err = upload("POST", blockHash, headers, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.Header.Get("Upload-Offset") != "300" {
+		t.Errorf("bad resume response: %d, offset %s", res.StatusCode, res.Header.Get("Upload-Offset"))
+		return
+	}
+	res, err = upload("HEAD", blockHash, nil, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.Header.Get("Upload-Offset") != "300" {
+		t.Errorf("bad head response: %d, offset %s", res.StatusCode, res.Header.Get("Upload-Offset"))
+		return
+	}
+
+	// last chunk completes the upload
+	res, err = upload("PATCH", blockHash, chunkHeaders(300), data[300:])
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("bad final chunk response: %d", res.StatusCode)
+		return
+	}
+	res, err = upload("HEAD", blockHash, nil, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("completed upload should be removed, got status: %d", res.StatusCode)
+	}
+
+	usage, err := node2.CafeClientUsage(node1.Ipfs().Identity.Pretty())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if usage.PinCount == 0 {
+		t.Error("completed upload should be pinned for client")
+	}
+}
+
+func TestCafeApi_TokenScopes(t *testing.T) {
+	if _, err := node2.CreateCafeTokenWithConfig("", true, CafeTokenConfig{
+		Scopes: []string{"bogus"},
+	}); err == nil {
+		t.Error("creating a token with an invalid scope should fail")
+		return
+	}
+	if _, err := node2.CreateCafeTokenWithConfig("", true, CafeTokenConfig{
+		Plan: "missing",
+	}); err == nil {
+		t.Error("creating a token with an unknown plan should fail")
+		return
+	}
+
+	token, err := node2.CreateCafeTokenWithConfig("", true, CafeTokenConfig{
+		Scopes:  []string{"threads", "search"},
+		Expires: time.Now().Add(time.Hour),
+		Label:   "limited",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if ok, err := node2.ValidateCafeToken(token); !ok || err != nil {
+		t.Errorf("token should be valid: %s", err)
+		return
+	}
+
+	var limited, registered *pb.CafeTokenUsage
+	for _, u := range node2.CafeTokensUsage().Items {
+		if u.Token.Label == "limited" {
+			limited = u
+		} else if u.ClientCount > 0 {
+			registered = u
+		}
+	}
+	if limited == nil || len(limited.Token.Scopes) != 2 || limited.Token.Expires == nil {
+		t.Error("limited token info not listed")
+		return
+	}
+	if len(limited.Token.Value) != 0 {
+		t.Error("token hash should not be listed")
+	}
+	if registered == nil || registered.ClientCount != 1 || registered.PinCount == 0 {
+		t.Error("registered token usage not listed")
+	}
+}
+
+func TestCafeApi_Metrics(t *testing.T) {
+	url := session.Cafe.Url + "/metrics"
+
+	// metrics are disabled by default
+	res, err := admin("GET", url, "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+
+	host := &node2.Config().Cafe.Host
+	host.Metrics = true
+	host.AdminToken = "secret"
+	defer func() {
+		host.Metrics = false
+		host.AdminToken = ""
+	}()
+
+	res, err = admin("GET", url, "wrong")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusForbidden {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+
+	res, err = admin("GET", url, "secret")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for _, line := range []string{
+		`textile_cafe_registrations_total{result="ok"} 1`,
+		`textile_cafe_clients 1`,
+		`textile_cafe_api_requests_total{method="PUT",handler="storeThread",code="204"}`,
+		`textile_cafe_service_handle_seconds_count{type="CAFE_REGISTRATION"}`,
+	} {
+		if !strings.Contains(string(body), line) {
+			t.Errorf("metrics missing %s", line)
+		}
+	}
+}
+
+func TestCafeApi_Limits(t *testing.T) {
+	url := session.Cafe.Url + "/health"
+
+	host := &node2.Config().Cafe.Host
+	host.IPRateLimits["GET /health"] = config.CafeRateLimit{Rate: 0, Burst: 1}
+	defer func() {
+		delete(host.IPRateLimits, "GET /health")
+		host.BannedIPs = []string{}
+	}()
+
+	res, err := admin("GET", url, "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+
+	res, err = admin("GET", url, "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+
+	// other routes are unaffected
+	res, err = admin("GET", session.Cafe.Url+"/", "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+
+	host.BannedIPs = []string{"127.0.0.0/8"}
+	res, err = admin("GET", session.Cafe.Url+"/", "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusForbidden {
+		t.Errorf("got bad status: %d", res.StatusCode)
+	}
+}
+
+func TestCafeApi_Federation(t *testing.T) {
+	// a fake peer cafe that answers federated queries with a single contact
+	fakePeer := "QmZRkVnXUVv6CMmmSeCMAmWRMcmKiyR4hRDozHMyEbfLzb"
+	contact := &pb.Peer{
+		Id:      "QmYKXbUwRDd1pvRyTHrJESmRJgLoEgCVJ27nuSLBoD1RGL",
+		Address: "P8wW5FYs2ANDan2DV8D45XWKtFFYNTMY8RgLCRcQHjyPZ9Ly",
+		Name:    "federated",
+	}
+	queries := make(chan *pb.FederatedQuery, 1)
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			(&jsonpb.Marshaler{}).Marshal(w, &pb.Cafe{
+				Peer: fakePeer,
+				Url:  server.URL,
+				Api:  "v0",
+			})
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		env := new(pb.Envelope)
+		if err := proto.Unmarshal(body, env); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fq := new(pb.FederatedQuery)
+		if err := ptypes.UnmarshalAny(env.Message.Payload, fq); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		queries <- fq
+
+		value, _ := proto.Marshal(contact)
+		payload, _ := ptypes.MarshalAny(&pb.QueryResults{
+			Type: pb.Query_CONTACTS,
+			Items: []*pb.QueryResult{{
+				Id:    contact.Id,
+				Date:  ptypes.TimestampNow(),
+				Value: &any.Any{TypeUrl: "/Peer", Value: value},
+			}},
+		})
+		json.NewEncoder(w).Encode(&pb.Envelope{
+			Message: &pb.Message{
+				Type:       pb.Message_CAFE_QUERY_RES,
+				Payload:    payload,
+				RequestId:  env.Message.RequestId,
+				IsResponse: true,
+			},
+		})
+	}))
+	defer server.Close()
+
+	host := &node2.Config().Cafe.Host
+	host.FederatedPeers = []string{server.URL}
+	defer func() {
+		host.FederatedPeers = []string{}
+	}()
+
+	resCh, errCh, _, err := node1.SearchContacts(&pb.ContactQuery{
+		Address: contact.Address,
+	}, &pb.QueryOptions{
+		RemoteOnly: true,
+		Limit:      1,
+		Wait:       3,
+		Federated:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var found bool
+loop:
+	for {
+		select {
+		case err := <-errCh:
+			t.Fatal(err)
+		case res, ok := <-resCh:
+			if !ok {
+				break loop
+			}
+			if res.Id == contact.Address {
+				found = true
+			}
+		}
+	}
+	if !found {
+		t.Error("federated contact not found")
+	}
+
+	select {
+	case fq := <-queries:
+		if fq.Query.Token != "" {
+			t.Error("federated query should not include the client token")
+		}
+		if fq.Hops != int32(host.FederationMaxHops-1) {
+			t.Errorf("wrong hops: %d", fq.Hops)
+		}
+		if len(fq.Visited) == 0 || fq.Visited[0] != node2.Ipfs().Identity.Pretty() {
+			t.Error("federated query should list the forwarding cafe as visited")
+		}
+	default:
+		t.Error("query was not forwarded")
+	}
+}
+
+func TestCafeApi_FederationPeerFailures(t *testing.T) {
+	// a peer cafe that can't be reached
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	host := &node2.Config().Cafe.Host
+	host.FederatedPeers = []string{server.URL}
+	defer func() {
+		host.FederatedPeers = []string{}
+	}()
+
+	for i := 0; i < 2; i++ {
+		resCh, errCh, _, err := node1.SearchContacts(&pb.ContactQuery{
+			Address: "P8wW5FYs2ANDan2DV8D45XWKtFFYNTMY8RgLCRcQHjyPZ9Ly",
+		}, &pb.QueryOptions{
+			RemoteOnly: true,
+			Limit:      1,
+			Wait:       1,
+			Federated:  true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	loop:
+		for {
+			select {
+			case err := <-errCh:
+				t.Fatal(err)
+			case _, ok := <-resCh:
+				if !ok {
+					break loop
+				}
+			}
+		}
+	}
+
+	// the failure is cached, so the second search doesn't ask again
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("expected one peer info request, got %d", n)
+	}
+}
+
+func TestCafeApi_SharedPins(t *testing.T) {
+	// register another client with the cafe
+	os.RemoveAll(repoPath3)
+	if err := InitRepo(InitConfig{
+		Account:  keypair.Random(),
+		RepoPath: repoPath3,
+	}); err != nil {
+		t.Errorf("init node3 failed: %s", err)
+		return
+	}
+	node3, err := NewTextile(RunConfig{
+		RepoPath: repoPath3,
+	})
+	if err != nil {
+		t.Errorf("create node3 failed: %s", err)
+		return
+	}
+	node3.Start()
+	defer func() {
+		node3.Stop()
+		os.RemoveAll(repoPath3)
+	}()
+	<-node3.OnlineCh()
+
+	token, err := node2.CreateCafeToken("", true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	session3, err := node3.RegisterCafe(session.Cafe.Url, token)
+	if err != nil {
+		t.Errorf("register node3 w/ node2 failed: %s", err)
+		return
+	}
+
+	// both clients store the same data
+	data, err := ioutil.ReadFile("testdata/" + blockHash)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res, err := store(blockHash, session.Access, bytes.NewReader(data))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+	id, err := cid.Decode(blockHash)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// going over quota after the add keeps the data pinned for the other client,
+	// an unknown content length skips the quota check before the add
+	host := &node2.Config().Cafe.Host
+	host.ClientStorageQuota = 1
+	res, err = store(blockHash, session3.Access, ioutil.NopCloser(bytes.NewReader(data)))
+	host.ClientStorageQuota = 0
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if res.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+	if _, pinned, err := node2.Ipfs().Pinning.IsPinned(id); err != nil || !pinned {
+		t.Error("shared pin should remain pinned after a quota failure")
+		return
+	}
+
+	res, err = store(blockHash, session3.Access, bytes.NewReader(data))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got bad status: %d", res.StatusCode)
+		return
+	}
+
+	// evicting one client keeps the data pinned for the other
+	before, err := node2.CafeClientUsage(node1.Ipfs().Identity.Pretty())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := node2.EvictCafeClient(node3.Ipfs().Identity.Pretty()); err != nil {
+		t.Error(err)
+		return
+	}
+	if _, pinned, err := node2.Ipfs().Pinning.IsPinned(id); err != nil || !pinned {
+		t.Error("shared pin should remain pinned")
+		return
+	}
+	after, err := node2.CafeClientUsage(node1.Ipfs().Identity.Pretty())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if after.PinCount != before.PinCount || after.PinSize != before.PinSize {
+		t.Error("evicting node3 should not change node1's pins")
+	}
+}
+
 func TestCafeApi_Teardown(t *testing.T) {
 	node1.Stop()
 	node2.Stop()
@@ -185,6 +870,58 @@ func pin(reader io.Reader, cType string, token string, addr string) (*http.Respo
 	return client.Do(req)
 }
 
+func store(hash string, token string, body io.Reader) (*http.Response, error) {
+	url := fmt.Sprintf("%s/api/v1/store/%s", session.Cafe.Url, hash)
+	req, err := http.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("X-Textile-Store-Type", "data")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	res.Body.Close()
+	return res, nil
+}
+
+func admin(method string, url string, token string) (*http.Response, error) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	client := &http.Client{}
+	return client.Do(req)
+}
+
+func upload(method string, hash string, headers map[string]string, body []byte) (*http.Response, error) {
+	url := fmt.Sprintf("%s/api/v1/store/%s", session.Cafe.Url, hash)
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", session.Access))
+	req.Header.Set("X-Textile-Peer", node1.Ipfs().Identity.Pretty())
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	res.Body.Close()
+	return res, nil
+}
+
+func chunkHeaders(offset int) map[string]string {
+	return map[string]string{
+		"Content-Type":  "application/offset+octet-stream",
+		"Upload-Offset": fmt.Sprintf("%d", offset),
+	}
+}
+
 func unmarshalJSON(body io.ReadCloser, target interface{}) error {
 	b, err := ioutil.ReadAll(body)
 	if err != nil {
@@ -0,0 +1,395 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	cid "github.com/ipfs/go-cid"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+)
+
+// tusVersion is the resumable upload protocol version
+const tusVersion = "1.0.0"
+
+// uploadContentType is the required content type of upload chunks
+const uploadContentType = "application/offset+octet-stream"
+
+// cafeUploadChunkThreshold is the size above which store requests are sent as resumable uploads
+const cafeUploadChunkThreshold = 4 << 20
+
+// cafeUploadChunkSize is the size of each resumable upload chunk
+const cafeUploadChunkSize = 1 << 20
+
+// cafeUploadMaxAge is the age after which incomplete uploads are removed
+const cafeUploadMaxAge = time.Hour * 24
+
+// cafeUpload describes an in-progress resumable upload
+type cafeUpload struct {
+	Length  int64     `json:"length"`
+	Type    string    `json:"type"`
+	Created time.Time `json:"created"`
+}
+
+// maybeReapCafeUploads removes incomplete uploads that have not been finished in time
+func (t *Textile) maybeReapCafeUploads() {
+	if !t.config.Cafe.Host.Open || cafeApiHost == nil {
+		return
+	}
+	cafeApiHost.reapUploads()
+}
+
+// reapUploads removes expired uploads
+func (c *cafeApi) reapUploads() {
+	infos, err := filepath.Glob(filepath.Join(c.node.repoPath, "uploads", "*", "*.info"))
+	if err != nil {
+		log.Errorf("error listing uploads: %s", err)
+		return
+	}
+	for _, info := range infos {
+		pid := filepath.Base(filepath.Dir(info))
+		hash := strings.TrimSuffix(filepath.Base(info), ".info")
+		c.reapUpload(pid, hash)
+	}
+}
+
+// reapUpload removes an upload if it's expired, checking its age under the
+// upload lock so that a concurrent create or chunk isn't removed out from under it
+func (c *cafeApi) reapUpload(pid string, hash string) {
+	c.lockUpload(pid, hash)
+	defer c.unlockUpload(pid, hash)
+
+	pth := c.uploadPath(pid, hash)
+	upload, err := readCafeUpload(pth + ".info")
+	if os.IsNotExist(err) {
+		return // completed or removed meanwhile
+	}
+	if err == nil && time.Since(upload.Created) <= cafeUploadMaxAge {
+		return
+	}
+	c.removeUpload(pth)
+}
+
+func (c *cafeApi) createUpload(g *gin.Context) {
+	id, err := cid.Decode(g.Param("cid"))
+	if err != nil {
+		c.abort(g, http.StatusBadRequest, err)
+		return
+	}
+	hash := id.Hash().B58String()
+	client := c.tokenClient(g)
+	if client == nil {
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+	pid := client.Id
+
+	length, err := strconv.ParseInt(g.Request.Header.Get("Upload-Length"), 10, 64)
+	if err != nil || length <= 0 {
+		c.abort(g, http.StatusBadRequest, fmt.Errorf("invalid upload length"))
+		return
+	}
+	stype := g.Request.Header.Get("X-Textile-Store-Type")
+	if stype != "data" && stype != "object" {
+		c.abort(g, http.StatusBadRequest, fmt.Errorf("missing store type header"))
+		return
+	}
+
+	if limit := c.node.Config().Cafe.Host.SizeLimit; limit > 0 && length > limit {
+		c.abort(g, http.StatusRequestEntityTooLarge, fmt.Errorf("upload length exceeds size limit"))
+		return
+	}
+	if !c.node.cafe.canStore(client, length) {
+		c.abort(g, http.StatusRequestEntityTooLarge, ErrQuotaExceeded)
+		return
+	}
+
+	c.lockUpload(pid, hash)
+	defer c.unlockUpload(pid, hash)
+
+	pth := c.uploadPath(pid, hash)
+	if upload, err := readCafeUpload(pth + ".info"); err == nil && upload.Length == length {
+		offset, err := uploadOffset(pth)
+		if err != nil {
+			c.abort(g, http.StatusInternalServerError, err)
+			return
+		}
+		writeUploadHeaders(g, offset, length)
+		g.Status(http.StatusOK)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(pth), os.ModePerm); err != nil {
+		c.abort(g, http.StatusInternalServerError, err)
+		return
+	}
+	if err := ioutil.WriteFile(pth, nil, 0600); err != nil {
+		c.abort(g, http.StatusInternalServerError, err)
+		return
+	}
+	info, err := json.Marshal(&cafeUpload{
+		Length:  length,
+		Type:    stype,
+		Created: time.Now(),
+	})
+	if err != nil {
+		c.abort(g, http.StatusInternalServerError, err)
+		return
+	}
+	if err := ioutil.WriteFile(pth+".info", info, 0600); err != nil {
+		c.abort(g, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeUploadHeaders(g, 0, length)
+	g.Status(http.StatusCreated)
+}
+
+func (c *cafeApi) headUpload(g *gin.Context) {
+	id, err := cid.Decode(g.Param("cid"))
+	if err != nil {
+		c.abort(g, http.StatusBadRequest, err)
+		return
+	}
+	client := c.tokenClient(g)
+	if client == nil {
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+	pth := c.uploadPath(client.Id, id.Hash().B58String())
+
+	upload, err := readCafeUpload(pth + ".info")
+	if err != nil {
+		c.abort(g, http.StatusNotFound, nil)
+		return
+	}
+	offset, err := uploadOffset(pth)
+	if err != nil {
+		c.abort(g, http.StatusInternalServerError, err)
+		return
+	}
+
+	g.Header("Cache-Control", "no-store")
+	writeUploadHeaders(g, offset, upload.Length)
+	g.Status(http.StatusOK)
+}
+
+func (c *cafeApi) patchUpload(g *gin.Context) {
+	id, err := cid.Decode(g.Param("cid"))
+	if err != nil {
+		c.abort(g, http.StatusBadRequest, err)
+		return
+	}
+	hash := id.Hash().B58String()
+	client := c.tokenClient(g)
+	if client == nil {
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+	pid := client.Id
+
+	if g.Request.Header.Get("Content-Type") != uploadContentType {
+		c.abort(g, http.StatusUnsupportedMediaType, nil)
+		return
+	}
+	offset, err := strconv.ParseInt(g.Request.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		c.abort(g, http.StatusBadRequest, fmt.Errorf("invalid upload offset"))
+		return
+	}
+
+	c.lockUpload(pid, hash)
+	defer c.unlockUpload(pid, hash)
+
+	pth := c.uploadPath(pid, hash)
+	upload, err := readCafeUpload(pth + ".info")
+	if err != nil {
+		c.abort(g, http.StatusNotFound, nil)
+		return
+	}
+	current, err := uploadOffset(pth)
+	if err != nil {
+		c.abort(g, http.StatusInternalServerError, err)
+		return
+	}
+	if offset != current {
+		writeUploadHeaders(g, current, upload.Length)
+		c.abort(g, http.StatusConflict, fmt.Errorf("upload offset is %d", current))
+		return
+	}
+
+	// write the chunk, rolling back on failure so offsets stay on chunk boundaries
+	f, err := os.OpenFile(pth, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		c.abort(g, http.StatusInternalServerError, err)
+		return
+	}
+	n, err := io.Copy(f, io.LimitReader(g.Request.Body, upload.Length-offset+1))
+	f.Close()
+	if err == nil && offset+n > upload.Length {
+		err = fmt.Errorf("chunk exceeds upload length")
+	}
+	if err != nil {
+		if terr := os.Truncate(pth, offset); terr != nil {
+			log.Errorf("error rolling back upload %s: %s", hash, terr)
+		}
+		c.abort(g, http.StatusBadRequest, err)
+		return
+	}
+	offset += n
+
+	if offset < upload.Length {
+		writeUploadHeaders(g, offset, upload.Length)
+		g.Status(http.StatusNoContent)
+		return
+	}
+
+	// upload is complete
+	status, err := c.finalizeUpload(client, hash, pth, upload)
+	c.removeUpload(pth)
+	if err != nil {
+		c.abort(g, status, err)
+		return
+	}
+
+	writeUploadHeaders(g, offset, upload.Length)
+	g.Status(http.StatusNoContent)
+}
+
+// finalizeUpload adds a completed upload and verifies its cid
+func (c *cafeApi) finalizeUpload(client *pb.CafeClient, hash string, pth string, upload *cafeUpload) (int, error) {
+	f, err := os.Open(pth)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer f.Close()
+
+	var aid *cid.Cid
+	switch upload.Type {
+	case "data":
+		aid, err = ipfs.AddData(c.node.Ipfs(), f, true)
+	case "object":
+		aid, err = ipfs.AddObject(c.node.Ipfs(), f, true)
+	}
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	rhash := aid.Hash().B58String()
+
+	if rhash != hash {
+		if err := c.node.cafe.releasePin(*aid); err != nil {
+			log.Errorf("error unpinning %s: %s", rhash, err)
+		}
+		return http.StatusBadRequest, fmt.Errorf("cids do not match (received %s, resolved %s)", hash, rhash)
+	}
+
+	log.Debugf("stored upload %s", rhash)
+
+	// other uploads may have completed since this one was created,
+	// leaving the content pinned if it's already stored for other clients
+	if !c.node.cafe.canStore(client, upload.Length) {
+		if err := c.node.cafe.releasePin(*aid); err != nil {
+			log.Errorf("error unpinning %s: %s", rhash, err)
+		}
+		return http.StatusRequestEntityTooLarge, ErrQuotaExceeded
+	}
+	if err := c.node.cafe.addClientPin(client, rhash, upload.Length); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	c.node.cafe.replicate(pb.CafeReplication_PIN, client.Id, rhash)
+	observeCafeStore("upload", upload.Length)
+	return http.StatusNoContent, nil
+}
+
+// uploadPath returns the path of a client's upload
+func (c *cafeApi) uploadPath(pid string, hash string) string {
+	return filepath.Join(c.node.repoPath, "uploads", pid, hash)
+}
+
+// removeUpload deletes an upload's data and info
+func (c *cafeApi) removeUpload(pth string) {
+	for _, p := range []string{pth, pth + ".info"} {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			log.Errorf("error removing upload %s: %s", p, err)
+		}
+	}
+}
+
+// cafeUploadLock guards a single upload, counting the requests that hold or wait on it
+type cafeUploadLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lockUpload locks a single upload against concurrent writes
+func (c *cafeApi) lockUpload(pid string, hash string) {
+	c.umux.Lock()
+	if c.uploads == nil {
+		c.uploads = make(map[string]*cafeUploadLock)
+	}
+	key := pid + "/" + hash
+	lock, ok := c.uploads[key]
+	if !ok {
+		lock = &cafeUploadLock{}
+		c.uploads[key] = lock
+	}
+	lock.refs++
+	c.umux.Unlock()
+
+	lock.Lock()
+}
+
+// unlockUpload unlocks an upload, dropping its lock once no other requests need it
+func (c *cafeApi) unlockUpload(pid string, hash string) {
+	c.umux.Lock()
+	defer c.umux.Unlock()
+	key := pid + "/" + hash
+	lock, ok := c.uploads[key]
+	if !ok {
+		return
+	}
+	lock.refs--
+	if lock.refs == 0 {
+		delete(c.uploads, key)
+	}
+	lock.Unlock()
+}
+
+// readCafeUpload reads an upload's info file
+func readCafeUpload(pth string) (*cafeUpload, error) {
+	data, err := ioutil.ReadFile(pth)
+	if err != nil {
+		return nil, err
+	}
+	upload := new(cafeUpload)
+	if err := json.Unmarshal(data, upload); err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+// uploadOffset returns the number of bytes received for an upload
+func uploadOffset(pth string) (int64, error) {
+	stat, err := os.Stat(pth)
+	if err != nil {
+		return 0, err
+	}
+	return stat.Size(), nil
+}
+
+// writeUploadHeaders sets the resumable upload response headers
+func writeUploadHeaders(g *gin.Context, offset int64, length int64) {
+	g.Header("Tus-Resumable", tusVersion)
+	g.Header("Upload-Offset", strconv.FormatInt(offset, 10))
+	g.Header("Upload-Length", strconv.FormatInt(length, 10))
+}
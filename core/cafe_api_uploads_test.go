@@ -0,0 +1,65 @@
+package core
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCafeApi_ReapUploads(t *testing.T) {
+	dir, err := ioutil.TempDir("", "uploads")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := &cafeApi{node: &Textile{repoPath: dir}}
+	expired := time.Now().Add(-cafeUploadMaxAge * 2)
+	writeTestUpload(t, c, "peer", "stale", expired)
+	writeTestUpload(t, c, "peer", "fresh", time.Now())
+	writeTestUpload(t, c, "peer", "renewed", expired)
+
+	// an upload re-created while the reaper waits on its lock is kept
+	c.lockUpload("peer", "renewed")
+	done := make(chan struct{})
+	go func() {
+		c.reapUploads()
+		close(done)
+	}()
+	time.Sleep(time.Millisecond * 100)
+	if _, err := os.Stat(c.uploadPath("peer", "renewed") + ".info"); err != nil {
+		t.Fatalf("locked upload was reaped: %s", err)
+	}
+	writeTestUpload(t, c, "peer", "renewed", time.Now())
+	c.unlockUpload("peer", "renewed")
+	<-done
+
+	for hash, kept := range map[string]bool{"stale": false, "fresh": true, "renewed": true} {
+		pth := c.uploadPath("peer", hash)
+		for _, p := range []string{pth, pth + ".info"} {
+			if _, err := os.Stat(p); os.IsNotExist(err) == kept {
+				t.Errorf("upload %s: expected kept=%v", filepath.Base(p), kept)
+			}
+		}
+	}
+}
+
+func writeTestUpload(t *testing.T, c *cafeApi, pid string, hash string, created time.Time) {
+	pth := c.uploadPath(pid, hash)
+	if err := os.MkdirAll(filepath.Dir(pth), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(pth, []byte("data"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	info, err := json.Marshal(&cafeUpload{Length: 8, Type: "data", Created: created})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(pth+".info", info, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
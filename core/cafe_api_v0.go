@@ -126,6 +126,9 @@ func (c *cafeApi) service(g *gin.Context) {
 		g.String(http.StatusBadRequest, err.Error())
 		return
 	}
+	if !c.limitMessage(g, pmes.Message.Type) {
+		return
+	}
 
 	// handle the message as normal
 	log.Debugf("received %s from %s", pmes.Message.Type.String(), mPeer.Pretty())
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/ptypes"
@@ -23,12 +24,23 @@ func (c *cafeApi) store(g *gin.Context) {
 	}
 	hash := id.Hash().B58String()
 
+	client := c.tokenClient(g)
+	if client == nil {
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+	if !c.node.cafe.canStore(client, g.Request.ContentLength) {
+		c.abort(g, http.StatusRequestEntityTooLarge, ErrQuotaExceeded)
+		return
+	}
+
+	body := &countReader{r: g.Request.Body}
 	var aid *cid.Cid
 	switch g.Request.Header.Get("X-Textile-Store-Type") {
 	case "data":
-		aid, err = ipfs.AddData(c.node.Ipfs(), g.Request.Body, true)
+		aid, err = ipfs.AddData(c.node.Ipfs(), body, true)
 	case "object":
-		aid, err = ipfs.AddObject(c.node.Ipfs(), g.Request.Body, true)
+		aid, err = ipfs.AddObject(c.node.Ipfs(), body, true)
 	default:
 		c.abort(g, http.StatusBadRequest, fmt.Errorf("missing store type header"))
 		return
@@ -46,6 +58,22 @@ func (c *cafeApi) store(g *gin.Context) {
 		return
 	}
 
+	// content length may be missing, so check again now that the size is known,
+	// leaving the content pinned if it's already stored for other clients
+	if !c.node.cafe.canStore(client, body.n) {
+		if err := c.node.cafe.releasePin(*aid); err != nil {
+			log.Errorf("error unpinning %s: %s", rhash, err)
+		}
+		c.abort(g, http.StatusRequestEntityTooLarge, ErrQuotaExceeded)
+		return
+	}
+	if err := c.node.cafe.addClientPin(client, rhash, body.n); err != nil {
+		c.abort(g, http.StatusInternalServerError, err)
+		return
+	}
+	c.node.cafe.replicate(pb.CafeReplication_PIN, client.Id, rhash)
+	observeCafeStore("http", body.n)
+
 	g.Status(http.StatusNoContent)
 }
 
@@ -62,28 +90,47 @@ func (c *cafeApi) unstore(g *gin.Context) {
 		return
 	}
 
+	client := c.tokenClient(g)
+	if client == nil {
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+	pid := client.Id
+	var unstored int
 	for _, p := range pinned {
 		if p.Mode != pin.NotPinned {
-			if err := ipfs.UnpinCid(c.node.Ipfs(), p.Key, true); err != nil {
+			hash := p.Key.Hash().B58String()
+			if err := c.node.datastore.CafeClientPins().Delete(hash, pid); err != nil {
+				c.abort(g, http.StatusInternalServerError, err)
+				return
+			}
+			if err := c.node.cafe.releasePin(p.Key); err != nil {
 				c.abort(g, http.StatusBadRequest, err)
 				return
 			}
+			c.node.cafe.replicate(pb.CafeReplication_UNPIN, pid, hash)
+			unstored++
 		}
 	}
+	observeCafeUnstore("http", unstored)
 
 	g.Status(http.StatusNoContent)
 }
 
 func (c *cafeApi) storeThread(g *gin.Context) {
-	pid := g.Request.Header.Get("X-Textile-Peer")
 	id := g.Param("id")
 
-	client := c.node.datastore.CafeClients().Get(pid)
+	client := c.tokenClient(g)
 	if client == nil {
 		c.abort(g, http.StatusForbidden, nil)
 		return
 	}
 
+	if !c.node.cafe.canStoreThread(client, id) {
+		c.abort(g, http.StatusRequestEntityTooLarge, ErrQuotaExceeded)
+		return
+	}
+
 	buf := bodyPool.Get().(*bytes.Buffer)
 	defer func() {
 		buf.Reset()
@@ -102,19 +149,19 @@ func (c *cafeApi) storeThread(g *gin.Context) {
 		Client:     client.Id,
 		Ciphertext: buf.Bytes(),
 	}
-	if err := c.node.datastore.CafeClientThreads().AddOrUpdate(thrd); err != nil {
+	if err := c.node.cafe.storeClientThread(thrd); err != nil {
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
+	c.node.cafe.replicate(pb.CafeReplication_THREAD, client.Id, id)
 
 	g.Status(http.StatusNoContent)
 }
 
 func (c *cafeApi) unstoreThread(g *gin.Context) {
-	pid := g.Request.Header.Get("X-Textile-Peer")
 	id := g.Param("id")
 
-	client := c.node.datastore.CafeClients().Get(pid)
+	client := c.tokenClient(g)
 	if client == nil {
 		c.abort(g, http.StatusForbidden, nil)
 		return
@@ -124,17 +171,19 @@ func (c *cafeApi) unstoreThread(g *gin.Context) {
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
+	c.node.cafe.replicate(pb.CafeReplication_UNTHREAD, client.Id, id)
 
 	g.Status(http.StatusNoContent)
 }
 
 func (c *cafeApi) deliverMessage(g *gin.Context) {
+	// the inbox is open to any sender, so the peer header is only the sender's claim
 	pid := g.Request.Header.Get("X-Textile-Peer")
 	clientId := g.Param("pid")
 
 	client := c.node.datastore.CafeClients().Get(clientId)
 	if client == nil {
-		log.Warningf("received message from %s for unknown client %s", pid, clientId)
+		log.Warningf("received message from %s for unknown client %s", g.ClientIP(), clientId)
 		g.Status(http.StatusOK)
 		return
 	}
@@ -159,16 +208,34 @@ func (c *cafeApi) deliverMessage(g *gin.Context) {
 		return
 	}
 
+	// size is optional, older senders do not include it
+	size, _ := strconv.ParseInt(g.Request.Header.Get("X-Textile-Size"), 10, 64)
+
+	if code, _ := c.node.cafe.scopeError(client, cafeScopeInbox); code != 0 {
+		go c.node.cafe.notifyDropped(pid, mid.Hash().B58String(), client.Id, dropNoInbox)
+		g.Status(http.StatusOK)
+		return
+	}
+
+	size, reason := c.node.cafe.checkInboxMessage(client.Id, mid.Hash().B58String(), size)
+	if reason != "" {
+		go c.node.cafe.notifyDropped(pid, mid.Hash().B58String(), client.Id, reason)
+		g.Status(http.StatusOK)
+		return
+	}
+
 	message := &pb.CafeClientMessage{
 		Id:     mid.Hash().B58String(),
 		Peer:   pid,
 		Client: client.Id,
 		Date:   ptypes.TimestampNow(),
+		Size:   size,
 	}
 	if err := c.node.datastore.CafeClientMessages().AddOrUpdate(message); err != nil {
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
+	c.node.cafe.replicate(pb.CafeReplication_MESSAGE, client.Id, message.Id)
 
 	go func() {
 		cpid, err := peer.IDB58Decode(client.Id)
@@ -183,3 +250,15 @@ func (c *cafeApi) deliverMessage(g *gin.Context) {
 
 	g.Status(http.StatusOK)
 }
+
+// countReader counts the bytes read from the underlying reader
+type countReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
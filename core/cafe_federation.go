@@ -0,0 +1,349 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/util"
+)
+
+// federationInfoTimeout is the max duration of a federated peer info request
+const federationInfoTimeout = time.Second * 10
+
+// federationRetryInterval is how long a failed peer info request is cached before it's retried
+const federationRetryInterval = time.Minute
+
+// cafeFederation tracks the peer cafes that federated queries are exchanged with
+type cafeFederation struct {
+	config *config.Config
+	client *http.Client
+	peers  map[string]*federatedPeer
+	mux    sync.Mutex
+
+	queries map[string]struct{}
+	qmux    sync.Mutex
+}
+
+// newCafeFederation returns a new federation for the configured peer cafes
+func newCafeFederation(conf *config.Config) *cafeFederation {
+	return &cafeFederation{
+		config:  conf,
+		client:  &http.Client{Timeout: federationInfoTimeout},
+		peers:   make(map[string]*federatedPeer),
+		queries: make(map[string]struct{}),
+	}
+}
+
+// Enabled returns whether or not any federated peers are configured
+func (f *cafeFederation) Enabled() bool {
+	return len(f.config.Cafe.Host.FederatedPeers) > 0
+}
+
+// federatedPeer is the cached info of a peer cafe, or the time its info request failed
+type federatedPeer struct {
+	info   *pb.Cafe
+	failed time.Time
+}
+
+// Peers returns info for each configured peer cafe, skipping any that can't be reached.
+// Failed requests are retried after federationRetryInterval.
+func (f *cafeFederation) Peers() []*pb.Cafe {
+	urls := f.config.Cafe.Host.FederatedPeers
+
+	var fetch []string
+	f.mux.Lock()
+	for _, url := range urls {
+		p, ok := f.peers[url]
+		if !ok || (p.info == nil && time.Since(p.failed) >= federationRetryInterval) {
+			fetch = append(fetch, url)
+		}
+	}
+	f.mux.Unlock()
+
+	// fetch outside the lock so that slow peers don't block other callers
+	if len(fetch) > 0 {
+		fetched := make([]*federatedPeer, len(fetch))
+		wg := sync.WaitGroup{}
+		for i, url := range fetch {
+			wg.Add(1)
+			go func(i int, url string) {
+				defer wg.Done()
+				info, err := fetchCafeInfo(f.client, url)
+				if err != nil {
+					log.Warningf("error getting federated peer info from %s: %s", url, err)
+					fetched[i] = &federatedPeer{failed: time.Now()}
+					return
+				}
+				fetched[i] = &federatedPeer{info: info}
+			}(i, url)
+		}
+		wg.Wait()
+
+		f.mux.Lock()
+		for i, url := range fetch {
+			f.peers[url] = fetched[i]
+		}
+		f.mux.Unlock()
+	}
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	var list []*pb.Cafe
+	for _, url := range urls {
+		if p, ok := f.peers[url]; ok && p.info != nil {
+			list = append(list, p.info)
+		}
+	}
+	return list
+}
+
+// IsPeer returns whether or not the given peer is a configured peer cafe
+func (f *cafeFederation) IsPeer(pid peer.ID) bool {
+	for _, p := range f.Peers() {
+		if p.Peer == pid.Pretty() {
+			return true
+		}
+	}
+	return false
+}
+
+// reset clears cached info so it's fetched again on the next query
+func (f *cafeFederation) reset(url string) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	delete(f.peers, url)
+}
+
+// begin marks a query as in flight, returning false if it already is
+func (f *cafeFederation) begin(id string) bool {
+	f.qmux.Lock()
+	defer f.qmux.Unlock()
+	if _, ok := f.queries[id]; ok {
+		return false
+	}
+	f.queries[id] = struct{}{}
+	return true
+}
+
+// end removes an in flight query
+func (f *cafeFederation) end(id string) {
+	f.qmux.Lock()
+	defer f.qmux.Unlock()
+	delete(f.queries, id)
+}
+
+// searchFederated forwards a query to federated peer cafes, streaming their results to reply
+// until the wait time has elapsed, all peers have responded, or reply returns true
+func (h *CafeService) searchFederated(fq *pb.FederatedQuery, reply func(*pb.QueryResults) bool, cancelCh <-chan interface{}) error {
+	if fq.Hops <= 0 {
+		return nil
+	}
+
+	self := h.service.Node().Identity.Pretty()
+	visited := append([]string{self}, fq.Visited...)
+	var targets []*pb.Cafe
+	for _, p := range h.federation.Peers() {
+		if !util.ListContainsString(visited, p.Peer) {
+			targets = append(targets, p)
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	// targets are marked visited up front so they don't forward to each other
+	for _, t := range targets {
+		visited = append(visited, t.Peer)
+	}
+
+	// peers must finish before this query times out, and never see the requester's token
+	query := proto.Clone(fq.Query).(*pb.Query)
+	query.Token = ""
+	wait := query.Options.Wait
+	if query.Options.Wait > 1 {
+		query.Options.Wait = query.Options.Wait * 2 / 3
+	}
+	next := &pb.FederatedQuery{
+		Query:   query,
+		Hops:    fq.Hops - 1,
+		Visited: visited,
+	}
+
+	resCh := make(chan *pb.QueryResults)
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	wg := sync.WaitGroup{}
+	for _, t := range targets {
+		wg.Add(1)
+		go func(cafe *pb.Cafe) {
+			defer wg.Done()
+			if err := h.sendFederatedQuery(cafe, next, resCh, doneCh); err != nil {
+				log.Warningf("federated query to %s failed: %s", cafe.Url, err)
+			}
+		}(t)
+	}
+	allCh := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(allCh)
+	}()
+
+	timer := time.NewTimer(time.Second * time.Duration(wait))
+	defer timer.Stop()
+
+	seen := make(map[string]*timestamp.Timestamp)
+	for {
+		select {
+		case <-cancelCh:
+			return nil
+		case <-timer.C:
+			return nil
+		case <-allCh:
+			return nil
+		case res := <-resCh:
+			// the same result may arrive via more than one peer
+			var items []*pb.QueryResult
+			for _, item := range res.Items {
+				last, ok := seen[item.Id]
+				if ok && util.ProtoNanos(item.Date) <= util.ProtoNanos(last) {
+					continue
+				}
+				seen[item.Id] = item.Date
+				items = append(items, item)
+			}
+			if len(items) == 0 {
+				continue
+			}
+			if reply(&pb.QueryResults{Type: res.Type, Items: items}) {
+				return nil
+			}
+		}
+	}
+}
+
+// sendFederatedQuery sends a query to a peer cafe, passing results to resCh until doneCh is closed
+func (h *CafeService) sendFederatedQuery(cafe *pb.Cafe, fq *pb.FederatedQuery, resCh chan<- *pb.QueryResults, doneCh <-chan struct{}) error {
+	env, err := h.service.NewEnvelope(pb.Message_CAFE_FEDERATED_QUERY, fq, nil, false)
+	if err != nil {
+		return err
+	}
+
+	addr := fmt.Sprintf("%s/cafe/%s/service", cafe.Url, cafe.Api)
+	renvCh, errCh, cancel := h.service.SendHTTPStreamRequest(addr, env)
+	stop := func() {
+		if cancel != nil {
+			fn := *cancel
+			if fn != nil {
+				fn()
+			}
+		}
+
+		// drain so the request can exit
+		go func() {
+			for {
+				select {
+				case <-errCh:
+				case _, ok := <-renvCh:
+					if !ok {
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	for {
+		select {
+		case <-doneCh:
+			stop()
+			return nil
+		case err := <-errCh:
+			h.federation.reset(cafe.Url)
+			return err
+		case renv, ok := <-renvCh:
+			if !ok {
+				return nil
+			}
+
+			res := new(pb.QueryResults)
+			if err := ptypes.UnmarshalAny(renv.Message.Payload, res); err != nil {
+				stop()
+				return err
+			}
+			select {
+			case resCh <- res:
+			case <-doneCh:
+				stop()
+				return nil
+			}
+		}
+	}
+}
+
+// handleFederatedQuery receives a query forwarded by a federated peer cafe
+func (h *CafeService) handleFederatedQuery(pid peer.ID, env *pb.Envelope, renvs chan *pb.Envelope, cancelCh <-chan interface{}) error {
+	fq := new(pb.FederatedQuery)
+	if err := ptypes.UnmarshalAny(env.Message.Payload, fq); err != nil {
+		return err
+	}
+
+	// only accept queries from our own federated peers
+	if !h.open || !h.federation.IsPeer(pid) {
+		log.Warningf("received federated query from unknown cafe %s", pid.Pretty())
+		rerr, err := h.service.NewError(403, errForbidden, env.Message.RequestId)
+		if err != nil {
+			return err
+		}
+		renvs <- rerr
+		return nil
+	}
+	if fq.Query == nil {
+		rerr, err := h.service.NewError(400, errBadRequest, env.Message.RequestId)
+		if err != nil {
+			return err
+		}
+		renvs <- rerr
+		return nil
+	}
+	query := queryDefaults(fq.Query)
+
+	// the same query may arrive via more than one peer
+	if !h.federation.begin(query.Id) {
+		return nil
+	}
+	defer h.federation.end(query.Id)
+
+	reply := h.queryReplier(query, env.Message.RequestId, renvs)
+
+	// search local
+	localResults, err := h.searchLocal(query.Type, query.Options, query.Payload, false)
+	if err != nil {
+		return err
+	}
+	if reply(&pb.QueryResults{
+		Type:  query.Type,
+		Items: localResults.List(),
+	}) {
+		return nil
+	}
+
+	// forward, respecting our own hop limit
+	hops := fq.Hops
+	if max := int32(h.config.Cafe.Host.FederationMaxHops); hops > max {
+		hops = max
+	}
+	return h.searchFederated(&pb.FederatedQuery{
+		Query:   query,
+		Hops:    hops,
+		Visited: fq.Visited,
+	}, reply, cancelCh)
+}
@@ -0,0 +1,187 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/service"
+)
+
+// cafeUnhealthyFailures is the number of consecutive failures after which a cafe is unhealthy
+const cafeUnhealthyFailures = 3
+
+// UpdateCafePriority sets the routing priority of a cafe session.
+// Higher priority cafes are preferred for new requests and listed first as inboxes.
+func (t *Textile) UpdateCafePriority(id string, priority int) (*pb.CafeSession, error) {
+	if t.datastore.CafeSessions().Get(id) == nil {
+		return nil, fmt.Errorf("session not found")
+	}
+	if err := t.datastore.CafeSessions().UpdatePriority(id, priority); err != nil {
+		return nil, err
+	}
+	if err := t.updatePeerInboxes(); err != nil {
+		return nil, err
+	}
+	return t.datastore.CafeSessions().Get(id), nil
+}
+
+// maybeCheckCafeHealth pings each cafe session and re-publishes this peer
+// if the set of routable inboxes changed
+func (t *Textile) maybeCheckCafeHealth() {
+	if !t.Online() || !t.cafe.online {
+		return
+	}
+	t.cafe.checkHealth()
+
+	self := t.datastore.Peers().Get(t.node.Identity.Pretty())
+	if self == nil || peersEqual(self, &pb.Peer{
+		Id:      self.Id,
+		Address: self.Address,
+		Name:    self.Name,
+		Avatar:  self.Avatar,
+		Inboxes: t.inboxes(),
+	}) {
+		return
+	}
+
+	if err := t.updatePeerInboxes(); err != nil {
+		log.Errorf("error updating peer inboxes: %s", err)
+		return
+	}
+	if err := t.publishPeer(); err != nil {
+		log.Errorf("error publishing peer: %s", err)
+	}
+}
+
+// routableSessions returns the sessions new requests should be routed to,
+// skipping unhealthy cafes unless there are no others
+func routableSessions(sessions []*pb.CafeSession) []*pb.CafeSession {
+	var healthy []*pb.CafeSession
+	for _, session := range sessions {
+		if !cafeUnhealthy(session) {
+			healthy = append(healthy, session)
+		}
+	}
+	if len(healthy) == 0 {
+		return sessions
+	}
+	return healthy
+}
+
+// cafeUnhealthy returns whether or not a session's cafe is currently unhealthy
+func cafeUnhealthy(session *pb.CafeSession) bool {
+	return session.Health != nil && session.Health.Status == pb.CafeHealth_UNHEALTHY
+}
+
+// checkHealth pings each cafe session
+func (h *CafeService) checkHealth() {
+	for _, session := range h.datastore.CafeSessions().List().Items {
+		pid, err := peer.IDB58Decode(session.Id)
+		if err != nil {
+			log.Errorf("error parsing cafe id %s: %s", session.Id, err)
+			continue
+		}
+
+		start := time.Now()
+		status, err := h.Ping(pid)
+		if err == nil && status != service.PeerOnline {
+			err = fmt.Errorf("cafe is %s", status)
+		}
+		if err != nil {
+			h.recordFailure(session.Id, err)
+		} else {
+			h.recordSuccess(session.Id, time.Since(start))
+		}
+	}
+}
+
+// recordSuccess marks a cafe healthy
+func (h *CafeService) recordSuccess(cafeId string, latency time.Duration) {
+	session := h.datastore.CafeSessions().Get(cafeId)
+	if session == nil {
+		return
+	}
+	health := session.Health
+	if health == nil {
+		health = &pb.CafeHealth{}
+	}
+	was := health.Status
+
+	health.Status = pb.CafeHealth_HEALTHY
+	health.Failures = 0
+	health.Error = ""
+	health.Checked = ptypes.TimestampNow()
+	if latency > 0 {
+		health.Latency = int64(latency / time.Millisecond)
+	}
+	if err := h.datastore.CafeSessions().UpdateHealth(cafeId, health); err != nil {
+		log.Errorf("error updating cafe health: %s", err)
+		return
+	}
+
+	if was == pb.CafeHealth_UNHEALTHY {
+		log.Infof("cafe %s is healthy again", ipfs.ShortenID(cafeId))
+	}
+}
+
+// recordFailure counts a failure against a cafe, marking it unhealthy and
+// re-routing its pending requests after too many in a row
+func (h *CafeService) recordFailure(cafeId string, err error) {
+	session := h.datastore.CafeSessions().Get(cafeId)
+	if session == nil {
+		return
+	}
+	health := session.Health
+	if health == nil {
+		health = &pb.CafeHealth{}
+	}
+	was := health.Status
+
+	health.Failures++
+	health.Error = err.Error()
+	health.Checked = ptypes.TimestampNow()
+	if health.Failures >= cafeUnhealthyFailures {
+		health.Status = pb.CafeHealth_UNHEALTHY
+	}
+	if err := h.datastore.CafeSessions().UpdateHealth(cafeId, health); err != nil {
+		log.Errorf("error updating cafe health: %s", err)
+		return
+	}
+
+	if health.Status == pb.CafeHealth_UNHEALTHY {
+		if was != pb.CafeHealth_UNHEALTHY {
+			log.Warningf("cafe %s is unhealthy: %s", ipfs.ShortenID(cafeId), health.Error)
+		}
+		// keep moving new requests away while the cafe is down
+		h.reroute(cafeId)
+	}
+}
+
+// reroute moves pending requests from an unhealthy cafe to the
+// highest priority healthy cafe, if there is one
+func (h *CafeService) reroute(cafeId string) {
+	var to *pb.Cafe
+	for _, session := range h.datastore.CafeSessions().List().Items {
+		if session.Id != cafeId && !cafeUnhealthy(session) {
+			to = session.Cafe
+			break
+		}
+	}
+	if to == nil {
+		return
+	}
+
+	n, err := h.datastore.CafeRequests().Reroute(cafeId, to)
+	if err != nil {
+		log.Errorf("error re-routing requests from cafe %s: %s", cafeId, err)
+		return
+	}
+	if n > 0 {
+		log.Infof("re-routed %d requests from cafe %s to %s",
+			n, ipfs.ShortenID(cafeId), ipfs.ShortenID(to.Peer))
+	}
+}
@@ -121,6 +121,7 @@ func (q *CafeInbox) batch(msgs []pb.CafeMessage) error {
 				log.Warningf("handle attempt failed for cafe message %s: %s", msg.Id, err)
 				return
 			}
+			cafeInboxMessages.Inc(resultOk)
 			if err := q.datastore.CafeMessages().Delete(msg.Id); err != nil {
 				log.Errorf("failed to delete cafe message %s: %s", msg.Id, err)
 			} else {
@@ -173,10 +174,12 @@ func (q *CafeInbox) handle(msg pb.CafeMessage) error {
 // handleErr deletes or adds an attempt to a message processing error
 func (q *CafeInbox) handleErr(herr error, msg pb.CafeMessage) error {
 	if msg.Attempts+1 >= maxDownloadAttempts {
+		cafeInboxMessages.Inc(resultDropped)
 		if err := q.datastore.CafeMessages().Delete(msg.Id); err != nil {
 			return err
 		}
 	} else {
+		cafeInboxMessages.Inc(resultError)
 		if err := q.datastore.CafeMessages().AddAttempt(msg.Id); err != nil {
 			return err
 		}
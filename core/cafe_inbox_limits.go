@@ -0,0 +1,122 @@
+package core
+
+import (
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// reasons given to senders when a held message is dropped
+const (
+	dropInboxFull = "inbox full"
+	dropExpired   = "message expired"
+	dropNoInbox   = "inbox not allowed"
+	dropNotFound  = "message not found"
+)
+
+// maybeReapCafeMessages drops held client messages outside of the configured inbox limits
+func (t *Textile) maybeReapCafeMessages() {
+	if !t.config.Cafe.Host.Open {
+		return
+	}
+	t.cafe.reapMessages()
+}
+
+// checkInboxMessage returns the size a message is held under, or the reason a client's inbox
+// can't hold it. Size is only what the sender reports, so when there's a size limit, the
+// envelope is fetched and measured instead.
+func (h *CafeService) checkInboxMessage(client string, mid string, size int64) (int64, string) {
+	host := h.config.Cafe.Host
+	if host.InboxMaxMessages > 0 {
+		if h.datastore.CafeClientMessages().CountByClient(client) >= host.InboxMaxMessages {
+			return 0, dropInboxFull
+		}
+	}
+	if host.InboxMaxSize > 0 {
+		stat, err := ipfs.StatObjectAtPath(h.service.Node(), mid)
+		if err != nil {
+			log.Warningf("error measuring message %s: %s", mid, err)
+			return 0, dropNotFound
+		}
+		size = int64(stat.CumulativeSize)
+		if h.datastore.CafeClientMessages().SizeByClient(client)+size > host.InboxMaxSize {
+			return 0, dropInboxFull
+		}
+	}
+	return size, ""
+}
+
+// reapMessages drops expired messages and the oldest messages of any inbox
+// that is over its count or size limit
+func (h *CafeService) reapMessages() {
+	host := h.config.Cafe.Host
+	if host.InboxMaxAge <= 0 && host.InboxMaxMessages <= 0 && host.InboxMaxSize <= 0 {
+		return
+	}
+
+	var cutoff int64
+	if host.InboxMaxAge > 0 {
+		cutoff = time.Now().Add(-time.Hour * 24 * time.Duration(host.InboxMaxAge)).UnixNano()
+	}
+
+	for _, client := range h.datastore.CafeClients().List() {
+		msgs := h.datastore.CafeClientMessages().ListByClient(client.Id, -1)
+
+		var count int
+		var size int64
+		for _, msg := range msgs {
+			count++
+			size += msg.Size
+		}
+
+		// messages are listed oldest first
+		for _, msg := range msgs {
+			var reason string
+			if cutoff > 0 && util.ProtoNanos(msg.Date) < cutoff {
+				reason = dropExpired
+			} else if host.InboxMaxMessages > 0 && count > host.InboxMaxMessages {
+				reason = dropInboxFull
+			} else if host.InboxMaxSize > 0 && size > host.InboxMaxSize {
+				reason = dropInboxFull
+			} else {
+				break
+			}
+
+			if err := h.datastore.CafeClientMessages().Delete(msg.Id, msg.Client); err != nil {
+				log.Errorf("error dropping message %s: %s", msg.Id, err)
+				continue
+			}
+			count--
+			size -= msg.Size
+			h.replicate(pb.CafeReplication_UNMESSAGE, msg.Client, msg.Id)
+
+			go h.notifyDropped(msg.Peer, msg.Id, msg.Client, reason)
+		}
+	}
+}
+
+// notifyDropped tells the sender of a message that it will not be delivered by this inbox
+func (h *CafeService) notifyDropped(sender string, mid string, client string, reason string) {
+	log.Debugf("dropped message %s from %s for %s: %s", mid, sender, client, reason)
+
+	pid, err := peer.IDB58Decode(sender)
+	if err != nil {
+		log.Errorf("error parsing sender id %s: %s", sender, err)
+		return
+	}
+	env, err := h.service.NewEnvelope(pb.Message_CAFE_MESSAGE_DROPPED, &pb.CafeMessageDropped{
+		Id:     mid,
+		Client: client,
+		Reason: reason,
+	}, nil, false)
+	if err != nil {
+		log.Errorf("error creating dropped message notice: %s", err)
+		return
+	}
+	if err := h.service.SendMessage(nil, pid, env); err != nil {
+		log.Debugf("unable to notify sender %s of dropped message: %s", sender, err)
+	}
+}
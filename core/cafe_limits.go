@@ -0,0 +1,205 @@
+package core
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/ratelimit"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/util"
+)
+
+// errRateLimited is returned to peers that have exceeded a rate limit
+const errRateLimited = "rate limit exceeded"
+
+// rate limit kinds
+const (
+	limitByPeer = "peer"
+	limitByIP   = "ip"
+)
+
+// rejection reasons
+const (
+	rejectBanned      = "banned"
+	rejectRateLimited = "rate_limited"
+)
+
+// cafePubSubTypes are message types received over pubsub, which are dropped
+// instead of answered when rejected
+var cafePubSubTypes = map[pb.Message_Type]bool{
+	pb.Message_CAFE_PUBSUB_QUERY:     true,
+	pb.Message_CAFE_PUBSUB_QUERY_RES: true,
+	pb.Message_CAFE_YOU_HAVE_MAIL:    true,
+}
+
+// cafeLimits applies the configured rate limits and ban lists to cafe requests
+type cafeLimits struct {
+	config   *config.Config
+	limiters map[string]*ratelimit.Limiter
+	mux      sync.Mutex
+}
+
+// newCafeLimits returns limits backed by the given config
+func newCafeLimits(conf *config.Config) *cafeLimits {
+	return &cafeLimits{
+		config:   conf,
+		limiters: make(map[string]*ratelimit.Limiter),
+	}
+}
+
+// allow takes a request from id's bucket for key, if limited, returning false and
+// the time until the next request is allowed when the bucket is empty
+func (l *cafeLimits) allow(by string, key string, id string) (bool, time.Duration) {
+	var limits map[string]config.CafeRateLimit
+	switch by {
+	case limitByPeer:
+		limits = l.config.Cafe.Host.PeerRateLimits
+	case limitByIP:
+		limits = l.config.Cafe.Host.IPRateLimits
+	}
+	conf, ok := limits[key]
+	if !ok {
+		return true, 0
+	}
+	return l.limiter(by+" "+key, conf).Allow(id)
+}
+
+// limiter returns the named limiter, replacing it if its config has changed
+func (l *cafeLimits) limiter(name string, conf config.CafeRateLimit) *ratelimit.Limiter {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	lim, ok := l.limiters[name]
+	if !ok || lim.Rate() != conf.Rate || lim.Burst() != conf.Burst {
+		lim = ratelimit.New(conf.Rate, conf.Burst)
+		l.limiters[name] = lim
+	}
+	return lim
+}
+
+// peerBanned returns whether or not a peer is banned
+func (l *cafeLimits) peerBanned(id string) bool {
+	return util.ListContainsString(l.config.Cafe.Host.BannedPeers, id)
+}
+
+// ipBanned returns whether or not an address matches a banned IP or CIDR range
+func (l *cafeLimits) ipBanned(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, banned := range l.config.Cafe.Host.BannedIPs {
+		if strings.Contains(banned, "/") {
+			_, cidr, err := net.ParseCIDR(banned)
+			if err != nil {
+				log.Warningf("invalid banned ip range %s: %s", banned, err)
+				continue
+			}
+			if cidr.Contains(ip) {
+				return true
+			}
+		} else if bip := net.ParseIP(banned); bip != nil && bip.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// rejectPeer checks the ban list and rate limits for a message from a peer,
+// returning true and an optional response if the message should not be handled
+func (h *CafeService) rejectPeer(pid peer.ID, env *pb.Envelope) (bool, *pb.Envelope) {
+	mtype := env.Message.Type.String()
+	if h.limits.peerBanned(pid.Pretty()) {
+		log.Warningf("rejected %s from banned peer %s", mtype, pid.Pretty())
+		cafeRejections.Inc(mtype, rejectBanned)
+		return true, nil
+	}
+
+	if ok, _ := h.limits.allow(limitByPeer, mtype, pid.Pretty()); ok {
+		return false, nil
+	}
+	log.Warningf("rejected %s from rate limited peer %s", mtype, pid.Pretty())
+	cafeRejections.Inc(mtype, rejectRateLimited)
+
+	if cafePubSubTypes[env.Message.Type] {
+		return true, nil
+	}
+	rerr, err := h.service.NewError(http.StatusTooManyRequests, errRateLimited, env.Message.RequestId)
+	if err != nil {
+		log.Errorf("error creating rate limit response: %s", err)
+		return true, nil
+	}
+	return true, rerr
+}
+
+// limit aborts requests from banned or rate limited IPs and peers
+func (c *cafeApi) limit(g *gin.Context) {
+	limits := c.node.cafe.limits
+	ip := g.ClientIP()
+	route := c.routes[g.Request.Method+" "+g.HandlerName()]
+	pid := g.Request.Header.Get("X-Textile-Peer")
+
+	if limits.ipBanned(ip) || (pid != "" && limits.peerBanned(pid)) {
+		log.Warningf("rejected %s from banned address %s (peer %s)", g.Request.URL.Path, ip, pid)
+		cafeRejections.Inc(route, rejectBanned)
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+	if route == "" {
+		return
+	}
+
+	ok, wait := limits.allow(limitByIP, route, ip)
+	if ok && pid != "" {
+		ok, wait = limits.allow(limitByPeer, route, pid)
+	}
+	if !ok {
+		log.Warningf("rejected %s from rate limited address %s (peer %s)", route, ip, pid)
+		cafeRejections.Inc(route, rejectRateLimited)
+		setRetryAfter(g, wait)
+		c.abort(g, http.StatusTooManyRequests, nil)
+	}
+}
+
+// limitMessage applies IP rate limits to a service message received over HTTP,
+// returning false if it was rejected
+func (c *cafeApi) limitMessage(g *gin.Context, mtype pb.Message_Type) bool {
+	ip := g.ClientIP()
+	ok, wait := c.node.cafe.limits.allow(limitByIP, mtype.String(), ip)
+	if ok {
+		return true
+	}
+	log.Warningf("rejected %s from rate limited address %s", mtype.String(), ip)
+	cafeRejections.Inc(mtype.String(), rejectRateLimited)
+	setRetryAfter(g, wait)
+	g.String(http.StatusTooManyRequests, errRateLimited)
+	return false
+}
+
+// indexRoutes maps each route's handler to its path so that limits can be keyed by route
+func (c *cafeApi) indexRoutes(router *gin.Engine) {
+	c.routes = make(map[string]string)
+	for _, r := range router.Routes() {
+		key := r.Method + " " + r.Handler
+		if _, ok := c.routes[key]; !ok {
+			c.routes[key] = r.Method + " " + r.Path
+		}
+	}
+}
+
+// setRetryAfter sets the Retry-After header in whole seconds
+func setRetryAfter(g *gin.Context, wait time.Duration) {
+	secs := math.Ceil(wait.Seconds())
+	if secs < 1 || secs > math.MaxInt32 {
+		return
+	}
+	g.Header("Retry-After", strconv.Itoa(int(secs)))
+}
@@ -64,6 +64,7 @@ func CafeRequestOptions(opts ...CafeRequestOption) *CafeRequestSettings {
 
 // CafeOutbox queues and processes outbound cafe requests
 type CafeOutbox struct {
+	service   func() *CafeService
 	node      func() *core.IpfsNode
 	datastore repo.Datastore
 	handler   CafeOutboxHandler
@@ -71,15 +72,22 @@ type CafeOutbox struct {
 }
 
 // NewCafeOutbox creates a new outbox queue
-func NewCafeOutbox(node func() *core.IpfsNode, datastore repo.Datastore, handler CafeOutboxHandler) *CafeOutbox {
+func NewCafeOutbox(
+	service func() *CafeService,
+	node func() *core.IpfsNode,
+	datastore repo.Datastore,
+	handler CafeOutboxHandler,
+) *CafeOutbox {
 	return &CafeOutbox{
+		service:   service,
 		node:      node,
 		datastore: datastore,
 		handler:   handler,
 	}
 }
 
-// Add adds a request for each active cafe session
+// Add adds a request for each active cafe session, skipping unhealthy cafes
+// unless there are no others
 func (q *CafeOutbox) Add(target string, rtype pb.CafeRequest_Type, opts ...CafeRequestOption) error {
 	pid := q.node().Identity
 	settings := CafeRequestOptions(opts...)
@@ -97,8 +105,8 @@ func (q *CafeOutbox) Add(target string, rtype pb.CafeRequest_Type, opts ...CafeR
 		}
 	}
 
-	// add a request for each session
-	sessions := q.datastore.CafeSessions().List().Items
+	// add a request for each healthy session
+	sessions := routableSessions(q.datastore.CafeSessions().List().Items)
 	for _, session := range sessions {
 		// all possible request types are for our own peer
 		if err := q.add(pid, target, session.Cafe, rtype, settings); err != nil {
@@ -114,13 +122,14 @@ func (q *CafeOutbox) AddForInbox(pid peer.ID, env *pb.Envelope, inboxes []*pb.Ca
 		return nil
 	}
 
-	hash, err := q.prepForInbox(pid, env)
+	hash, size, err := q.prepForInbox(pid, env)
 	if err != nil {
 		return err
 	}
 
 	target := hash.B58String()
 	settings := &CafeRequestSettings{
+		Size:  size,
 		Group: target,
 	}
 	for _, inbox := range inboxes {
@@ -131,6 +140,18 @@ func (q *CafeOutbox) AddForInbox(pid peer.ID, env *pb.Envelope, inboxes []*pb.Ca
 	return nil
 }
 
+// Dropped handles a message that was dropped by one of a peer's inboxes
+// by falling back to direct delivery
+func (q *CafeOutbox) Dropped(pid peer.ID, mid string) error {
+	if q.service() == nil || !q.service().online {
+		return fmt.Errorf("cafe service is not online")
+	}
+
+	log.Debugf("delivering dropped message %s direct to %s", mid, ipfs.ShortenID(pid.Pretty()))
+
+	return q.service().deliverMessageDirect(mid, pid)
+}
+
 // Flush processes pending requests
 func (q *CafeOutbox) Flush() {
 	q.mux.Lock()
@@ -161,31 +182,31 @@ func (q *CafeOutbox) add(pid peer.ID, target string, cafe *pb.Cafe, rtype pb.Caf
 }
 
 // prepForInbox encrypts and pins a message intended for a peer inbox
-func (q *CafeOutbox) prepForInbox(pid peer.ID, env *pb.Envelope) (mh.Multihash, error) {
+func (q *CafeOutbox) prepForInbox(pid peer.ID, env *pb.Envelope) (mh.Multihash, int, error) {
 	// encrypt envelope w/ recipient's pk
 	envb, err := proto.Marshal(env)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	pk, err := pid.ExtractPublicKey()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	ciphertext, err := crypto.Encrypt(pk, envb)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	id, err := ipfs.AddData(q.node(), bytes.NewReader(ciphertext), true)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	hash := id.Hash().B58String()
 
 	if err := q.Add(hash, pb.CafeRequest_STORE, cafeReqOpt.Group(hash)); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return id.Hash(), nil
+	return id.Hash(), len(ciphertext), nil
 }
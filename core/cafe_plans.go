@@ -0,0 +1,110 @@
+package core
+
+import (
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/util"
+)
+
+// cafe token scopes, tokens without scopes are allowed all of them
+const (
+	cafeScopeStore   = "store"
+	cafeScopeInbox   = "inbox"
+	cafeScopeThreads = "threads"
+	cafeScopeSearch  = "search"
+)
+
+// cafeScopes lists all valid token scopes
+var cafeScopes = []string{cafeScopeStore, cafeScopeInbox, cafeScopeThreads, cafeScopeSearch}
+
+// CafeTokensUsage returns info and combined client usage for each stored token
+func (t *Textile) CafeTokensUsage() *pb.CafeTokenUsageList {
+	list := &pb.CafeTokenUsageList{Items: make([]*pb.CafeTokenUsage, 0)}
+	usage := make(map[string]*pb.CafeTokenUsage)
+	for _, token := range t.datastore.CafeTokens().List() {
+		tk := token
+		tk.Value = nil
+		u := &pb.CafeTokenUsage{Token: &tk}
+		usage[tk.Id] = u
+		list.Items = append(list.Items, u)
+	}
+
+	for _, client := range t.datastore.CafeClients().List() {
+		u, ok := usage[client.Token]
+		if !ok {
+			continue
+		}
+		c := client
+		cu := t.cafeClientUsage(&c)
+		u.ClientCount++
+		u.ThreadCount += cu.ThreadCount
+		u.InboxCount += cu.InboxCount
+		u.PinCount += cu.PinCount
+		u.PinSize += cu.PinSize
+	}
+	return list
+}
+
+// tokenExpired returns whether or not a token is past its expiry
+func tokenExpired(token *pb.CafeToken) bool {
+	return token.Expires != nil && util.ProtoNanos(token.Expires) < time.Now().UnixNano()
+}
+
+// tokenAllows returns whether or not a token grants a scope
+func tokenAllows(token *pb.CafeToken, scope string) bool {
+	return len(token.Scopes) == 0 || util.ListContainsString(token.Scopes, scope)
+}
+
+// authScope checks that the token a client registered with is unexpired and grants scope.
+// Peers that are not clients, and clients whose token has since been removed, are not limited.
+func (h *CafeService) authScope(pid peer.ID, scope string, requestId int32) (*pb.Envelope, error) {
+	client := h.datastore.CafeClients().Get(pid.Pretty())
+	if client == nil {
+		return nil, nil
+	}
+	if code, msg := h.scopeError(client, scope); code != 0 {
+		return h.service.NewError(code, msg, requestId)
+	}
+	return nil, nil
+}
+
+// scopeError returns the status and message a client's request for scope should be refused with,
+// or zero if it's allowed
+func (h *CafeService) scopeError(client *pb.CafeClient, scope string) (int, string) {
+	if client.Token == "" {
+		return 0, ""
+	}
+	token := h.datastore.CafeTokens().Get(client.Token)
+	if token == nil {
+		return 0, ""
+	}
+	if tokenExpired(token) {
+		return 401, errTokenExpired
+	}
+	if !tokenAllows(token, scope) {
+		return 403, errScopeDenied
+	}
+	return 0, ""
+}
+
+// clientPlan returns the quotas that apply to a client, taken from its token's plan if it has one
+func (h *CafeService) clientPlan(client *pb.CafeClient) config.CafePlan {
+	host := h.config.Cafe.Host
+	if client.Token != "" {
+		token := h.datastore.CafeTokens().Get(client.Token)
+		if token != nil && token.Plan != "" {
+			if plan, ok := host.Plans[token.Plan]; ok {
+				return plan
+			}
+			log.Warningf("token %s has unknown plan %s", token.Id, token.Plan)
+		}
+	}
+	return config.CafePlan{
+		ClientStorageQuota: host.ClientStorageQuota,
+		ClientThreadQuota:  host.ClientThreadQuota,
+		TokenStorageQuota:  host.TokenStorageQuota,
+	}
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	cid "github.com/ipfs/go-cid"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+)
+
+// canStore returns whether or not a client may store size more bytes
+func (h *CafeService) canStore(client *pb.CafeClient, size int64) bool {
+	plan := h.clientPlan(client)
+	if plan.ClientStorageQuota > 0 {
+		if h.datastore.CafeClientPins().SizeByClient(client.Id)+size > plan.ClientStorageQuota {
+			return false
+		}
+	}
+	if plan.TokenStorageQuota > 0 && client.Token != "" {
+		if h.datastore.CafeClientPins().SizeByToken(client.Token)+size > plan.TokenStorageQuota {
+			return false
+		}
+	}
+	return true
+}
+
+// canStoreThread returns whether or not a client may store a thread snapshot,
+// updates to an existing snapshot are always allowed
+func (h *CafeService) canStoreThread(client *pb.CafeClient, id string) bool {
+	quota := h.clientPlan(client).ClientThreadQuota
+	if quota <= 0 {
+		return true
+	}
+	if h.datastore.CafeClientThreads().Get(id, client.Id) != nil {
+		return true
+	}
+	return h.datastore.CafeClientThreads().CountByClient(client.Id) < quota
+}
+
+// addClientPin accounts for an object pinned on behalf of a client
+func (h *CafeService) addClientPin(client *pb.CafeClient, id string, size int64) error {
+	return h.datastore.CafeClientPins().AddOrUpdate(&pb.CafeClientPin{
+		Id:     id,
+		Client: client.Id,
+		Size:   size,
+		Date:   ptypes.TimestampNow(),
+	})
+}
+
+// releasePin unpins an object once no client has a pin record for it,
+// since the same content may have been stored on behalf of several clients
+func (h *CafeService) releasePin(id cid.Cid) error {
+	if h.datastore.CafeClientPins().CountById(id.Hash().B58String()) > 0 {
+		return nil
+	}
+	return ipfs.UnpinCid(h.service.Node(), id, true)
+}
+
+// failRequests marks requests rejected by a cafe as failed so they are no longer retried
+func (h *CafeService) failRequests(reqs []*pb.CafeRequest, handled []string, reason string) {
+loop:
+	for _, req := range reqs {
+		for _, id := range handled {
+			if id == req.Id {
+				continue loop
+			}
+		}
+		log.Errorf("cafe %s request for %s was rejected: %s", req.Type.String(), req.Target, reason)
+		if err := h.datastore.CafeRequests().UpdateStatus(req.Id, pb.CafeRequest_FAILED); err != nil {
+			log.Error(err.Error())
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	cid "github.com/ipfs/go-cid"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+)
+
+// CafeReplicationStatus returns the state of replication to this cafe's neighbors
+func (t *Textile) CafeReplicationStatus() *pb.CafeReplicationStatus {
+	return t.replicationOutbox.Status()
+}
+
+// replicate queues a client record change for the neighbor cafes
+func (h *CafeService) replicate(rtype pb.CafeReplication_Type, client string, target string) {
+	if h.replication == nil || !h.replication.Enabled() {
+		return
+	}
+	if err := h.replication.Add(rtype, client, target); err != nil {
+		log.Errorf("error adding %s replication for %s: %s", rtype.String(), target, err)
+		return
+	}
+	go h.replication.Flush()
+}
+
+// handleReplicate receives a client record change from a neighbor cafe
+func (h *CafeService) handleReplicate(pid peer.ID, env *pb.Envelope) (*pb.Envelope, error) {
+	rep := new(pb.CafeReplicate)
+	if err := ptypes.UnmarshalAny(env.Message.Payload, rep); err != nil {
+		return nil, err
+	}
+
+	// only accept records from our own neighbors
+	if !h.config.Cafe.Host.Open || h.replication == nil {
+		return h.service.NewError(403, errForbidden, env.Message.RequestId)
+	}
+	if !h.replication.IsNeighbor(pid.Pretty()) {
+		log.Warningf("received replication from unknown cafe %s", pid.Pretty())
+		return h.service.NewError(403, errForbidden, env.Message.RequestId)
+	}
+
+	switch rep.Type {
+	case pb.CafeReplication_PIN:
+		if rep.Pin == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.RequestId)
+		}
+		node, err := ipfs.NodeAtPath(h.service.Node(), rep.Target)
+		if err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.RequestId)
+		}
+		if err := ipfs.PinNode(h.service.Node(), node, true); err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.RequestId)
+		}
+		rep.Pin.Client = rep.Client
+		if err := h.datastore.CafeClientPins().AddOrUpdate(rep.Pin); err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.RequestId)
+		}
+
+	case pb.CafeReplication_UNPIN:
+		id, err := cid.Decode(rep.Target)
+		if err != nil {
+			return h.service.NewError(400, errBadRequest, env.Message.RequestId)
+		}
+		if err := h.datastore.CafeClientPins().Delete(rep.Target, rep.Client); err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.RequestId)
+		}
+		if err := h.releasePin(id); err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.RequestId)
+		}
+
+	case pb.CafeReplication_THREAD:
+		if rep.Thread == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.RequestId)
+		}
+		rep.Thread.Client = rep.Client
+		if err := h.storeClientThread(rep.Thread); err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.RequestId)
+		}
+
+	case pb.CafeReplication_UNTHREAD:
+		if err := h.datastore.CafeClientThreads().Delete(rep.Target, rep.Client); err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.RequestId)
+		}
+
+	case pb.CafeReplication_MESSAGE:
+		if rep.Message == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.RequestId)
+		}
+		rep.Message.Client = rep.Client
+		if err := h.datastore.CafeClientMessages().AddOrUpdate(rep.Message); err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.RequestId)
+		}
+
+	case pb.CafeReplication_UNMESSAGE:
+		if err := h.datastore.CafeClientMessages().Delete(rep.Target, rep.Client); err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.RequestId)
+		}
+	}
+
+	log.Debugf("replicated %s %s for %s from %s",
+		rep.Type.String(), rep.Target, ipfs.ShortenID(rep.Client), ipfs.ShortenID(pid.Pretty()))
+
+	res := &pb.CafeReplicateAck{Id: rep.Target}
+	return h.service.NewEnvelope(pb.Message_CAFE_REPLICATE_ACK, res, &env.Message.RequestId, true)
+}
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// maxCafeRequestAttempts is the number of times a request can fail before being marked as failed
+const maxCafeRequestAttempts = 10
+
+// cafeRequestBackoff is the delay before a request's first retry, doubled after each failed attempt
+const cafeRequestBackoff = time.Second * 30
+
+// maxCafeRequestBackoff caps the delay between retries
+const maxCafeRequestBackoff = time.Hour * 6
+
+// FailedCafeRequests returns a batch of requests that have been given up on
+func (t *Textile) FailedCafeRequests(offset string, limit int) *pb.CafeRequestList {
+	return t.datastore.CafeRequests().ListFailed(offset, limit)
+}
+
+// AddCafeRequestAttempt records a failed attempt to handle a request,
+// scheduling a retry or marking it as failed after too many attempts
+func (t *Textile) AddCafeRequestAttempt(id string) error {
+	req := t.datastore.CafeRequests().Get(id)
+	if req == nil {
+		return fmt.Errorf("request not found")
+	}
+	return t.cafe.failAttempt(req, fmt.Errorf("attempt failed"))
+}
+
+// RetryCafeRequests queues a failed request, or all failed requests if id is empty,
+// to be handled again with a fresh attempt count
+func (t *Textile) RetryCafeRequests(id string) (int, error) {
+	n, err := t.datastore.CafeRequests().Retry(id)
+	if err != nil {
+		return 0, err
+	}
+	if n > 0 {
+		go t.cafeOutbox.Flush()
+	}
+	return n, nil
+}
+
+// PurgeCafeRequests deletes a failed request, or all failed requests if id is empty
+func (t *Textile) PurgeCafeRequests(id string) (int, error) {
+	n, err := t.datastore.CafeRequests().DeleteFailed(id)
+	if err != nil {
+		return 0, err
+	}
+
+	// groups held open by a failed request may now be complete
+	for _, gid := range t.datastore.CafeRequests().ListCompletedGroups() {
+		if err := t.datastore.CafeRequests().DeleteByGroup(gid); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// failAttempt backs off a request after a failed attempt or marks it as failed
+// once it has reached the max number of attempts
+func (h *CafeService) failAttempt(req *pb.CafeRequest, err error) error {
+	attempts := req.Attempts + 1
+	next := time.Now().Add(cafeRequestDelay(attempts))
+	if err := h.datastore.CafeRequests().AddAttempt(req.Id, next); err != nil {
+		return err
+	}
+
+	if attempts >= maxCafeRequestAttempts {
+		log.Warningf("giving up on cafe %s request for %s after %d attempts: %s",
+			req.Type.String(), req.Target, attempts, err)
+		return h.datastore.CafeRequests().UpdateStatus(req.Id, pb.CafeRequest_FAILED)
+	}
+	return nil
+}
+
+// retryLater adds a failed attempt to each request that was not handled
+func (h *CafeService) retryLater(reqs []*pb.CafeRequest, handled []string, err error) {
+loop:
+	for _, req := range reqs {
+		for _, id := range handled {
+			if id == req.Id {
+				continue loop
+			}
+		}
+		if err := h.failAttempt(req, err); err != nil {
+			log.Errorf("error adding attempt to cafe request %s: %s", req.Id, err)
+		}
+	}
+}
+
+// cafeRequestDelay returns the backoff delay after the given number of failed attempts
+func cafeRequestDelay(attempts int32) time.Duration {
+	delay := cafeRequestBackoff
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= maxCafeRequestBackoff {
+			return maxCafeRequestBackoff
+		}
+	}
+	return delay
+}
@@ -59,8 +59,14 @@ const (
 	errUnauthorized   = "unauthorized"
 	errForbidden      = "forbidden"
 	errBadRequest     = "bad request"
+	errQuotaExceeded  = "quota exceeded"
+	errTokenExpired   = "token expired"
+	errScopeDenied    = "token scope denied"
 )
 
+// ErrQuotaExceeded indicates a cafe rejected a request because a client's quota is used up
+var ErrQuotaExceeded = fmt.Errorf(errQuotaExceeded)
+
 // cafeServiceProtocol is the current protocol tag
 const cafeServiceProtocol = protocol.ID("/textile/cafe/1.0.0")
 
@@ -68,12 +74,17 @@ const cafeServiceProtocol = protocol.ID("/textile/cafe/1.0.0")
 type CafeService struct {
 	service         *service.Service
 	datastore       repo.Datastore
+	config          *config.Config
 	inbox           *CafeInbox
+	outbox          *CafeOutbox
+	replication     *ReplicationOutbox
 	info            *pb.Cafe
 	online          bool
 	open            bool
 	queryResults    *broadcast.Broadcaster
 	inFlightQueries map[string]struct{}
+	federation      *cafeFederation
+	limits          *cafeLimits
 }
 
 // NewCafeService returns a new threads service
@@ -81,13 +92,21 @@ func NewCafeService(
 	account *keypair.Full,
 	node func() *core.IpfsNode,
 	datastore repo.Datastore,
+	conf *config.Config,
 	inbox *CafeInbox,
+	outbox *CafeOutbox,
+	replication *ReplicationOutbox,
 ) *CafeService {
 	handler := &CafeService{
 		datastore:       datastore,
+		config:          conf,
 		inbox:           inbox,
+		outbox:          outbox,
+		replication:     replication,
 		queryResults:    broadcast.NewBroadcaster(10),
 		inFlightQueries: make(map[string]struct{}),
+		federation:      newCafeFederation(conf),
+		limits:          newCafeLimits(conf),
 	}
 	handler.service = service.NewService(account, handler, node)
 	return handler
@@ -110,6 +129,18 @@ func (h *CafeService) Ping(pid peer.ID) (service.PeerStatus, error) {
 
 // Handle is called by the underlying service handler method
 func (h *CafeService) Handle(pid peer.ID, env *pb.Envelope) (*pb.Envelope, error) {
+	if rejected, rerr := h.rejectPeer(pid, env); rejected {
+		return rerr, nil
+	}
+
+	start := time.Now()
+	renv, err := h.handle(pid, env)
+	observeCafeMessage(env.Message.Type, start, renv, err)
+	return renv, err
+}
+
+// handle routes a message to its handler
+func (h *CafeService) handle(pid peer.ID, env *pb.Envelope) (*pb.Envelope, error) {
 	switch env.Message.Type {
 	case pb.Message_CAFE_CHALLENGE:
 		return h.handleChallenge(pid, env)
@@ -131,6 +162,8 @@ func (h *CafeService) Handle(pid peer.ID, env *pb.Envelope) (*pb.Envelope, error
 		return h.handleUnstoreThread(pid, env)
 	case pb.Message_CAFE_DELIVER_MESSAGE:
 		return h.handleDeliverMessage(pid, env)
+	case pb.Message_CAFE_MESSAGE_DROPPED:
+		return h.handleMessageDropped(pid, env)
 	case pb.Message_CAFE_CHECK_MESSAGES:
 		return h.handleCheckMessages(pid, env)
 	case pb.Message_CAFE_DELETE_MESSAGES:
@@ -143,6 +176,8 @@ func (h *CafeService) Handle(pid peer.ID, env *pb.Envelope) (*pb.Envelope, error
 		return h.handlePubSubQuery(pid, env)
 	case pb.Message_CAFE_PUBSUB_QUERY_RES:
 		return h.handlePubSubQueryResults(pid, env)
+	case pb.Message_CAFE_REPLICATE:
+		return h.handleReplicate(pid, env)
 	default:
 		return nil, nil
 	}
@@ -157,10 +192,19 @@ func (h *CafeService) HandleStream(pid peer.ID, env *pb.Envelope) (chan *pb.Enve
 	go func() {
 		defer close(renvCh)
 
+		if rejected, rerr := h.rejectPeer(pid, env); rejected {
+			if rerr != nil {
+				renvCh <- rerr
+			}
+			return
+		}
+
 		var err error
 		switch env.Message.Type {
 		case pb.Message_CAFE_QUERY:
 			err = h.handleQuery(pid, env, renvCh, cancelCh)
+		case pb.Message_CAFE_FEDERATED_QUERY:
+			err = h.handleFederatedQuery(pid, env, renvCh, cancelCh)
 		}
 		if err != nil {
 			errCh <- err
@@ -593,18 +637,21 @@ func (h *CafeService) searchLocal(qtype pb.Query_Type, options *pb.QueryOptions,
 
 		clients := h.datastore.CafeClients().ListByAddress(q.Address)
 		for _, client := range clients {
-			snapshots := h.datastore.CafeClientThreads().ListByClient(client.Id)
+			snapshots := h.clientThreadSnapshots(client.Id, q)
 			for _, s := range snapshots {
 				value, err := proto.Marshal(&pb.CafeClientThread{
 					Id:         s.Id,
 					Client:     s.Client,
 					Ciphertext: s.Ciphertext,
+					Version:    s.Version,
+					Date:       s.Date,
 				})
 				if err != nil {
 					return nil, err
 				}
 				results.Add(&pb.QueryResult{
 					Id:    s.Id,
+					Date:  s.Date,
 					Local: local,
 					Value: &any.Any{
 						TypeUrl: "/CafeClientThread",
@@ -614,10 +661,14 @@ func (h *CafeService) searchLocal(qtype pb.Query_Type, options *pb.QueryOptions,
 			}
 		}
 
-		// return own threads (encrypted) if query is from an account peer
-		if q.Address == h.service.Account.Address() {
+		// return own threads (encrypted) if query is from an account peer,
+		// these have no history so are skipped when searching for an earlier version
+		if q.Address == h.service.Account.Address() && q.Version == 0 && q.AsOf == nil {
 			self := h.service.Node().Identity.Pretty()
 			for _, t := range h.datastore.Threads().List().Items {
+				if q.Thread != "" && t.Id != q.Thread {
+					continue
+				}
 				plaintext, err := proto.Marshal(t)
 				if err != nil {
 					return nil, err
@@ -814,6 +865,9 @@ func (h *CafeService) handleRegistration(pid peer.ID, env *pb.Envelope) (*pb.Env
 	if err != nil {
 		return h.service.NewError(403, errForbidden, env.Message.RequestId)
 	}
+	if tokenExpired(encodedToken) {
+		return h.service.NewError(403, errTokenExpired, env.Message.RequestId)
+	}
 
 	// check nonce
 	snonce := h.datastore.CafeClientNonces().Get(reg.Value)
@@ -880,14 +934,8 @@ func (h *CafeService) handleDeregistration(pid peer.ID, env *pb.Envelope) (*pb.E
 	}
 
 	// cleanup
-	if err := h.datastore.CafeClientThreads().DeleteByClient(pid.Pretty()); err != nil {
-		return h.service.NewError(500, "delete client threads failed", env.Message.RequestId)
-	}
-	if err := h.datastore.CafeClientMessages().DeleteByClient(pid.Pretty(), -1); err != nil {
-		return h.service.NewError(500, "delete client messages failed", env.Message.RequestId)
-	}
-	if err := h.datastore.CafeClients().Delete(pid.Pretty()); err != nil {
-		return h.service.NewError(500, "delete client failed", env.Message.RequestId)
+	if err := h.deleteClient(pid.Pretty()); err != nil {
+		return h.service.NewError(500, err.Error(), env.Message.RequestId)
 	}
 
 	res := &pb.CafeDeregistrationAck{
@@ -974,6 +1022,14 @@ func (h *CafeService) handleStore(pid peer.ID, env *pb.Envelope) (*pb.Envelope,
 		return rerr, nil
 	}
 
+	rerr, err = h.authScope(pid, cafeScopeStore, env.Message.RequestId)
+	if err != nil {
+		return nil, err
+	}
+	if rerr != nil {
+		return rerr, nil
+	}
+
 	// ignore cids for data already pinned
 	var decoded []cid.Cid
 	for _, id := range store.Cids {
@@ -996,6 +1052,13 @@ func (h *CafeService) handleStore(pid peer.ID, env *pb.Envelope) (*pb.Envelope,
 		}
 	}
 
+	if len(need) > 0 {
+		client := h.datastore.CafeClients().Get(pid.Pretty())
+		if client != nil && !h.canStore(client, 1) {
+			return h.service.NewError(413, errQuotaExceeded, env.Message.RequestId)
+		}
+	}
+
 	res := &pb.CafeObjectList{Cids: need}
 	return h.service.NewEnvelope(pb.Message_CAFE_OBJECT_LIST, res, &env.Message.RequestId, true)
 }
@@ -1015,6 +1078,14 @@ func (h *CafeService) handleUnstore(pid peer.ID, env *pb.Envelope) (*pb.Envelope
 		return rerr, nil
 	}
 
+	rerr, err = h.authScope(pid, cafeScopeStore, env.Message.RequestId)
+	if err != nil {
+		return nil, err
+	}
+	if rerr != nil {
+		return rerr, nil
+	}
+
 	// ignore cids for data not pinned
 	var decoded []cid.Cid
 	for _, id := range unstore.Cids {
@@ -1033,13 +1104,20 @@ func (h *CafeService) handleUnstore(pid peer.ID, env *pb.Envelope) (*pb.Envelope
 	var unstored []string
 	for _, p := range pinned {
 		if p.Mode != pin.NotPinned {
-			if err := ipfs.UnpinCid(h.service.Node(), p.Key, true); err != nil {
+			hash := p.Key.Hash().B58String()
+			if err := h.datastore.CafeClientPins().Delete(hash, pid.Pretty()); err != nil {
 				return nil, err
 			}
-			unstored = append(unstored, p.Key.Hash().B58String())
+			if err := h.releasePin(p.Key); err != nil {
+				return nil, err
+			}
+			h.replicate(pb.CafeReplication_UNPIN, pid.Pretty(), hash)
+			unstored = append(unstored, hash)
 		}
 	}
 
+	observeCafeUnstore("p2p", len(unstored))
+
 	res := &pb.CafeUnstoreAck{Cids: unstored}
 	return h.service.NewEnvelope(pb.Message_CAFE_UNSTORE_ACK, res, &env.Message.RequestId, true)
 }
@@ -1059,6 +1137,20 @@ func (h *CafeService) handleObject(pid peer.ID, env *pb.Envelope) (*pb.Envelope,
 		return rerr, nil
 	}
 
+	rerr, err = h.authScope(pid, cafeScopeStore, env.Message.RequestId)
+	if err != nil {
+		return nil, err
+	}
+	if rerr != nil {
+		return rerr, nil
+	}
+
+	client := h.datastore.CafeClients().Get(pid.Pretty())
+	size := int64(len(obj.Data) + len(obj.Node))
+	if client != nil && !h.canStore(client, size) {
+		return h.service.NewError(413, errQuotaExceeded, env.Message.RequestId)
+	}
+
 	var aid *cid.Cid
 	if obj.Data != nil {
 		aid, err = ipfs.AddData(h.service.Node(), bytes.NewReader(obj.Data), true)
@@ -1077,6 +1169,14 @@ func (h *CafeService) handleObject(pid peer.ID, env *pb.Envelope) (*pb.Envelope,
 	if rhash != obj.Cid {
 		log.Warningf("cids do not match (received %s, resolved %s)", obj.Cid, rhash)
 	}
+	observeCafeStore("p2p", size)
+
+	if client != nil {
+		if err := h.addClientPin(client, rhash, size); err != nil {
+			return nil, err
+		}
+		h.replicate(pb.CafeReplication_PIN, client.Id, rhash)
+	}
 
 	res := &pb.CafeStoreAck{Id: obj.Cid}
 	return h.service.NewEnvelope(pb.Message_CAFE_STORE_ACK, res, &env.Message.RequestId, true)
@@ -1097,19 +1197,32 @@ func (h *CafeService) handleStoreThread(pid peer.ID, env *pb.Envelope) (*pb.Enve
 		return rerr, nil
 	}
 
+	rerr, err = h.authScope(pid, cafeScopeThreads, env.Message.RequestId)
+	if err != nil {
+		return nil, err
+	}
+	if rerr != nil {
+		return rerr, nil
+	}
+
 	client := h.datastore.CafeClients().Get(pid.Pretty())
 	if client == nil {
 		return h.service.NewError(403, errForbidden, env.Message.RequestId)
 	}
 
+	if !h.canStoreThread(client, store.Id) {
+		return h.service.NewError(413, errQuotaExceeded, env.Message.RequestId)
+	}
+
 	thrd := &pb.CafeClientThread{
 		Id:         store.Id,
 		Client:     client.Id,
 		Ciphertext: store.Ciphertext,
 	}
-	if err := h.datastore.CafeClientThreads().AddOrUpdate(thrd); err != nil {
+	if err := h.storeClientThread(thrd); err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.RequestId)
 	}
+	h.replicate(pb.CafeReplication_THREAD, client.Id, thrd.Id)
 
 	res := &pb.CafeStoreThreadAck{Id: store.Id}
 	return h.service.NewEnvelope(pb.Message_CAFE_STORE_THREAD_ACK, res, &env.Message.RequestId, true)
@@ -1130,6 +1243,14 @@ func (h *CafeService) handleUnstoreThread(pid peer.ID, env *pb.Envelope) (*pb.En
 		return rerr, nil
 	}
 
+	rerr, err = h.authScope(pid, cafeScopeThreads, env.Message.RequestId)
+	if err != nil {
+		return nil, err
+	}
+	if rerr != nil {
+		return rerr, nil
+	}
+
 	client := h.datastore.CafeClients().Get(pid.Pretty())
 	if client == nil {
 		return h.service.NewError(403, errForbidden, env.Message.RequestId)
@@ -1138,6 +1259,7 @@ func (h *CafeService) handleUnstoreThread(pid peer.ID, env *pb.Envelope) (*pb.En
 	if err := h.datastore.CafeClientThreads().Delete(unstore.Id, client.Id); err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.RequestId)
 	}
+	h.replicate(pb.CafeReplication_UNTHREAD, client.Id, unstore.Id)
 
 	res := &pb.CafeUnstoreThreadAck{Id: unstore.Id}
 	return h.service.NewEnvelope(pb.Message_CAFE_UNSTORE_THREAD_ACK, res, &env.Message.RequestId, true)
@@ -1150,22 +1272,49 @@ func (h *CafeService) handleDeliverMessage(pid peer.ID, env *pb.Envelope) (*pb.E
 		return nil, err
 	}
 
+	// messages delivered directly (e.g., after being dropped by an inbox) go to our own inbox
+	if msg.Client == h.service.Node().Identity.Pretty() {
+		if err := h.inbox.Add(&pb.CafeMessage{
+			Id:   msg.Id,
+			Peer: pid.Pretty(),
+			Date: ptypes.TimestampNow(),
+		}); err != nil {
+			if !db.ConflictError(err) {
+				return nil, err
+			}
+		}
+		go h.inbox.Flush()
+		return nil, nil
+	}
+
 	client := h.datastore.CafeClients().Get(msg.Client)
 	if client == nil {
 		log.Warningf("received message from %s for unknown client %s", pid.Pretty(), msg.Client)
 		return nil, nil
 	}
 
+	if code, _ := h.scopeError(client, cafeScopeInbox); code != 0 {
+		go h.notifyDropped(pid.Pretty(), msg.Id, client.Id, dropNoInbox)
+		return nil, nil
+	}
+	size, reason := h.checkInboxMessage(client.Id, msg.Id, msg.Size)
+	if reason != "" {
+		go h.notifyDropped(pid.Pretty(), msg.Id, client.Id, reason)
+		return nil, nil
+	}
+
 	message := &pb.CafeClientMessage{
 		Id:     msg.Id,
 		Peer:   pid.Pretty(),
 		Client: client.Id,
 		Date:   ptypes.TimestampNow(),
+		Size:   size,
 	}
 	if err := h.datastore.CafeClientMessages().AddOrUpdate(message); err != nil {
 		log.Errorf("error adding message: %s", err)
 		return nil, nil
 	}
+	h.replicate(pb.CafeReplication_MESSAGE, client.Id, message.Id)
 
 	go func() {
 		pid, err := peer.IDB58Decode(client.Id)
@@ -1180,6 +1329,42 @@ func (h *CafeService) handleDeliverMessage(pid peer.ID, env *pb.Envelope) (*pb.E
 	return nil, nil
 }
 
+// handleMessageDropped receives a notice from a peer's inbox that a message was dropped
+func (h *CafeService) handleMessageDropped(pid peer.ID, env *pb.Envelope) (*pb.Envelope, error) {
+	dropped := new(pb.CafeMessageDropped)
+	if err := ptypes.UnmarshalAny(env.Message.Payload, dropped); err != nil {
+		return nil, err
+	}
+
+	// ensure the notice came from one of the client's inboxes
+	var inbox bool
+	contact := h.datastore.Peers().Get(dropped.Client)
+	if contact != nil {
+		for _, c := range contact.Inboxes {
+			if c.Peer == pid.Pretty() {
+				inbox = true
+				break
+			}
+		}
+	}
+	if !inbox {
+		log.Warningf("received dropped message notice from unknown inbox %s", pid.Pretty())
+		return nil, nil
+	}
+
+	log.Warningf("inbox %s dropped message %s for %s: %s",
+		ipfs.ShortenID(pid.Pretty()), dropped.Id, ipfs.ShortenID(dropped.Client), dropped.Reason)
+
+	client, err := peer.IDB58Decode(dropped.Client)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.outbox.Dropped(client, dropped.Id); err != nil {
+		log.Errorf("direct delivery of message %s to %s failed: %s", dropped.Id, dropped.Client, err)
+	}
+	return nil, nil
+}
+
 // handleCheckMessages receives a check inbox messages request
 func (h *CafeService) handleCheckMessages(pid peer.ID, env *pb.Envelope) (*pb.Envelope, error) {
 	check := new(pb.CafeCheckMessages)
@@ -1195,6 +1380,14 @@ func (h *CafeService) handleCheckMessages(pid peer.ID, env *pb.Envelope) (*pb.En
 		return rerr, nil
 	}
 
+	rerr, err = h.authScope(pid, cafeScopeInbox, env.Message.RequestId)
+	if err != nil {
+		return nil, err
+	}
+	if rerr != nil {
+		return rerr, nil
+	}
+
 	client := h.datastore.CafeClients().Get(pid.Pretty())
 	if client == nil {
 		return h.service.NewError(403, errForbidden, env.Message.RequestId)
@@ -1234,15 +1427,27 @@ func (h *CafeService) handleDeleteMessages(pid peer.ID, env *pb.Envelope) (*pb.E
 		return rerr, nil
 	}
 
+	rerr, err = h.authScope(pid, cafeScopeInbox, env.Message.RequestId)
+	if err != nil {
+		return nil, err
+	}
+	if rerr != nil {
+		return rerr, nil
+	}
+
 	client := h.datastore.CafeClients().Get(pid.Pretty())
 	if client == nil {
 		return h.service.NewError(403, errForbidden, env.Message.RequestId)
 	}
 
 	// delete the most recent page
+	page := h.datastore.CafeClientMessages().ListByClient(client.Id, inboxMessagePageSize)
 	if err := h.datastore.CafeClientMessages().DeleteByClient(client.Id, inboxMessagePageSize); err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.RequestId)
 	}
+	for _, msg := range page {
+		h.replicate(pb.CafeReplication_UNMESSAGE, client.Id, msg.Id)
+	}
 
 	// check for more
 	remaining := h.datastore.CafeClientMessages().CountByClient(client.Id)
@@ -1313,23 +1518,17 @@ func (h *CafeService) handleQuery(pid peer.ID, env *pb.Envelope, renvs chan *pb.
 		return nil
 	}
 
-	results := newQueryResultSet(query.Options)
-	reply := func(res *pb.QueryResults) bool {
-		added := results.Add(res.Items...)
-		if len(added) == 0 {
-			return false
-		}
-
-		renv, err := h.service.NewEnvelope(pb.Message_CAFE_QUERY_RES, res, &env.Message.RequestId, true)
-		if err != nil {
-			log.Errorf("error replying with query results: %s", err)
-			return false
-		}
-		renvs <- renv
-
-		return results.Full()
+	rerr, err = h.authScope(pid, cafeScopeSearch, env.Message.RequestId)
+	if err != nil {
+		return err
+	}
+	if rerr != nil {
+		renvs <- rerr
+		return nil
 	}
 
+	reply := h.queryReplier(query, env.Message.RequestId, renvs)
+
 	// search local
 	localResults, err := h.searchLocal(query.Type, query.Options, query.Payload, false)
 	if err != nil {
@@ -1343,9 +1542,36 @@ func (h *CafeService) handleQuery(pid peer.ID, env *pb.Envelope, renvs chan *pb.
 	}
 
 	// search network
+	if query.Options.Federated && h.federation.Enabled() {
+		return h.searchFederated(&pb.FederatedQuery{
+			Query: query,
+			Hops:  int32(h.config.Cafe.Host.FederationMaxHops),
+		}, reply, cancelCh)
+	}
 	return h.searchPubSub(query, reply, cancelCh, true)
 }
 
+// queryReplier returns a reply func that streams new results back to the requester,
+// returning true when the result limit is reached
+func (h *CafeService) queryReplier(query *pb.Query, requestId int32, renvs chan *pb.Envelope) func(*pb.QueryResults) bool {
+	results := newQueryResultSet(query.Options)
+	return func(res *pb.QueryResults) bool {
+		added := results.Add(res.Items...)
+		if len(added) == 0 {
+			return false
+		}
+
+		renv, err := h.service.NewEnvelope(pb.Message_CAFE_QUERY_RES, res, &requestId, true)
+		if err != nil {
+			log.Errorf("error replying with query results: %s", err)
+			return false
+		}
+		renvs <- renv
+
+		return results.Full()
+	}
+}
+
 // handlePubSubQuery receives a query request over pubsub and responds with a direct message
 func (h *CafeService) handlePubSubQuery(pid peer.ID, env *pb.Envelope) (*pb.Envelope, error) {
 	query := new(pb.PubSubQuery)
@@ -1354,6 +1580,7 @@ func (h *CafeService) handlePubSubQuery(pid peer.ID, env *pb.Envelope) (*pb.Enve
 	}
 
 	if _, ok := h.inFlightQueries[query.Id]; ok {
+		cafePubSubQueries.Inc(query.Type.String(), "own")
 		return nil, nil
 	}
 
@@ -1364,11 +1591,14 @@ func (h *CafeService) handlePubSubQuery(pid peer.ID, env *pb.Envelope) (*pb.Enve
 	}
 	results, err := h.searchLocal(query.Type, options, query.Payload, false)
 	if err != nil {
+		cafePubSubQueries.Inc(query.Type.String(), resultError)
 		return nil, err
 	}
 	if len(results.items) == 0 {
+		cafePubSubQueries.Inc(query.Type.String(), "empty")
 		return nil, nil
 	}
+	cafePubSubQueries.Inc(query.Type.String(), "answered")
 
 	res := &pb.PubSubQueryResults{
 		Id: query.Id,
@@ -1445,7 +1675,11 @@ func (h *CafeService) setAddrs(conf *config.Config) {
 				ip4 = "127.0.0.1"
 			}
 		}
-		url = "http://" + ip4
+		scheme := "http://"
+		if conf.TLS.Enabled {
+			scheme = "https://"
+		}
+		url = scheme + ip4
 		parts := strings.Split(conf.Addresses.CafeAPI, ":")
 		if len(parts) == 2 {
 			url += ":" + parts[1]
@@ -1507,15 +1741,25 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 			for _, req := range reqs {
 				types[req.Type] = append(types[req.Type], req)
 			}
+			var cerr error
 			for t, group := range types {
 				handled, err := h.handleRequests(group, t, cafe)
+				cafeOutboxRequests.Add(float64(len(handled)), t.String(), resultOk)
 				if err != nil {
+					cafeOutboxRequests.Add(float64(len(group)-len(handled)), t.String(), resultError)
 					berr = err
+					cerr = err
+					h.retryLater(group, handled, err)
 				}
 				for _, id := range handled {
 					toComplete = append(toComplete, id)
 				}
 			}
+			if cerr != nil {
+				h.recordFailure(cafe.Pretty(), cerr)
+			} else {
+				h.recordSuccess(cafe.Pretty(), 0)
+			}
 			wg.Done()
 		}(cafe, group)
 	}
@@ -1571,8 +1815,12 @@ func (h *CafeService) handleRequests(reqs []*pb.CafeRequest, rtype pb.CafeReques
 			}
 		}
 		if err != nil {
-			log.Errorf("cafe %s request to %s failed: %s", rtype.String(), cafe.Pretty(), err)
-			herr = err
+			if err == ErrQuotaExceeded {
+				h.failRequests(reqs, handled, err.Error())
+			} else {
+				log.Errorf("cafe %s request to %s failed: %s", rtype.String(), cafe.Pretty(), err)
+				herr = err
+			}
 		}
 
 	case pb.CafeRequest_UNSTORE:
@@ -1604,6 +1852,10 @@ func (h *CafeService) handleRequests(reqs []*pb.CafeRequest, rtype pb.CafeReques
 			}
 
 			if err := h.storeThread(thrd, cafe); err != nil {
+				if err == ErrQuotaExceeded {
+					h.failRequests([]*pb.CafeRequest{req}, nil, err.Error())
+					continue
+				}
 				log.Errorf("cafe %s request to %s failed: %s", rtype.String(), cafe.Pretty(), err)
 				herr = err
 				continue
@@ -1629,7 +1881,7 @@ func (h *CafeService) handleRequests(reqs []*pb.CafeRequest, rtype pb.CafeReques
 				continue
 			}
 
-			if err := h.deliverMessage(req.Target, pid, req.Cafe); err != nil {
+			if err := h.deliverMessage(req.Target, pid, req.Size, req.Cafe); err != nil {
 				log.Errorf("cafe %s request to %s failed: %s", rtype.String(), cafe.Pretty(), err)
 				herr = err
 				continue
@@ -1657,7 +1909,7 @@ func (h *CafeService) store(cids []string, cafe peer.ID) ([]string, error) {
 		return h.service.NewEnvelope(pb.Message_CAFE_STORE, store, nil, false)
 	})
 	if err != nil {
-		return stored, err
+		return stored, quotaError(err)
 	}
 
 	// unpack response as a request list of cids the cafe is able/willing to store
@@ -1690,7 +1942,7 @@ loop:
 			return stored, err
 		}
 		if err := h.sendObject(decoded, addr, accessToken); err != nil {
-			return stored, err
+			return stored, quotaError(err)
 		}
 		stored = append(stored, id)
 	}
@@ -1734,11 +1986,19 @@ func (h *CafeService) storeThread(thrd *pb.Thread, cafe peer.ID) error {
 			Ciphertext: ciphertext,
 		}, nil, false)
 	}); err != nil {
-		return err
+		return quotaError(err)
 	}
 	return nil
 }
 
+// quotaError maps a cafe's quota exceeded response to ErrQuotaExceeded
+func quotaError(err error) error {
+	if err.Error() == errQuotaExceeded {
+		return ErrQuotaExceeded
+	}
+	return err
+}
+
 // unstoreThread removes a cafe's thread snapshot
 func (h *CafeService) unstoreThread(id string, cafe peer.ID) error {
 	renv, err := h.sendCafeHTTPRequest(cafe, func(session *pb.CafeSession) (*pb.Envelope, error) {
@@ -1757,10 +2017,11 @@ func (h *CafeService) unstoreThread(id string, cafe peer.ID) error {
 
 // deliverMessage delivers a message content id to a peer's cafe inbox
 // TODO: unpin message locally after it's delivered
-func (h *CafeService) deliverMessage(mid string, pid peer.ID, cafe *pb.Cafe) error {
+func (h *CafeService) deliverMessage(mid string, pid peer.ID, size int64, cafe *pb.Cafe) error {
 	env, err := h.service.NewEnvelope(pb.Message_CAFE_DELIVER_MESSAGE, &pb.CafeDeliverMessage{
 		Id:     mid,
 		Client: pid.Pretty(),
+		Size:   size,
 	}, nil, false)
 	if err != nil {
 		return err
@@ -1770,6 +2031,19 @@ func (h *CafeService) deliverMessage(mid string, pid peer.ID, cafe *pb.Cafe) err
 	return h.service.SendHTTPMessage(addr, env)
 }
 
+// deliverMessageDirect delivers a message to a peer over libp2p, bypassing its inbox(es)
+func (h *CafeService) deliverMessageDirect(mid string, pid peer.ID) error {
+	env, err := h.service.NewEnvelope(pb.Message_CAFE_DELIVER_MESSAGE, &pb.CafeDeliverMessage{
+		Id:     mid,
+		Client: pid.Pretty(),
+	}, nil, false)
+	if err != nil {
+		return err
+	}
+
+	return h.service.SendMessage(nil, pid, env)
+}
+
 // queryDefaults ensures the query is within the expected bounds
 func queryDefaults(query *pb.Query) *pb.Query {
 	if query.Options == nil {
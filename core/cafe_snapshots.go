@@ -0,0 +1,81 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/pb"
+)
+
+// RestoreThread resets a thread to the head of an earlier snapshot,
+// adding the thread first if needed
+func (t *Textile) RestoreThread(snap *pb.Thread) error {
+	if err := t.AddOrUpdateThread(snap); err != nil {
+		return err
+	}
+
+	thrd := t.Thread(snap.Id)
+	if thrd == nil {
+		return ErrThreadNotFound
+	}
+	head, err := thrd.Head()
+	if err != nil {
+		return err
+	}
+	if head == snap.Head {
+		return nil
+	}
+	if t.datastore.Blocks().Get(snap.Head) == nil {
+		return fmt.Errorf("snapshot head %s not found", snap.Head)
+	}
+
+	hash, err := mh.FromB58String(snap.Head)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("restoring thread %s to %s", thrd.Id, snap.Head)
+
+	// the restored head is snapshotted to cafes as their latest version
+	return thrd.updateHead(hash)
+}
+
+// storeClientThread adds a new snapshot version of a client thread,
+// dropping versions beyond the configured history
+func (h *CafeService) storeClientThread(thrd *pb.CafeClientThread) error {
+	if err := h.datastore.CafeClientThreads().AddOrUpdate(thrd); err != nil {
+		return err
+	}
+	return h.datastore.CafeClientThreads().PruneVersions(thrd.Id, thrd.Client, h.config.Cafe.Host.ThreadSnapshotHistory)
+}
+
+// clientThreadSnapshots returns the client thread snapshots matching a query,
+// either the latest, a specific version, or the latest as of a date
+func (h *CafeService) clientThreadSnapshots(client string, q *pb.ThreadSnapshotQuery) []pb.CafeClientThread {
+	var list []pb.CafeClientThread
+	for _, thrd := range h.datastore.CafeClientThreads().ListByClient(client) {
+		if q.Thread != "" && thrd.Id != q.Thread {
+			continue
+		}
+
+		var snap *pb.CafeClientThread
+		switch {
+		case q.Version > 0:
+			snap = h.datastore.CafeClientThreads().GetVersion(thrd.Id, client, int(q.Version))
+		case q.AsOf != nil:
+			date, err := ptypes.Timestamp(q.AsOf)
+			if err != nil {
+				log.Warningf("invalid snapshot query date: %s", err)
+				return nil
+			}
+			snap = h.datastore.CafeClientThreads().GetAsOf(thrd.Id, client, date)
+		default:
+			snap = &thrd
+		}
+		if snap != nil {
+			list = append(list, *snap)
+		}
+	}
+	return list
+}
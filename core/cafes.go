@@ -3,6 +3,7 @@ package core
 import (
 	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/golang/protobuf/proto"
 	iface "github.com/ipfs/interface-go-ipfs-core"
@@ -94,11 +95,18 @@ func (t *Textile) CafeRequests(offset string, limit int) *pb.CafeRequestList {
 
 // UpdateCafeRequestStatus updates a request status
 func (t *Textile) UpdateCafeRequestStatus(id string, status pb.CafeRequest_Status) error {
+	if status == pb.CafeRequest_COMPLETE {
+		req := t.datastore.CafeRequests().Get(id)
+		if req != nil {
+			t.cafe.recordSuccess(req.Cafe.Peer, 0)
+		}
+	}
 	return t.datastore.CafeRequests().UpdateStatus(id, status)
 }
 
 // CafeHTTPRequest returns the type, path, headers, body and token for an HTTP cafe request
 // - store: PUT /store/:cid, body => raw object data
+// - store large data: POST /store/:cid, body => none, then chunks of PATCH /store/:cid, body => data at Upload-Offset
 // - unstore: DELETE /store/:cid, body => none
 // - store thread: PUT /threads/:id, body => encrypted thread object (snapshot)
 // - unstore thread: DELETE /threads/:id, body => none
@@ -173,18 +181,73 @@ func (t *Textile) CafeHTTPRequest(id string) (*pb.CafeHTTPRequest, error) {
 	case pb.CafeRequest_INBOX:
 		hreq.Type = pb.CafeHTTPRequest_POST
 		hreq.Url += "/inbox/" + req.Peer
+		hreq.Headers["X-Textile-Size"] = strconv.FormatInt(req.Size, 10)
 		hreq.Body = []byte(req.Target)
 	}
 
-	if hreq.Body != nil {
-		sig, err := t.node.PrivateKey.Sign(hreq.Body)
-		if err != nil {
+	if req.Type == pb.CafeRequest_STORE && len(hreq.Body) > cafeUploadChunkThreshold {
+		return t.chunkCafeHTTPRequest(hreq)
+	}
+
+	if err := t.signCafeHTTPRequest(hreq); err != nil {
+		return nil, err
+	}
+	return hreq, nil
+}
+
+// chunkCafeHTTPRequest turns a store request into a resumable upload. The returned
+// request creates the upload (or reports the offset of an existing one) and its chunks
+// should be sent in order, skipping any that end at or before the reported offset.
+func (t *Textile) chunkCafeHTTPRequest(hreq *pb.CafeHTTPRequest) (*pb.CafeHTTPRequest, error) {
+	body := hreq.Body
+	creq := &pb.CafeHTTPRequest{
+		Type:    pb.CafeHTTPRequest_POST,
+		Url:     hreq.Url,
+		Headers: make(map[string]string),
+	}
+	for k, v := range hreq.Headers {
+		creq.Headers[k] = v
+	}
+	creq.Headers["Upload-Length"] = strconv.Itoa(len(body))
+	creq.Headers["Tus-Resumable"] = tusVersion
+
+	for offset := 0; offset < len(body); offset += cafeUploadChunkSize {
+		end := offset + cafeUploadChunkSize
+		if end > len(body) {
+			end = len(body)
+		}
+		chunk := &pb.CafeHTTPRequest{
+			Type: pb.CafeHTTPRequest_PATCH,
+			Url:  hreq.Url,
+			Headers: map[string]string{
+				"Authorization":  hreq.Headers["Authorization"],
+				"X-Textile-Peer": hreq.Headers["X-Textile-Peer"],
+				"Content-Type":   uploadContentType,
+				"Tus-Resumable":  tusVersion,
+				"Upload-Offset":  strconv.Itoa(offset),
+			},
+			Body: body[offset:end],
+		}
+		if err := t.signCafeHTTPRequest(chunk); err != nil {
 			return nil, err
 		}
-		hreq.Headers["X-Textile-Peer-Sig"] = hex.EncodeToString(sig)
+		creq.Chunks = append(creq.Chunks, chunk)
 	}
 
-	return hreq, nil
+	return creq, nil
+}
+
+// signCafeHTTPRequest adds a signature of the request body, if any
+func (t *Textile) signCafeHTTPRequest(hreq *pb.CafeHTTPRequest) error {
+	if hreq.Body == nil {
+		return nil
+	}
+	sig, err := t.node.PrivateKey.Sign(hreq.Body)
+	if err != nil {
+		return err
+	}
+	hreq.Headers["X-Textile-Peer-Sig"] = hex.EncodeToString(sig)
+	return nil
 }
 
 // CafeRequestGroupStatus returns the status of a request group
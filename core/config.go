@@ -51,6 +51,9 @@ func applyTextileConfigOptions(init InitConfig) error {
 	if init.CafeApiAddr != "" {
 		conf.Addresses.CafeAPI = init.CafeApiAddr
 	}
+	if init.GrpcAddr != "" {
+		conf.Addresses.GRPC = init.GrpcAddr
+	}
 	if init.GatewayAddr != "" {
 		conf.Addresses.Gateway = init.GatewayAddr
 	}
@@ -70,6 +73,17 @@ func applyTextileConfigOptions(init InitConfig) error {
 	conf.Cafe.Host.URL = init.CafeURL
 	conf.Cafe.Host.NeighborURL = init.CafeNeighborURL
 
+	// tls settings
+	conf.TLS.Enabled = init.TLS
+	if init.TLSCertFile != "" {
+		conf.TLS.CertFile = init.TLSCertFile
+	}
+	if init.TLSKeyFile != "" {
+		conf.TLS.KeyFile = init.TLSKeyFile
+	}
+	conf.TLS.ACME.Enabled = init.TLSACME
+	conf.TLS.ACME.Email = init.TLSACMEEmail
+
 	// write to disk
 	return config.Write(init.RepoPath, conf)
 }
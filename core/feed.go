@@ -3,6 +3,7 @@ package core
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
@@ -11,6 +12,18 @@ import (
 	"github.com/textileio/go-textile/pb"
 )
 
+// ErrInvalidCursor indicates a thread update cursor is not a known block id or date
+var ErrInvalidCursor = fmt.Errorf("cursor must be a block id or RFC3339 date")
+
+// ErrCursorTooOld indicates too many blocks were added since a thread update cursor to replay
+var ErrCursorTooOld = fmt.Errorf("too many updates since cursor, list the feed instead")
+
+// threadUpdatesReplayLimit is the max number of blocks replayed since a cursor
+const threadUpdatesReplayLimit = 1000
+
+// threadUpdatesPageSize is the number of blocks loaded at a time while replaying
+const threadUpdatesPageSize = 100
+
 var flatFeedTypes = []pb.Block_BlockType{
 	pb.Block_JOIN,
 	pb.Block_LEAVE,
@@ -112,23 +125,66 @@ func (t *Textile) Feed(req *pb.FeedRequest) (*pb.FeedItemList, error) {
 		}
 	}
 
-	var nextOffset string
-	if len(blocks.Items) > 0 {
-		nextOffset = blocks.Items[len(blocks.Items)-1].Id
-
-		// see if there's actually more
-		if len(t.datastore.Blocks().List(nextOffset, 1, query).Items) == 0 {
-			nextOffset = ""
-		}
-	}
-
 	return &pb.FeedItemList{
 		Items: list,
 		Count: int32(count),
-		Next:  nextOffset,
+		Next:  t.nextBlockOffset(blocks, query),
 	}, nil
 }
 
+// ThreadUpdatesSince returns the thread updates for blocks added since a cursor,
+// which lets a thread update listener resume after a reconnect. The cursor is the id of the
+// last block received, which replays every block stored after it, or an RFC3339 date, which
+// replays blocks dated at or after it. Updates are ordered by block date. Cursors with more
+// than threadUpdatesReplayLimit blocks after them fail with ErrCursorTooOld.
+func (t *Textile) ThreadUpdatesSince(since string) ([]*pb.FeedItem, error) {
+	var query string
+	if block := t.datastore.Blocks().Get(since); block != nil {
+		// blocks may be received out of date order, so use the order they were stored
+		query = fmt.Sprintf("rowid>(select rowid from blocks where id='%s')", block.Id)
+	} else if date, err := time.Parse(time.RFC3339Nano, since); err == nil {
+		query = fmt.Sprintf("date>=%d", date.UnixNano())
+	} else {
+		return nil, ErrInvalidCursor
+	}
+
+	// account thread blocks are not sent as updates
+	if acct := t.AccountThread(); acct != nil {
+		query += fmt.Sprintf(" and threadId!='%s'", acct.Id)
+	}
+
+	if t.datastore.Blocks().Count(query) > threadUpdatesReplayLimit {
+		return nil, ErrCursorTooOld
+	}
+
+	// pages are listed newest first
+	var items []*pb.FeedItem
+	var offset string
+	for {
+		blocks := t.datastore.Blocks().List(offset, threadUpdatesPageSize, query).Items
+		for _, block := range blocks {
+			item, err := t.feedItem(block, feedItemOpts{})
+			if err != nil {
+				return nil, err
+			}
+			// some block types aren't rendered as updates
+			if item == nil {
+				continue
+			}
+			items = append(items, item)
+		}
+		if len(blocks) < threadUpdatesPageSize {
+			break
+		}
+		offset = blocks[len(blocks)-1].Id
+	}
+
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+	return items, nil
+}
+
 func (t *Textile) feedItem(block *pb.Block, opts feedItemOpts) (*pb.FeedItem, error) {
 	if block == nil {
 		return nil, nil
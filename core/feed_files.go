@@ -30,7 +30,11 @@ func (t *Textile) Files(offset string, limit int, threadId string) (*pb.FilesLis
 		list = append(list, file)
 	}
 
-	return &pb.FilesList{Items: list}, nil
+	return &pb.FilesList{
+		Items: list,
+		Count: int32(len(list)),
+		Next:  t.nextBlockOffset(blocks, query),
+	}, nil
 }
 
 func (t *Textile) File(blockId string) (*pb.Files, error) {
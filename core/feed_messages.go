@@ -28,7 +28,11 @@ func (t *Textile) Messages(offset string, limit int, threadId string) (*pb.TextL
 		list = append(list, msg)
 	}
 
-	return &pb.TextList{Items: list}, nil
+	return &pb.TextList{
+		Items: list,
+		Count: int32(len(list)),
+		Next:  t.nextBlockOffset(blocks, query),
+	}, nil
 }
 
 func (t *Textile) Message(blockId string) (*pb.Text, error) {
@@ -2,9 +2,12 @@ package core
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"fmt"
+	"hash"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"strconv"
 	"strings"
@@ -33,14 +36,23 @@ var ValidMetaLinkNames = []string{"f", "meta"}
 var ValidContentLinkNames = []string{"d", "content"}
 
 type AddFileConfig struct {
-	Input     []byte `json:"input"`
-	Use       string `json:"use"`
-	Media     string `json:"media"`
-	Name      string `json:"name"`
-	Plaintext bool   `json:"plaintext"`
+	Input     []byte    `json:"input"`
+	Reader    io.Reader `json:"-"` // streamed instead of input if set, requires a StreamMill
+	Use       string    `json:"use"`
+	Media     string    `json:"media"`
+	Name      string    `json:"name"`
+	Plaintext bool      `json:"plaintext"`
 }
 
 func (t *Textile) AddFileIndex(mill m.Mill, conf AddFileConfig) (*pb.FileIndex, error) {
+	if conf.Reader != nil {
+		smill, ok := mill.(m.StreamMill)
+		if !ok {
+			return nil, fmt.Errorf("mill %s does not support streaming", mill.ID())
+		}
+		return t.addFileIndexStream(smill, conf)
+	}
+
 	var source string
 	if conf.Use != "" {
 		source = conf.Use
@@ -81,18 +93,17 @@ func (t *Textile) AddFileIndex(mill m.Mill, conf AddFileConfig) (*pb.FileIndex,
 		Meta:     pb.ToStruct(res.Meta),
 	}
 
-	var reader *bytes.Reader
+	var reader io.Reader
 	if mill.Encrypt() && !conf.Plaintext {
 		key, err := crypto.GenerateAESKey()
 		if err != nil {
 			return nil, err
 		}
-		ciphertext, err := crypto.EncryptAES(res.File, key)
+		reader, err = crypto.NewAESEncryptReader(bytes.NewReader(res.File), key)
 		if err != nil {
 			return nil, err
 		}
 		model.Key = base58.FastBase58Encoding(key)
-		reader = bytes.NewReader(ciphertext)
 	} else {
 		reader = bytes.NewReader(res.File)
 	}
@@ -117,6 +128,172 @@ func (t *Textile) AddFileIndex(mill m.Mill, conf AddFileConfig) (*pb.FileIndex,
 	return t.datastore.Files().Get(model.Hash), nil
 }
 
+// addFileIndexStream mills, encrypts, and adds conf.Reader to IPFS as it is read.
+// Because checksums aren't known until the input has been read, existing files with the
+// same source or checksum are only found afterwards, in which case the new data is left
+// unindexed for garbage collection.
+func (t *Textile) addFileIndexStream(mill m.StreamMill, conf AddFileConfig) (*pb.FileIndex, error) {
+	opts, err := mill.Options(map[string]interface{}{
+		"plaintext": conf.Plaintext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Use != "" {
+		if efile := t.datastore.Files().GetBySource(mill.ID(), conf.Use, opts); efile != nil {
+			return efile, nil
+		}
+	}
+
+	input := conf.Reader
+	sourceSum := sha256.New()
+	if conf.Use == "" {
+		input = io.TeeReader(input, sourceSum)
+	}
+
+	res, err := mill.MillStream(input, conf.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.New()
+	var size byteCounter
+	reader := io.TeeReader(res.File, io.MultiWriter(sum, &size))
+
+	var key []byte
+	if mill.Encrypt() && !conf.Plaintext {
+		key, err = crypto.GenerateAESKey()
+		if err != nil {
+			return nil, err
+		}
+		reader, err = crypto.NewAESEncryptReader(reader, key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hash, err := ipfs.AddData(t.node, reader, mill.Pin())
+	if err != nil {
+		return nil, err
+	}
+
+	source := conf.Use
+	if source == "" {
+		source = checksumSum(sourceSum, conf.Plaintext)
+		if efile := t.datastore.Files().GetBySource(mill.ID(), source, opts); efile != nil {
+			return efile, nil
+		}
+	}
+	check := checksumSum(sum, conf.Plaintext)
+	if efile := t.datastore.Files().GetByPrimary(mill.ID(), check); efile != nil {
+		return efile, nil
+	}
+
+	model := &pb.FileIndex{
+		Mill:     mill.ID(),
+		Checksum: check,
+		Source:   source,
+		Opts:     opts,
+		Hash:     hash.Hash().B58String(),
+		Media:    conf.Media,
+		Name:     conf.Name,
+		Size:     int64(size),
+		Added:    ptypes.TimestampNow(),
+		Meta:     pb.ToStruct(res.Meta),
+	}
+	if key != nil {
+		model.Key = base58.FastBase58Encoding(key)
+	}
+
+	if err := t.datastore.Files().Add(model); err != nil {
+		if db.ConflictError(err) {
+			// we may have lost the race
+			return t.datastore.Files().Get(model.Hash), nil
+		}
+		return nil, err
+	}
+
+	return t.datastore.Files().Get(model.Hash), nil
+}
+
+// millData adds a file index for data, or for the file at use if data is nil
+func (t *Textile) millData(mill m.Mill, data []byte, name string, use string, plaintext bool) (*pb.FileIndex, error) {
+	var reader io.ReadSeeker
+	conf := AddFileConfig{
+		Name:      name,
+		Plaintext: plaintext,
+	}
+
+	if use == "" {
+		reader = bytes.NewReader(data)
+	} else {
+		var file *pb.FileIndex
+		var err error
+		reader, file, err = t.FileData(use)
+		if err != nil {
+			return nil, err
+		}
+		conf.Name = file.Name
+		conf.Use = file.Checksum
+	}
+
+	switch mill.ID() {
+	case "/schema", "/json", "/image/exif":
+		conf.Media = "application/json"
+	default:
+		media, err := t.GetMedia(reader, mill)
+		if err != nil {
+			return nil, err
+		}
+		conf.Media = media
+		reader.Seek(0, 0)
+	}
+
+	input, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	conf.Input = input
+
+	return t.AddFileIndex(mill, conf)
+}
+
+// addThreadDirs adds milled files to a thread, either as single files or directories
+func (t *Textile) addThreadDirs(thrd *Thread, dirs *pb.DirectoryList, caption string) (*pb.Files, error) {
+	if len(dirs.Items) == 0 {
+		return nil, fmt.Errorf("no files found")
+	}
+
+	var node ipld.Node
+	var keys *pb.Keys
+	var err error
+	if dirs.Items[0].Files[schema.SingleFileTag] != nil {
+		var files []*pb.FileIndex
+		for _, dir := range dirs.Items {
+			if len(dir.Files) > 0 && dir.Files[schema.SingleFileTag].Hash != "" {
+				files = append(files, dir.Files[schema.SingleFileTag])
+			}
+		}
+		node, keys, err = t.AddNodeFromFiles(files)
+	} else {
+		node, keys, err = t.AddNodeFromDirs(dirs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, fmt.Errorf("no files found")
+	}
+
+	hash, err := thrd.AddFiles(node, caption, keys.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.File(hash.B58String())
+}
+
 func (t *Textile) GetMedia(reader io.Reader, mill m.Mill) (string, error) {
 	buffer := make([]byte, 512)
 	n, err := reader.Read(buffer)
@@ -216,30 +393,74 @@ func (t *Textile) FileIndex(hash string) (*pb.FileIndex, error) {
 }
 
 func (t *Textile) FileData(hash string) (io.ReadSeeker, *pb.FileIndex, error) {
+	reader, file, err := t.OpenFileData(t.node.Context(), hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer reader.Close()
+
+	plaintext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bytes.NewReader(plaintext), file, nil
+}
+
+// ReadSeekCloser is a seekable reader that must be closed
+type ReadSeekCloser interface {
+	io.ReadSeeker
+	io.Closer
+}
+
+// OpenFileData returns a seekable reader of a file's plaintext, which must be closed.
+// Files encrypted with the streamed AES format are fetched and decrypted as they are
+// read, while files encrypted with the legacy format are decrypted up front. Reads are
+// canceled along with ctx.
+func (t *Textile) OpenFileData(ctx context.Context, hash string) (ReadSeekCloser, *pb.FileIndex, error) {
 	file := t.datastore.Files().Get(hash)
 	if file == nil {
 		return nil, nil, ErrFileNotFound
 	}
-	fd, err := ipfs.DataAtPath(t.node, file.Hash)
+	fd, err := ipfs.ReaderAtPath(ctx, t.node, file.Hash)
 	if err != nil {
 		return nil, nil, err
 	}
+	if file.Key == "" {
+		return fd, file, nil
+	}
 
-	var plaintext []byte
-	if file.Key != "" {
-		key, err := base58.Decode(file.Key)
-		if err != nil {
-			return nil, nil, err
-		}
-		plaintext, err = crypto.DecryptAES(fd, key)
-		if err != nil {
-			return nil, nil, err
-		}
-	} else {
-		plaintext = fd
+	key, err := base58.Decode(file.Key)
+	if err != nil {
+		fd.Close()
+		return nil, nil, err
 	}
 
-	return bytes.NewReader(plaintext), file, nil
+	plaintext, err := crypto.NewAESDecryptReadSeeker(fd, key)
+	if err == nil {
+		return &readSeekCloser{ReadSeeker: plaintext, Closer: fd}, file, nil
+	}
+
+	// fallback to the legacy format
+	defer fd.Close()
+	if _, err := fd.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, err
+	}
+	ciphertext, err := ioutil.ReadAll(fd)
+	if err != nil {
+		return nil, nil, err
+	}
+	legacy, err := crypto.DecryptAES(ciphertext, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &readSeekCloser{ReadSeeker: bytes.NewReader(legacy), Closer: ioutil.NopCloser(nil)}, file, nil
+}
+
+// readSeekCloser joins a seekable reader with a closer
+type readSeekCloser struct {
+	io.ReadSeeker
+	io.Closer
 }
 
 func (t *Textile) TargetNodeKeys(node ipld.Node) (*pb.Keys, error) {
@@ -329,6 +550,24 @@ func (t *Textile) checksum(plaintext []byte, willEncrypt bool) string {
 	return base58.FastBase58Encoding(sum[:])
 }
 
+// checksumSum finishes a streamed checksum, matching checksum for the same plaintext
+func checksumSum(sum hash.Hash, willEncrypt bool) string {
+	var add int
+	if willEncrypt {
+		add = 1
+	}
+	sum.Write([]byte{byte(add)})
+	return base58.FastBase58Encoding(sum.Sum(nil))
+}
+
+// byteCounter counts the bytes written to it
+type byteCounter int64
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	*c += byteCounter(len(p))
+	return len(p), nil
+}
+
 func (t *Textile) fileNodeKeys(node ipld.Node, index int, keys *map[string]string) error {
 	vkeys := *keys
 
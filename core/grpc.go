@@ -0,0 +1,145 @@
+package core
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/textileio/go-textile/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcApiHost is the instance used by the daemon
+var grpcApiHost *grpcApi
+
+// grpcApi serves the local API over gRPC, implementing the Api service
+// defined in pb/protos/api.proto, which also lists the REST routes without an rpc.
+type grpcApi struct {
+	addr   string
+	server *grpc.Server
+	node   *Textile
+}
+
+// StartGrpcApi starts the gRPC host instance
+func (t *Textile) StartGrpcApi(addr string) {
+	grpcApiHost = &grpcApi{addr: addr, node: t}
+	grpcApiHost.Start()
+}
+
+// StopGrpcApi stops the gRPC host instance
+func (t *Textile) StopGrpcApi() error {
+	if grpcApiHost == nil {
+		return nil
+	}
+	return grpcApiHost.Stop()
+}
+
+// GrpcApiAddr returns the gRPC api address
+func (t *Textile) GrpcApiAddr() string {
+	if grpcApiHost == nil {
+		return ""
+	}
+	return grpcApiHost.addr
+}
+
+// Start starts the gRPC api
+func (s *grpcApi) Start() {
+	s.server = grpc.NewServer(
+		grpc.UnaryInterceptor(s.unaryInterceptor),
+		grpc.StreamInterceptor(s.streamInterceptor),
+	)
+	pb.RegisterApiServer(s.server, s)
+
+	lis, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		log.Errorf("grpc api error: %s", err)
+		return
+	}
+
+	// start serving
+	go func() {
+		if err := s.server.Serve(lis); err != nil {
+			log.Errorf("grpc api error: %s", err)
+		}
+		log.Info("grpc api was shutdown")
+	}()
+	log.Infof("grpc api listening at %s", s.addr)
+}
+
+// Stop stops the gRPC api, ending open streams
+func (s *grpcApi) Stop() error {
+	s.server.Stop()
+	return nil
+}
+
+// unaryInterceptor authorizes unary calls
+func (s *grpcApi) unaryInterceptor(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	res, err := handler(ctx, req)
+	if err != nil {
+		return nil, grpcContextError(ctx, err)
+	}
+	return res, nil
+}
+
+// streamInterceptor authorizes server streaming calls
+func (s *grpcApi) streamInterceptor(srv interface{}, stream grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := stream.Context()
+	if err := s.authorize(ctx, info.FullMethod); err != nil {
+		return err
+	}
+	if err := handler(srv, stream); err != nil {
+		return grpcContextError(ctx, err)
+	}
+	return nil
+}
+
+// authorize requires a valid API key with the method's scope when auth is enabled
+func (s *grpcApi) authorize(ctx context.Context, method string) error {
+	if !s.node.Config().API.Auth {
+		return nil
+	}
+
+	var auth []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get("authorization"); len(vals) > 0 {
+			auth = strings.Split(vals[0], " ")
+		}
+	}
+	if len(auth) < 2 || auth[0] != "Bearer" {
+		return status.Errorf(codes.Unauthenticated, "missing api key")
+	}
+	key, err := s.node.ValidateApiKey(auth[1])
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid api key: %s", err)
+	}
+
+	// rpcs without a listed scope are admin only
+	scope, ok := grpcScopes[method[strings.LastIndex(method, "/")+1:]]
+	if !ok {
+		scope = apiScopeAdmin
+	}
+	if !apiKeyAllows(key, scope) {
+		return status.Errorf(codes.PermissionDenied, "api key is missing the %s scope", scope)
+	}
+	return nil
+}
+
+// grpcContextError returns a status error for calls ended by their deadline or cancellation
+func grpcContextError(ctx context.Context, err error) error {
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		return status.Errorf(codes.DeadlineExceeded, "%s", ctx.Err())
+	case context.Canceled:
+		return status.Errorf(codes.Canceled, "%s", ctx.Err())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,1195 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	libp2pc "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/mr-tron/base58/base58"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/broadcast"
+	"github.com/textileio/go-textile/crypto"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcFeedPageSize is the number of blocks read at a time while streaming the feed
+const grpcFeedPageSize = 20
+
+// grpcDataChunkSize is the largest chunk of data sent in a single message while streaming data
+const grpcDataChunkSize = 64 * 1024
+
+// grpcScopes are the API key scopes needed to call the rpcs defined by the Api service
+// in pb/protos/api.proto, matching the REST routes
+var grpcScopes = map[string]string{
+	"Summary": apiScopeRead,
+	"Ping":    apiScopeRead,
+
+	"Account":        apiScopeRead,
+	"AccountSeed":    apiScopeSeed,
+	"AccountAddress": apiScopeRead,
+
+	"Profile":   apiScopeRead,
+	"SetName":   apiScopeWrite,
+	"SetAvatar": apiScopeWrite,
+
+	"Contacts":       apiScopeRead,
+	"Contact":        apiScopeRead,
+	"AddContact":     apiScopeWrite,
+	"RemoveContact":  apiScopeWrite,
+	"SearchContacts": apiScopeRead,
+
+	"Mill": apiScopeWrite,
+
+	"AddThread":         apiScopeWrite,
+	"AddOrUpdateThread": apiScopeWrite,
+	"RenameThread":      apiScopeWrite,
+	"Threads":           apiScopeRead,
+	"Thread":            apiScopeRead,
+	"ThreadPeers":       apiScopeRead,
+	"RemoveThread":      apiScopeWrite,
+
+	"CreateThreadSnapshots": apiScopeWrite,
+	"SearchThreadSnapshots": apiScopeRead,
+
+	"Blocks":      apiScopeRead,
+	"BlockViz":    apiScopeRead,
+	"Block":       apiScopeRead,
+	"RemoveBlock": apiScopeWrite,
+
+	"AddComment": apiScopeWrite,
+	"Comments":   apiScopeRead,
+	"Comment":    apiScopeRead,
+
+	"AddLike": apiScopeWrite,
+	"Likes":   apiScopeRead,
+	"Like":    apiScopeRead,
+
+	"AddMessage": apiScopeWrite,
+	"Messages":   apiScopeRead,
+	"Message":    apiScopeRead,
+
+	"AddFiles": apiScopeWrite,
+	"Files":    apiScopeRead,
+	"File":     apiScopeRead,
+	"FileData": apiScopeRead,
+	"FileKeys": apiScopeRead,
+
+	"Feed":      apiScopeRead,
+	"Subscribe": apiScopeRead,
+
+	"AddInvite":    apiScopeWrite,
+	"Invites":      apiScopeRead,
+	"AcceptInvite": apiScopeWrite,
+	"IgnoreInvite": apiScopeWrite,
+
+	"Notifications":      apiScopeRead,
+	"ReadNotification":   apiScopeWrite,
+	"RemoveNotification": apiScopeWrite,
+
+	"AddWebhook":    apiScopeAdmin,
+	"Webhooks":      apiScopeAdmin,
+	"RemoveWebhook": apiScopeAdmin,
+
+	"AddCafe":           apiScopeWrite,
+	"CafeSessions":      apiScopeRead,
+	"CafeSession":       apiScopeRead,
+	"UpdateCafe":        apiScopeWrite,
+	"RemoveCafe":        apiScopeWrite,
+	"CheckCafeMessages": apiScopeWrite,
+
+	"CafeRequests":      apiScopeRead,
+	"RetryCafeRequests": apiScopeWrite,
+	"PurgeCafeRequests": apiScopeWrite,
+
+	"CreateApiKey": apiScopeAdmin,
+	"ApiKeys":      apiScopeAdmin,
+	"RemoveApiKey": apiScopeAdmin,
+
+	"CreateToken":   apiScopeAdmin,
+	"Tokens":        apiScopeAdmin,
+	"ValidateToken": apiScopeAdmin,
+	"RemoveToken":   apiScopeAdmin,
+
+	"CafeClients":      apiScopeAdmin,
+	"EvictCafeClient":  apiScopeAdmin,
+	"CafeClientsUsage": apiScopeAdmin,
+	"CafeClientUsage":  apiScopeAdmin,
+	"CafeReplication":  apiScopeAdmin,
+
+	"IpfsId":           apiScopeRead,
+	"IpfsCat":          apiScopeRead,
+	"IpfsSwarmConnect": apiScopeWrite,
+	"IpfsSwarmPeers":   apiScopeRead,
+
+	"Logs": apiScopeAdmin,
+
+	"Config":      apiScopeAdmin,
+	"SetConfig":   apiScopeAdmin,
+	"PatchConfig": apiScopeAdmin,
+}
+
+func (s *grpcApi) Summary(ctx context.Context, req *pb.ApiEmpty) (*pb.Summary, error) {
+	return s.node.Summary(), nil
+}
+
+func (s *grpcApi) Ping(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiValue, error) {
+	pid, err := peer.IDB58Decode(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	pstatus, err := s.node.Ping(pid)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApiValue{Value: string(pstatus)}, nil
+}
+
+func (s *grpcApi) Account(ctx context.Context, req *pb.ApiEmpty) (*pb.Contact, error) {
+	return s.node.AccountContact(), nil
+}
+
+func (s *grpcApi) AccountSeed(ctx context.Context, req *pb.ApiEmpty) (*pb.ApiValue, error) {
+	return &pb.ApiValue{Value: s.node.account.Seed()}, nil
+}
+
+func (s *grpcApi) AccountAddress(ctx context.Context, req *pb.ApiEmpty) (*pb.ApiValue, error) {
+	return &pb.ApiValue{Value: s.node.account.Address()}, nil
+}
+
+func (s *grpcApi) Profile(ctx context.Context, req *pb.ApiEmpty) (*pb.Peer, error) {
+	self := s.node.Profile()
+	if self == nil {
+		return nil, status.Errorf(codes.NotFound, "profile not found")
+	}
+	return self, nil
+}
+
+func (s *grpcApi) SetName(ctx context.Context, req *pb.ApiSetNameRequest) (*pb.Peer, error) {
+	if err := s.node.SetName(req.Name); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return s.Profile(ctx, &pb.ApiEmpty{})
+}
+
+func (s *grpcApi) SetAvatar(ctx context.Context, req *pb.ApiEmpty) (*pb.Peer, error) {
+	if err := s.node.SetAvatar(); err != nil {
+		return nil, err
+	}
+	return s.Profile(ctx, &pb.ApiEmpty{})
+}
+
+func (s *grpcApi) Contacts(ctx context.Context, req *pb.ApiEmpty) (*pb.ContactList, error) {
+	return s.node.Contacts(), nil
+}
+
+func (s *grpcApi) Contact(ctx context.Context, req *pb.ApiIdRequest) (*pb.Contact, error) {
+	contact := s.node.Contact(req.Id)
+	if contact == nil {
+		return nil, status.Errorf(codes.NotFound, "contact not found")
+	}
+	return contact, nil
+}
+
+func (s *grpcApi) AddContact(ctx context.Context, req *pb.Contact) (*pb.ApiEmpty, error) {
+	contact := req
+	if contact.Address == "" || len(contact.Peers) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contact")
+	}
+	if err := s.node.AddContact(contact); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) RemoveContact(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiEmpty, error) {
+	address := req.Id
+	if s.node.Contact(address) == nil {
+		return nil, status.Errorf(codes.NotFound, "contact not found")
+	}
+	if err := s.node.RemoveContact(address); err != nil {
+		return nil, err
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) SearchContacts(req *pb.ApiSearchContactsRequest, srv pb.Api_SearchContactsServer) error {
+	ctx := srv.Context()
+	sreq := req
+	query := sreq.Query
+	if query == nil {
+		query = &pb.ContactQuery{}
+	}
+	options := sreq.Options
+	if options == nil {
+		options = &pb.QueryOptions{Limit: 5, Wait: 5}
+	}
+
+	resCh, errCh, cancel, err := s.node.SearchContacts(query, options)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return grpcSearchStream(ctx, resCh, errCh, cancel, srv.Send)
+}
+
+// Mill processes data, or an existing file, with a mill, returning the file index
+func (s *grpcApi) Mill(ctx context.Context, req *pb.ApiMillRequest) (*pb.FileIndex, error) {
+	mreq := req
+	mill, err := millForId(mreq.Mill, mreq.Opts)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	if mreq.Use == "" && len(mreq.Data) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "missing data")
+	}
+
+	file, err := s.node.millData(mill, mreq.Data, mreq.Name, mreq.Use, mreq.Plaintext)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return file, nil
+}
+
+func (s *grpcApi) AddThread(ctx context.Context, req *pb.AddThreadConfig) (*pb.Thread, error) {
+	config := *req
+	if config.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing thread name")
+	}
+	if config.Key == "" {
+		config.Key = ksuid.New().String()
+	}
+
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	thrd, err := s.node.AddThread(config, sk, s.node.account.Address(), true, true)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return s.node.ThreadView(thrd.Id)
+}
+
+func (s *grpcApi) AddOrUpdateThread(ctx context.Context, req *pb.ApiAddOrUpdateThreadRequest) (*pb.ApiEmpty, error) {
+	treq := req
+	if treq.Thread == nil || treq.Thread.Id == "" || len(treq.Thread.Sk) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid thread")
+	}
+
+	var err error
+	if treq.Restore {
+		err = s.node.RestoreThread(treq.Thread)
+	} else {
+		err = s.node.AddOrUpdateThread(treq.Thread)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) RenameThread(ctx context.Context, req *pb.ApiRenameThreadRequest) (*pb.ApiEmpty, error) {
+	rreq := req
+	if rreq.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing thread name")
+	}
+	if err := s.node.RenameThread(s.threadId(rreq.Id), rreq.Name); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) Threads(ctx context.Context, req *pb.ApiEmpty) (*pb.ThreadList, error) {
+	views := &pb.ThreadList{
+		Items: make([]*pb.Thread, 0),
+	}
+	for _, thrd := range s.node.Threads() {
+		view, err := s.node.ThreadView(thrd.Id)
+		if err != nil {
+			return nil, err
+		}
+		views.Items = append(views.Items, view)
+	}
+	return views, nil
+}
+
+func (s *grpcApi) Thread(ctx context.Context, req *pb.ApiIdRequest) (*pb.Thread, error) {
+	view, err := s.node.ThreadView(s.threadId(req.Id))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", ErrThreadNotFound)
+	}
+	return view, nil
+}
+
+func (s *grpcApi) ThreadPeers(ctx context.Context, req *pb.ApiIdRequest) (*pb.PeerList, error) {
+	peers, err := s.node.ThreadPeers(s.threadId(req.Id))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	return peers, nil
+}
+
+func (s *grpcApi) RemoveThread(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiEmpty, error) {
+	id := s.threadId(req.Id)
+	if s.node.Thread(id) == nil {
+		return nil, status.Errorf(codes.NotFound, "%s", ErrThreadNotFound)
+	}
+	if _, err := s.node.RemoveThread(id); err != nil {
+		return nil, err
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) CreateThreadSnapshots(ctx context.Context, req *pb.ApiEmpty) (*pb.ApiEmpty, error) {
+	if err := s.node.SnapshotThreads(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) SearchThreadSnapshots(req *pb.ApiSearchThreadSnapshotsRequest, srv pb.Api_SearchThreadSnapshotsServer) error {
+	ctx := srv.Context()
+	sreq := req
+	query := sreq.Query
+	if query == nil {
+		query = &pb.ThreadSnapshotQuery{}
+	}
+	query.Address = s.node.account.Address()
+	options := sreq.Options
+	if options == nil {
+		options = &pb.QueryOptions{Wait: 5}
+	}
+	options.Limit = -1
+
+	resCh, errCh, cancel, err := s.node.SearchThreadSnapshots(query, options)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return grpcSearchStream(ctx, resCh, errCh, cancel, srv.Send)
+}
+
+func (s *grpcApi) Blocks(ctx context.Context, req *pb.ApiListRequest) (*pb.BlockList, error) {
+	lreq := req
+	if lreq.Thread == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing thread id")
+	}
+	thrd := s.node.Thread(s.threadId(lreq.Thread))
+	if thrd == nil {
+		return nil, status.Errorf(codes.NotFound, "%s", ErrThreadNotFound)
+	}
+
+	query := fmt.Sprintf("threadId='%s'", thrd.Id)
+	blocks := s.node.datastore.Blocks().List(lreq.Offset, listLimit(lreq.Limit, 5), query)
+	for _, block := range blocks.Items {
+		block.User = s.node.PeerUser(block.Author)
+	}
+	blocks.Count = int32(len(blocks.Items))
+	blocks.Next = s.node.nextBlockOffset(blocks, query)
+	return blocks, nil
+}
+
+// BlockViz renders a page of blocks as a graphviz digraph
+func (s *grpcApi) BlockViz(ctx context.Context, req *pb.ApiListRequest) (*pb.BlockViz, error) {
+	blocks, err := s.Blocks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	dots, err := s.node.toDots(blocks)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.BlockViz{
+		Dots:  dots,
+		Count: blocks.Count,
+		Next:  blocks.Next,
+	}, nil
+}
+
+func (s *grpcApi) Block(ctx context.Context, req *pb.ApiIdRequest) (*pb.Block, error) {
+	block, err := s.node.BlockView(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	return block, nil
+}
+
+// RemoveBlock ignores a block, returning the ignore block
+func (s *grpcApi) RemoveBlock(ctx context.Context, req *pb.ApiIdRequest) (*pb.Block, error) {
+	id := req.Id
+	thrd, err := s.blockThread(id)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := thrd.AddIgnore(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.node.BlockView(hash.B58String())
+}
+
+func (s *grpcApi) AddComment(ctx context.Context, req *pb.ApiAddCommentRequest) (*pb.Comment, error) {
+	creq := req
+	if creq.Body == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing comment body")
+	}
+	thrd, err := s.blockThread(creq.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := thrd.AddComment(creq.Block, creq.Body)
+	if err != nil {
+		return nil, err
+	}
+	return s.node.Comment(hash.B58String())
+}
+
+func (s *grpcApi) Comments(ctx context.Context, req *pb.ApiIdRequest) (*pb.CommentList, error) {
+	return s.node.Comments(req.Id)
+}
+
+func (s *grpcApi) Comment(ctx context.Context, req *pb.ApiIdRequest) (*pb.Comment, error) {
+	comment, err := s.node.Comment(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	return comment, nil
+}
+
+func (s *grpcApi) AddLike(ctx context.Context, req *pb.ApiIdRequest) (*pb.Like, error) {
+	id := req.Id
+	thrd, err := s.blockThread(id)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := thrd.AddLike(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.node.Like(hash.B58String())
+}
+
+func (s *grpcApi) Likes(ctx context.Context, req *pb.ApiIdRequest) (*pb.LikeList, error) {
+	return s.node.Likes(req.Id)
+}
+
+func (s *grpcApi) Like(ctx context.Context, req *pb.ApiIdRequest) (*pb.Like, error) {
+	like, err := s.node.Like(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	return like, nil
+}
+
+func (s *grpcApi) AddMessage(ctx context.Context, req *pb.ApiAddMessageRequest) (*pb.Text, error) {
+	mreq := req
+	if mreq.Body == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing message body")
+	}
+	thrd := s.node.Thread(s.threadId(mreq.Thread))
+	if thrd == nil {
+		return nil, status.Errorf(codes.NotFound, "%s", ErrThreadNotFound)
+	}
+
+	hash, err := thrd.AddMessage(mreq.Body)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return s.node.Message(hash.B58String())
+}
+
+func (s *grpcApi) Messages(ctx context.Context, req *pb.ApiListRequest) (*pb.TextList, error) {
+	lreq := req
+	threadId := s.threadId(lreq.Thread)
+	if threadId != "" && s.node.Thread(threadId) == nil {
+		return nil, status.Errorf(codes.NotFound, "%s", ErrThreadNotFound)
+	}
+
+	list, err := s.node.Messages(lreq.Offset, listLimit(lreq.Limit, 10), threadId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return list, nil
+}
+
+func (s *grpcApi) Message(ctx context.Context, req *pb.ApiIdRequest) (*pb.Text, error) {
+	msg, err := s.node.Message(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	return msg, nil
+}
+
+// AddFiles adds milled files to a thread
+func (s *grpcApi) AddFiles(ctx context.Context, req *pb.ApiAddFilesRequest) (*pb.Files, error) {
+	freq := req
+	thrd := s.node.Thread(s.threadId(freq.Thread))
+	if thrd == nil {
+		return nil, status.Errorf(codes.NotFound, "%s", ErrThreadNotFound)
+	}
+	dirs := freq.Dirs
+	if dirs == nil {
+		dirs = &pb.DirectoryList{}
+	}
+
+	files, err := s.node.addThreadDirs(thrd, dirs, freq.Caption)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return files, nil
+}
+
+func (s *grpcApi) Files(ctx context.Context, req *pb.ApiListRequest) (*pb.FilesList, error) {
+	lreq := req
+	threadId := s.threadId(lreq.Thread)
+	if threadId != "" && s.node.Thread(threadId) == nil {
+		return nil, status.Errorf(codes.NotFound, "%s", ErrThreadNotFound)
+	}
+
+	list, err := s.node.Files(lreq.Offset, listLimit(lreq.Limit, 5), threadId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return list, nil
+}
+
+func (s *grpcApi) File(ctx context.Context, req *pb.ApiIdRequest) (*pb.Files, error) {
+	files, err := s.node.File(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	return files, nil
+}
+
+// FileData streams the decrypted data of a file in chunks
+func (s *grpcApi) FileData(req *pb.ApiIdRequest, srv pb.Api_FileDataServer) error {
+	ctx := srv.Context()
+	reader, _, err := s.node.OpenFileData(ctx, req.Id)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "%s", err)
+	}
+	defer reader.Close()
+	return grpcSendData(ctx, reader, srv.Send)
+}
+
+func (s *grpcApi) FileKeys(ctx context.Context, req *pb.ApiIdRequest) (*pb.Keys, error) {
+	node, err := ipfs.NodeAtPath(s.node.Ipfs(), req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	keys, err := s.node.TargetNodeKeys(node)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return keys, nil
+}
+
+// Feed streams feed items a page at a time, a zero limit streams the whole feed
+func (s *grpcApi) Feed(req *pb.FeedRequest, srv pb.Api_FeedServer) error {
+	ctx := srv.Context()
+	freq := *req
+	freq.Thread = s.threadId(freq.Thread)
+	if freq.Thread != "" && s.node.Thread(freq.Thread) == nil {
+		return status.Errorf(codes.NotFound, "%s", ErrThreadNotFound)
+	}
+
+	remaining := int(freq.Limit)
+	for {
+		freq.Limit = grpcFeedPageSize
+		if remaining > 0 && remaining < grpcFeedPageSize {
+			freq.Limit = int32(remaining)
+		}
+		list, err := s.node.Feed(&freq)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+		for _, item := range list.Items {
+			if err := srv.Send(item); err != nil {
+				return err
+			}
+		}
+
+		if remaining > 0 {
+			remaining -= len(list.Items)
+			if remaining <= 0 {
+				return nil
+			}
+		}
+		if list.Next == "" || len(list.Items) == 0 {
+			return nil
+		}
+		freq.Offset = list.Next
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// Subscribe streams thread updates until the call is canceled
+func (s *grpcApi) Subscribe(req *pb.ApiSubscribeRequest, srv pb.Api_SubscribeServer) error {
+	ctx := srv.Context()
+	sreq := req
+	threadId := s.threadId(sreq.Thread)
+	var types []string
+	for _, t := range sreq.Types {
+		types = append(types, strings.ToUpper(strings.TrimSpace(t)))
+	}
+
+	listener := s.node.ThreadUpdateListener()
+	defer listener.Close()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case value, ok := <-listener.Ch:
+			if !ok {
+				return nil
+			}
+			update, ok := value.(*pb.FeedItem)
+			if !ok || (threadId != "" && update.Thread != threadId) {
+				continue
+			}
+			if len(types) > 0 {
+				btype, err := FeedItemType(update)
+				if err != nil {
+					log.Error(err.Error())
+					continue
+				}
+				if !util.ListContainsString(types, btype.String()) {
+					continue
+				}
+			}
+			if err := srv.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// AddInvite invites an account to a thread, or creates an external invite if no address is given
+func (s *grpcApi) AddInvite(ctx context.Context, req *pb.ApiAddInviteRequest) (*pb.ExternalInvite, error) {
+	ireq := req
+	threadId := s.threadId(ireq.Thread)
+
+	if ireq.Address != "" {
+		if err := s.node.AddInvite(threadId, ireq.Address); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+		return &pb.ExternalInvite{}, nil
+	}
+
+	invite, err := s.node.AddExternalInvite(threadId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return invite, nil
+}
+
+func (s *grpcApi) Invites(ctx context.Context, req *pb.ApiEmpty) (*pb.InviteViewList, error) {
+	return s.node.Invites(), nil
+}
+
+// AcceptInvite accepts a peer invite, or an external invite if a key is given
+func (s *grpcApi) AcceptInvite(ctx context.Context, req *pb.ApiAcceptInviteRequest) (*pb.Block, error) {
+	ireq := req
+
+	var hash mh.Multihash
+	var err error
+	if ireq.Key != "" {
+		var key []byte
+		key, err = base58.Decode(ireq.Key)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+		hash, err = s.node.AcceptExternalInvite(ireq.Id, key)
+	} else {
+		hash, err = s.node.AcceptInvite(ireq.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	if hash == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "thread already exists")
+	}
+	return s.node.BlockView(hash.B58String())
+}
+
+func (s *grpcApi) IgnoreInvite(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiEmpty, error) {
+	if err := s.node.IgnoreInvite(req.Id); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) Notifications(ctx context.Context, req *pb.NotificationRequest) (*pb.NotificationList, error) {
+	nreq := *req
+	nreq.Thread = s.threadId(nreq.Thread)
+	nreq.Limit = int32(listLimit(nreq.Limit, -1))
+	return s.node.FilterNotifications(&nreq), nil
+}
+
+func (s *grpcApi) ReadNotification(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiEmpty, error) {
+	id := req.Id
+	var err error
+	if id == "all" {
+		err = s.node.ReadAllNotifications()
+	} else {
+		err = s.node.ReadNotification(id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) RemoveNotification(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiEmpty, error) {
+	id := req.Id
+	if id == "all" {
+		if err := s.node.RemoveNotifications(&pb.NotificationRequest{}); err != nil {
+			return nil, err
+		}
+		return &pb.ApiEmpty{}, nil
+	}
+
+	if err := s.node.RemoveNotification(id); err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) AddWebhook(ctx context.Context, req *pb.ApiWebhook) (*pb.ApiWebhook, error) {
+	wreq := req
+	if wreq.Url == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing webhook URL")
+	}
+	var threads []string
+	for _, id := range wreq.Threads {
+		threads = append(threads, s.threadId(id))
+	}
+
+	hook, err := s.node.AddWebhook(config.Webhook{
+		URL:     wreq.Url,
+		Secret:  wreq.Secret,
+		Threads: threads,
+		Types:   wreq.Types,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return grpcWebhook(*hook), nil
+}
+
+func (s *grpcApi) Webhooks(ctx context.Context, req *pb.ApiEmpty) (*pb.ApiWebhookList, error) {
+	list := &pb.ApiWebhookList{
+		Items: make([]*pb.ApiWebhook, 0),
+	}
+	for _, hook := range s.node.Webhooks() {
+		list.Items = append(list.Items, grpcWebhook(hook))
+	}
+	return list, nil
+}
+
+func (s *grpcApi) RemoveWebhook(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiEmpty, error) {
+	if err := s.node.RemoveWebhook(req.Id); err != nil {
+		if err == ErrWebhookNotFound {
+			return nil, status.Errorf(codes.NotFound, "%s", err)
+		}
+		return nil, err
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) AddCafe(ctx context.Context, req *pb.ApiAddCafeRequest) (*pb.CafeSession, error) {
+	creq := req
+	if creq.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing cafe host")
+	}
+	if creq.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing access token")
+	}
+	return s.node.RegisterCafe(creq.Host, creq.Token)
+}
+
+func (s *grpcApi) CafeSessions(ctx context.Context, req *pb.ApiEmpty) (*pb.CafeSessionList, error) {
+	return s.node.CafeSessions(), nil
+}
+
+func (s *grpcApi) CafeSession(ctx context.Context, req *pb.ApiIdRequest) (*pb.CafeSession, error) {
+	session, err := s.node.CafeSession(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, status.Errorf(codes.NotFound, "cafe not found")
+	}
+	return session, nil
+}
+
+func (s *grpcApi) UpdateCafe(ctx context.Context, req *pb.ApiUpdateCafeRequest) (*pb.CafeSession, error) {
+	ureq := req
+	if session, _ := s.node.CafeSession(ureq.Id); session == nil {
+		return nil, status.Errorf(codes.NotFound, "cafe not found")
+	}
+	return s.node.UpdateCafePriority(ureq.Id, int(ureq.Priority))
+}
+
+func (s *grpcApi) RemoveCafe(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiEmpty, error) {
+	if err := s.node.DeregisterCafe(req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) CheckCafeMessages(ctx context.Context, req *pb.ApiEmpty) (*pb.ApiEmpty, error) {
+	if err := s.node.CheckCafeMessages(); err != nil {
+		return nil, err
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) CafeRequests(ctx context.Context, req *pb.ApiCafeRequestsRequest) (*pb.CafeRequestList, error) {
+	rreq := req
+	limit := listLimit(rreq.Limit, -1)
+	if rreq.Failed {
+		return s.node.FailedCafeRequests(rreq.Offset, limit), nil
+	}
+	return s.node.CafeRequests(rreq.Offset, limit), nil
+}
+
+func (s *grpcApi) RetryCafeRequests(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiCount, error) {
+	n, err := s.node.RetryCafeRequests(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApiCount{Count: int32(n)}, nil
+}
+
+func (s *grpcApi) PurgeCafeRequests(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiCount, error) {
+	n, err := s.node.PurgeCafeRequests(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApiCount{Count: int32(n)}, nil
+}
+
+func (s *grpcApi) CreateApiKey(ctx context.Context, req *pb.ApiCreateApiKeyRequest) (*pb.ApiKey, error) {
+	kreq := req
+	conf := ApiKeyConfig{
+		Name:   kreq.Name,
+		Scopes: kreq.Scopes,
+	}
+	if kreq.Expires != nil {
+		var err error
+		conf.Expires, err = ptypes.Timestamp(kreq.Expires)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+	}
+
+	key, err := s.node.CreateApiKey(conf)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return key, nil
+}
+
+func (s *grpcApi) ApiKeys(ctx context.Context, req *pb.ApiEmpty) (*pb.ApiKeyList, error) {
+	return s.node.ApiKeys(), nil
+}
+
+func (s *grpcApi) RemoveApiKey(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiEmpty, error) {
+	if err := s.node.RemoveApiKey(req.Id); err != nil {
+		if err == ErrApiKeyNotFound {
+			return nil, status.Errorf(codes.NotFound, "%s", err)
+		}
+		return nil, err
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) CreateToken(ctx context.Context, req *pb.ApiCreateTokenRequest) (*pb.ApiValue, error) {
+	treq := req
+	conf := CafeTokenConfig{
+		Scopes: treq.Scopes,
+		Plan:   treq.Plan,
+		Label:  treq.Label,
+	}
+	if treq.Expires != nil {
+		var err error
+		conf.Expires, err = ptypes.Timestamp(treq.Expires)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+	}
+
+	token, err := s.node.CreateCafeTokenWithConfig(treq.Token, treq.Store, conf)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.ApiValue{Value: token}, nil
+}
+
+func (s *grpcApi) Tokens(ctx context.Context, req *pb.ApiEmpty) (*pb.CafeTokenUsageList, error) {
+	return s.node.CafeTokensUsage(), nil
+}
+
+func (s *grpcApi) ValidateToken(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiEmpty, error) {
+	ok, err := s.node.ValidateCafeToken(req.Id)
+	if err != nil || !ok {
+		return nil, status.Errorf(codes.NotFound, "invalid token")
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) RemoveToken(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiEmpty, error) {
+	if err := s.node.RemoveCafeToken(req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) CafeClients(ctx context.Context, req *pb.ApiEmpty) (*pb.CafeClientList, error) {
+	return s.node.CafeClients(), nil
+}
+
+func (s *grpcApi) EvictCafeClient(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiEmpty, error) {
+	if err := s.node.EvictCafeClient(req.Id); err != nil {
+		if err == ErrCafeClientNotFound {
+			return nil, status.Errorf(codes.NotFound, "%s", err)
+		}
+		return nil, err
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) CafeClientsUsage(ctx context.Context, req *pb.ApiEmpty) (*pb.CafeClientUsageList, error) {
+	return s.node.CafeClientsUsage(), nil
+}
+
+func (s *grpcApi) CafeClientUsage(ctx context.Context, req *pb.ApiIdRequest) (*pb.CafeClientUsage, error) {
+	usage, err := s.node.CafeClientUsage(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	return usage, nil
+}
+
+func (s *grpcApi) CafeReplication(ctx context.Context, req *pb.ApiEmpty) (*pb.CafeReplicationStatus, error) {
+	return s.node.CafeReplicationStatus(), nil
+}
+
+func (s *grpcApi) IpfsId(ctx context.Context, req *pb.ApiEmpty) (*pb.ApiValue, error) {
+	pid, err := s.node.PeerId()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApiValue{Value: pid.Pretty()}, nil
+}
+
+// IpfsCat streams the data at an ipfs path in chunks, decrypting it if a key is given
+func (s *grpcApi) IpfsCat(req *pb.ApiIpfsCatRequest, srv pb.Api_IpfsCatServer) error {
+	ctx := srv.Context()
+	creq := req
+	if creq.Path == "" {
+		return status.Errorf(codes.InvalidArgument, "missing ipfs path")
+	}
+
+	data, err := s.node.DataAtPath(creq.Path)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "%s", err)
+	}
+	if creq.Key != "" {
+		key, err := base58.Decode(creq.Key)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+		data, err = crypto.DecryptAES(data, key)
+		if err != nil {
+			return status.Errorf(codes.PermissionDenied, "%s", err)
+		}
+	}
+	return grpcSendData(ctx, bytes.NewReader(data), srv.Send)
+}
+
+func (s *grpcApi) IpfsSwarmConnect(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiValueList, error) {
+	addr := req.Id
+	if addr == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing peer multi address")
+	}
+	res, err := ipfs.SwarmConnect(s.node.node, []string{addr})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.ApiValueList{Items: res}, nil
+}
+
+func (s *grpcApi) IpfsSwarmPeers(ctx context.Context, req *pb.ApiIpfsSwarmPeersRequest) (*pb.ApiIpfsSwarmPeerList, error) {
+	preq := req
+	res, err := ipfs.SwarmPeers(s.node.node, preq.Verbose, preq.Latency, preq.Streams, preq.Direction)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	list := &pb.ApiIpfsSwarmPeerList{
+		Items: make([]*pb.ApiIpfsSwarmPeer, 0),
+	}
+	for _, info := range res.Peers {
+		item := &pb.ApiIpfsSwarmPeer{
+			Addr:      info.Addr,
+			Peer:      info.Peer,
+			Latency:   info.Latency,
+			Muxer:     info.Muxer,
+			Direction: int32(info.Direction),
+		}
+		for _, stream := range info.Streams {
+			item.Streams = append(item.Streams, stream.Protocol)
+		}
+		list.Items = append(list.Items, item)
+	}
+	return list, nil
+}
+
+// Logs lists log levels, first setting them if a level is given
+func (s *grpcApi) Logs(ctx context.Context, req *pb.ApiLogsRequest) (*pb.ApiLogLevels, error) {
+	lreq := req
+	systems, err := logLevels(lreq.Subsystem, lreq.Level, lreq.TexOnly)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.ApiLogLevels{Systems: systems}, nil
+}
+
+// Config returns the active config as json, or the value at a path, e.g., Addresses/API
+func (s *grpcApi) Config(ctx context.Context, req *pb.ApiIdRequest) (*pb.ApiJson, error) {
+	pth := strings.Trim(req.Id, "/")
+	var value interface{} = s.node.Config()
+	if pth != "" {
+		var err error
+		value, err = getKeyValue(pth, value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+	}
+
+	jsn, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApiJson{Json: string(jsn)}, nil
+}
+
+func (s *grpcApi) SetConfig(ctx context.Context, req *pb.ApiJson) (*pb.ApiEmpty, error) {
+	if err := s.node.setConfigFile([]byte(req.Json)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+func (s *grpcApi) PatchConfig(ctx context.Context, req *pb.ApiJson) (*pb.ApiEmpty, error) {
+	if err := s.node.patchConfigFile([]byte(req.Json)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return &pb.ApiEmpty{}, nil
+}
+
+// threadId resolves the 'default' thread alias
+func (s *grpcApi) threadId(id string) string {
+	if id == "default" {
+		return s.node.config.Threads.Defaults.ID
+	}
+	return id
+}
+
+// blockThread returns the thread of a block
+func (s *grpcApi) blockThread(id string) (*Thread, error) {
+	block, err := s.node.Block(id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "block not found")
+	}
+	thrd := s.node.Thread(block.Thread)
+	if thrd == nil {
+		return nil, status.Errorf(codes.NotFound, "%s", ErrThreadNotFound)
+	}
+	return thrd, nil
+}
+
+// grpcWebhook converts a config webhook to its message
+func grpcWebhook(hook config.Webhook) *pb.ApiWebhook {
+	return &pb.ApiWebhook{
+		Id:      hook.ID,
+		Url:     hook.URL,
+		Secret:  hook.Secret,
+		Threads: hook.Threads,
+		Types:   hook.Types,
+	}
+}
+
+// grpcSendData sends data from reader in chunks until EOF or the call is canceled
+func grpcSendData(ctx context.Context, reader io.Reader, send func(*pb.ApiData) error) error {
+	buf := make([]byte, grpcDataChunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if err := send(&pb.ApiData{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// grpcSearchStream sends query results until the search is done or the call is canceled
+func grpcSearchStream(ctx context.Context, resCh <-chan *pb.QueryResult, errCh <-chan error, cancel *broadcast.Broadcaster, send func(*pb.QueryResult) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			cancel.Close()
+			return ctx.Err()
+
+		case err := <-errCh:
+			return status.Errorf(codes.InvalidArgument, "%s", err)
+
+		case res, ok := <-resCh:
+			if !ok {
+				return nil
+			}
+			if err := send(res); err != nil {
+				cancel.Close()
+				return err
+			}
+		}
+	}
+}
+
+// listLimit returns limit, or def if limit is unset
+func listLimit(limit int32, def int) int {
+	if limit == 0 {
+		return def
+	}
+	return int(limit)
+}
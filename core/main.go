@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"os"
@@ -53,6 +54,7 @@ type InitConfig struct {
 	SwarmPorts      string
 	ApiAddr         string
 	CafeApiAddr     string
+	GrpcAddr        string
 	GatewayAddr     string
 	ProfilingAddr   string
 	IsMobile        bool
@@ -62,6 +64,11 @@ type InitConfig struct {
 	CafeOpen        bool
 	CafeURL         string
 	CafeNeighborURL string
+	TLS             bool
+	TLSCertFile     string
+	TLSKeyFile      string
+	TLSACME         bool
+	TLSACMEEmail    string
 }
 
 // MigrateConfig is used to define options during a major migration
@@ -92,17 +99,24 @@ type Textile struct {
 	online            chan struct{}
 	done              chan struct{}
 	updates           chan *pb.WalletUpdate
+	walletUpdates     *broadcast.Broadcaster
 	threadUpdates     *broadcast.Broadcaster
 	notifications     chan *pb.Notification
+	notificationFeed  *broadcast.Broadcaster
 	threads           *ThreadsService
 	blockOutbox       *BlockOutbox
 	cafe              *CafeService
 	cafeOutbox        *CafeOutbox
 	cafeOutboxHandler CafeOutboxHandler
 	cafeInbox         *CafeInbox
+	webhookOutbox     *WebhookOutbox
+	replicationOutbox *ReplicationOutbox
 	cancelSync        *broadcast.Broadcaster
 	mux               sync.Mutex
 	writer            io.Writer
+	tlsConf           *tls.Config
+	tlsMux            sync.Mutex
+	configMux         sync.Mutex
 }
 
 // common errors
@@ -208,8 +222,10 @@ func NewTextile(conf RunConfig) (*Textile, error) {
 	node := &Textile{
 		repoPath:          conf.RepoPath,
 		updates:           make(chan *pb.WalletUpdate, 10),
+		walletUpdates:     broadcast.NewBroadcaster(10),
 		threadUpdates:     broadcast.NewBroadcaster(10),
 		notifications:     make(chan *pb.Notification, 10),
+		notificationFeed:  broadcast.NewBroadcaster(10),
 		cafeOutboxHandler: conf.CafeOutboxHandler,
 	}
 
@@ -291,6 +307,14 @@ func (t *Textile) Start() error {
 		return err
 	}
 
+	// the cafe api starts after the node is online, so load its tls config
+	// here, where a bad config can still fail startup
+	if t.config.Cafe.Host.Open {
+		if _, err := t.TLSConfig(); err != nil {
+			return fmt.Errorf("error loading cafe api tls config: %s", err)
+		}
+	}
+
 	// create queues
 	t.cafeInbox = NewCafeInbox(
 		t.cafeService,
@@ -298,6 +322,7 @@ func (t *Textile) Start() error {
 		t.Ipfs,
 		t.datastore)
 	t.cafeOutbox = NewCafeOutbox(
+		t.cafeService,
 		t.Ipfs,
 		t.datastore,
 		t.cafeOutboxHandler)
@@ -306,6 +331,13 @@ func (t *Textile) Start() error {
 		t.Ipfs,
 		t.datastore,
 		t.cafeOutbox)
+	t.webhookOutbox = NewWebhookOutbox(
+		t.Webhooks,
+		t.datastore)
+	t.replicationOutbox = NewReplicationOutbox(
+		t.cafeService,
+		t.datastore,
+		t.config)
 
 	// create services
 	t.threads = NewThreadsService(
@@ -320,7 +352,10 @@ func (t *Textile) Start() error {
 		t.account,
 		t.Ipfs,
 		t.datastore,
-		t.cafeInbox)
+		t.config,
+		t.cafeInbox,
+		t.cafeOutbox,
+		t.replicationOutbox)
 
 	if t.cafeOutbox.handler == nil {
 		t.cafeOutbox.handler = t.cafe
@@ -348,7 +383,9 @@ func (t *Textile) Start() error {
 			go func() {
 				t.cafe.setAddrs(t.config)
 				t.cafe.open = true
-				t.startCafeApi(t.config.Addresses.CafeAPI)
+				if err := t.startCafeApi(t.config.Addresses.CafeAPI); err != nil {
+					log.Errorf("error starting cafe api: %s", err)
+				}
 			}()
 		}
 
@@ -436,8 +473,10 @@ func (t *Textile) Stop() error {
 // CloseChns closes update channels
 func (t *Textile) CloseChns() {
 	close(t.updates)
+	t.walletUpdates.Close()
 	t.threadUpdates.Close()
 	close(t.notifications)
+	t.notificationFeed.Close()
 }
 
 // Started returns node started status
@@ -493,11 +532,23 @@ func (t *Textile) UpdateCh() <-chan *pb.WalletUpdate {
 	return t.updates
 }
 
+// WalletUpdateListener returns a listener for wallet updates, which unlike UpdateCh
+// can be used by more than one consumer
+func (t *Textile) WalletUpdateListener() *broadcast.Listener {
+	return t.walletUpdates.Listen()
+}
+
 // ThreadUpdateListener returns the thread update channel
 func (t *Textile) ThreadUpdateListener() *broadcast.Listener {
 	return t.threadUpdates.Listen()
 }
 
+// NotificationListener returns a listener for notifications, which unlike NotificationCh
+// can be used by more than one consumer
+func (t *Textile) NotificationListener() *broadcast.Listener {
+	return t.notificationFeed.Listen()
+}
+
 // NotificationsCh returns the notifications channel
 func (t *Textile) NotificationCh() <-chan *pb.Notification {
 	return t.notifications
@@ -518,6 +569,12 @@ func (t *Textile) DataAtPath(path string) ([]byte, error) {
 	return ipfs.DataAtPath(t.node, path)
 }
 
+// ReaderAtPath returns a seekable reader of the data behind an ipfs path, which must be closed.
+// Reads are canceled along with ctx.
+func (t *Textile) ReaderAtPath(ctx context.Context, path string) (ReadSeekCloser, error) {
+	return ipfs.ReaderAtPath(ctx, t.node, path)
+}
+
 // LinksAtPath returns ipld links behind an ipfs path
 func (t *Textile) LinksAtPath(path string) ([]*ipld.Link, error) {
 	return ipfs.LinksAtPath(t.node, path)
@@ -607,6 +664,10 @@ func (t *Textile) runJobs() {
 
 	go t.flushQueues()
 	t.maybeSyncAccount()
+	t.maybePruneNotifications()
+	t.maybeReapCafeMessages()
+	t.maybeReapCafeUploads()
+	t.maybeCheckCafeHealth()
 	t.runGC()
 
 	for {
@@ -619,6 +680,10 @@ func (t *Textile) runJobs() {
 
 			go t.flushQueues()
 			t.maybeSyncAccount()
+			t.maybePruneNotifications()
+			t.maybeReapCafeMessages()
+			t.maybeReapCafeUploads()
+			t.maybeCheckCafeHealth()
 
 		case <-t.done:
 			return
@@ -630,6 +695,8 @@ func (t *Textile) runJobs() {
 func (t *Textile) flushQueues() {
 	t.cafeOutbox.Flush()
 	t.blockOutbox.Flush()
+	t.webhookOutbox.Flush()
+	t.replicationOutbox.Flush()
 	if err := t.cafeInbox.CheckMessages(); err != nil {
 		log.Errorf("error checking messages: %s", err)
 	}
@@ -698,6 +765,7 @@ func (t *Textile) sendUpdate(update *pb.WalletUpdate) {
 		return
 	}
 	t.updates <- update
+	t.walletUpdates.Send(update)
 }
 
 // sendThreadUpdate sends a feed item to the update channel
@@ -713,6 +781,12 @@ func (t *Textile) sendThreadUpdate(block *pb.Block, key string) {
 	}
 
 	t.threadUpdates.Send(update)
+
+	if err := t.webhookOutbox.Add(update); err != nil {
+		log.Errorf("error adding webhook messages: %s", err)
+		return
+	}
+	go t.webhookOutbox.Flush()
 }
 
 // sendNotification adds a notification to the notification channel
@@ -721,7 +795,9 @@ func (t *Textile) sendNotification(note *pb.Notification) error {
 		return err
 	}
 
-	t.notifications <- t.NotificationView(note)
+	view := t.NotificationView(note)
+	t.notifications <- view
+	t.notificationFeed.Send(view)
 	return nil
 }
 
@@ -1,22 +1,42 @@
 package core_test
 
 import (
+	"bytes"
+	"context"
 	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"mime/multipart"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/textileio/go-textile/util"
 
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/gorilla/websocket"
 	libp2pc "github.com/libp2p/go-libp2p-crypto"
 	"github.com/segmentio/ksuid"
 	. "github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/crypto"
 	"github.com/textileio/go-textile/keypair"
 	"github.com/textileio/go-textile/mill"
 	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
 	"github.com/textileio/go-textile/schema/textile"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 var repoPath = "testdata/.textile"
@@ -111,6 +131,148 @@ func TestTextile_API_Health(t *testing.T) {
 	util.TestURL(t, addr, http.MethodGet, http.StatusNoContent)
 }
 
+func TestTextile_API_Auth(t *testing.T) {
+	if !node.Config().API.Auth {
+		t.Fatal("api auth should be enabled by default")
+	}
+	addr := "http://" + node.ApiAddr() + "/api/v0/"
+
+	// the api listens in the background
+	waitListening(node.ApiAddr())
+
+	if code := apiStatus(t, "GET", addr+"account/address", ""); code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without a key, got %d", code)
+	}
+
+	reader, err := node.CreateApiKey(ApiKeyConfig{Name: "reader", Scopes: []string{"read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := apiStatus(t, "GET", addr+"account/address", reader.Token); code != http.StatusOK {
+		t.Fatalf("expected 200 with a read key, got %d", code)
+	}
+	if code := apiStatus(t, "POST", addr+"profile/name", reader.Token); code != http.StatusForbidden {
+		t.Fatalf("expected 403 writing with a read key, got %d", code)
+	}
+	if code := apiStatus(t, "GET", addr+"account/seed", reader.Token); code != http.StatusForbidden {
+		t.Fatalf("expected 403 for seed with a read key, got %d", code)
+	}
+	if code := apiStatus(t, "GET", addr+"config", reader.Token); code != http.StatusForbidden {
+		t.Fatalf("expected 403 for config with a read key, got %d", code)
+	}
+
+	admin, err := node.CreateApiKey(ApiKeyConfig{Name: "admin", Scopes: []string{"admin", "seed"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := apiStatus(t, "GET", addr+"account/seed", admin.Token); code != http.StatusOK {
+		t.Fatalf("expected 200 for seed with a seed key, got %d", code)
+	}
+	if code := apiStatus(t, "GET", addr+"config", admin.Token); code != http.StatusOK {
+		t.Fatalf("expected 200 for config with an admin key, got %d", code)
+	}
+
+	// revoked keys are rejected
+	if code := apiStatus(t, "DELETE", addr+"api-keys/"+reader.Id, admin.Token); code != http.StatusNoContent {
+		t.Fatalf("expected 204 removing a key, got %d", code)
+	}
+	if code := apiStatus(t, "GET", addr+"account/address", reader.Token); code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 with a revoked key, got %d", code)
+	}
+	if len(node.ApiKeys().Items) != 1 {
+		t.Error("expected one remaining key")
+	}
+}
+
+func TestTextile_API_V1(t *testing.T) {
+	key, err := node.CreateApiKey(ApiKeyConfig{Name: "v1", Scopes: []string{"read", "write"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := "http://" + node.ApiAddr() + "/api/v1/"
+
+	// args may contain commas and equal signs
+	name := "v1,name=x"
+	res := apiRequest(t, "POST", addr+"profile/name", key.Token, strings.NewReader(`{"args":["`+name+`"]}`))
+	res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204 setting name, got %d", res.StatusCode)
+	}
+	if node.Name() != name {
+		t.Fatalf("expected name %s, got %s", name, node.Name())
+	}
+
+	// options are query params
+	res = apiRequest(t, "GET", addr+"messages?limit=1", key.Token, nil)
+	list := new(pb.TextList)
+	if err := jsonpb.Unmarshal(res.Body, list); err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 listing messages, got %d", res.StatusCode)
+	}
+
+	// errors are json objects
+	res = apiRequest(t, "GET", addr+"messages?thread=nope", key.Token, nil)
+	var body struct {
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNotFound || body.Error != ErrThreadNotFound.Error() {
+		t.Fatalf("expected a 404 error object, got %d %s", res.StatusCode, body.Error)
+	}
+
+	if err := node.RemoveApiKey(key.Id); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTextile_EnsureApiKey(t *testing.T) {
+	first, err := node.EnsureApiKey("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a valid token is reused
+	token, err := node.EnsureApiKey(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != first {
+		t.Fatal("expected the default key to be reused")
+	}
+
+	// a missing token rotates the default key
+	second, err := node.EnsureApiKey("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := node.ValidateApiKey(first); err == nil {
+		t.Fatal("expected the former default key to be revoked")
+	}
+	key, err := node.ValidateApiKey(second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var defaults int
+	for _, k := range node.ApiKeys().Items {
+		if k.Name == "default" {
+			defaults++
+		}
+	}
+	if defaults != 1 {
+		t.Fatalf("expected one default key, got %d", defaults)
+	}
+
+	if err := node.RemoveApiKey(key.Id); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestTextile_API_Stop(t *testing.T) {
 	if err := node.StopApi(); err != nil {
 		t.Errorf("stop api failed: %s", err)
@@ -118,6 +280,109 @@ func TestTextile_API_Stop(t *testing.T) {
 	}
 }
 
+func TestTextile_GrpcApi(t *testing.T) {
+	node.StartGrpcApi(node.Config().Addresses.GRPC)
+	defer func() {
+		if err := node.StopGrpcApi(); err != nil {
+			t.Errorf("stop grpc api failed: %s", err)
+		}
+	}()
+	waitListening(node.GrpcApiAddr())
+
+	conn, err := grpc.Dial(node.GrpcApiAddr(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewApiClient(conn)
+
+	key, err := node.CreateApiKey(ApiKeyConfig{Name: "grpc", Scopes: []string{"read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+key.Token)
+
+	// unauthenticated
+	if _, err := client.Summary(context.Background(), &pb.ApiEmpty{}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected unauthenticated without a key, got %s", err)
+	}
+
+	// unary
+	summary, err := client.Summary(ctx, &pb.ApiEmpty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Address != node.Account().Address() {
+		t.Error("summary has wrong address")
+	}
+
+	address, err := client.AccountAddress(ctx, &pb.ApiEmpty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address.Value != node.Account().Address() {
+		t.Error("got wrong account address")
+	}
+
+	// missing scope
+	if _, err := client.SetName(ctx, &pb.ApiSetNameRequest{Name: "x"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected permission denied writing with a read key, got %s", err)
+	}
+	if _, err := client.AccountSeed(ctx, &pb.ApiEmpty{}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected permission denied reading the seed with a read key, got %s", err)
+	}
+	if _, err := client.Config(ctx, &pb.ApiIdRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected permission denied reading config with a read key, got %s", err)
+	}
+
+	// streamed data
+	added, err := node.AddFileIndex(&mill.Blob{}, AddFileConfig{
+		Input: []byte("grpc data"),
+		Media: "text/plain",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream, err := client.FileData(ctx, &pb.ApiIdRequest{Id: added.Hash})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data []byte
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = append(data, chunk.Data...)
+	}
+	if string(data) != "grpc data" {
+		t.Errorf("got wrong file data: %s", data)
+	}
+
+	// unknown method
+	if err := conn.Invoke(ctx, "/Api/Nope", &pb.ApiEmpty{}, &pb.ApiEmpty{}); status.Code(err) != codes.Unimplemented {
+		t.Fatalf("expected unimplemented for an unknown method, got %s", err)
+	}
+
+	// streams end at the call deadline
+	tctx, cancel := context.WithTimeout(ctx, time.Millisecond*200)
+	defer cancel()
+	sub, err := client.Subscribe(tctx, &pb.ApiSubscribeRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sub.Recv(); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded when a subscription times out, got %s", err)
+	}
+
+	if err := node.RemoveApiKey(key.Id); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestTextile_CafeSetup(t *testing.T) {
 	// start another
 	_ = os.RemoveAll(otherPath)
@@ -357,6 +622,561 @@ func TestTextile_RenameThread(t *testing.T) {
 	}
 }
 
+func TestTextile_Webhooks(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	hook, err := node.AddWebhook(config.Webhook{
+		URL:     server.URL,
+		Threads: []string{testThread.Id},
+		Types:   []string{"text"},
+	})
+	if err != nil {
+		t.Fatalf("error adding webhook: %s", err)
+	}
+	if hook.Secret == "" {
+		t.Fatal("webhook secret was not generated")
+	}
+	if len(node.Webhooks()) != 1 {
+		t.Fatal("webhook was not added to config")
+	}
+
+	if _, err := testThread.AddMessage("hi"); err != nil {
+		t.Fatalf("error adding message: %s", err)
+	}
+
+	select {
+	case req := <-received:
+		body := <-bodies
+		if req.Header.Get(WebhookSignatureHeader) != "sha256="+SignWebhookBody(hook.Secret, body) {
+			t.Fatal("bad webhook signature")
+		}
+	case <-time.After(time.Second * 10):
+		t.Fatal("webhook was not called")
+	}
+
+	if err := node.RemoveWebhook(hook.ID); err != nil {
+		t.Fatalf("error removing webhook: %s", err)
+	}
+	if len(node.Webhooks()) != 0 {
+		t.Fatal("webhook was not removed from config")
+	}
+}
+
+func TestTextile_API_Websocket(t *testing.T) {
+	node.StartApi(node.Config().Addresses.API, false)
+	defer node.StopApi()
+	waitListening(node.ApiAddr())
+
+	key, err := node.CreateApiKey(ApiKeyConfig{Name: "ws", Scopes: []string{"read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.RemoveApiKey(key.Id)
+
+	since, err := testThread.AddMessage("ws since")
+	if err != nil {
+		t.Fatal(err)
+	}
+	missed, err := testThread.AddMessage("ws missed")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// browsers send the key as a query param
+	addr := "ws://" + node.ApiAddr() + "/api/v0/ws"
+	if _, _, err := websocket.DefaultDialer.Dial(addr, nil); err != websocket.ErrBadHandshake {
+		t.Fatalf("expected a bad handshake without a key, got %v", err)
+	}
+
+	// origins are matched against the allowed CORS wildcards
+	origin := func(o string) http.Header {
+		return http.Header{"Origin": {o}}
+	}
+	if _, _, err := websocket.DefaultDialer.Dial(addr+"?api_key="+key.Token, origin("http://evil.com")); err != websocket.ErrBadHandshake {
+		t.Fatalf("expected a bad handshake from a disallowed origin, got %v", err)
+	}
+	allowed, _, err := websocket.DefaultDialer.Dial(addr+"?api_key="+key.Token, origin("http://localhost:3000"))
+	if err != nil {
+		t.Fatalf("expected an allowed origin to connect, got %v", err)
+	}
+	allowed.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(addr+"?api_key="+key.Token, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	type wsMessage struct {
+		Type  string          `json:"type"`
+		Id    string          `json:"id"`
+		Data  json.RawMessage `json:"data"`
+		Error string          `json:"error"`
+	}
+	read := func() *wsMessage {
+		conn.SetReadDeadline(time.Now().Add(time.Second * 10))
+		msg := new(wsMessage)
+		if err := conn.ReadJSON(msg); err != nil {
+			t.Fatal(err)
+		}
+		return msg
+	}
+	readUpdate := func() *pb.FeedItem {
+		msg := read()
+		if msg.Type != "thread_update" {
+			t.Fatalf("expected a thread update, got %s %s", msg.Type, msg.Error)
+		}
+		update := new(pb.FeedItem)
+		if err := jsonpb.UnmarshalString(string(msg.Data), update); err != nil {
+			t.Fatal(err)
+		}
+		return update
+	}
+
+	// invalid types are rejected
+	if err := conn.WriteJSON(map[string]interface{}{
+		"id":     "1",
+		"action": "subscribe",
+		"types":  []string{"nope"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := read(); msg.Type != "error" || msg.Id != "1" {
+		t.Fatalf("expected an error, got %s", msg.Type)
+	}
+
+	// missed updates are replayed before the ack
+	if err := conn.WriteJSON(map[string]interface{}{
+		"id":      "2",
+		"action":  "subscribe",
+		"threads": []string{testThread.Id},
+		"types":   []string{"text"},
+		"since":   since.B58String(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if update := readUpdate(); update.Block != missed.B58String() {
+		t.Fatalf("expected the missed update, got %s", update.Block)
+	}
+	if msg := read(); msg.Type != "ack" || msg.Id != "2" {
+		t.Fatalf("expected an ack, got %s", msg.Type)
+	}
+
+	live, err := testThread.AddMessage("ws live")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update := readUpdate(); update.Block != live.B58String() {
+		t.Fatalf("expected the live update, got %s", update.Block)
+	}
+
+	// unsubscribed threads are not sent
+	if err := conn.WriteJSON(map[string]interface{}{
+		"id":      "3",
+		"action":  "unsubscribe",
+		"threads": []string{testThread.Id},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := read(); msg.Type != "ack" || msg.Id != "3" {
+		t.Fatalf("expected an ack, got %s", msg.Type)
+	}
+	if _, err := testThread.AddMessage("ws ignored"); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.WriteJSON(map[string]interface{}{"id": "4", "action": "subscribe"}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := read(); msg.Type != "ack" || msg.Id != "4" {
+		t.Fatalf("expected only an ack, got %s", msg.Type)
+	}
+}
+
+func TestTextile_API_SubscribeSince(t *testing.T) {
+	node.StartApi(node.Config().Addresses.API, false)
+	defer node.StopApi()
+	waitListening(node.ApiAddr())
+
+	key, err := node.CreateApiKey(ApiKeyConfig{Name: "sub", Scopes: []string{"read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.RemoveApiKey(key.Id)
+	addr := "http://" + node.ApiAddr() + "/api/v1/subscribe/" + testThread.Id + "?type=text&since="
+
+	if code := apiStatus(t, "GET", addr+"nope", key.Token); code != http.StatusBadRequest {
+		t.Fatalf("expected 400 with a bad cursor, got %d", code)
+	}
+
+	since, err := testThread.AddMessage("sub since")
+	if err != nil {
+		t.Fatal(err)
+	}
+	missed, err := testThread.AddMessage("sub missed")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := apiRequest(t, "GET", addr+since.B58String(), key.Token, nil)
+	defer res.Body.Close()
+	updates := make(chan *pb.FeedItem)
+	go func() {
+		defer close(updates)
+		decoder := json.NewDecoder(res.Body)
+		for {
+			update := new(pb.FeedItem)
+			if err := jsonpb.UnmarshalNext(decoder, update); err != nil {
+				return
+			}
+			updates <- update
+		}
+	}()
+	next := func() *pb.FeedItem {
+		select {
+		case update := <-updates:
+			if update == nil {
+				t.Fatal("subscription ended")
+			}
+			return update
+		case <-time.After(time.Second * 10):
+			t.Fatal("timed out waiting for an update")
+		}
+		return nil
+	}
+
+	// missed updates are replayed before new ones
+	if update := next(); update.Block != missed.B58String() {
+		t.Fatalf("expected the missed update, got %s", update.Block)
+	}
+	live, err := testThread.AddMessage("sub live")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update := next(); update.Block != live.B58String() {
+		t.Fatalf("expected the live update, got %s", update.Block)
+	}
+}
+
+func TestTextile_ThreadUpdatesSince(t *testing.T) {
+	updates, err := node.ThreadUpdatesSince(time.Unix(0, 0).Format(time.RFC3339Nano))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updates) == 0 {
+		t.Fatal("expected updates since the epoch")
+	}
+	for _, update := range updates {
+		if update == nil {
+			t.Fatal("updates should not include unrendered blocks")
+		}
+		if update.Payload == nil {
+			t.Fatalf("update %s is missing a payload", update.Block)
+		}
+	}
+}
+
+func TestTextile_API_Batch(t *testing.T) {
+	node.StartApi(node.Config().Addresses.API, false)
+	defer node.StopApi()
+	waitListening(node.ApiAddr())
+
+	key, err := node.CreateApiKey(ApiKeyConfig{Name: "batch", Scopes: []string{"write"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.RemoveApiKey(key.Id)
+
+	data, err := ioutil.ReadFile("../mill/testdata/image.jpeg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"ops": []map[string]interface{}{
+			{"id": "m", "type": "message", "thread": testThread.Id, "body": "batch"},
+			{"type": "like", "block": "$m"},
+			{"type": "comment", "block": "$missing", "body": "nope"},
+			{"id": "b", "type": "mill", "mill": "/blob", "data": []byte("batch blob"), "name": "blob.txt"},
+			{"id": "f", "type": "files", "thread": testThread.Id, "caption": "batch",
+				"files": []map[string]interface{}{{"data": data, "name": "image.jpeg"}}},
+			{"type": "comment", "block": "$f", "body": "nice"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := apiRequest(t, "POST", "http://"+node.ApiAddr()+"/api/v1/batch", key.Token, bytes.NewReader(body))
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", res.StatusCode)
+	}
+	var batch struct {
+		Results []struct {
+			Type  string          `json:"type"`
+			Data  json.RawMessage `json:"data"`
+			Error string          `json:"error"`
+		} `json:"results"`
+		Failed int `json:"failed"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&batch); err != nil {
+		t.Fatal(err)
+	}
+	if len(batch.Results) != 6 || batch.Failed != 1 {
+		t.Fatalf("expected 6 results with 1 failure, got %d with %d", len(batch.Results), batch.Failed)
+	}
+	for i, result := range batch.Results {
+		if i == 2 {
+			if result.Error == "" {
+				t.Fatal("comment on a missing operation should fail")
+			}
+			continue
+		}
+		if result.Error != "" {
+			t.Fatalf("%s operation failed: %s", result.Type, result.Error)
+		}
+	}
+
+	msg := new(pb.Text)
+	if err := jsonpb.UnmarshalString(string(batch.Results[0].Data), msg); err != nil {
+		t.Fatal(err)
+	}
+	likes, err := node.Likes(msg.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(likes.Items) != 1 {
+		t.Fatal("like should target the batch message")
+	}
+
+	files := new(pb.Files)
+	if err := jsonpb.UnmarshalString(string(batch.Results[4].Data), files); err != nil {
+		t.Fatal(err)
+	}
+	comments, err := node.Comments(files.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(comments.Items) != 1 || comments.Items[0].Body != "nice" {
+		t.Fatal("comment should target the batch files")
+	}
+}
+
+func TestTextile_OpenFileData(t *testing.T) {
+	data := make([]byte, crypto.AESStreamChunkSize*2+5)
+	rand.Read(data)
+
+	file, err := node.AddFileIndex(&mill.Blob{}, AddFileConfig{
+		Input: data,
+		Media: "application/octet-stream",
+		Name:  "chunks.bin",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// new file indexes use the streamed format
+	ciphertext, err := node.DataAtPath(file.Hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !crypto.IsAESStream(ciphertext) {
+		t.Fatal("new file index should be encrypted with the streamed format")
+	}
+
+	reader, _, err := node.OpenFileData(context.Background(), file.Hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	offset := int64(crypto.AESStreamChunkSize + 3)
+	if _, err := reader.Seek(offset, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	part := make([]byte, 10)
+	if _, err := io.ReadFull(reader, part); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(part, data[offset:offset+10]) {
+		t.Fatal("read after seek returned wrong data")
+	}
+}
+
+func TestTextile_API_FileDataRange(t *testing.T) {
+	node.StartApi(node.Config().Addresses.API, false)
+	defer node.StopApi()
+	waitListening(node.ApiAddr())
+
+	key, err := node.CreateApiKey(ApiKeyConfig{Name: "range", Scopes: []string{"read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.RemoveApiKey(key.Id)
+
+	data := make([]byte, crypto.AESStreamChunkSize*2+5)
+	rand.Read(data)
+
+	for _, plaintext := range []bool{true, false} {
+		file, err := node.AddFileIndex(&mill.Blob{}, AddFileConfig{
+			Input:     data,
+			Media:     "video/mp4",
+			Name:      "clip one.mp4",
+			Plaintext: plaintext,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		addr := "http://" + node.ApiAddr() + "/api/v1/file/" + file.Hash + "/data"
+
+		req, err := http.NewRequest("GET", addr, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+key.Token)
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", crypto.AESStreamChunkSize-5, crypto.AESStreamChunkSize+4))
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.StatusCode != http.StatusPartialContent {
+			t.Fatalf("expected 206, got %d", res.StatusCode)
+		}
+		if !bytes.Equal(body, data[crypto.AESStreamChunkSize-5:crypto.AESStreamChunkSize+5]) {
+			t.Fatalf("wrong range data (plaintext=%v)", plaintext)
+		}
+		etag := res.Header.Get("ETag")
+		if etag != `"`+file.Hash+`"` {
+			t.Fatalf("wrong etag: %s", etag)
+		}
+		if res.Header.Get("Content-Type") != "video/mp4" {
+			t.Fatalf("wrong content type: %s", res.Header.Get("Content-Type"))
+		}
+		if res.Header.Get("Content-Disposition") != `inline; filename="clip one.mp4"` {
+			t.Fatalf("wrong content disposition: %s", res.Header.Get("Content-Disposition"))
+		}
+
+		req.Header.Del("Range")
+		req.Header.Set("If-None-Match", `W/"other", `+etag)
+		res, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusNotModified {
+			t.Fatalf("expected 304, got %d", res.StatusCode)
+		}
+	}
+}
+
+func TestTextile_API_BlobStream(t *testing.T) {
+	node.StartApi(node.Config().Addresses.API, false)
+	defer node.StopApi()
+	waitListening(node.ApiAddr())
+
+	key, err := node.CreateApiKey(ApiKeyConfig{Name: "blob", Scopes: []string{"read", "write"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.RemoveApiKey(key.Id)
+
+	data := make([]byte, crypto.AESStreamChunkSize*3+11)
+	rand.Read(data)
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "blob.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(data)
+	writer.Close()
+
+	req, err := http.NewRequest("POST", "http://"+node.ApiAddr()+"/api/v1/mills/blob", &body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+key.Token)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", res.StatusCode)
+	}
+	file := new(pb.FileIndex)
+	if err := jsonpb.Unmarshal(res.Body, file); err != nil {
+		t.Fatal(err)
+	}
+	if file.Key == "" || file.Name != "blob.bin" || file.Size != int64(len(data)) {
+		t.Fatalf("wrong file index: %v", file)
+	}
+
+	res2 := apiRequest(t, "GET", "http://"+node.ApiAddr()+"/api/v1/file/"+file.Hash+"/data", key.Token, nil)
+	defer res2.Body.Close()
+	out, err := ioutil.ReadAll(res2.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Fatal("streamed blob data does not match")
+	}
+
+	// the same data is not indexed twice
+	again, err := node.AddFileIndex(&mill.Blob{}, AddFileConfig{
+		Reader: bytes.NewReader(data),
+		Media:  file.Media,
+		Name:   "blob.bin",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Hash != file.Hash {
+		t.Fatal("streaming the same data should return the existing file")
+	}
+}
+
+func TestTextile_RestoreThread(t *testing.T) {
+	snap, err := node.ThreadView(testThread.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := testThread.AddMessage("after snapshot"); err != nil {
+		t.Fatalf("error adding message: %s", err)
+	}
+	head, err := testThread.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head == snap.Head {
+		t.Fatal("thread head should have moved")
+	}
+
+	if err := node.RestoreThread(snap); err != nil {
+		t.Fatalf("error restoring thread: %s", err)
+	}
+	head, err = testThread.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != snap.Head {
+		t.Fatal("thread was not restored to the snapshot head")
+	}
+}
+
 func TestTextile_RemoveCafeToken(t *testing.T) {
 	err := other.RemoveCafeToken(token)
 	if err != nil {
@@ -369,6 +1189,46 @@ func TestTextile_RemoveCafeToken(t *testing.T) {
 	}
 }
 
+func TestTextile_TLSConfig(t *testing.T) {
+	tconf, err := node.TLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tconf != nil {
+		t.Fatal("tls should be disabled by default")
+	}
+
+	conf := &node.Config().TLS
+	conf.Enabled = true
+	defer func() {
+		conf.Enabled = false
+	}()
+
+	tconf, err = node.TLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tconf == nil {
+		t.Fatal("tls should be enabled")
+	}
+
+	// a self-signed certificate should have been generated
+	if _, err := os.Stat(filepath.Join(repoPath, conf.CertFile)); err != nil {
+		t.Fatalf("certificate was not generated: %s", err)
+	}
+	cert, err := tconf.GetCertificate(&tls.ClientHelloInfo{ServerName: "localhost"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := leaf.VerifyHostname("localhost"); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestTextile_Stop(t *testing.T) {
 	err := node.Stop()
 	if err != nil {
@@ -404,3 +1264,40 @@ func TestTextile_Teardown(t *testing.T) {
 	other = nil
 	_ = os.RemoveAll(otherPath)
 }
+
+func apiStatus(t *testing.T, method string, url string, key string) int {
+	res := apiRequest(t, method, url, key, nil)
+	res.Body.Close()
+	return res.StatusCode
+}
+
+// apiRequest makes a local api request, sending body as JSON
+func apiRequest(t *testing.T, method string, url string, key string, body io.Reader) *http.Response {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+// waitListening waits for a server to start listening at addr
+func waitListening(addr string) {
+	for i := 0; i < 50; i++ {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+			return
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+}
@@ -0,0 +1,216 @@
+package core
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/metrics"
+	"github.com/textileio/go-textile/pb"
+)
+
+// cafe service metrics
+var (
+	cafeServiceMessages = metrics.NewCounter(
+		"textile_cafe_service_messages_total",
+		"Cafe service messages handled, by message type and result.",
+		"type", "result")
+	cafeServiceSeconds = metrics.NewHistogram(
+		"textile_cafe_service_handle_seconds",
+		"Time spent handling cafe service messages, by message type.",
+		metrics.DefBuckets, "type")
+	cafeRegistrations = metrics.NewCounter(
+		"textile_cafe_registrations_total",
+		"Cafe client registration attempts, by result.",
+		"result")
+	cafeStoreObjects = metrics.NewCounter(
+		"textile_cafe_store_objects_total",
+		"Objects stored or unstored for cafe clients, by operation and transport.",
+		"op", "transport")
+	cafeStoreBytes = metrics.NewCounter(
+		"textile_cafe_store_bytes_total",
+		"Bytes stored for cafe clients, by transport.",
+		"transport")
+	cafePubSubQueries = metrics.NewCounter(
+		"textile_cafe_pubsub_queries_total",
+		"Pubsub queries received by the cafe, by query type and result.",
+		"type", "result")
+	cafeRejections = metrics.NewCounter(
+		"textile_cafe_rejected_requests_total",
+		"Cafe messages and API requests rejected by ban lists or rate limits, by message type or route and reason.",
+		"type", "reason")
+)
+
+// http api metrics
+var (
+	cafeApiRequests = metrics.NewCounter(
+		"textile_cafe_api_requests_total",
+		"Cafe API requests, by method, handler, and status code.",
+		"method", "handler", "code")
+	cafeApiSeconds = metrics.NewHistogram(
+		"textile_cafe_api_request_seconds",
+		"Cafe API request latencies, by method and handler.",
+		metrics.DefBuckets, "method", "handler")
+	apiRequests = metrics.NewCounter(
+		"textile_api_requests_total",
+		"Local API requests, by method, handler, and status code.",
+		"method", "handler", "code")
+	apiSeconds = metrics.NewHistogram(
+		"textile_api_request_seconds",
+		"Local API request latencies, by method and handler.",
+		metrics.DefBuckets, "method", "handler")
+)
+
+// queue metrics
+var (
+	cafeOutboxRequests = metrics.NewCounter(
+		"textile_cafe_outbox_requests_total",
+		"Cafe requests sent from the outbox, by request type and result.",
+		"type", "result")
+	cafeInboxMessages = metrics.NewCounter(
+		"textile_cafe_inbox_messages_total",
+		"Messages downloaded from cafe inboxes, by result.",
+		"result")
+	blockOutboxMessages = metrics.NewCounter(
+		"textile_block_outbox_messages_total",
+		"Block messages sent from the outbox, by delivery method.",
+		"result")
+)
+
+// gauges are refreshed from the datastore on each scrape
+var (
+	cafeOutboxPending = metrics.NewGauge(
+		"textile_cafe_outbox_requests",
+		"Cafe requests currently in the outbox, by status.",
+		"status")
+	cafeInboxPending = metrics.NewGauge(
+		"textile_cafe_inbox_messages",
+		"Messages waiting to be downloaded from cafe inboxes.")
+	blockOutboxPending = metrics.NewGauge(
+		"textile_block_outbox_messages",
+		"Block messages waiting to be sent.")
+	cafeClientsGauge = metrics.NewGauge(
+		"textile_cafe_clients",
+		"Clients registered with this cafe.")
+	cafeClientInboxDepth = metrics.NewGauge(
+		"textile_cafe_client_inbox_messages",
+		"Messages held in client inboxes by this cafe.")
+)
+
+// metric label values
+const (
+	resultOk      = "ok"
+	resultError   = "error"
+	resultDropped = "dropped"
+)
+
+// observeCafeMessage records a handled cafe service message
+func observeCafeMessage(mtype pb.Message_Type, start time.Time, renv *pb.Envelope, err error) {
+	name := mtype.String()
+	result := resultOk
+	if err != nil || (renv != nil && renv.Message.Type == pb.Message_ERROR) {
+		result = resultError
+	}
+	cafeServiceMessages.Inc(name, result)
+	cafeServiceSeconds.Observe(time.Since(start).Seconds(), name)
+
+	if mtype == pb.Message_CAFE_REGISTRATION {
+		cafeRegistrations.Inc(result)
+	}
+}
+
+// observeCafeStore records an object stored for a cafe client
+func observeCafeStore(transport string, size int64) {
+	cafeStoreObjects.Inc("store", transport)
+	cafeStoreBytes.Add(float64(size), transport)
+}
+
+// observeCafeUnstore records objects unstored for a cafe client
+func observeCafeUnstore(transport string, count int) {
+	cafeStoreObjects.Add(float64(count), "unstore", transport)
+}
+
+// instrument returns a middleware that records request counts and latencies
+func instrument(requests *metrics.Counter, seconds *metrics.Histogram) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		start := time.Now()
+		handler := handlerName(g.HandlerName())
+		g.Next()
+
+		method := g.Request.Method
+		requests.Inc(method, handler, strconv.Itoa(g.Writer.Status()))
+		seconds.Observe(time.Since(start).Seconds(), method, handler)
+	}
+}
+
+// handlerName trims a gin handler name down to its method name,
+// which keeps label cardinality low compared to raw request paths
+func handlerName(name string) string {
+	name = strings.TrimSuffix(name, "-fm")
+	if i := strings.LastIndex(name, "."); i != -1 {
+		name = name[i+1:]
+	}
+	if name == "" || strings.HasPrefix(name, "func") {
+		return "other"
+	}
+	return name
+}
+
+// collectMetrics refreshes gauges from the datastore
+func (t *Textile) collectMetrics() {
+	requests := t.datastore.CafeRequests()
+	for _, status := range []pb.CafeRequest_Status{
+		pb.CafeRequest_NEW,
+		pb.CafeRequest_PENDING,
+		pb.CafeRequest_FAILED,
+	} {
+		cafeOutboxPending.Set(float64(requests.CountByStatus(status)), strings.ToLower(status.String()))
+	}
+	cafeInboxPending.Set(float64(t.datastore.CafeMessages().Count()))
+	blockOutboxPending.Set(float64(t.datastore.BlockMessages().Count()))
+
+	if t.cafe != nil && t.cafe.open {
+		cafeClientsGauge.Set(float64(t.datastore.CafeClients().Count()))
+		cafeClientInboxDepth.Set(float64(t.datastore.CafeClientMessages().Count()))
+	}
+}
+
+// serveMetrics writes all metrics in the Prometheus text format
+func (t *Textile) serveMetrics(g *gin.Context) {
+	t.collectMetrics()
+
+	g.Header("Content-Type", metrics.ContentType)
+	g.Status(http.StatusOK)
+	if err := metrics.Default.Write(g.Writer); err != nil {
+		log.Errorf("error writing metrics: %s", err)
+	}
+}
+
+// getMetrics serves metrics if enabled, requiring the admin token when one is configured
+func (c *cafeApi) getMetrics(g *gin.Context) {
+	conf := c.node.Config().Cafe.Host
+	if !conf.Metrics {
+		c.abort(g, http.StatusNotFound, nil)
+		return
+	}
+	if admin := conf.AdminToken; admin != "" {
+		c.validateBearer(g, admin)
+		if g.IsAborted() {
+			return
+		}
+	}
+
+	c.node.serveMetrics(g)
+}
+
+// getMetrics serves metrics if enabled
+func (a *api) getMetrics(g *gin.Context) {
+	if !a.node.Config().API.Metrics {
+		g.String(http.StatusNotFound, "metrics are disabled")
+		return
+	}
+
+	a.node.serveMetrics(g)
+}
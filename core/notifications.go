@@ -2,14 +2,27 @@ package core
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	mh "github.com/multiformats/go-multihash"
 	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
 )
 
 // Notifications lists notifications
 func (t *Textile) Notifications(offset string, limit int) *pb.NotificationList {
-	list := t.datastore.Notifications().List(offset, limit)
+	return t.FilterNotifications(&pb.NotificationRequest{
+		Offset: offset,
+		Limit:  int32(limit),
+	})
+}
+
+// FilterNotifications lists notifications matching the request's thread, types, read state and date.
+// A limit of -1 lists all matching notifications.
+func (t *Textile) FilterNotifications(req *pb.NotificationRequest) *pb.NotificationList {
+	query, args := notificationsQuery(req)
+	list := t.datastore.Notifications().List(req.Offset, int(req.Limit), query, args...)
 	for i, note := range list.Items {
 		list.Items[i] = t.NotificationView(note)
 	}
@@ -45,6 +58,26 @@ func (t *Textile) ReadAllNotifications() error {
 	return t.datastore.Notifications().ReadAll()
 }
 
+// ReadNotifications marks all notifications matching the request as read
+func (t *Textile) ReadNotifications(req *pb.NotificationRequest) error {
+	query, args := notificationsQuery(req)
+	return t.datastore.Notifications().ReadByQuery(query, args...)
+}
+
+// RemoveNotification deletes a notification
+func (t *Textile) RemoveNotification(id string) error {
+	if t.datastore.Notifications().Get(id) == nil {
+		return fmt.Errorf("could not find notification: %s", id)
+	}
+	return t.datastore.Notifications().Delete(id)
+}
+
+// RemoveNotifications deletes all notifications matching the request
+func (t *Textile) RemoveNotifications(req *pb.NotificationRequest) error {
+	query, args := notificationsQuery(req)
+	return t.datastore.Notifications().DeleteByQuery(query, args...)
+}
+
 // AcceptInviteViaNotification uses an invite notification to accept an invite to a thread
 func (t *Textile) AcceptInviteViaNotification(id string) (mh.Multihash, error) {
 	notification := t.datastore.Notifications().Get(id)
@@ -79,3 +112,55 @@ func (t *Textile) IgnoreInviteViaNotification(id string) error {
 
 	return t.datastore.Notifications().Delete(id)
 }
+
+// maybePruneNotifications deletes notifications outside of the configured retention policy
+func (t *Textile) maybePruneNotifications() {
+	conf := t.config.Notifications
+
+	var keep string
+	if conf.KeepUnread {
+		keep = "read=1 and "
+	}
+
+	if conf.MaxAge > 0 {
+		before := time.Now().Add(-time.Hour * 24 * time.Duration(conf.MaxAge))
+		if err := t.datastore.Notifications().DeleteByQuery(keep+"date<?", before.UnixNano()); err != nil {
+			log.Errorf("error pruning notifications by age: %s", err)
+		}
+	}
+
+	if conf.MaxCount > 0 {
+		query := keep + "id not in (select id from notifications order by date desc limit ?)"
+		if err := t.datastore.Notifications().DeleteByQuery(query, conf.MaxCount); err != nil {
+			log.Errorf("error pruning notifications by count: %s", err)
+		}
+	}
+}
+
+// notificationsQuery returns a datastore query and its args for the request's filters
+func notificationsQuery(req *pb.NotificationRequest) (string, []interface{}) {
+	var parts []string
+	var args []interface{}
+	if req.Thread != "" {
+		parts = append(parts, "subjectId=?")
+		args = append(args, req.Thread)
+	}
+	if len(req.Types) > 0 {
+		var types []string
+		for _, t := range req.Types {
+			types = append(types, fmt.Sprintf("type=%d", t))
+		}
+		parts = append(parts, "("+strings.Join(types, " or ")+")")
+	}
+	switch req.Read {
+	case pb.NotificationRequest_UNREAD:
+		parts = append(parts, "read=0")
+	case pb.NotificationRequest_READ:
+		parts = append(parts, "read=1")
+	}
+	if req.Before != nil {
+		parts = append(parts, "date<?")
+		args = append(args, util.ProtoNanos(req.Before))
+	}
+	return strings.Join(parts, " and "), args
+}
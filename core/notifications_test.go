@@ -0,0 +1,98 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/repo/db"
+	"github.com/textileio/go-textile/util"
+)
+
+func TestTextile_MaybePruneNotifications(t *testing.T) {
+	ds, done := testDatastore(t)
+	defer done()
+
+	node := &Textile{
+		config: &config.Config{
+			Notifications: config.Notifications{
+				MaxAge:     1,
+				MaxCount:   2,
+				KeepUnread: true,
+			},
+		},
+		datastore: ds,
+	}
+
+	now := time.Now()
+	notes := []struct {
+		id   string
+		date time.Time
+		read bool
+	}{
+		{"old", now.Add(-time.Hour * 48), true},
+		{"unread", now.Add(-time.Hour * 48), false},
+		{"a", now.Add(-time.Minute * 3), true},
+		{"b", now.Add(-time.Minute * 2), true},
+		{"c", now.Add(-time.Minute), true},
+	}
+	for _, n := range notes {
+		if err := ds.Notifications().Add(&pb.Notification{
+			Id:   n.id,
+			Date: util.ProtoTs(n.date.UnixNano()),
+			Type: pb.Notification_MESSAGE_ADDED,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if n.read {
+			if err := ds.Notifications().Read(n.id); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// old read notifications and those beyond the max count are pruned,
+	// unread notifications are kept
+	node.maybePruneNotifications()
+
+	var ids []string
+	for _, note := range ds.Notifications().List("", -1, "").Items {
+		ids = append(ids, note.Id)
+	}
+	expected := []string{"c", "b", "unread"}
+	if len(ids) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, ids)
+	}
+	for i, id := range expected {
+		if ids[i] != id {
+			t.Fatalf("expected %v, got %v", expected, ids)
+		}
+	}
+}
+
+// testDatastore returns a datastore in a temp dir, along with a func that removes it
+func testDatastore(t *testing.T) (repo.Datastore, func()) {
+	dir, err := ioutil.TempDir("", "datastore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(path.Join(dir, "datastore"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	ds, err := db.Create(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ds.InitTables(""); err != nil {
+		t.Fatal(err)
+	}
+	return ds, func() {
+		ds.Close()
+		os.RemoveAll(dir)
+	}
+}
@@ -77,11 +77,16 @@ func (t *Textile) publishPeer() error {
 
 // updatePeerInboxes sets own peer inboxes from the current cafe sessions
 func (t *Textile) updatePeerInboxes() error {
+	return t.datastore.Peers().UpdateInboxes(t.node.Identity.Pretty(), t.inboxes())
+}
+
+// inboxes returns routable cafe sessions as inboxes, ordered by priority
+func (t *Textile) inboxes() []*pb.Cafe {
 	var inboxes []*pb.Cafe
-	for _, session := range t.datastore.CafeSessions().List().Items {
+	for _, session := range routableSessions(t.datastore.CafeSessions().List().Items) {
 		inboxes = append(inboxes, session.Cafe)
 	}
-	return t.datastore.Peers().UpdateInboxes(t.node.Identity.Pretty(), inboxes)
+	return inboxes
 }
 
 // peersEqual returns whether or not the two peers are identical
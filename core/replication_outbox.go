@@ -0,0 +1,340 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/util"
+)
+
+// replicationFlushGroupSize is the number of replications handled per flush batch
+const replicationFlushGroupSize = 16
+
+// maxReplicationAttempts is the number of times a replication can fail before being deleted
+const maxReplicationAttempts = 10
+
+// neighborInfoTimeout is the max duration of a neighbor cafe info request
+const neighborInfoTimeout = time.Second * 10
+
+// ReplicationOutbox queues and processes replications to neighbor cafes
+type ReplicationOutbox struct {
+	service   func() *CafeService
+	datastore repo.Datastore
+	config    *config.Config
+	client    *http.Client
+	neighbors map[string]*pb.Cafe
+	status    map[string]*pb.CafeNeighborStatus
+	mux       sync.Mutex
+	smux      sync.Mutex
+}
+
+// NewReplicationOutbox creates a new outbox queue
+func NewReplicationOutbox(
+	service func() *CafeService,
+	datastore repo.Datastore,
+	conf *config.Config,
+) *ReplicationOutbox {
+	return &ReplicationOutbox{
+		service:   service,
+		datastore: datastore,
+		config:    conf,
+		client:    &http.Client{Timeout: neighborInfoTimeout},
+		neighbors: make(map[string]*pb.Cafe),
+		status:    make(map[string]*pb.CafeNeighborStatus),
+	}
+}
+
+// Enabled returns whether or not replication to neighbors is configured
+func (q *ReplicationOutbox) Enabled() bool {
+	return q.config.Cafe.Host.ReplicateToNeighbors && len(q.Neighbors()) > 0
+}
+
+// Neighbors returns the configured neighbor cafe urls
+func (q *ReplicationOutbox) Neighbors() []string {
+	host := q.config.Cafe.Host
+	var urls []string
+	for _, url := range append([]string{host.NeighborURL}, host.NeighborURLs...) {
+		if url != "" && !util.ListContainsString(urls, url) {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// Add queues a replication of a client record to each neighbor
+func (q *ReplicationOutbox) Add(rtype pb.CafeReplication_Type, client string, target string) error {
+	if !q.Enabled() {
+		return nil
+	}
+
+	log.Debugf("adding %s replication for %s", rtype.String(), target)
+	for _, url := range q.Neighbors() {
+		if err := q.datastore.CafeReplications().Add(&pb.CafeReplication{
+			Id:       ksuid.New().String(),
+			Type:     rtype,
+			Client:   client,
+			Target:   target,
+			Date:     ptypes.TimestampNow(),
+			Neighbor: url,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush processes pending replications
+func (q *ReplicationOutbox) Flush() {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+
+	if q.service() == nil {
+		return
+	}
+	log.Debug("flushing cafe replications")
+
+	q.batch(q.datastore.CafeReplications().List("", replicationFlushGroupSize), make(map[string]bool))
+}
+
+// Status returns the current state of replication to each neighbor
+func (q *ReplicationOutbox) Status() *pb.CafeReplicationStatus {
+	q.smux.Lock()
+	defer q.smux.Unlock()
+
+	status := &pb.CafeReplicationStatus{
+		Enabled:   q.Enabled(),
+		Pending:   int32(q.datastore.CafeReplications().Count()),
+		Neighbors: make([]*pb.CafeNeighborStatus, 0),
+	}
+	for _, url := range q.Neighbors() {
+		nstatus := &pb.CafeNeighborStatus{
+			Url:     url,
+			Pending: int32(q.datastore.CafeReplications().CountByNeighbor(url)),
+		}
+		if s, ok := q.status[url]; ok {
+			nstatus.LastSuccess = s.LastSuccess
+			nstatus.LastError = s.LastError
+			nstatus.LastErrorDate = s.LastErrorDate
+		}
+		if info, ok := q.neighbors[url]; ok {
+			nstatus.Peer = info.Peer
+		}
+		status.Neighbors = append(status.Neighbors, nstatus)
+	}
+	return status
+}
+
+// Neighbor returns a neighbor cafe's info, fetching it if needed
+func (q *ReplicationOutbox) Neighbor(url string) (*pb.Cafe, error) {
+	q.smux.Lock()
+	info, ok := q.neighbors[url]
+	q.smux.Unlock()
+	if ok {
+		return info, nil
+	}
+
+	info, err := fetchCafeInfo(q.client, url)
+	if err != nil {
+		return nil, err
+	}
+
+	q.smux.Lock()
+	q.neighbors[url] = info
+	q.smux.Unlock()
+	return info, nil
+}
+
+// IsNeighbor returns whether or not a peer is one of the configured neighbor cafes
+func (q *ReplicationOutbox) IsNeighbor(pid string) bool {
+	for _, url := range q.Neighbors() {
+		info, err := q.Neighbor(url)
+		if err != nil {
+			log.Warningf("error getting neighbor info from %s: %s", url, err)
+			continue
+		}
+		if info.Peer == pid {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchCafeInfo requests info from a cafe url
+func fetchCafeInfo(client *http.Client, url string) (*pb.Cafe, error) {
+	res, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		return nil, fmt.Errorf("%s returned bad status: %d", url, res.StatusCode)
+	}
+
+	info := new(pb.Cafe)
+	if err := jsonpb.Unmarshal(res.Body, info); err != nil {
+		return nil, err
+	}
+	if info.Peer == "" {
+		return nil, fmt.Errorf("%s returned invalid cafe info", url)
+	}
+	return info, nil
+}
+
+// batch flushes a batch of replications
+// note: replications are handled in order so that a later unpin or delete
+// can't overtake the record it removes. once a neighbor fails, its remaining
+// replications are left for the next flush.
+func (q *ReplicationOutbox) batch(reps []pb.CafeReplication, failed map[string]bool) {
+	log.Debugf("handling %d cafe replications", len(reps))
+	if len(reps) == 0 {
+		return
+	}
+
+	neighbors := q.Neighbors()
+	var toDelete, toRetry []pb.CafeReplication
+	for _, rep := range reps {
+		if failed[rep.Neighbor] {
+			continue
+		}
+		if !util.ListContainsString(neighbors, rep.Neighbor) {
+			// neighbor was removed
+			toDelete = append(toDelete, rep)
+			continue
+		}
+		if err := q.handle(rep); err != nil {
+			log.Warningf("replication attempt to %s failed for %s: %s", rep.Neighbor, rep.Id, err)
+			q.setError(rep.Neighbor, err)
+			failed[rep.Neighbor] = true
+			toRetry = append(toRetry, rep)
+			continue
+		}
+		q.setSuccess(rep.Neighbor)
+		toDelete = append(toDelete, rep)
+	}
+
+	// next batch, listed before deleting so that the offset replication still exists
+	offset := reps[len(reps)-1].Id
+	next := q.datastore.CafeReplications().List(offset, replicationFlushGroupSize)
+
+	for _, rep := range toDelete {
+		q.delete(rep)
+	}
+	for _, rep := range toRetry {
+		q.handleErr(rep)
+	}
+
+	q.batch(next, failed)
+}
+
+// handle sends a single replication to the neighbor
+func (q *ReplicationOutbox) handle(rep pb.CafeReplication) error {
+	msg := &pb.CafeReplicate{
+		Type:   rep.Type,
+		Client: rep.Client,
+		Target: rep.Target,
+	}
+
+	// attach the current record, skipping any that have since been removed
+	switch rep.Type {
+	case pb.CafeReplication_PIN:
+		msg.Pin = q.datastore.CafeClientPins().Get(rep.Target, rep.Client)
+		if msg.Pin == nil {
+			return nil
+		}
+	case pb.CafeReplication_THREAD:
+		msg.Thread = q.datastore.CafeClientThreads().Get(rep.Target, rep.Client)
+		if msg.Thread == nil {
+			return nil
+		}
+	case pb.CafeReplication_MESSAGE:
+		msg.Message = q.datastore.CafeClientMessages().Get(rep.Target, rep.Client)
+		if msg.Message == nil {
+			return nil
+		}
+	}
+
+	neighbor, err := q.Neighbor(rep.Neighbor)
+	if err != nil {
+		return err
+	}
+
+	service := q.service()
+	env, err := service.service.NewEnvelope(pb.Message_CAFE_REPLICATE, msg, nil, false)
+	if err != nil {
+		return err
+	}
+
+	addr := fmt.Sprintf("%s/cafe/%s/service", neighbor.Url, neighbor.Api)
+	renv, err := service.service.SendHTTPRequest(addr, env)
+	if err != nil {
+		q.resetNeighbor(rep.Neighbor)
+		return err
+	}
+	if renv == nil || renv.Message.Type != pb.Message_CAFE_REPLICATE_ACK {
+		return fmt.Errorf("invalid replication response from %s", addr)
+	}
+	return nil
+}
+
+// handleErr deletes or adds an attempt to a failed replication
+func (q *ReplicationOutbox) handleErr(rep pb.CafeReplication) {
+	if rep.Attempts+1 >= maxReplicationAttempts {
+		log.Warningf("giving up on replication %s after %d attempts", rep.Id, maxReplicationAttempts)
+		q.delete(rep)
+		return
+	}
+	if err := q.datastore.CafeReplications().AddAttempt(rep.Id); err != nil {
+		log.Errorf("failed to add attempt to replication %s: %s", rep.Id, err)
+	}
+}
+
+// delete removes a replication from the queue
+func (q *ReplicationOutbox) delete(rep pb.CafeReplication) {
+	if err := q.datastore.CafeReplications().Delete(rep.Id); err != nil {
+		log.Errorf("failed to delete replication %s: %s", rep.Id, err)
+	} else {
+		log.Debugf("handled replication %s", rep.Id)
+	}
+}
+
+// resetNeighbor clears cached neighbor info so it's fetched again on the next attempt
+func (q *ReplicationOutbox) resetNeighbor(url string) {
+	q.smux.Lock()
+	defer q.smux.Unlock()
+	delete(q.neighbors, url)
+}
+
+// setSuccess records a successful replication to a neighbor
+func (q *ReplicationOutbox) setSuccess(url string) {
+	q.smux.Lock()
+	defer q.smux.Unlock()
+	q.neighborStatus(url).LastSuccess = ptypes.TimestampNow()
+}
+
+// setError records a failed replication to a neighbor
+func (q *ReplicationOutbox) setError(url string, err error) {
+	q.smux.Lock()
+	defer q.smux.Unlock()
+	status := q.neighborStatus(url)
+	status.LastError = err.Error()
+	status.LastErrorDate = ptypes.TimestampNow()
+}
+
+// neighborStatus returns the tracked status of a neighbor, the caller must hold smux
+func (q *ReplicationOutbox) neighborStatus(url string) *pb.CafeNeighborStatus {
+	status, ok := q.status[url]
+	if !ok {
+		status = &pb.CafeNeighborStatus{Url: url}
+		q.status[url] = status
+	}
+	return status
+}
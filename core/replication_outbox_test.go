@@ -0,0 +1,49 @@
+package core
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
+)
+
+func TestReplicationOutbox_Flush(t *testing.T) {
+	ds, done := testDatastore(t)
+	defer done()
+
+	conf := &config.Config{}
+	conf.Cafe.Host.ReplicateToNeighbors = true
+	conf.Cafe.Host.NeighborURL = "http://127.0.0.1:1"
+	conf.Cafe.Host.NeighborURLs = []string{"http://127.0.0.1:2", "http://127.0.0.1:1"}
+	service := &CafeService{}
+	outbox := NewReplicationOutbox(func() *CafeService { return service }, ds, conf)
+
+	if len(outbox.Neighbors()) != 2 {
+		t.Fatalf("expected 2 neighbors, got %v", outbox.Neighbors())
+	}
+
+	// queue more than one batch for each neighbor, the pins have no records,
+	// so they're dropped without contacting the neighbors
+	count := replicationFlushGroupSize + 5
+	for i := 0; i < count; i++ {
+		if err := outbox.Add(pb.CafeReplication_PIN, "client", "Qm"+strconv.Itoa(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	status := outbox.Status()
+	if int(status.Pending) != count*2 {
+		t.Fatalf("expected %d pending, got %d", count*2, status.Pending)
+	}
+	for _, n := range status.Neighbors {
+		if int(n.Pending) != count {
+			t.Fatalf("expected %d pending for %s, got %d", count, n.Url, n.Pending)
+		}
+	}
+
+	outbox.Flush()
+
+	if pending := ds.CafeReplications().Count(); pending != 0 {
+		t.Fatalf("expected an empty outbox, got %d replications", pending)
+	}
+}
@@ -0,0 +1,188 @@
+package core
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/ssl"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// default tls paths, used when missing from older configs
+const (
+	defaultTLSCertFile  = "tls/cert.pem"
+	defaultTLSKeyFile   = "tls/key.pem"
+	defaultACMECacheDir = "tls/acme"
+)
+
+// kTLSReloadFreq is how often certificate files are checked for changes
+const kTLSReloadFreq = time.Minute
+
+// TLSConfig returns the TLS config shared by the cafe API and gateway,
+// or nil if TLS is disabled
+func (t *Textile) TLSConfig() (*tls.Config, error) {
+	conf := t.config.TLS
+	if !conf.Enabled {
+		return nil, nil
+	}
+
+	t.tlsMux.Lock()
+	defer t.tlsMux.Unlock()
+	if t.tlsConf != nil {
+		return t.tlsConf, nil
+	}
+
+	var tconf *tls.Config
+	var err error
+	if conf.ACME.Enabled {
+		tconf, err = t.acmeTLSConfig(conf)
+	} else {
+		tconf, err = t.fileTLSConfig(conf)
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.tlsConf = tconf
+	return tconf, nil
+}
+
+// fileTLSConfig serves the configured certificate files, generating a
+// self-signed certificate if they don't exist
+func (t *Textile) fileTLSConfig(conf config.TLS) (*tls.Config, error) {
+	certFile := t.repoFile(conf.CertFile, defaultTLSCertFile)
+	keyFile := t.repoFile(conf.KeyFile, defaultTLSKeyFile)
+
+	if err := ssl.Check(certFile, keyFile); err != nil {
+		for _, f := range []string{certFile, keyFile} {
+			if err := os.MkdirAll(filepath.Dir(f), os.ModePerm); err != nil {
+				return nil, err
+			}
+		}
+		hosts := t.tlsHosts()
+		log.Infof("generating a self-signed certificate for %s", strings.Join(hosts, ", "))
+		if err := ssl.Generate(certFile, keyFile, strings.Join(hosts, ",")); err != nil {
+			return nil, err
+		}
+		if err := os.Chmod(keyFile, 0600); err != nil {
+			return nil, err
+		}
+	}
+
+	certs := &certReloader{certFile: certFile, keyFile: keyFile}
+	if err := certs.load(); err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		GetCertificate: certs.getCertificate,
+	}, nil
+}
+
+// acmeTLSConfig obtains and renews certificates from an ACME certificate authority,
+// answering challenges over TLS so that no other ports are needed
+func (t *Textile) acmeTLSConfig(conf config.TLS) (*tls.Config, error) {
+	var hosts []string
+	for _, h := range t.tlsHosts() {
+		if net.ParseIP(h) == nil && h != "localhost" {
+			hosts = append(hosts, h)
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("acme requires a host name, set TLS.Hosts or Cafe.Host.URL")
+	}
+
+	manager := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache(t.repoFile(conf.ACME.CacheDir, defaultACMECacheDir)),
+		HostPolicy: autocert.HostWhitelist(hosts...),
+		Email:      conf.ACME.Email,
+	}
+	if conf.ACME.DirectoryURL != "" {
+		manager.Client = &acme.Client{DirectoryURL: conf.ACME.DirectoryURL}
+	}
+	return manager.TLSConfig(), nil
+}
+
+// tlsHosts returns the hosts certificates should cover
+func (t *Textile) tlsHosts() []string {
+	if len(t.config.TLS.Hosts) > 0 {
+		return t.config.TLS.Hosts
+	}
+
+	var hosts []string
+	if u, err := url.Parse(t.config.Cafe.Host.URL); err == nil && u.Hostname() != "" {
+		hosts = append(hosts, u.Hostname())
+	}
+	return append(hosts, "localhost", "127.0.0.1")
+}
+
+// repoFile resolves a config path relative to the repo
+func (t *Textile) repoFile(pth string, def string) string {
+	if pth == "" {
+		pth = def
+	}
+	if filepath.IsAbs(pth) {
+		return pth
+	}
+	return filepath.Join(t.repoPath, pth)
+}
+
+// certReloader serves a certificate from disk, picking up changes,
+// e.g., renewals by an external ACME client
+type certReloader struct {
+	certFile string
+	keyFile  string
+	cert     *tls.Certificate
+	modTime  time.Time
+	checked  time.Time
+	mux      sync.Mutex
+}
+
+// getCertificate returns the current certificate, reloading it if the files have changed
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if time.Since(r.checked) >= kTLSReloadFreq {
+		if err := r.load(); err != nil {
+			log.Warningf("error reloading certificate %s: %s", r.certFile, err)
+		}
+	}
+	return r.cert, nil
+}
+
+// load reads the certificate files if they have changed since the last load
+// note: caller must hold the lock once the reloader is in use
+func (r *certReloader) load() error {
+	r.checked = time.Now()
+
+	var mod time.Time
+	for _, f := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(f)
+		if err != nil {
+			return err
+		}
+		if info.ModTime().After(mod) {
+			mod = info.ModTime()
+		}
+	}
+	if r.cert != nil && !mod.After(r.modTime) {
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	r.cert = &cert
+	r.modTime = mod
+	return nil
+}
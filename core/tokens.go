@@ -3,11 +3,14 @@ package core
 import (
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/mr-tron/base58/base58"
 	"github.com/textileio/go-textile/crypto"
 	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -27,9 +30,40 @@ func (t *Textile) CafeTokens() ([]string, error) {
 	return strings, nil
 }
 
+// CafeTokenConfig describes the rights granted to clients registered with a token
+type CafeTokenConfig struct {
+	Scopes  []string  // store, inbox, threads, search, or empty for all
+	Plan    string    // name of a quota class in the cafe host config
+	Expires time.Time // zero never expires
+	Label   string
+}
+
 // CreateCafeToken creates (or uses `token`) random access token, returns base58 encoded version,
 // and stores (unless `store` is false) a bcrypt hashed version for later comparison
 func (t *Textile) CreateCafeToken(token string, store bool) (string, error) {
+	return t.CreateCafeTokenWithConfig(token, store, CafeTokenConfig{})
+}
+
+// CreateCafeTokenWithConfig is like CreateCafeToken, but limits the token's rights
+func (t *Textile) CreateCafeTokenWithConfig(token string, store bool, conf CafeTokenConfig) (string, error) {
+	for _, scope := range conf.Scopes {
+		if !util.ListContainsString(cafeScopes, scope) {
+			return "", fmt.Errorf("invalid scope: %s", scope)
+		}
+	}
+	if conf.Plan != "" {
+		if _, ok := t.config.Cafe.Host.Plans[conf.Plan]; !ok {
+			return "", fmt.Errorf("plan not found: %s", conf.Plan)
+		}
+	}
+	var expires *timestamp.Timestamp
+	if !conf.Expires.IsZero() {
+		if conf.Expires.Before(time.Now()) {
+			return "", fmt.Errorf("expiry is in the past")
+		}
+		expires = util.ProtoTs(conf.Expires.UnixNano())
+	}
+
 	var key []byte
 	var err error
 	if token != "" {
@@ -55,9 +89,13 @@ func (t *Textile) CreateCafeToken(token string, store bool) (string, error) {
 
 	if store {
 		if err := t.datastore.CafeTokens().Add(&pb.CafeToken{
-			Id:    hex.EncodeToString(key[:12]),
-			Value: safeToken,
-			Date:  ptypes.TimestampNow(),
+			Id:      hex.EncodeToString(key[:12]),
+			Value:   safeToken,
+			Date:    ptypes.TimestampNow(),
+			Scopes:  conf.Scopes,
+			Plan:    conf.Plan,
+			Expires: expires,
+			Label:   conf.Label,
 		}); err != nil {
 			return "", err
 		}
@@ -85,6 +123,9 @@ func (t *Textile) ValidateCafeToken(token string) (bool, error) {
 	if err := bcrypt.CompareHashAndPassword(encodedToken.Value, plainBytes[12:]); err != nil {
 		return false, err
 	}
+	if tokenExpired(encodedToken) {
+		return false, fmt.Errorf(errTokenExpired)
+	}
 	return true, nil
 }
 
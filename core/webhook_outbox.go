@@ -0,0 +1,216 @@
+package core
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/util"
+)
+
+// webhookFlushGroupSize is the size of concurrently processed deliveries
+const webhookFlushGroupSize = 16
+
+// maxWebhookAttempts is the number of times a delivery can fail before being deleted
+const maxWebhookAttempts = 10
+
+// webhookTimeout is the max duration of a single delivery request
+const webhookTimeout = time.Second * 10
+
+// WebhookSignatureHeader holds the hex encoded HMAC-SHA256 of a delivery's body
+const WebhookSignatureHeader = "X-Textile-Signature"
+
+// WebhookOutbox queues and processes outbound webhook deliveries
+type WebhookOutbox struct {
+	hooks     func() []config.Webhook
+	datastore repo.Datastore
+	client    *http.Client
+	mux       sync.Mutex
+}
+
+// NewWebhookOutbox creates a new outbox queue
+func NewWebhookOutbox(
+	hooks func() []config.Webhook,
+	datastore repo.Datastore,
+) *WebhookOutbox {
+	return &WebhookOutbox{
+		hooks:     hooks,
+		datastore: datastore,
+		client:    &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Add queues a delivery of the update for each matching webhook
+func (q *WebhookOutbox) Add(update *pb.FeedItem) error {
+	hooks := q.hooks()
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	btype, err := FeedItemType(update)
+	if err != nil {
+		return err
+	}
+
+	var body string
+	for _, hook := range hooks {
+		if !webhookMatches(hook, update.Thread, btype) {
+			continue
+		}
+		if body == "" {
+			body, err = pbMarshaler.MarshalToString(update)
+			if err != nil {
+				return err
+			}
+		}
+
+		log.Debugf("adding webhook message for %s", hook.ID)
+		if err := q.datastore.WebhookMessages().Add(&pb.WebhookMessage{
+			Id:      ksuid.New().String(),
+			Webhook: hook.ID,
+			Body:    body,
+			Date:    ptypes.TimestampNow(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush processes pending deliveries
+func (q *WebhookOutbox) Flush() {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	log.Debug("flushing webhook messages")
+
+	q.batch(q.datastore.WebhookMessages().List("", webhookFlushGroupSize))
+}
+
+// batch flushes a batch of deliveries
+func (q *WebhookOutbox) batch(msgs []pb.WebhookMessage) {
+	log.Debugf("handling %d webhook messages", len(msgs))
+	if len(msgs) == 0 {
+		return
+	}
+
+	hooks := make(map[string]config.Webhook)
+	for _, hook := range q.hooks() {
+		hooks[hook.ID] = hook
+	}
+
+	var toDelete, failed []pb.WebhookMessage
+	var mux sync.Mutex
+	wg := sync.WaitGroup{}
+	for _, msg := range msgs {
+		wg.Add(1)
+		go func(msg pb.WebhookMessage) {
+			defer wg.Done()
+			hook, ok := hooks[msg.Webhook]
+			// messages for removed webhooks are dropped
+			if ok {
+				if err := q.handle(hook, msg); err != nil {
+					log.Warningf("delivery attempt failed for webhook message %s: %s", msg.Id, err)
+					mux.Lock()
+					failed = append(failed, msg)
+					mux.Unlock()
+					return
+				}
+			}
+			mux.Lock()
+			toDelete = append(toDelete, msg)
+			mux.Unlock()
+		}(msg)
+	}
+	wg.Wait()
+
+	// next batch, listed before deleting so that the offset message still exists
+	offset := msgs[len(msgs)-1].Id
+	next := q.datastore.WebhookMessages().List(offset, webhookFlushGroupSize)
+
+	for _, msg := range toDelete {
+		q.delete(msg)
+	}
+	for _, msg := range failed {
+		q.handleErr(msg)
+	}
+
+	q.batch(next)
+}
+
+// handle delivers a single message
+func (q *WebhookOutbox) handle(hook config.Webhook, msg pb.WebhookMessage) error {
+	body := []byte(msg.Body)
+	req, err := http.NewRequest("POST", hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Textile-Webhook", hook.ID)
+	req.Header.Set("X-Textile-Delivery", msg.Id)
+	if hook.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookBody(hook.Secret, body))
+	}
+
+	res, err := q.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("got status %d from %s", res.StatusCode, hook.URL)
+	}
+	return nil
+}
+
+// handleErr deletes a failed delivery or backs it off until its next attempt
+func (q *WebhookOutbox) handleErr(msg pb.WebhookMessage) {
+	attempts := msg.Attempts + 1
+	if attempts >= maxWebhookAttempts {
+		log.Warningf("giving up on webhook message %s after %d attempts", msg.Id, maxWebhookAttempts)
+		q.delete(msg)
+		return
+	}
+	// deliveries back off on the same schedule as cafe requests
+	next := time.Now().Add(cafeRequestDelay(attempts))
+	if err := q.datastore.WebhookMessages().AddAttempt(msg.Id, next); err != nil {
+		log.Errorf("failed to add attempt to webhook message %s: %s", msg.Id, err)
+	}
+}
+
+// delete removes a message from the queue
+func (q *WebhookOutbox) delete(msg pb.WebhookMessage) {
+	if err := q.datastore.WebhookMessages().Delete(msg.Id); err != nil {
+		log.Errorf("failed to delete webhook message %s: %s", msg.Id, err)
+	} else {
+		log.Debugf("handled webhook message %s", msg.Id)
+	}
+}
+
+// SignWebhookBody returns the hex encoded HMAC-SHA256 of body using secret
+func SignWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookMatches returns whether or not the hook's filters match a thread and block type
+func webhookMatches(hook config.Webhook, thread string, btype pb.Block_BlockType) bool {
+	if len(hook.Threads) > 0 && !util.ListContainsString(hook.Threads, thread) {
+		return false
+	}
+	if len(hook.Types) > 0 && !util.ListContainsString(hook.Types, btype.String()) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/util"
+)
+
+func TestWebhookOutbox_Flush(t *testing.T) {
+	ds, done := testDatastore(t)
+	defer done()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	hooks := []config.Webhook{{ID: "hook", URL: server.URL}}
+	outbox := NewWebhookOutbox(func() []config.Webhook { return hooks }, ds)
+
+	// queue more than one batch
+	count := webhookFlushGroupSize*2 + 1
+	now := time.Now()
+	for i := 0; i < count; i++ {
+		if err := ds.WebhookMessages().Add(&pb.WebhookMessage{
+			Id:      strconv.Itoa(i),
+			Webhook: "hook",
+			Body:    "{}",
+			Date:    util.ProtoTs(now.Add(time.Millisecond * time.Duration(i)).UnixNano()),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	outbox.Flush()
+
+	if n := atomic.LoadInt32(&calls); int(n) != count {
+		t.Fatalf("expected %d deliveries, got %d", count, n)
+	}
+	if left := len(ds.WebhookMessages().List("", -1)); left != 0 {
+		t.Fatalf("expected an empty outbox, got %d messages", left)
+	}
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/mr-tron/base58/base58"
+	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/crypto"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
+)
+
+// ErrWebhookNotFound indicates a webhook is not in the config
+var ErrWebhookNotFound = fmt.Errorf("webhook not found")
+
+// Webhooks lists configured webhooks
+func (t *Textile) Webhooks() []config.Webhook {
+	t.configMux.Lock()
+	defer t.configMux.Unlock()
+	hooks := make([]config.Webhook, len(t.config.Webhooks))
+	copy(hooks, t.config.Webhooks)
+	return hooks
+}
+
+// AddWebhook validates and saves a new webhook to the config.
+// A random secret is generated if one is not provided.
+func (t *Textile) AddWebhook(hook config.Webhook) (*config.Webhook, error) {
+	u, err := url.Parse(hook.URL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("webhook URL must use http or https")
+	}
+
+	for _, id := range hook.Threads {
+		if t.Thread(id) == nil {
+			return nil, ErrThreadNotFound
+		}
+	}
+	for i, typ := range hook.Types {
+		typ = strings.ToUpper(typ)
+		if _, ok := pb.Block_BlockType_value[typ]; !ok {
+			return nil, fmt.Errorf("invalid block type: %s", typ)
+		}
+		hook.Types[i] = typ
+	}
+
+	if hook.Secret == "" {
+		key, err := crypto.GenerateAESKey()
+		if err != nil {
+			return nil, err
+		}
+		hook.Secret = base58.FastBase58Encoding(key)
+	}
+	hook.ID = ksuid.New().String()
+
+	t.configMux.Lock()
+	defer t.configMux.Unlock()
+	hooks := make([]config.Webhook, len(t.config.Webhooks), len(t.config.Webhooks)+1)
+	copy(hooks, t.config.Webhooks)
+	hooks = append(hooks, hook)
+
+	if err := t.writeWebhooks(hooks); err != nil {
+		return nil, err
+	}
+	return &hook, nil
+}
+
+// RemoveWebhook removes a webhook from the config along with its pending deliveries
+func (t *Textile) RemoveWebhook(id string) error {
+	t.configMux.Lock()
+	defer t.configMux.Unlock()
+	var hooks []config.Webhook
+	for _, hook := range t.config.Webhooks {
+		if hook.ID != id {
+			hooks = append(hooks, hook)
+		}
+	}
+	if len(hooks) == len(t.config.Webhooks) {
+		return ErrWebhookNotFound
+	}
+	if hooks == nil {
+		hooks = []config.Webhook{}
+	}
+
+	if err := t.writeWebhooks(hooks); err != nil {
+		return err
+	}
+	return t.datastore.WebhookMessages().DeleteByWebhook(id)
+}
+
+// writeWebhooks saves webhooks to the config file without clobbering
+// other pending changes on disk, callers must hold the config lock
+func (t *Textile) writeWebhooks(hooks []config.Webhook) error {
+	conf, err := config.Read(t.repoPath)
+	if err != nil {
+		return err
+	}
+	conf.Webhooks = hooks
+	if err := config.Write(t.repoPath, conf); err != nil {
+		return err
+	}
+	t.config.Webhooks = hooks
+	return nil
+}
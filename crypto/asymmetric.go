@@ -95,6 +95,9 @@ func decryptCurve25519(privKey *libp2pc.Ed25519PrivateKey, ciphertext []byte) ([
 
 	var plaintext []byte
 
+	if len(ciphertext) < NonceBytes+EphemeralPublicKeyBytes {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
 	n := ciphertext[:NonceBytes]
 	ephemPubkeyBytes := ciphertext[NonceBytes : NonceBytes+EphemeralPublicKeyBytes]
 	ct := ciphertext[NonceBytes+EphemeralPublicKeyBytes:]
@@ -71,3 +71,14 @@ func TestDecryptCurve25519(t *testing.T) {
 		t.Error("Failed to catch curve25519 drcyption error")
 	}
 }
+
+func TestDecryptCurve25519ShortCiphertext(t *testing.T) {
+	priv, _, err := libp2pc.GenerateKeyPair(libp2pc.Ed25519, 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if _, err := Decrypt(priv, []byte("ciphertext")); err == nil {
+		t.Error("decrypting a short ciphertext should fail")
+	}
+}
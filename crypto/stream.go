@@ -0,0 +1,356 @@
+package crypto
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// Streamed AES ciphertext starts with a header of the magic bytes "TXAE", a version byte,
+// the plaintext chunk size (4 bytes, big endian), and a random 12 byte nonce. The header
+// is followed by AES-256 GCM sealed chunks, each with its own 16 byte tag. The nonce of each
+// chunk is the header nonce xor'd with the chunk index, and the last chunk is sealed with
+// different additional data than the others. Because chunks are a fixed size, any chunk can
+// be located and decrypted independently, which makes the plaintext seekable. Readers only
+// accept the current chunk size, so a header can't force large allocations.
+
+// AESStreamChunkSize is the plaintext size of each chunk of streamed AES ciphertext
+const AESStreamChunkSize = 64 * 1024
+
+// aesStreamMagic starts the header of streamed AES ciphertext
+var aesStreamMagic = []byte("TXAE")
+
+// aesStreamVersion is the current streamed AES format version
+const aesStreamVersion = 1
+
+// aesStreamHeaderSize is the size of magic, version, chunk size, and nonce
+const aesStreamHeaderSize = 4 + 1 + 4 + 12
+
+// ErrInvalidAESStream indicates ciphertext is not in the streamed AES format
+var ErrInvalidAESStream = fmt.Errorf("invalid AES stream")
+
+// IsAESStream returns whether or not ciphertext starts with a streamed AES header
+func IsAESStream(ciphertext []byte) bool {
+	return len(ciphertext) >= aesStreamHeaderSize && bytes.Equal(ciphertext[:4], aesStreamMagic)
+}
+
+// NewAESEncryptReader returns a reader of AES-256 GCM ciphertext for plaintext, which is
+// encrypted in chunks as it is read. Chunks are sealed with their index and whether or not
+// they are the last chunk, so that they can't be reordered or truncated. Key is the same
+// 44 byte key used by EncryptAES, though only the first 32 bytes are used.
+func NewAESEncryptReader(plaintext io.Reader, key []byte) (io.Reader, error) {
+	aesgcm, err := newAESStreamCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, aesStreamHeaderSize)
+	copy(header, aesStreamMagic)
+	header[4] = aesStreamVersion
+	binary.BigEndian.PutUint32(header[5:9], AESStreamChunkSize)
+	if _, err := rand.Read(header[9:]); err != nil {
+		return nil, err
+	}
+
+	return &aesEncryptReader{
+		src:       bufio.NewReaderSize(plaintext, AESStreamChunkSize),
+		aesgcm:    aesgcm,
+		nonce:     header[9:],
+		chunkSize: AESStreamChunkSize,
+		buf:       header,
+	}, nil
+}
+
+// NewAESDecryptReader returns a reader of the plaintext of streamed AES ciphertext,
+// which is decrypted and authenticated in chunks as it is read
+func NewAESDecryptReader(ciphertext io.Reader, key []byte) (io.Reader, error) {
+	aesgcm, err := newAESStreamCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, aesStreamHeaderSize)
+	if _, err := io.ReadFull(ciphertext, header); err != nil {
+		return nil, ErrInvalidAESStream
+	}
+	if !IsAESStream(header) || header[4] != aesStreamVersion {
+		return nil, ErrInvalidAESStream
+	}
+	if binary.BigEndian.Uint32(header[5:9]) != AESStreamChunkSize {
+		return nil, ErrInvalidAESStream
+	}
+	chunkSize := AESStreamChunkSize
+
+	return &aesDecryptReader{
+		src:       bufio.NewReaderSize(ciphertext, chunkSize+aesgcm.Overhead()),
+		aesgcm:    aesgcm,
+		nonce:     header[9:],
+		chunkSize: chunkSize,
+	}, nil
+}
+
+// NewAESDecryptReadSeeker returns a seekable reader of the plaintext of streamed AES
+// ciphertext. Only the chunks containing data that is read are decrypted. Seeking to the
+// end returns the plaintext size.
+func NewAESDecryptReadSeeker(ciphertext io.ReadSeeker, key []byte) (io.ReadSeeker, error) {
+	aesgcm, err := newAESStreamCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ciphertext.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	header := make([]byte, aesStreamHeaderSize)
+	if _, err := io.ReadFull(ciphertext, header); err != nil {
+		return nil, ErrInvalidAESStream
+	}
+	if !IsAESStream(header) || header[4] != aesStreamVersion {
+		return nil, ErrInvalidAESStream
+	}
+	if binary.BigEndian.Uint32(header[5:9]) != AESStreamChunkSize {
+		return nil, ErrInvalidAESStream
+	}
+	chunkSize := int64(AESStreamChunkSize)
+
+	end, err := ciphertext.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	overhead := int64(aesgcm.Overhead())
+	total := end - aesStreamHeaderSize
+	chunks := (total + chunkSize + overhead - 1) / (chunkSize + overhead)
+	if chunks == 0 || total-(chunks-1)*(chunkSize+overhead) < overhead {
+		return nil, ErrInvalidAESStream
+	}
+
+	reader := &aesDecryptReadSeeker{
+		src:       ciphertext,
+		aesgcm:    aesgcm,
+		nonce:     header[9:],
+		chunkSize: chunkSize,
+		chunks:    chunks,
+		size:      total - chunks*overhead,
+		chunk:     -1,
+	}
+
+	// authenticate the first chunk so that a bad key fails early
+	if err := reader.load(0); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// decryptAESStream decrypts all of a streamed AES ciphertext
+func decryptAESStream(ciphertext []byte, key []byte) ([]byte, error) {
+	reader, err := NewAESDecryptReader(bytes.NewReader(ciphertext), key)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(reader)
+}
+
+type aesEncryptReader struct {
+	src       *bufio.Reader
+	aesgcm    cipher.AEAD
+	nonce     []byte
+	chunkSize int
+	index     uint64
+	buf       []byte
+	done      bool
+}
+
+func (r *aesEncryptReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// next seals the next chunk of plaintext
+func (r *aesEncryptReader) next() error {
+	chunk := make([]byte, r.chunkSize)
+	n, err := io.ReadFull(r.src, chunk)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	// the chunk is final if there's nothing left to read
+	final := err != nil
+	if !final {
+		if _, err := r.src.Peek(1); err == io.EOF {
+			final = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	r.buf = r.aesgcm.Seal(nil, aesStreamNonce(r.nonce, r.index), chunk[:n], aesStreamData(final))
+	r.index++
+	r.done = final
+	return nil
+}
+
+type aesDecryptReader struct {
+	src       *bufio.Reader
+	aesgcm    cipher.AEAD
+	nonce     []byte
+	chunkSize int
+	index     uint64
+	buf       []byte
+	done      bool
+}
+
+func (r *aesDecryptReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// next opens the next chunk of ciphertext
+func (r *aesDecryptReader) next() error {
+	chunk := make([]byte, r.chunkSize+r.aesgcm.Overhead())
+	n, err := io.ReadFull(r.src, chunk)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	final := err != nil
+	if !final {
+		if _, err := r.src.Peek(1); err == io.EOF {
+			final = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	plain, err := r.aesgcm.Open(nil, aesStreamNonce(r.nonce, r.index), chunk[:n], aesStreamData(final))
+	if err != nil {
+		return err
+	}
+	r.buf = plain
+	r.index++
+	r.done = final
+	return nil
+}
+
+type aesDecryptReadSeeker struct {
+	src       io.ReadSeeker
+	aesgcm    cipher.AEAD
+	nonce     []byte
+	chunkSize int64
+	chunks    int64
+	size      int64
+	pos       int64
+	chunk     int64
+	buf       []byte
+}
+
+func (r *aesDecryptReadSeeker) Read(p []byte) (int, error) {
+	if r.pos >= r.size {
+		return 0, io.EOF
+	}
+	index := r.pos / r.chunkSize
+	if index != r.chunk {
+		if err := r.load(index); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.buf[r.pos-index*r.chunkSize:])
+	r.pos += int64(n)
+	return n, nil
+}
+
+func (r *aesDecryptReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = r.pos + offset
+	case io.SeekEnd:
+		pos = r.size + offset
+	default:
+		return 0, fmt.Errorf("invalid whence")
+	}
+	if pos < 0 {
+		return 0, fmt.Errorf("negative position")
+	}
+	r.pos = pos
+	return pos, nil
+}
+
+// load opens the chunk at index
+func (r *aesDecryptReadSeeker) load(index int64) error {
+	overhead := int64(r.aesgcm.Overhead())
+	if _, err := r.src.Seek(aesStreamHeaderSize+index*(r.chunkSize+overhead), io.SeekStart); err != nil {
+		return err
+	}
+	chunk := make([]byte, r.chunkSize+overhead)
+	n, err := io.ReadFull(r.src, chunk)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	final := index == r.chunks-1
+	plain, err := r.aesgcm.Open(nil, aesStreamNonce(r.nonce, uint64(index)), chunk[:n], aesStreamData(final))
+	if err != nil {
+		return err
+	}
+	r.buf = plain
+	r.chunk = index
+	return nil
+}
+
+// newAESStreamCipher returns an AES-256 GCM cipher for a 44 byte key
+func newAESStreamCipher(key []byte) (cipher.AEAD, error) {
+	if len(key) != 44 {
+		return nil, fmt.Errorf("invalid key")
+	}
+	block, err := aes.NewCipher(key[:32])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// aesStreamNonce returns the nonce for a chunk, the header nonce xor'd with the chunk index
+func aesStreamNonce(nonce []byte, index uint64) []byte {
+	n := make([]byte, len(nonce))
+	copy(n, nonce)
+	var ib [8]byte
+	binary.BigEndian.PutUint64(ib[:], index)
+	for i := range ib {
+		n[len(n)-8+i] ^= ib[i]
+	}
+	return n
+}
+
+// aesStreamData returns the additional data sealed with a chunk, which marks the last chunk
+func aesStreamData(final bool) []byte {
+	if final {
+		return []byte{1}
+	}
+	return []byte{0}
+}
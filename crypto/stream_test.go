@@ -0,0 +1,165 @@
+package crypto_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	. "github.com/textileio/go-textile/crypto"
+)
+
+func TestAESStream(t *testing.T) {
+	key, err := GenerateAESKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, size := range []int{0, 1, AESStreamChunkSize, AESStreamChunkSize*2 + 7} {
+		plaintext := make([]byte, size)
+		rand.Read(plaintext)
+
+		reader, err := NewAESEncryptReader(bytes.NewReader(plaintext), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ciphertext, err := ioutil.ReadAll(reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !IsAESStream(ciphertext) {
+			t.Fatal("ciphertext should start with a stream header")
+		}
+
+		decrypted, err := DecryptAES(ciphertext, key)
+		if err != nil {
+			t.Fatalf("decrypt %d bytes failed: %s", size, err)
+		}
+		if !bytes.Equal(decrypted, plaintext) {
+			t.Fatalf("decrypt %d bytes returned wrong plaintext", size)
+		}
+
+		// truncation is detected
+		if size > AESStreamChunkSize {
+			if _, err := DecryptAES(ciphertext[:len(ciphertext)-size%AESStreamChunkSize-16], key); err == nil {
+				t.Fatal("decrypt truncated stream should fail")
+			}
+		}
+	}
+}
+
+func TestAESStream_BadKey(t *testing.T) {
+	key, err := GenerateAESKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err := NewAESEncryptReader(bytes.NewReader([]byte("yoyoyoyo!")), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := GenerateAESKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecryptAES(ciphertext, other); err == nil {
+		t.Error("decrypt AES stream with bad key succeeded")
+	}
+}
+
+func TestAESStream_BadChunkSize(t *testing.T) {
+	key, err := GenerateAESKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err := NewAESEncryptReader(bytes.NewReader([]byte("yoyoyoyo!")), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// chunk size is the 4 bytes after the magic and version
+	copy(ciphertext[5:9], []byte{0xff, 0xff, 0xff, 0xff})
+	if _, err := NewAESDecryptReader(bytes.NewReader(ciphertext), key); err != ErrInvalidAESStream {
+		t.Errorf("decrypt reader with bad chunk size should fail, got: %v", err)
+	}
+	if _, err := NewAESDecryptReadSeeker(bytes.NewReader(ciphertext), key); err != ErrInvalidAESStream {
+		t.Errorf("decrypt read seeker with bad chunk size should fail, got: %v", err)
+	}
+}
+
+func TestAESStream_Seek(t *testing.T) {
+	key, err := GenerateAESKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext := make([]byte, AESStreamChunkSize*3+100)
+	rand.Read(plaintext)
+
+	reader, err := NewAESEncryptReader(bytes.NewReader(plaintext), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seeker, err := NewAESDecryptReadSeeker(bytes.NewReader(ciphertext), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	size, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != int64(len(plaintext)) {
+		t.Fatalf("wrong plaintext size: %d", size)
+	}
+
+	// read across a chunk boundary
+	offset := int64(AESStreamChunkSize*2 - 10)
+	if _, err := seeker.Seek(offset, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	part := make([]byte, 30)
+	if _, err := io.ReadFull(seeker, part); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(part, plaintext[offset:offset+30]) {
+		t.Fatal("read after seek returned wrong plaintext")
+	}
+
+	// read the tail
+	if _, err := seeker.Seek(-50, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+	tail, err := ioutil.ReadAll(seeker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tail, plaintext[len(plaintext)-50:]) {
+		t.Fatal("read to end returned wrong plaintext")
+	}
+
+	// a tampered chunk fails to decrypt
+	ciphertext[len(ciphertext)-1] ^= 1
+	seeker, err = NewAESDecryptReadSeeker(bytes.NewReader(ciphertext), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := seeker.Seek(-1, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(seeker); err == nil {
+		t.Fatal("read of tampered chunk should fail")
+	}
+}
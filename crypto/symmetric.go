@@ -34,10 +34,18 @@ func EncryptAES(bytes []byte, key []byte) ([]byte, error) {
 }
 
 // DecryptAES uses key (:32 key, 32:12 nonce) to perform AES-256 GCM decryption on bytes.
+// Streamed AES ciphertext (see NewAESEncryptReader) is also decrypted.
 func DecryptAES(bytes []byte, key []byte) ([]byte, error) {
 	if len(key) != 44 {
 		return nil, fmt.Errorf("invalid key")
 	}
+	if IsAESStream(bytes) {
+		// fallback in case legacy ciphertext happens to start with a stream header
+		if plain, err := decryptAESStream(bytes, key); err == nil {
+			return plain, nil
+		}
+	}
+
 	block, err := aes.NewCipher(key[:32])
 	if err != nil {
 		return nil, err
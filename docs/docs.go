@@ -1,6 +1,6 @@
 // GENERATED BY THE COMMAND ABOVE; DO NOT EDIT
 // This file was generated by swaggo/swag at
-// 2019-05-07 11:30:57.173808 -0700 PDT m=+0.302045893
+// 2026-10-19 02:16:41.251587395 +0000 UTC m=+0.211300665
 
 package docs
 
@@ -26,10 +26,10 @@ var doc = `{
             "name": "MIT License",
             "url": "https://github.com/textileio/go-textile/blob/master/LICENSE"
         },
-        "version": "0"
+        "version": "1"
     },
     "host": "{{.Host}}",
-    "basePath": "/api/v0",
+    "basePath": "/api/v1",
     "paths": {
         "/account": {
             "get": {
@@ -52,7 +52,8 @@ var doc = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
@@ -98,35 +99,53 @@ var doc = `{
                 }
             }
         },
-        "/blocks": {
+        "/admin/clients": {
             "get": {
-                "description": "Paginates blocks in a thread. Blocks are the raw components in a thread.\nThink of them as an append-only log of thread updates where each update is\nhash-linked to its parent(s). New / recovering peers can sync history by simply\ntraversing the hash tree.",
+                "description": "Lists all clients registered with this cafe, ordered by last seen",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "blocks"
-                ],
-                "summary": "Paginates blocks in a thread",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "thread=,offset=,limit=5",
-                        "description": "thread: Thread ID (can also use 'default'), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5)",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
-                    }
+                    "admin"
                 ],
+                "summary": "List cafe clients",
                 "responses": {
                     "200": {
-                        "description": "blocks",
+                        "description": "clients",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.BlockList"
+                            "$ref": "#/definitions/pb.CafeClientList"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/clients/{id}": {
+            "delete": {
+                "description": "Removes a registered client along with its stored threads, inbox messages,\nand pin records",
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Evict a cafe client",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "client id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "ok",
                         "schema": {
                             "type": "string"
                         }
@@ -134,271 +153,315 @@ var doc = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/blocks/{id}": {
+        "/admin/replication": {
             "get": {
-                "description": "Gets a thread block by ID",
+                "description": "Shows the status of client record replication to each neighbor cafe,\nincluding pending replications and the last error, if any",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "blocks"
-                ],
-                "summary": "Gets thread block",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "block id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "admin"
                 ],
+                "summary": "Show cafe replication status",
                 "responses": {
                     "200": {
-                        "description": "block",
+                        "description": "status",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.Block"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/pb.CafeReplicationStatus"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Removes a thread block by ID",
+            }
+        },
+        "/admin/usage/{id}": {
+            "get": {
+                "description": "Shows stored thread count, inbox depth, and pinned content for all\nregistered clients, or a single client if an ID is given",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "blocks"
+                    "admin"
                 ],
-                "summary": "Remove thread block",
+                "summary": "Show cafe client usage",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "block id",
+                        "description": "client id",
                         "name": "id",
-                        "in": "path",
-                        "required": true
+                        "in": "path"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "block",
+                    "200": {
+                        "description": "usage",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.Block"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/pb.CafeClientUsageList"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/blocks/{id}/comment": {
+        "/api-keys": {
             "get": {
-                "description": "Gets a thread comment by block ID",
+                "description": "Lists info about all local API keys. Tokens are not included.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "blocks"
+                    "api-keys"
                 ],
-                "summary": "Get thread comment",
+                "summary": "List API keys",
+                "responses": {
+                    "200": {
+                        "description": "keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.ApiKeyList"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a local API key signed by the account key. The response contains the key's\ntoken, which is only returned once and should be sent as a bearer token\n(Authorization: Bearer \u003ctoken\u003e). Scopes: read (GET requests), write (other\nrequests), admin (config, logs, tokens, webhooks, cafe admin, and API keys; implies\nread and write), and seed (account seed).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "api-keys"
+                ],
+                "summary": "Create an API key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "block id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "A name for the key",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "read,write",
+                        "description": "Comma-separated list of scopes (read, write, admin, seed)",
+                        "name": "scopes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC3339 date after which the key can no longer be used",
+                        "name": "expires",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "comment",
+                    "201": {
+                        "description": "key",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.Comment"
+                            "$ref": "#/definitions/pb.ApiKey"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/blocks/{id}/comments": {
-            "get": {
-                "description": "Lists comments on a thread block",
-                "produces": [
-                    "application/json"
-                ],
+        "/api-keys/{id}": {
+            "delete": {
+                "description": "Revokes a local API key",
                 "tags": [
-                    "blocks"
+                    "api-keys"
                 ],
-                "summary": "List comments",
+                "summary": "Remove an API key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "block id",
+                        "description": "key id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "comments",
+                    "204": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.CommentList"
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/batch": {
             "post": {
-                "description": "Adds a comment to a thread block",
+                "description": "Runs an ordered list of operations (mill, files, message, comment, like) in a\nsingle request. Operations may reference the result of an earlier operation\nby its id with a '$' prefix, e.g., a mill's file hash in use, a file index\nin a files dir, or a files or message block in a comment or like. A failed\noperation does not stop the batch unless stop_on_error is set.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "blocks"
+                    "batch"
                 ],
-                "summary": "Add a comment",
+                "summary": "Runs a batch of operations",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "block id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "urlescaped comment body",
-                        "name": "X-Textile-Args",
-                        "in": "header",
-                        "required": true
+                        "description": "operations",
+                        "name": "ops",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.batchRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "comment",
+                    "200": {
+                        "description": "results",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.Comment"
+                            "$ref": "#/definitions/core.batchResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/blocks/{id}/like": {
+        "/blocks": {
             "get": {
-                "description": "Gets a thread like by block ID",
+                "description": "Paginates blocks in a thread. Blocks are the raw components in a thread.\nThink of them as an append-only log of thread updates where each update is\nhash-linked to its parent(s). New / recovering peers can sync history by simply\ntraversing the hash tree.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "blocks"
                 ],
-                "summary": "Get thread like",
+                "summary": "Paginates blocks in a thread",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "block id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Thread ID (can also use 'default')",
+                        "name": "thread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Offset ID to start listing from (omit for latest)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "List page size (default: 5)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "like",
+                        "description": "blocks",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.Like"
+                            "$ref": "#/definitions/pb.BlockList"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/blocks/{id}/likes": {
+        "/blocks/{id}": {
             "get": {
-                "description": "Lists likes on a thread block",
+                "description": "Gets a thread block by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "blocks"
                 ],
-                "summary": "List likes",
+                "summary": "Gets thread block",
                 "parameters": [
                     {
                         "type": "string",
@@ -410,29 +473,30 @@ var doc = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "likes",
+                        "description": "block",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.LikeList"
+                            "$ref": "#/definitions/pb.Block"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "Adds a like to a thread block",
+            "delete": {
+                "description": "Removes a thread block by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "blocks"
                 ],
-                "summary": "Add a like",
+                "summary": "Remove thread block",
                 "parameters": [
                     {
                         "type": "string",
@@ -444,147 +508,229 @@ var doc = `{
                 ],
                 "responses": {
                     "201": {
-                        "description": "like",
+                        "description": "block",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.Like"
+                            "$ref": "#/definitions/pb.Block"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/cafes": {
+        "/blocks/{id}/comment": {
             "get": {
-                "description": "List info about all active cafe sessions. Cafes are other peers on the network\nwho offer pinning, backup, and inbox services",
+                "description": "Gets a thread comment by block ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "cafes"
+                    "blocks"
+                ],
+                "summary": "Get thread comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "block id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List info about all active cafe sessions",
                 "responses": {
                     "200": {
-                        "description": "cafe sessions",
+                        "description": "comment",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.CafeSessionList"
+                            "$ref": "#/definitions/pb.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/blocks/{id}/comments": {
+            "get": {
+                "description": "Lists comments on a thread block",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blocks"
+                ],
+                "summary": "List comments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "block id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "comments",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.CommentList"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Registers with a cafe and saves an expiring service session token. An access\ntoken is required to register, and should be obtained separately from the target\nCafe",
+                "description": "Adds a comment to a thread block",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "cafes"
+                    "blocks"
                 ],
-                "summary": "Register with a Cafe",
+                "summary": "Add a comment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "cafe host",
-                        "name": "X-Textile-Args",
-                        "in": "header",
+                        "description": "block id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "default": "token=",
-                        "description": "token: An access token supplied by the Cafe",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "urlescaped comment body",
+                        "name": "arg",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON alternative to arg query params",
+                        "name": "args",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiArgs"
+                        }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "cafe session",
+                        "description": "comment",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.CafeSession"
+                            "$ref": "#/definitions/pb.Comment"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/cafes/messages": {
-            "post": {
-                "description": "Check for messages at all cafes. New messages are downloaded and processed\nopportunistically.",
+        "/blocks/{id}/like": {
+            "get": {
+                "description": "Gets a thread like by block ID",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "cafes"
+                    "blocks"
+                ],
+                "summary": "Get thread like",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "block id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Check for messages at all cafes",
                 "responses": {
                     "200": {
-                        "description": "ok",
+                        "description": "like",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Like"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/cafes/{id}": {
+        "/blocks/{id}/likes": {
             "get": {
-                "description": "Gets and displays info about a cafe session. Cafes are other peers on the network\nwho offer pinning, backup, and inbox services",
+                "description": "Lists likes on a thread block",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "cafes"
+                    "blocks"
                 ],
-                "summary": "Gets and displays info about a cafe session",
+                "summary": "List likes",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "cafe id",
+                        "description": "block id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -592,307 +738,343 @@ var doc = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "cafe session",
+                        "description": "likes",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.CafeSession"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/pb.LikeList"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Deregisters with a cafe (content will expire based on the cafe's service rules)",
+            "post": {
+                "description": "Adds a like to a thread block",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "cafes"
+                    "blocks"
                 ],
-                "summary": "Deregisters a cafe",
+                "summary": "Add a like",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "cafe id",
+                        "description": "block id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "ok",
+                    "201": {
+                        "description": "like",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Like"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/config": {
-            "put": {
-                "description": "Replace entire config file contents. The config command controls configuration\nvariables. It works much like 'git config'. The configuration values are stored\nin a config file inside the Textile repository.",
-                "consumes": [
+        "/cafes": {
+            "get": {
+                "description": "List info about all active cafe sessions. Cafes are other peers on the network\nwho offer pinning, backup, and inbox services",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "config"
-                ],
-                "summary": "Replace config settings.",
-                "parameters": [
-                    {
-                        "description": "JSON document",
-                        "name": "config",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/mill.Json"
-                        }
-                    }
+                    "cafes"
                 ],
+                "summary": "List info about all active cafe sessions",
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "cafe sessions",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.CafeSessionList"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             },
-            "patch": {
-                "description": "When patching config values, valid JSON types must be used. For example, a string\nshould be escaped or wrapped in single quotes (e.g., \\\"127.0.0.1:40600\\\") and\narrays and objects work fine (e.g. '{\"API\": \"127.0.0.1:40600\"}') but should be\nwrapped in single quotes. Be sure to restart the daemon for changes to take effect.\nSee https://tools.ietf.org/html/rfc6902 for details on RFC6902 JSON patch format.",
+            "post": {
+                "description": "Registers with a cafe and saves an expiring service session token. An access\ntoken is required to register, and should be obtained separately from the target\nCafe",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "config"
+                    "cafes"
                 ],
-                "summary": "Set/update config settings",
+                "summary": "Register with a Cafe",
                 "parameters": [
                     {
-                        "description": "An RFC6902 JSON patch (array of ops)",
-                        "name": "patch",
+                        "type": "string",
+                        "description": "cafe host",
+                        "name": "arg",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON alternative to arg query params",
+                        "name": "args",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/mill.Json"
+                            "$ref": "#/definitions/core.apiArgs"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "An access token supplied by the Cafe",
+                        "name": "token",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "201": {
+                        "description": "cafe session",
                         "schema": {
-                            "type": "string"
-                        }
+                            "type": "object",
+                            "$ref": "#/definitions/pb.CafeSession"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/config/{path}": {
-            "get": {
-                "description": "Report the currently active config settings, which may differ from the values\nspecifed when setting/patching values.",
+        "/cafes/messages": {
+            "post": {
+                "description": "Check for messages at all cafes. New messages are downloaded and processed\nopportunistically.",
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "config"
-                ],
-                "summary": "Get active config settings",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "config path (e.g., Addresses/API)",
-                        "name": "path",
-                        "in": "path"
-                    }
+                    "cafes"
                 ],
+                "summary": "Check for messages at all cafes",
                 "responses": {
-                    "200": {
-                        "description": "new config value",
+                    "204": {
+                        "description": "ok",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/mill.Json"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/contacts": {
+        "/cafes/{id}": {
             "get": {
-                "description": "Lists known contacts.",
+                "description": "Gets and displays info about a cafe session. Cafes are other peers on the network\nwho offer pinning, backup, and inbox services",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "contacts"
+                    "cafes"
+                ],
+                "summary": "Gets and displays info about a cafe session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "cafe id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List known contacts",
                 "responses": {
                     "200": {
-                        "description": "contacts",
+                        "description": "cafe session",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.ContactList"
+                            "$ref": "#/definitions/pb.CafeSession"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
-            }
-        },
-        "/contacts/search": {
-            "post": {
-                "description": "Search for contacts known locally and on the network",
+            },
+            "put": {
+                "description": "Updates a cafe session's routing priority. Higher priority cafes are preferred\nfor new requests and are listed first as inboxes",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "contacts"
+                    "cafes"
                 ],
-                "summary": "Search for contacts",
+                "summary": "Updates a cafe session",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "local=\"false\",limit=5,wait=5,address=,username=,events=\"false\"",
-                        "description": "local: Whether to only search local contacts, remote: Whether to only search remote contacts, limit: Stops searching after limit results are found, wait: Stops searching after 'wait' seconds have elapsed (max 30s), username: search by username string, address: search by account address string, events: Whether to emit Server-Sent Events (SSEvent) or plain JSON",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "cafe id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Routing priority",
+                        "name": "priority",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "results stream",
+                        "description": "cafe session",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.QueryResult"
+                            "$ref": "#/definitions/pb.CafeSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
-            }
-        },
-        "/contacts/{address}": {
-            "get": {
-                "description": "Gets a known contact",
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Deregisters with a cafe (content will expire based on the cafe's service rules)",
                 "tags": [
-                    "contacts"
+                    "cafes"
                 ],
-                "summary": "Get a known contact",
+                "summary": "Deregisters a cafe",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "address",
-                        "name": "address",
+                        "description": "cafe id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "contact",
+                    "204": {
+                        "description": "ok",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.Contact"
+                            "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/config": {
             "put": {
-                "description": "Adds a contact by username or account address to known contacts.",
+                "description": "Replace entire config file contents. The config command controls configuration\nvariables. It works much like 'git config'. The configuration values are stored\nin a config file inside the Textile repository.",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "contacts"
+                    "config"
                 ],
-                "summary": "Add to known contacts",
+                "summary": "Replace config settings.",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "address",
-                        "name": "address",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "contact",
-                        "name": "contact",
+                        "description": "JSON document",
+                        "name": "config",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.Contact"
+                            "$ref": "#/definitions/mill.Json"
                         }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "ok",
+                        "description": "No Content",
                         "schema": {
                             "type": "string"
                         }
@@ -900,263 +1082,309 @@ var doc = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Removes a known contact",
+            "patch": {
+                "description": "When patching config values, valid JSON types must be used. For example, a string\nshould be escaped or wrapped in single quotes (e.g., \\\"127.0.0.1:40600\\\") and\narrays and objects work fine (e.g. '{\"API\": \"127.0.0.1:40600\"}') but should be\nwrapped in single quotes. Be sure to restart the daemon for changes to take effect.\nSee https://tools.ietf.org/html/rfc6902 for details on RFC6902 JSON patch format.",
+                "consumes": [
+                    "application/json"
+                ],
                 "tags": [
-                    "contacts"
+                    "config"
                 ],
-                "summary": "Remove a contact",
+                "summary": "Set/update config settings",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "address",
-                        "name": "address",
-                        "in": "path",
-                        "required": true
+                        "description": "An RFC6902 JSON patch (array of ops)",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/mill.Json"
+                        }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "ok",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                        "description": "No Content",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/feed": {
+        "/config/{path}": {
             "get": {
-                "description": "Paginates post (join|leave|files|message) and annotation (comment|like) block types\nThe mode option dictates how the feed is displayed:\n\"chrono\": All feed block types are shown. Annotations always nest their target post,\ni.e., the post a comment is about.\n\"annotated\": Annotations are nested under post targets, but are not shown in the\ntop-level feed.\n\"stacks\": Related blocks are chronologically grouped into \"stacks\". A new stack is\nstarted if an unrelated block breaks continuity. This mode is used by Textile\nPhotos. Stacks may include:\n* The initial post with some nested annotations. Newer annotations may have already\nbeen listed.\n* One or more annotations about a post. The newest annotation assumes the \"top\"\nposition in the stack. Additional annotations are nested under the target.\nNewer annotations may have already been listed in the case as well.",
+                "description": "Report the currently active config settings, which may differ from the values\nspecifed when setting/patching values.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "feed"
+                    "config"
                 ],
-                "summary": "Paginates post and annotation block types",
+                "summary": "Get active config settings",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "thread=,offset=,limit=5,mode=\"chrono\"",
-                        "description": "thread: Thread ID (can also use 'default'), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5), mode: Feed mode (one of 'chrono', 'annotated', or 'stacks')",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "config path (e.g., Addresses/API)",
+                        "name": "path",
+                        "in": "path"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "feed",
+                        "description": "new config value",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.FeedItemList"
+                            "$ref": "#/definitions/mill.Json"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts": {
+            "get": {
+                "description": "Lists known contacts.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "List known contacts",
+                "responses": {
+                    "200": {
+                        "description": "contacts",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.ContactList"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/file/{hash}/data": {
-            "get": {
-                "description": "Returns raw data for file",
+        "/contacts/search": {
+            "post": {
+                "description": "Search for contacts known locally and on the network",
                 "produces": [
-                    "application/octet-stream"
+                    "application/json"
                 ],
                 "tags": [
-                    "files"
+                    "contacts"
                 ],
-                "summary": "File data at hash",
+                "summary": "Search for contacts",
                 "parameters": [
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether to only search local contacts",
+                        "name": "local",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "file hash",
-                        "name": "hash",
-                        "in": "path",
-                        "required": true
+                        "description": "Whether to only search remote contacts",
+                        "name": "remote",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Stops searching after limit results are found",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Stops searching after 'wait' seconds have elapsed (max 30s)",
+                        "name": "wait",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by username string",
+                        "name": "username",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by account address string",
+                        "name": "address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether cafes should forward the search to their federated peer cafes instead of using pubsub",
+                        "name": "federated",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether to emit Server-Sent Events (SSEvent) or plain JSON",
+                        "name": "events",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "results stream",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.QueryResult"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/files": {
+        "/contacts/{address}": {
             "get": {
-                "description": "Paginates thread files. If thread id not provided, paginate all files. Specify\n\"default\" to use the default thread (if set).",
+                "description": "Gets a known contact",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "files"
+                    "contacts"
                 ],
-                "summary": "Paginates thread files",
+                "summary": "Get a known contact",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "thread=,offset=,limit=5",
-                        "description": "thread: Thread ID. Omit for all, offset: Offset ID to start listing from. Omit for latest, limit: List page size. (default: 5)",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "files",
+                        "description": "contact",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.FilesList"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/pb.Contact"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
-            }
-        },
-        "/files/{block}": {
-            "get": {
-                "description": "Gets a thread file by block ID",
-                "produces": [
+            },
+            "put": {
+                "description": "Adds a contact by username or account address to known contacts.",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "files"
+                    "contacts"
                 ],
-                "summary": "Get thread file",
+                "summary": "Add to known contacts",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "block id",
-                        "name": "block",
+                        "description": "address",
+                        "name": "address",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "contact",
+                        "name": "contact",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Contact"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "file",
+                    "204": {
+                        "description": "ok",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.Files"
+                            "type": "string"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            }
-        },
-        "/invites": {
-            "get": {
-                "description": "Lists all pending thread invites",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "invites"
-                ],
-                "summary": "List invites",
-                "responses": {
-                    "200": {
-                        "description": "invites",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.InviteViewList"
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "Creates a direct account-to-account or external invite to a thread",
-                "produces": [
-                    "application/json"
-                ],
+            "delete": {
+                "description": "Removes a known contact",
                 "tags": [
-                    "invites"
+                    "contacts"
                 ],
-                "summary": "Create an invite to a thread",
+                "summary": "Remove a contact",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "thread=,address=",
-                        "description": "thread: Thread ID (can also use 'default'), address: Account Address (omit to create an external invite)",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "invite",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.ExternalInvite"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "204": {
+                        "description": "ok",
                         "schema": {
                             "type": "string"
                         }
@@ -1164,1207 +1392,1430 @@ var doc = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/invites/{id}/accept": {
-            "post": {
-                "description": "Accepts a direct peer-to-peer or external invite to a thread. Use the key option\nwith an external invite",
+        "/feed": {
+            "get": {
+                "description": "Paginates post (join|leave|files|message) and annotation (comment|like) block types\nThe mode option dictates how the feed is displayed:\n\"chrono\": All feed block types are shown. Annotations always nest their target post,\ni.e., the post a comment is about.\n\"annotated\": Annotations are nested under post targets, but are not shown in the\ntop-level feed.\n\"stacks\": Related blocks are chronologically grouped into \"stacks\". A new stack is\nstarted if an unrelated block breaks continuity. This mode is used by Textile\nPhotos. Stacks may include:\n* The initial post with some nested annotations. Newer annotations may have already\nbeen listed.\n* One or more annotations about a post. The newest annotation assumes the \"top\"\nposition in the stack. Additional annotations are nested under the target.\nNewer annotations may have already been listed in the case as well.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "invites"
+                    "feed"
                 ],
-                "summary": "Accept a thread invite",
+                "summary": "Paginates post and annotation block types",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "invite id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Thread ID (can also use 'default')",
+                        "name": "thread",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "key=",
-                        "description": "key: key for an external invite",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "Offset ID to start listing from (omit for latest)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "List page size (default: 5)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "chrono",
+                        "description": "Feed mode (one of 'chrono', 'annotated', or 'stacks')",
+                        "name": "mode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "join block",
+                    "200": {
+                        "description": "feed",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.Block"
+                            "$ref": "#/definitions/pb.FeedItemList"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/invites/{id}/ignore": {
-            "post": {
-                "description": "Ignores a direct peer-to-peer invite to a thread",
+        "/file/{hash}/data": {
+            "get": {
+                "description": "Returns raw data for file. Range requests are supported for seeking and\nresuming downloads. The file hash is used as an ETag for conditional requests.",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "invites"
+                    "files"
                 ],
-                "summary": "Ignore a thread invite",
+                "summary": "File data at hash",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "invite id",
-                        "name": "id",
+                        "description": "file hash",
+                        "name": "hash",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "byte range, e.g., bytes=0-1023",
+                        "name": "Range",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "file hash ETag from a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "ok",
+                        "description": "OK",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "206": {
+                        "description": "Partial Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified",
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "416": {
+                        "description": "Requested Range Not Satisfiable",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
                     }
                 }
             }
         },
-        "/ipfs/cat/{path}": {
+        "/files": {
             "get": {
-                "description": "Displays the data behind an IPFS CID (hash) or Path",
+                "description": "Paginates thread files. If thread id not provided, paginate all files. Specify\n\"default\" to use the default thread (if set).",
                 "produces": [
-                    "application/octet-stream"
+                    "application/json"
                 ],
                 "tags": [
-                    "ipfs"
+                    "files"
                 ],
-                "summary": "Cat IPFS data",
+                "summary": "Paginates thread files",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ipfs/ipns cid",
-                        "name": "path",
-                        "in": "path",
-                        "required": true
+                        "description": "Thread ID. Omit for all",
+                        "name": "thread",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "key=",
-                        "description": "key: Key to decrypt data on-the-fly",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "Offset ID to start listing from. Omit for latest",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "List page size. (default: 5)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data",
+                        "description": "files",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "integer"
-                            }
+                            "type": "object",
+                            "$ref": "#/definitions/pb.FilesList"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/ipfs/id": {
+        "/files/{block}": {
             "get": {
-                "description": "Displays underlying IPFS peer ID",
+                "description": "Gets a thread file by block ID",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "ipfs"
+                    "files"
+                ],
+                "summary": "Get thread file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "block id",
+                        "name": "block",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get IPFS peer ID",
                 "responses": {
                     "200": {
-                        "description": "peer id",
+                        "description": "file",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Files"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/ipfs/swarm/connect": {
-            "post": {
-                "description": "Opens a new direct connection to a peer using an IPFS multiaddr",
+        "/invites": {
+            "get": {
+                "description": "Lists all pending thread invites",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ipfs"
-                ],
-                "summary": "Opens a new direct connection to a peer address",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "peer address",
-                        "name": "X-Textile-Args",
-                        "in": "header",
-                        "required": true
-                    }
+                    "invites"
                 ],
+                "summary": "List invites",
                 "responses": {
                     "200": {
-                        "description": "ok",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                        "description": "invites",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.InviteViewList"
                         }
                     }
                 }
-            }
-        },
-        "/ipfs/swarm/peers": {
-            "get": {
-                "description": "Lists the set of peers this node is connected to",
+            },
+            "post": {
+                "description": "Creates a direct account-to-account or external invite to a thread",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ipfs"
+                    "invites"
                 ],
-                "summary": "List swarm peers",
+                "summary": "Create an invite to a thread",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "verbose=\"false\",latency=\"false\",streams=\"false\",direction=\"false\"",
-                        "description": "verbose: Display all extra information, latency: Also list information about latency to each peer, streams: Also list information about open streams for each peer, direction: Also list information about the direction of connection",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "Thread ID (can also use 'default')",
+                        "name": "thread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Account Address (omit to create an external invite)",
+                        "name": "address",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "connection",
+                    "201": {
+                        "description": "invite",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ipfs.ConnInfos"
+                            "$ref": "#/definitions/pb.ExternalInvite"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/keys/{target}": {
-            "get": {
-                "description": "Shows file keys under the given target from an add",
+        "/invites/{id}/accept": {
+            "post": {
+                "description": "Accepts a direct peer-to-peer or external invite to a thread. Use the key option\nwith an external invite",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "files"
+                    "invites"
                 ],
-                "summary": "Show file keys",
+                "summary": "Accept a thread invite",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "target id",
-                        "name": "target",
+                        "description": "invite id",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key for an external invite",
+                        "name": "key",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "keys",
+                    "201": {
+                        "description": "join block",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.Keys"
+                            "$ref": "#/definitions/pb.Block"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/logs/{subsystem}": {
+        "/invites/{id}/ignore": {
             "post": {
-                "description": "List or change the verbosity of one or all subsystems log output. Textile logs\npiggyback on the IPFS event logs",
+                "description": "Ignores a direct peer-to-peer invite to a thread",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "utils"
+                    "invites"
                 ],
-                "summary": "Access subsystem logs",
+                "summary": "Ignore a thread invite",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "subsystem logging identifier (omit for all)",
-                        "name": "subsystem",
-                        "in": "path"
-                    },
-                    {
-                        "type": "string",
-                        "default": "level=,tex-only=\"false\"",
-                        "description": "level: Log-level (one of: debug, info, warning, error, critical, or ",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "invite id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "subsystems",
+                    "204": {
+                        "description": "ok",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/core.SubsystemInfo"
+                            "type": "string"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/messages": {
+        "/ipfs/cat/{path}": {
             "get": {
-                "description": "Paginates thread messages",
+                "description": "Displays the data behind an IPFS CID (hash) or Path",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "messages"
+                    "ipfs"
                 ],
-                "summary": "Paginates thread messages",
+                "summary": "Cat IPFS data",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "thread=,offset=,limit=10",
-                        "description": "thread: Thread ID (can also use 'default', omit for all), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5)",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "ipfs/ipns cid",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key to decrypt data on-the-fly",
+                        "name": "key",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "messages",
+                        "description": "data",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.TextList"
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/messages/{block}": {
+        "/ipfs/id": {
             "get": {
-                "description": "Gets a thread message by block ID",
+                "description": "Displays underlying IPFS peer ID",
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "messages"
-                ],
-                "summary": "Get thread message",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "block id",
-                        "name": "block",
-                        "in": "path",
-                        "required": true
-                    }
+                    "ipfs"
                 ],
+                "summary": "Get IPFS peer ID",
                 "responses": {
                     "200": {
-                        "description": "message",
+                        "description": "peer id",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.Text"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/mills/blob": {
+        "/ipfs/swarm/connect": {
             "post": {
-                "description": "Takes a binary data blob, and optionally encrypts it, before adding to IPFS,\nand returns a file object",
+                "description": "Opens a new direct connection to a peer using an IPFS multiaddr",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "mills"
+                    "ipfs"
                 ],
-                "summary": "Process raw data blobs",
+                "summary": "Opens a new direct connection to a peer address",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "multipart/form-data file",
-                        "name": "file",
-                        "in": "formData"
+                        "type": "string",
+                        "description": "peer address",
+                        "name": "arg",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "default": "plaintext=false,use=\"\"",
-                        "description": "plaintext: whether to leave unencrypted), use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "JSON alternative to arg query params",
+                        "name": "args",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiArgs"
+                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "file",
+                    "200": {
+                        "description": "ok",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.FileIndex"
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/mills/image/exif": {
-            "post": {
-                "description": "Takes an input image, and extracts its EXIF data (optionally encrypting output),\nbefore adding to IPFS, and returns a file object",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/ipfs/swarm/peers": {
+            "get": {
+                "description": "Lists the set of peers this node is connected to",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "mills"
+                    "ipfs"
                 ],
-                "summary": "Extract EXIF data from image",
+                "summary": "List swarm peers",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "multipart/form-data file",
-                        "name": "file",
-                        "in": "formData"
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Display all extra information",
+                        "name": "verbose",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "default": "plaintext=false,use=\"\"",
-                        "description": "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Also list information about latency to each peer",
+                        "name": "latency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Also list information about open streams for each peer",
+                        "name": "streams",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Also list information about the direction of connection",
+                        "name": "direction",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "file",
+                    "200": {
+                        "description": "connection",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.FileIndex"
+                            "$ref": "#/definitions/ipfs.ConnInfos"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/mills/image/resize": {
-            "post": {
-                "description": "Takes an input image, and resizes/resamples it (optionally encrypting output),\nbefore adding to IPFS, and returns a file object",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/keys/{target}": {
+            "get": {
+                "description": "Shows file keys under the given target from an add",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "mills"
+                    "files"
                 ],
-                "summary": "Resize an image",
+                "summary": "Show file keys",
                 "parameters": [
-                    {
-                        "type": "file",
-                        "description": "multipart/form-data file",
-                        "name": "file",
-                        "in": "formData"
-                    },
                     {
                         "type": "string",
-                        "default": "plaintext=false,use=\"\",quality=75,width=100",
-                        "description": "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, width: the requested image width (required), quality: the requested JPEG image quality",
-                        "name": "X-Textile-Opts",
-                        "in": "header",
+                        "description": "target id",
+                        "name": "target",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "file",
+                    "200": {
+                        "description": "keys",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.FileIndex"
+                            "$ref": "#/definitions/pb.Keys"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/mills/json": {
+        "/logs/{subsystem}": {
             "post": {
-                "description": "Takes an input JSON document, validates it according to its json-schema.org definition,\noptionally encrypts the output before adding to IPFS, and returns a file object",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "List or change the verbosity of one or all subsystems log output. Textile logs\npiggyback on the IPFS event logs",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "mills"
+                    "utils"
                 ],
-                "summary": "Process input JSON data",
+                "summary": "Access subsystem logs",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "multipart/form-data file",
-                        "name": "file",
-                        "in": "formData"
+                        "type": "string",
+                        "description": "subsystem logging identifier (omit for all)",
+                        "name": "subsystem",
+                        "in": "path"
                     },
                     {
                         "type": "string",
-                        "default": "plaintext=\"false\",use=\"\"",
-                        "description": "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "Log-level (one of: debug, info, warning, error, critical, or ",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether to list/change only Textile subsystems, or all available subsystems",
+                        "name": "tex-only",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "file",
+                    "200": {
+                        "description": "subsystems",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.FileIndex"
+                            "$ref": "#/definitions/core.SubsystemInfo"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/mills/schema": {
-            "post": {
-                "description": "Takes a JSON-based Schema, validates it, adds it to IPFS, and returns a file object",
-                "consumes": [
-                    "application/json"
-                ],
+        "/messages": {
+            "get": {
+                "description": "Paginates thread messages",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "mills"
+                    "messages"
                 ],
-                "summary": "Validate, add, and pin a new Schema",
+                "summary": "Paginates thread messages",
                 "parameters": [
                     {
-                        "description": "schema",
-                        "name": "schema",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.Node"
-                        }
+                        "type": "string",
+                        "description": "Thread ID (can also use 'default', omit for all)",
+                        "name": "thread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Offset ID to start listing from (omit for latest)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "List page size (default: 5)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "file",
+                    "200": {
+                        "description": "messages",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.FileIndex"
+                            "$ref": "#/definitions/pb.TextList"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
-                    }
-                }
-            }
-        },
-        "/notifications": {
-            "get": {
-                "description": "Lists all notifications generated by thread and account activity.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "notifications"
-                ],
-                "summary": "List notifications",
-                "responses": {
-                    "200": {
-                        "description": "notifications",
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.NotificationList"
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/notifications/{id}/read": {
-            "post": {
-                "description": "Marks a notifiction as read by ID. Use 'all' to mark all as read.",
+        "/messages/{block}": {
+            "get": {
+                "description": "Gets a thread message by block ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notifications"
+                    "messages"
                 ],
-                "summary": "Mark notifiction as read",
+                "summary": "Get thread message",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "notification id",
-                        "name": "id",
+                        "description": "block id",
+                        "name": "block",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "ok",
+                        "description": "message",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Text"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/ping": {
-            "get": {
-                "description": "Pings another peer on the network, returning online|offline.",
+        "/mills/blob": {
+            "post": {
+                "description": "Takes a binary data blob, and optionally encrypts it, before adding to IPFS,\nand returns a file object. The blob is streamed rather than held in memory.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "utils"
+                    "mills"
                 ],
-                "summary": "Ping a network peer",
+                "summary": "Process raw data blobs",
                 "parameters": [
+                    {
+                        "type": "file",
+                        "description": "multipart/form-data file",
+                        "name": "file",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether to leave unencrypted",
+                        "name": "plaintext",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "peerid",
-                        "name": "X-Textile-Args",
-                        "in": "header",
-                        "required": true
+                        "description": "If empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS",
+                        "name": "use",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "One of online|offline",
+                    "201": {
+                        "description": "file",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.FileIndex"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/profile": {
-            "get": {
-                "description": "Gets the local node's public profile",
+        "/mills/image/exif": {
+            "post": {
+                "description": "Takes an input image, and extracts its EXIF data (optionally encrypting output),\nbefore adding to IPFS, and returns a file object",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "profile"
+                    "mills"
                 ],
-                "summary": "Get public profile",
-                "responses": {
-                    "200": {
-                        "description": "peer",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.Peer"
-                        }
+                "summary": "Extract EXIF data from image",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "multipart/form-data file",
+                        "name": "file",
+                        "in": "formData"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "string"
-                        }
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether to leave unencrypted",
+                        "name": "plaintext",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "If empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS",
+                        "name": "use",
+                        "in": "query"
                     }
-                }
-            }
-        },
-        "/profile/avatar": {
-            "post": {
-                "description": "Forces local node to update avatar image to latest image added to 'account' thread",
-                "produces": [
-                    "text/plain"
-                ],
-                "tags": [
-                    "profile"
                 ],
-                "summary": "Set avatar",
                 "responses": {
                     "201": {
-                        "description": "ok",
+                        "description": "file",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.FileIndex"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/profile/name": {
+        "/mills/image/resize": {
             "post": {
-                "description": "Sets public profile display name to given string",
+                "description": "Takes an input image, and resizes/resamples it (optionally encrypting output),\nbefore adding to IPFS, and returns a file object",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "profile"
+                    "mills"
                 ],
-                "summary": "Set display name",
+                "summary": "Resize an image",
                 "parameters": [
+                    {
+                        "type": "file",
+                        "description": "multipart/form-data file",
+                        "name": "file",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether to leave unencrypted",
+                        "name": "plaintext",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "name",
-                        "name": "X-Textile-Args",
-                        "in": "header",
+                        "description": "If empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS",
+                        "name": "use",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "The requested image width",
+                        "name": "width",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 75,
+                        "description": "The requested JPEG image quality",
+                        "name": "quality",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "ok",
+                        "description": "file",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.FileIndex"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/snapshots": {
+        "/mills/json": {
             "post": {
-                "description": "Snapshots all threads and pushes to registered cafes",
+                "description": "Takes an input JSON document, validates it according to its json-schema.org definition,\noptionally encrypts the output before adding to IPFS, and returns a file object",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "mills"
+                ],
+                "summary": "Process input JSON data",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "multipart/form-data file",
+                        "name": "file",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether to leave unencrypted",
+                        "name": "plaintext",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "If empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS",
+                        "name": "use",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Create thread snapshots",
                 "responses": {
                     "201": {
-                        "description": "ok",
+                        "description": "file",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.FileIndex"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/snapshots/search": {
+        "/mills/schema": {
             "post": {
-                "description": "Searches the network for thread snapshots",
+                "description": "Takes a JSON-based Schema, validates it, adds it to IPFS, and returns a file object",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "mills"
                 ],
-                "summary": "Search for thread snapshots",
+                "summary": "Validate, add, and pin a new Schema",
                 "parameters": [
                     {
-                        "type": "string",
-                        "default": "wait=5,events=\"false\"",
-                        "description": "wait: Stops searching after 'wait' seconds have elapsed (max 30s), events: Whether to emit Server-Sent Events (SSEvent) or plain JSON",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "schema",
+                        "name": "schema",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Node"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "results stream",
+                    "201": {
+                        "description": "file",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.QueryResult"
+                            "$ref": "#/definitions/pb.FileIndex"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/subscribe/{id}": {
+        "/notifications": {
             "get": {
-                "description": "Subscribes to updates in a thread or all threads. An update is generated\nwhen a new block is added to a thread. There are several update types:\nMERGE, IGNORE, FLAG, JOIN, ANNOUNCE, LEAVE, TEXT, FILES, COMMENT, LIKE",
+                "description": "Lists notifications generated by thread and account activity.\nNotifications can be filtered by thread, type, read state, and date.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "subscribe"
+                    "notifications"
                 ],
-                "summary": "Subscribe to thread updates",
+                "summary": "List notifications",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "thread id, omit to stream all events",
-                        "name": "id",
-                        "in": "path"
+                        "description": "Thread ID (can also use 'default')",
+                        "name": "thread",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "type=,events=\"false\"",
-                        "description": "type: Or'd list of event types (e.g., FILES|COMMENTS|LIKES) or empty to include all types, events: Whether to emit Server-Sent Events (SSEvent) or plain JSON",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "Or'd list of notification types (e.g., FILES_ADDED|COMMENT_ADDED) or empty to include all types",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Whether to list only read (true) or unread (false) notifications, omit for both",
+                        "name": "read",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only list notifications older than this RFC3339 date",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Offset ID to start listing from (omit for latest)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": -1,
+                        "description": "List page size (default: all)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "stream of updates",
+                        "description": "notifications",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.FeedItem"
+                            "$ref": "#/definitions/pb.NotificationList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/summary": {
-            "get": {
+        "/notifications/{id}": {
+            "delete": {
+                "description": "Removes a notification by ID. Use 'all' to remove all notifications.\nWhen using 'all', notifications can be filtered by thread, type, read state, and date.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "utils"
+                    "notifications"
                 ],
-                "summary": "Get a summary of node data",
-                "responses": {
-                    "200": {
-                        "description": "summary",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.Summary"
-                        }
+                "summary": "Remove notifications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "notification id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Thread ID (can also use 'default')",
+                        "name": "thread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Or'd list of notification types (e.g., FILES_ADDED|COMMENT_ADDED)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Whether to remove only read (true) or unread (false) notifications, omit for both",
+                        "name": "read",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only remove notifications older than this RFC3339 date",
+                        "name": "before",
+                        "in": "query"
                     }
-                }
-            }
-        },
-        "/threads": {
-            "get": {
-                "description": "Lists all local threads, returning a ThreadList object",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "threads"
                 ],
-                "summary": "Lists info on all threads",
                 "responses": {
-                    "200": {
-                        "description": "threads",
+                    "204": {
+                        "description": "ok",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.ThreadList"
+                            "type": "string"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/notifications/{id}/read": {
             "post": {
-                "description": "Adds a new Thread with given name, type, and sharing and whitelist options, returning\na Thread object",
+                "description": "Marks a notifiction as read by ID. Use 'all' to mark all as read.\nWhen using 'all', notifications can be filtered by thread, type, and date.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "notifications"
                 ],
-                "summary": "Adds and joins a new thread",
+                "summary": "Mark notifiction as read",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "name",
-                        "name": "X-Textile-Args",
-                        "in": "header",
-                        "required": true
-                    },
+                        "description": "notification id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
-                        "default": "type=private,sharing=not_shared,whitelist=",
-                        "description": "key: A locally unique key used by an app to identify this thread on recovery, schema: Existing Thread Schema IPFS CID, type: Set the thread type to one of 'private', 'read_only', 'public', or 'open', sharing: Set the thread sharing style to one of 'not_shared','invite_only', or 'shared', whitelist: An array of contact addresses. When supplied, the thread will not allow additional peers beyond those in array, useful for 1-1 chat/file sharing",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "Thread ID (can also use 'default')",
+                        "name": "thread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Or'd list of notification types (e.g., FILES_ADDED|COMMENT_ADDED)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only mark notifications older than this RFC3339 date",
+                        "name": "before",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "thread",
+                    "204": {
+                        "description": "ok",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.Thread"
+                            "type": "string"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/threads/{id}": {
+        "/ping": {
             "get": {
-                "description": "Gets and displays info about a thread",
-                "produces": [
+                "description": "Pings another peer on the network, returning online|offline.",
+                "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "text/plain"
+                ],
                 "tags": [
-                    "threads"
+                    "utils"
                 ],
-                "summary": "Gets a thread",
+                "summary": "Ping a network peer",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "thread id",
-                        "name": "id",
-                        "in": "path",
+                        "description": "peerid",
+                        "name": "arg",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "description": "JSON alternative to arg query params",
+                        "name": "args",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiArgs"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "thread",
+                        "description": "One of online|offline",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.Thread"
+                            "type": "string"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Adds or updates a thread directly, usually from a backup",
-                "tags": [
-                    "threads"
+            }
+        },
+        "/profile": {
+            "get": {
+                "description": "Gets the local node's public profile",
+                "produces": [
+                    "application/json"
                 ],
-                "summary": "Add or update a thread directly",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "thread",
-                        "name": "thread",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.Thread"
-                        }
-                    }
+                "tags": [
+                    "profile"
                 ],
+                "summary": "Get public profile",
                 "responses": {
-                    "204": {
-                        "description": "ok",
+                    "200": {
+                        "description": "peer",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Peer"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Leaves and removes a thread",
-                "tags": [
-                    "threads"
+            }
+        },
+        "/profile/avatar": {
+            "post": {
+                "description": "Forces local node to update avatar image to latest image added to 'account' thread",
+                "produces": [
+                    "text/plain"
                 ],
-                "summary": "Leave and remove a thread",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "thread id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                "tags": [
+                    "profile"
                 ],
+                "summary": "Set avatar",
                 "responses": {
                     "204": {
                         "description": "ok",
@@ -2372,343 +2823,1385 @@ var doc = `{
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/threads/{id}/files": {
+        "/profile/name": {
             "post": {
-                "description": "Adds a file or directory of files to a thread. Files not supported by the thread\nschema are ignored. Nested directories are included. An existing file hash may\nalso be used as input.",
+                "description": "Sets public profile display name to given string",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "threads"
+                    "profile"
                 ],
-                "summary": "Adds a file or directory of files to a thread",
+                "summary": "Set display name",
                 "parameters": [
                     {
-                        "description": "list of milled dirs (output from mill endpoint)",
-                        "name": "dir",
+                        "type": "string",
+                        "description": "name",
+                        "name": "arg",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON alternative to arg query params",
+                        "name": "args",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.DirectoryList"
+                            "$ref": "#/definitions/core.apiArgs"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "default": "caption=",
-                        "description": "caption: Caption to add to file(s)",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "file",
+                    "204": {
+                        "description": "ok",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/pb.Files"
+                            "type": "string"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/threads/{id}/messages": {
-            "post": {
-                "description": "Adds a message to a thread",
+        "/requests": {
+            "get": {
+                "description": "Lists queued cafe requests along with their attempt counts and next attempt\ndates. Use the failed option to list requests that have been given up on.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "cafes"
                 ],
-                "summary": "Add a message",
+                "summary": "List cafe requests",
                 "parameters": [
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether to list failed requests",
+                        "name": "failed",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "urlescaped message body",
-                        "name": "X-Textile-Args",
-                        "in": "header",
-                        "required": true
+                        "description": "Offset ID to start listing from",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": -1,
+                        "description": "List page size (default: all)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "message",
+                        "description": "requests",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/pb.Text"
+                            "$ref": "#/definitions/pb.CafeRequestList"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
-            }
-        },
-        "/threads/{id}/name": {
-            "put": {
-                "description": "Renames a thread. Only initiators can rename a thread.",
+            },
+            "delete": {
+                "description": "Deletes a failed cafe request. Omit the request ID to delete all failed requests.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
                 "tags": [
-                    "threads"
+                    "cafes"
                 ],
-                "summary": "Rename a thread",
+                "summary": "Purge failed cafe requests",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "request id",
+                        "name": "arg",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "name",
-                        "name": "X-Textile-Args",
-                        "in": "header",
-                        "required": true
+                        "description": "JSON alternative to arg query params",
+                        "name": "args",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiArgs"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "ok",
+                    "200": {
+                        "description": "number of purged requests",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/threads/{id}/peers": {
-            "get": {
-                "description": "Lists all peers in a thread, optionally listing peers in the default thread",
-                "produces": [
+        "/requests/retry": {
+            "post": {
+                "description": "Queues a failed cafe request to be handled again with a fresh attempt count.\nOmit the request ID to retry all failed requests.",
+                "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "text/plain"
+                ],
                 "tags": [
-                    "threads"
+                    "cafes"
                 ],
-                "summary": "List all thread peers",
+                "summary": "Retry failed cafe requests",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "thread id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "request id",
+                        "name": "arg",
+                        "in": "query"
+                    },
+                    {
+                        "description": "JSON alternative to arg query params",
+                        "name": "args",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiArgs"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "contacts",
+                        "description": "number of retried requests",
                         "schema": {
-                            "type": "object",
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/snapshots": {
+            "post": {
+                "description": "Snapshots all threads and pushes to registered cafes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Create thread snapshots",
+                "responses": {
+                    "204": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/snapshots/search": {
+            "post": {
+                "description": "Searches the network for thread snapshots, optionally for an earlier version",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Search for thread snapshots",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Stops searching after 'wait' seconds have elapsed (max 30s)",
+                        "name": "wait",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether to emit Server-Sent Events (SSEvent) or plain JSON",
+                        "name": "events",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only search for snapshots of this thread",
+                        "name": "thread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search for a specific snapshot version",
+                        "name": "version",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search for the latest snapshots stored at or before this RFC3339 date",
+                        "name": "as_of",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether cafes should forward the search to their federated peer cafes instead of using pubsub",
+                        "name": "federated",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "results stream",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.QueryResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscribe/{id}": {
+            "get": {
+                "description": "Subscribes to updates in a thread or all threads. An update is generated\nwhen a new block is added to a thread. There are several update types:\nMERGE, IGNORE, FLAG, JOIN, ANNOUNCE, LEAVE, TEXT, FILES, COMMENT, LIKE\nUse since to first replay updates missed while disconnected. Since is the block\nID of the last update received, or an RFC3339 date. Server-Sent Events include\nthe block ID as the event ID, so the Last-Event-ID header can be used instead.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscribe"
+                ],
+                "summary": "Subscribe to thread updates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread id, omit to stream all events",
+                        "name": "id",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Or'd list of event types (e.g., FILES|COMMENTS|LIKES) or empty to include all types",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether to emit Server-Sent Events (SSEvent) or plain JSON",
+                        "name": "events",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Block ID or RFC3339 date to replay updates from",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "stream of updates",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.FeedItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/summary": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "utils"
+                ],
+                "summary": "Get a summary of node data",
+                "responses": {
+                    "200": {
+                        "description": "summary",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Summary"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads": {
+            "get": {
+                "description": "Lists all local threads, returning a ThreadList object",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Lists info on all threads",
+                "responses": {
+                    "200": {
+                        "description": "threads",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.ThreadList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a new Thread with given name, type, and sharing and whitelist options, returning\na Thread object",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Adds and joins a new thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "name",
+                        "name": "arg",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON alternative to arg query params",
+                        "name": "args",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiArgs"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "A locally unique key used by an app to identify this thread on recovery",
+                        "name": "key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Existing Thread Schema IPFS CID",
+                        "name": "schema",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "private",
+                        "description": "Set the thread type to one of 'private', 'read_only', 'public', or 'open'",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "not_shared",
+                        "description": "Set the thread sharing style to one of 'not_shared','invite_only', or 'shared'",
+                        "name": "sharing",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "An array of contact addresses. When supplied, the thread will not allow additional peers beyond those in array, useful for 1-1 chat/file sharing",
+                        "name": "whitelist",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "thread",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Thread"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}": {
+            "get": {
+                "description": "Gets and displays info about a thread",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Gets a thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "thread",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Thread"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Adds or updates a thread directly, usually from a backup",
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Add or update a thread directly",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "thread",
+                        "name": "thread",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Thread"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Whether to reset the thread to the snapshot head, even if it is older than the current head",
+                        "name": "restore",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Leaves and removes a thread",
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Leave and remove a thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/files": {
+            "post": {
+                "description": "Adds a file or directory of files to a thread. Files not supported by the thread\nschema are ignored. Nested directories are included. An existing file hash may\nalso be used as input.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Adds a file or directory of files to a thread",
+                "parameters": [
+                    {
+                        "description": "list of milled dirs (output from mill endpoint)",
+                        "name": "dir",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.DirectoryList"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Caption to add to file(s)",
+                        "name": "caption",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "file",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Files"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/messages": {
+            "post": {
+                "description": "Adds a message to a thread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Add a message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "urlescaped message body",
+                        "name": "arg",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON alternative to arg query params",
+                        "name": "args",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiArgs"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "message",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.Text"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/name": {
+            "put": {
+                "description": "Renames a thread. Only initiators can rename a thread.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Rename a thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "name",
+                        "name": "arg",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON alternative to arg query params",
+                        "name": "args",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiArgs"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/peers": {
+            "get": {
+                "description": "Lists all peers in a thread, optionally listing peers in the default thread",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "List all thread peers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "contacts",
+                        "schema": {
+                            "type": "object",
                             "$ref": "#/definitions/pb.ContactList"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens": {
+            "get": {
+                "description": "List info about all stored cafe tokens, including scopes, plan, expiry, and the\ncombined usage of clients registered with each token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tokens"
+                ],
+                "summary": "List local tokens",
+                "responses": {
+                    "200": {
+                        "description": "tokens",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/pb.CafeTokenUsageList"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Generates an access token (44 random bytes) and saves a bcrypt hashed version for\nfuture lookup. The response contains a base58 encoded version of the random bytes\ntoken. If the 'store' option is set to false, the token is generated, but not\nstored in the local Cafe db. Alternatively, an existing token can be added using\nby specifying the 'token' option.\nTokens allow other peers to register with a Cafe peer.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tokens"
+                ],
+                "summary": "Create an access token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Use existing token, rather than creating a new one",
+                        "name": "token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Whether to store the added/generated token to the local db",
+                        "name": "store",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of allowed scopes (store, inbox, threads, search), or empty for all",
+                        "name": "scopes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of a quota plan from the cafe host config",
+                        "name": "plan",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC3339 date after which the token can no longer be used",
+                        "name": "expires",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "A label for the token",
+                        "name": "label",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens/{id}": {
+            "get": {
+                "description": "Check validity of existing cafe access token",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "tokens"
+                ],
+                "summary": "Check token validity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "invite id",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes an existing cafe token",
+                "tags": [
+                    "tokens"
+                ],
+                "summary": "Removes a cafe token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "ok",
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
                     }
                 }
             }
         },
-        "/tokens": {
+        "/webhooks": {
             "get": {
-                "description": "List info about all stored cafe tokens",
+                "description": "Lists webhooks in the config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tokens"
+                    "webhooks"
                 ],
-                "summary": "List local tokens",
+                "summary": "List webhooks",
                 "responses": {
                     "200": {
-                        "description": "tokens",
+                        "description": "webhooks",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "string"
+                                "$ref": "#/definitions/config.Webhook"
                             }
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "string"
-                        }
                     }
                 }
             },
             "post": {
-                "description": "Generates an access token (44 random bytes) and saves a bcrypt hashed version for\nfuture lookup. The response contains a base58 encoded version of the random bytes\ntoken. If the 'store' option is set to false, the token is generated, but not\nstored in the local Cafe db. Alternatively, an existing token can be added using\nby specifying the 'token' option.\nTokens allow other peers to register with a Cafe peer.",
+                "description": "Adds a webhook to the config. Thread updates matching the webhook's thread and\ntype filters are POSTed to the URL as JSON feed items. Each request body is\nsigned with the webhook's secret (HMAC-SHA256), which is sent in the\nX-Textile-Signature header. A random secret is generated if one is not provided.\nFailed deliveries are retried.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tokens"
+                    "webhooks"
                 ],
-                "summary": "Create an access token",
+                "summary": "Add a webhook",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "token=,store=\"true\"",
-                        "description": "token: Use existing token, rather than creating a new one, store: Whether to store the added/generated token to the local db",
-                        "name": "X-Textile-Opts",
-                        "in": "header"
+                        "description": "url",
+                        "name": "arg",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON alternative to arg query params",
+                        "name": "args",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiArgs"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of thread IDs (can also use 'default') or empty to include all threads",
+                        "name": "thread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Or'd list of block types (e.g., FILES|TEXT) or empty to include all types",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key used to sign request bodies",
+                        "name": "secret",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "token",
+                        "description": "webhook",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/config.Webhook"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
         },
-        "/tokens/{id}": {
-            "get": {
-                "description": "Check validity of existing cafe access token",
-                "produces": [
-                    "text/plain"
-                ],
+        "/webhooks/{id}": {
+            "delete": {
+                "description": "Removes a webhook from the config along with its pending deliveries",
                 "tags": [
-                    "tokens"
+                    "webhooks"
                 ],
-                "summary": "Check token validity",
+                "summary": "Remove a webhook",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "invite id",
-                        "name": "token",
+                        "description": "webhook id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
+                    "204": {
                         "description": "ok",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Removes an existing cafe token",
+            }
+        },
+        "/ws": {
+            "get": {
+                "description": "Upgrades to a websocket on which clients can dynamically subscribe to thread updates,\nwallet updates, and notifications. Browsers may send an API key with the api_key\nquery param. Requests are JSON objects with an action (subscribe or unsubscribe),\nthreads (thread IDs, 'default', or '*' for all), types (block types, e.g., FILES,\nor empty for all), and wallet and notifications flags. Subscribe requests may\ninclude since, a block ID or RFC3339 date after which thread updates are replayed,\ninterleaved with live updates, ahead of the request's ack. Each request is answered\nwith an ack or error message with the same id.\nUpdates are sent as thread_update, wallet_update, and notification messages.",
                 "tags": [
-                    "tokens"
+                    "subscribe"
                 ],
-                "summary": "Removes a cafe token",
+                "summary": "Subscribe to updates over a websocket",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "token",
-                        "name": "token",
-                        "in": "path",
-                        "required": true
+                        "description": "api key, if not set in the Authorization header",
+                        "name": "api_key",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "ok",
+                    "101": {
+                        "description": "switching protocols",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/core.apiError"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "core.SubsystemInfo": {
-            "type": "object",
-            "additionalProperties": {}
+        }
+    },
+    "definitions": {
+        "config.Webhook": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "unique webhook ID",
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "key used to sign request bodies (HMAC-SHA256), empty disables signing",
+                    "type": "string"
+                },
+                "threads": {
+                    "description": "thread IDs to match, empty matches all threads",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "types": {
+                    "description": "block types to match (e.g., FILES, TEXT), empty matches all types",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "description": "endpoint that receives POSTed thread updates as JSON",
+                    "type": "string"
+                }
+            }
+        },
+        "core.SubsystemInfo": {
+            "type": "object",
+            "additionalProperties": {}
+        },
+        "core.apiArgs": {
+            "type": "object",
+            "properties": {
+                "args": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.apiError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.batchFile": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "dir": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.batchOp": {
+            "type": "object",
+            "properties": {
+                "block": {
+                    "type": "string"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "caption": {
+                    "type": "string"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/core.batchFile"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mill": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opts": {
+                    "type": "object"
+                },
+                "thread": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.batchRequest": {
+            "type": "object",
+            "properties": {
+                "ops": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/core.batchOp"
+                    }
+                },
+                "stop_on_error": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "core.batchResponse": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/core.batchResult"
+                    }
+                }
+            }
+        },
+        "core.batchResult": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
         },
         "ipfs.ConnInfos": {
             "type": "object",
@@ -2758,6 +4251,43 @@ var doc = `{
         "mill.Json": {
             "type": "object"
         },
+        "pb.ApiKey": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "expires": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ApiKeyList": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ApiKey"
+                    }
+                }
+            }
+        },
         "pb.Block": {
             "type": "object",
             "properties": {
@@ -2798,11 +4328,17 @@ var doc = `{
         "pb.BlockList": {
             "type": "object",
             "properties": {
+                "count": {
+                    "type": "integer"
+                },
                 "items": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/pb.Block"
                     }
+                },
+                "next": {
+                    "type": "string"
                 }
             }
         },
@@ -2829,6 +4365,179 @@ var doc = `{
                 }
             }
         },
+        "pb.CafeClient": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "seen": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CafeClientList": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CafeClient"
+                    }
+                }
+            }
+        },
+        "pb.CafeClientUsage": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "type": "object",
+                    "$ref": "#/definitions/pb.CafeClient"
+                },
+                "inbox_count": {
+                    "type": "integer"
+                },
+                "pin_count": {
+                    "type": "integer"
+                },
+                "pin_size": {
+                    "type": "integer"
+                },
+                "thread_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.CafeClientUsageList": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CafeClientUsage"
+                    }
+                }
+            }
+        },
+        "pb.CafeHealth": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "failures": {
+                    "type": "integer"
+                },
+                "latency": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.CafeNeighborStatus": {
+            "type": "object",
+            "properties": {
+                "last_error": {
+                    "type": "string"
+                },
+                "last_error_date": {
+                    "type": "string"
+                },
+                "last_success": {
+                    "type": "string"
+                },
+                "peer": {
+                    "type": "string"
+                },
+                "pending": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CafeReplicationStatus": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "neighbors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CafeNeighborStatus"
+                    }
+                },
+                "pending": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.CafeRequest": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "cafe": {
+                    "type": "object",
+                    "$ref": "#/definitions/pb.Cafe"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "next": {
+                    "type": "string"
+                },
+                "peer": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "target": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.CafeRequestList": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CafeRequest"
+                    }
+                }
+            }
+        },
         "pb.CafeSession": {
             "type": "object",
             "properties": {
@@ -2842,9 +4551,16 @@ var doc = `{
                 "exp": {
                     "type": "string"
                 },
+                "health": {
+                    "type": "object",
+                    "$ref": "#/definitions/pb.CafeHealth"
+                },
                 "id": {
                     "type": "string"
                 },
+                "priority": {
+                    "type": "integer"
+                },
                 "refresh": {
                     "type": "string"
                 },
@@ -2870,6 +4586,73 @@ var doc = `{
                 }
             }
         },
+        "pb.CafeToken": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "expires": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "plan": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "value": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "pb.CafeTokenUsage": {
+            "type": "object",
+            "properties": {
+                "client_count": {
+                    "type": "integer"
+                },
+                "inbox_count": {
+                    "type": "integer"
+                },
+                "pin_count": {
+                    "type": "integer"
+                },
+                "pin_size": {
+                    "type": "integer"
+                },
+                "thread_count": {
+                    "type": "integer"
+                },
+                "token": {
+                    "type": "object",
+                    "$ref": "#/definitions/pb.CafeToken"
+                }
+            }
+        },
+        "pb.CafeTokenUsageList": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CafeTokenUsage"
+                    }
+                }
+            }
+        },
         "pb.Comment": {
             "type": "object",
             "properties": {
@@ -3111,11 +4894,17 @@ var doc = `{
         "pb.FilesList": {
             "type": "object",
             "properties": {
+                "count": {
+                    "type": "integer"
+                },
                 "items": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/pb.Files"
                     }
+                },
+                "next": {
+                    "type": "string"
                 }
             }
         },
@@ -3365,11 +5154,17 @@ var doc = `{
         "pb.TextList": {
             "type": "object",
             "properties": {
+                "count": {
+                    "type": "integer"
+                },
                 "items": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/pb.Text"
                     }
+                },
+                "next": {
+                    "type": "string"
                 }
             }
         },
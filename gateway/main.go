@@ -41,7 +41,7 @@ type Gateway struct {
 }
 
 // Start creates a gateway server
-func (g *Gateway) Start(addr string) {
+func (g *Gateway) Start(addr string) error {
 	gin.SetMode(gin.ReleaseMode)
 	if g.Node != nil {
 		gin.DefaultWriter = g.Node.Writer()
@@ -87,14 +87,23 @@ func (g *Gateway) Start(addr string) {
 		render404(c)
 	})
 
+	tconf, err := g.Node.TLSConfig()
+	if err != nil {
+		return fmt.Errorf("error loading gateway tls config: %s", err)
+	}
 	g.server = &http.Server{
-		Addr:    addr,
-		Handler: router,
+		Addr:      addr,
+		Handler:   router,
+		TLSConfig: tconf,
 	}
 
 	errc := make(chan error)
 	go func() {
-		errc <- g.server.ListenAndServe()
+		if tconf != nil {
+			errc <- g.server.ListenAndServeTLS("", "")
+		} else {
+			errc <- g.server.ListenAndServe()
+		}
 		close(errc)
 	}()
 	go func() {
@@ -112,6 +121,7 @@ func (g *Gateway) Start(addr string) {
 		}
 	}()
 	log.Infof("gateway listening at %s", g.server.Addr)
+	return nil
 }
 
 // Stop stops the gateway
@@ -134,20 +144,28 @@ func (g *Gateway) Addr() string {
 func (g *Gateway) ipfsHandler(c *gin.Context) {
 	contentPath := c.Param("root") + c.Param("path")
 
-	data := g.getDataAtPath(c, contentPath)
-	if data == nil {
-		return
-	}
-
 	// attempt decrypt if key present
 	key, exists := c.GetQuery("key")
+	var keyb []byte
 	if exists {
-		keyb, err := base58.Decode(key)
+		var err error
+		keyb, err = base58.Decode(key)
 		if err != nil {
 			log.Debugf("error decoding key %s: %s", key, err)
 			render404(c)
 			return
 		}
+		if g.serveStreamedData(c, contentPath, keyb) {
+			return
+		}
+	}
+
+	data := g.getDataAtPath(c, contentPath)
+	if data == nil {
+		return
+	}
+
+	if exists {
 		plain, err := crypto.DecryptAES(data, keyb)
 		if err != nil {
 			log.Debugf("error decrypting %s: %s", contentPath, err)
@@ -161,6 +179,24 @@ func (g *Gateway) ipfsHandler(c *gin.Context) {
 	c.Render(200, render.Data{Data: data})
 }
 
+// serveStreamedData serves streamed AES ciphertext behind an IPFS address, which is
+// decrypted as it's read and supports range requests. It returns false if the data
+// isn't a file in the streamed format.
+func (g *Gateway) serveStreamedData(c *gin.Context, pth string, key []byte) bool {
+	reader, err := g.Node.ReaderAtPath(c.Request.Context(), pth)
+	if err != nil {
+		return false
+	}
+	defer reader.Close()
+
+	plain, err := crypto.NewAESDecryptReadSeeker(reader, key)
+	if err != nil {
+		return false
+	}
+	http.ServeContent(c.Writer, c.Request, "", time.Time{}, plain)
+	return true
+}
+
 // ipnsHandler renders data behind an IPNS address
 func (g *Gateway) ipnsHandler(c *gin.Context) {
 	pathp := c.Param("path")
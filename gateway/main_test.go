@@ -36,7 +36,9 @@ func TestGateway_Creation(t *testing.T) {
 	}
 
 	Host = &Gateway{Node: node}
-	Host.Start(node.Config().Addresses.Gateway)
+	if err := Host.Start(node.Config().Addresses.Gateway); err != nil {
+		t.Errorf("start gateway failed: %s", err)
+	}
 }
 
 func TestGateway_Addr(t *testing.T) {
@@ -14,6 +14,7 @@ require (
 	github.com/fatih/color v1.7.0
 	github.com/gin-contrib/location v0.0.0-20190301062650-0462caccbb9c
 	github.com/gin-contrib/size v0.0.0-20190301062339-6fb8220baadb
+	github.com/gin-contrib/sse v0.0.0-20190301062529-5545eab6dad3
 	github.com/gin-gonic/gin v1.3.0
 	github.com/go-openapi/jsonpointer v0.19.0 // indirect
 	github.com/go-openapi/jsonreference v0.19.0 // indirect
@@ -21,6 +22,7 @@ require (
 	github.com/go-openapi/swag v0.19.0 // indirect
 	github.com/gogo/protobuf v1.2.1
 	github.com/golang/protobuf v1.3.1
+	github.com/gorilla/websocket v1.4.0
 	github.com/ipfs/go-cid v0.0.1
 	github.com/ipfs/go-ipfs v0.4.21-0.20190502163502-5fd5d444796d
 	github.com/ipfs/go-ipfs-addr v0.0.1
@@ -62,5 +64,7 @@ require (
 	github.com/xeipuuv/gojsonschema v1.1.0
 	golang.org/x/crypto v0.0.0-20190426145343-a29dc8fdc734
 	golang.org/x/image v0.0.0-20190321063152-3fc05d484e9f // indirect
+	golang.org/x/net v0.0.0-20190424112056-4829fb13d2c6
+	google.golang.org/grpc v1.19.0
 	gopkg.in/natefinch/lumberjack.v2 v2.0.0-20170531160350-a96e63847dc3
 )
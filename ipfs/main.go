@@ -57,6 +57,56 @@ func DataAtPath(node *core.IpfsNode, pth string) ([]byte, error) {
 	return ioutil.ReadAll(file)
 }
 
+// ReaderAtPath returns a seekable reader of the file under an ipfs path, which
+// fetches data as it is read and must be closed. Resolving the path is bounded by
+// the cat timeout, while reads are bound to ctx.
+func ReaderAtPath(ctx context.Context, node *core.IpfsNode, pth string) (files.File, error) {
+	api, err := coreapi.NewCoreAPI(node)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	timer := time.AfterFunc(catTimeout, cancel)
+
+	f, err := api.Unixfs().Get(ctx, path.New(pth))
+	if !timer.Stop() {
+		if err == nil {
+			f.Close()
+		}
+		cancel()
+		return nil, context.DeadlineExceeded
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	switch f := f.(type) {
+	case files.File:
+		return &fileReader{File: f, cancel: cancel}, nil
+	case files.Directory:
+		f.Close()
+		cancel()
+		return nil, iface.ErrIsDir
+	default:
+		f.Close()
+		cancel()
+		return nil, iface.ErrNotSupported
+	}
+}
+
+// fileReader cancels its context when closed
+type fileReader struct {
+	files.File
+	cancel context.CancelFunc
+}
+
+func (f *fileReader) Close() error {
+	defer f.cancel()
+	return f.File.Close()
+}
+
 // LinksAtPath return ipld links under a path
 func LinksAtPath(node *core.IpfsNode, pth string) ([]*ipld.Link, error) {
 	api, err := coreapi.NewCoreAPI(node)
@@ -0,0 +1,59 @@
+package jwt
+
+import (
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	libp2pc "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// ApiKeyAudience is the audience of local API keys
+const ApiKeyAudience = "textile-api"
+
+// ApiKeyClaims identify a local API key
+type ApiKeyClaims struct {
+	jwt.StandardClaims
+}
+
+// NewApiKey returns a signed API key with the given id
+func NewApiKey(sk libp2pc.PrivKey, id string, expires time.Time) (string, error) {
+	issuer, err := peer.IDFromPrivateKey(sk)
+	if err != nil {
+		return "", err
+	}
+
+	claims := &ApiKeyClaims{
+		StandardClaims: jwt.StandardClaims{
+			Audience: ApiKeyAudience,
+			Id:       id,
+			IssuedAt: time.Now().Unix(),
+			Issuer:   issuer.Pretty(),
+		},
+	}
+	if !expires.IsZero() {
+		claims.ExpiresAt = expires.Unix()
+	}
+	return jwt.NewWithClaims(SigningMethodEd25519i, claims).SignedString(sk)
+}
+
+// ParseApiKey verifies an API key against the issuer's public key, returning its id
+func ParseApiKey(tokenString string, pk libp2pc.PubKey) (string, error) {
+	claims := &ApiKeyClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(*jwt.Token) (interface{}, error) {
+		return pk, nil
+	})
+	if token == nil {
+		return "", ErrNoToken
+	}
+	if err != nil {
+		if !claims.VerifyExpiresAt(time.Now().Unix(), false) {
+			return "", ErrExpired
+		}
+		return "", ErrInvalid
+	}
+	if token.Method != SigningMethodEd25519i || !claims.VerifyAudience(ApiKeyAudience, true) {
+		return "", ErrInvalid
+	}
+	return claims.Id, nil
+}
@@ -1,10 +1,13 @@
 package jwt_test
 
 import (
+	"crypto/rand"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dgrijalva/jwt-go"
+	libp2pc "github.com/libp2p/go-libp2p-crypto"
 	"github.com/textileio/go-textile/ipfs"
 	. "github.com/textileio/go-textile/jwt"
 )
@@ -94,3 +97,43 @@ func TestGenerateEd25519Token(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestApiKey(t *testing.T) {
+	sk, err := ipfs.UnmarshalPrivateKeyFromString(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := NewApiKey(sk, "foo", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := ParseApiKey(key, sk.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "foo" {
+		t.Errorf("wrong id: %s", id)
+	}
+
+	// expired
+	key, err = NewApiKey(sk, "foo", time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseApiKey(key, sk.GetPublic()); err != ErrExpired {
+		t.Errorf("expected expired, got %v", err)
+	}
+
+	// wrong signer
+	key, err = NewApiKey(sk, "foo", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseApiKey(key, other.GetPublic()); err != ErrInvalid {
+		t.Errorf("expected invalid, got %v", err)
+	}
+}
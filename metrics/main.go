@@ -0,0 +1,303 @@
+// Package metrics implements a small registry of counters, gauges, and histograms
+// that can be scraped by Prometheus using its text exposition format.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ContentType is the Prometheus text exposition format content type
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefBuckets are the default histogram buckets, in seconds
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Default is the registry used by the package level constructors
+var Default = NewRegistry()
+
+// collector is a named metric family
+type collector interface {
+	name() string
+	write(w *bufio.Writer)
+}
+
+// Registry holds metric families
+type Registry struct {
+	mux        sync.Mutex
+	collectors map[string]collector
+}
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]collector)}
+}
+
+// register adds a collector, returning an existing one with the same name
+func (r *Registry) register(c collector) collector {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if existing, ok := r.collectors[c.name()]; ok {
+		return existing
+	}
+	r.collectors[c.name()] = c
+	return c
+}
+
+// Write writes all metrics in the text exposition format
+func (r *Registry) Write(w io.Writer) error {
+	r.mux.Lock()
+	names := make([]string, 0, len(r.collectors))
+	for name := range r.collectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	list := make([]collector, len(names))
+	for i, name := range names {
+		list[i] = r.collectors[name]
+	}
+	r.mux.Unlock()
+
+	bw := bufio.NewWriter(w)
+	for _, c := range list {
+		c.write(bw)
+	}
+	return bw.Flush()
+}
+
+// Handler returns an http handler that serves the registry
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		if err := r.Write(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// family holds the label names and per-label-value series of a metric
+type family struct {
+	fname  string
+	help   string
+	kind   string
+	labels []string
+	mux    sync.Mutex
+	series map[string]*series
+}
+
+// series is a single labeled time series
+type series struct {
+	values []string
+	value  float64
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+func newFamily(name string, help string, kind string, labels []string) *family {
+	return &family{
+		fname:  name,
+		help:   help,
+		kind:   kind,
+		labels: labels,
+		series: make(map[string]*series),
+	}
+}
+
+func (f *family) name() string {
+	return f.fname
+}
+
+// get returns the series for the given label values, creating it if needed
+// note: caller must hold the lock
+func (f *family) get(values []string, buckets int) *series {
+	if len(values) != len(f.labels) {
+		panic(fmt.Sprintf("metric %s expects %d label values, got %d", f.fname, len(f.labels), len(values)))
+	}
+	key := strings.Join(values, "\xff")
+	s, ok := f.series[key]
+	if !ok {
+		s = &series{values: values}
+		if buckets > 0 {
+			s.counts = make([]uint64, buckets)
+		}
+		f.series[key] = s
+	}
+	return s
+}
+
+// sorted returns the series ordered by label values
+// note: caller must hold the lock
+func (f *family) sorted() []*series {
+	keys := make([]string, 0, len(f.series))
+	for k := range f.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	list := make([]*series, len(keys))
+	for i, k := range keys {
+		list[i] = f.series[k]
+	}
+	return list
+}
+
+func (f *family) writeHeader(w *bufio.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n", f.fname, escapeHelp(f.help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", f.fname, f.kind)
+}
+
+func (f *family) write(w *bufio.Writer) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	f.writeHeader(w)
+	for _, s := range f.sorted() {
+		fmt.Fprintf(w, "%s%s %s\n", f.fname, labelString(f.labels, s.values, "", ""), formatFloat(s.value))
+	}
+}
+
+// Counter is a monotonically increasing metric
+type Counter struct {
+	*family
+}
+
+// NewCounter registers a counter with the default registry
+func NewCounter(name string, help string, labels ...string) *Counter {
+	return Default.NewCounter(name, help, labels...)
+}
+
+// NewCounter registers a counter
+func (r *Registry) NewCounter(name string, help string, labels ...string) *Counter {
+	c := r.register(&Counter{newFamily(name, help, "counter", labels)})
+	return c.(*Counter)
+}
+
+// Inc adds one to the series with the given label values
+func (c *Counter) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// Add adds v, which must not be negative, to the series with the given label values
+func (c *Counter) Add(v float64, values ...string) {
+	if v < 0 {
+		return
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.get(values, 0).value += v
+}
+
+// Gauge is a metric that can go up and down
+type Gauge struct {
+	*family
+}
+
+// NewGauge registers a gauge with the default registry
+func NewGauge(name string, help string, labels ...string) *Gauge {
+	return Default.NewGauge(name, help, labels...)
+}
+
+// NewGauge registers a gauge
+func (r *Registry) NewGauge(name string, help string, labels ...string) *Gauge {
+	g := r.register(&Gauge{newFamily(name, help, "gauge", labels)})
+	return g.(*Gauge)
+}
+
+// Set sets the series with the given label values
+func (g *Gauge) Set(v float64, values ...string) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	g.get(values, 0).value = v
+}
+
+// Histogram samples observations into buckets
+type Histogram struct {
+	*family
+	buckets []float64
+}
+
+// NewHistogram registers a histogram with the default registry
+func NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+	return Default.NewHistogram(name, help, buckets, labels...)
+}
+
+// NewHistogram registers a histogram, buckets must be sorted
+func (r *Registry) NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	h := r.register(&Histogram{family: newFamily(name, help, "histogram", labels), buckets: buckets})
+	return h.(*Histogram)
+}
+
+// Observe adds an observation to the series with the given label values
+func (h *Histogram) Observe(v float64, values ...string) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	s := h.get(values, len(h.buckets))
+	for i, upper := range h.buckets {
+		if v <= upper {
+			s.counts[i]++
+		}
+	}
+	s.sum += v
+	s.count++
+}
+
+func (h *Histogram) write(w *bufio.Writer) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	h.writeHeader(w)
+	for _, s := range h.sorted() {
+		for i, upper := range h.buckets {
+			fmt.Fprintf(w, "%s_bucket%s %d\n",
+				h.fname, labelString(h.labels, s.values, "le", formatFloat(upper)), s.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", h.fname, labelString(h.labels, s.values, "le", "+Inf"), s.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.fname, labelString(h.labels, s.values, "", ""), formatFloat(s.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.fname, labelString(h.labels, s.values, "", ""), s.count)
+	}
+}
+
+// labelString formats label pairs, with an optional extra pair
+func labelString(names []string, values []string, extraName string, extraValue string) string {
+	if len(names) == 0 && extraName == "" {
+		return ""
+	}
+	pairs := make([]string, 0, len(names)+1)
+	for i, name := range names {
+		pairs = append(pairs, name+`="`+escapeLabel(values[i])+`"`)
+	}
+	if extraName != "" {
+		pairs = append(pairs, extraName+`="`+extraValue+`"`)
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func escapeHelp(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(s)
+}
+
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(s)
+}
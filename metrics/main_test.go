@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRegistry_Write(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("test_requests_total", "Total requests.", "method")
+	c.Inc("GET")
+	c.Add(2, "GET")
+	c.Inc("POST")
+
+	g := r.NewGauge("test_pending", "Pending items.")
+	g.Set(5)
+
+	h := r.NewHistogram("test_seconds", "Request duration.", []float64{0.1, 1})
+	h.Observe(0.05)
+	h.Observe(0.5)
+	h.Observe(2)
+
+	var buf bytes.Buffer
+	if err := r.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	for _, line := range []string{
+		"# TYPE test_requests_total counter",
+		`test_requests_total{method="GET"} 3`,
+		`test_requests_total{method="POST"} 1`,
+		"# TYPE test_pending gauge",
+		"test_pending 5",
+		"# TYPE test_seconds histogram",
+		`test_seconds_bucket{le="0.1"} 1`,
+		`test_seconds_bucket{le="1"} 2`,
+		`test_seconds_bucket{le="+Inf"} 3`,
+		"test_seconds_sum 2.55",
+		"test_seconds_count 3",
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("missing line %q in:\n%s", line, out)
+		}
+	}
+}
+
+func TestRegistry_RegisterTwice(t *testing.T) {
+	r := NewRegistry()
+	c1 := r.NewCounter("test_total", "Total.")
+	c2 := r.NewCounter("test_total", "Total.")
+	c1.Inc()
+	c2.Inc()
+
+	var buf bytes.Buffer
+	if err := r.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "test_total 2\n") {
+		t.Errorf("counters with the same name should be shared:\n%s", buf.String())
+	}
+}
+
+func TestEscapeLabel(t *testing.T) {
+	if escapeLabel("a\"b\\c\nd") != `a\"b\\c\nd` {
+		t.Error("bad label escaping")
+	}
+}
@@ -1,6 +1,8 @@
 package mill
 
 import (
+	"bytes"
+	"io/ioutil"
 	"math/rand"
 	"testing"
 )
@@ -15,3 +17,22 @@ func TestBlob_Mill(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestBlob_MillStream(t *testing.T) {
+	m := &Blob{}
+
+	input := make([]byte, 512)
+	rand.Read(input)
+
+	res, err := m.MillStream(bytes.NewReader(input), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	output, err := ioutil.ReadAll(res.File)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(input, output) {
+		t.Fatal("blob stream should not change input")
+	}
+}
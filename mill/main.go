@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"io"
 
 	logging "github.com/ipfs/go-log"
 	"github.com/mr-tron/base58/base58"
@@ -27,6 +28,18 @@ type Mill interface {
 	Mill(input []byte, name string) (*Result, error)
 }
 
+// StreamResult is the output of a StreamMill, which is read as it's produced
+type StreamResult struct {
+	File io.Reader
+	Meta map[string]interface{}
+}
+
+// StreamMill is a Mill that can process input without holding it in memory
+type StreamMill interface {
+	Mill
+	MillStream(input io.Reader, name string) (*StreamResult, error)
+}
+
 func accepts(list []string, media string) error {
 	for _, m := range list {
 		if media == m {
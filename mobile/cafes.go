@@ -115,6 +115,27 @@ func (m *Mobile) SetCafeRequestComplete(id string) error {
 	return m.node.UpdateCafeRequestStatus(id, pb.CafeRequest_COMPLETE)
 }
 
+// SetCafeRequestFailed marks a request as failed, e.g., when the cafe rejects it for exceeding a quota.
+// Failed requests are no longer listed by CafeRequests.
+func (m *Mobile) SetCafeRequestFailed(id string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.UpdateCafeRequestStatus(id, pb.CafeRequest_FAILED)
+}
+
+// AddCafeRequestAttempt records a failed attempt to handle a request, e.g., when the cafe
+// could not be reached. The request is retried with exponential backoff until it reaches
+// the max number of attempts, after which it is marked as failed.
+func (m *Mobile) AddCafeRequestAttempt(id string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.AddCafeRequestAttempt(id)
+}
+
 // CafeHTTPRequest calls core CafeHTTPRequest
 func (m *Mobile) CafeHTTPRequest(id string) ([]byte, error) {
 	if !m.node.Started() {
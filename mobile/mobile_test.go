@@ -951,6 +951,29 @@ func TestMobile_Notifications(t *testing.T) {
 	}
 }
 
+func TestMobile_FilterNotifications(t *testing.T) {
+	req, err := proto.Marshal(&pb.NotificationRequest{
+		Limit: -1,
+		Read:  pb.NotificationRequest_UNREAD,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := mobile1.FilterNotifications(req)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	notes := new(pb.NotificationList)
+	if err := proto.Unmarshal(res, notes); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(notes.Items) != mobile1.CountUnreadNotifications() {
+		t.Error("filter notifications bad result")
+	}
+}
+
 func TestMobile_CountUnreadNotifications(t *testing.T) {
 	mobile1.CountUnreadNotifications()
 }
@@ -3,6 +3,7 @@ package mobile
 import (
 	"github.com/golang/protobuf/proto"
 	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
 )
 
 // Notifications call core Notifications
@@ -14,6 +15,20 @@ func (m *Mobile) Notifications(offset string, limit int) ([]byte, error) {
 	return proto.Marshal(m.node.Notifications(offset, limit))
 }
 
+// FilterNotifications calls core FilterNotifications
+func (m *Mobile) FilterNotifications(req []byte) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	mreq := new(pb.NotificationRequest)
+	if err := proto.Unmarshal(req, mreq); err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(m.node.FilterNotifications(mreq))
+}
+
 // CountUnreadNotifications calls core CountUnreadNotifications
 func (m *Mobile) CountUnreadNotifications() int {
 	if !m.node.Started() {
@@ -41,6 +56,43 @@ func (m *Mobile) ReadAllNotifications() error {
 	return m.node.ReadAllNotifications()
 }
 
+// ReadNotifications calls core ReadNotifications
+func (m *Mobile) ReadNotifications(req []byte) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	mreq := new(pb.NotificationRequest)
+	if err := proto.Unmarshal(req, mreq); err != nil {
+		return err
+	}
+
+	return m.node.ReadNotifications(mreq)
+}
+
+// RemoveNotification calls core RemoveNotification
+func (m *Mobile) RemoveNotification(id string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.RemoveNotification(id)
+}
+
+// RemoveNotifications calls core RemoveNotifications
+func (m *Mobile) RemoveNotifications(req []byte) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	mreq := new(pb.NotificationRequest)
+	if err := proto.Unmarshal(req, mreq); err != nil {
+		return err
+	}
+
+	return m.node.RemoveNotifications(mreq)
+}
+
 // AcceptInviteViaNotification call core AcceptInviteViaNotification
 func (m *Mobile) AcceptInviteViaNotification(id string) (string, error) {
 	if !m.node.Online() {
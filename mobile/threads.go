@@ -52,6 +52,20 @@ func (m *Mobile) AddOrUpdateThread(thrd []byte) error {
 	return m.node.AddOrUpdateThread(mthrd)
 }
 
+// RestoreThread calls core RestoreThread
+func (m *Mobile) RestoreThread(thrd []byte) error {
+	if !m.node.Online() {
+		return core.ErrOffline
+	}
+
+	mthrd := new(pb.Thread)
+	if err := proto.Unmarshal(thrd, mthrd); err != nil {
+		return err
+	}
+
+	return m.node.RestoreThread(mthrd)
+}
+
 // RenameThread call core RenameThread
 func (m *Mobile) RenameThread(id string, name string) error {
 	if !m.node.Started() {
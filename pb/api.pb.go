@@ -0,0 +1,4755 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import context "context"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import grpc "google.golang.org/grpc"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ApiEmpty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiEmpty) Reset()         { *m = ApiEmpty{} }
+func (m *ApiEmpty) String() string { return proto.CompactTextString(m) }
+func (*ApiEmpty) ProtoMessage()    {}
+func (*ApiEmpty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{0}
+}
+func (m *ApiEmpty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiEmpty.Unmarshal(m, b)
+}
+func (m *ApiEmpty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiEmpty.Marshal(b, m, deterministic)
+}
+func (dst *ApiEmpty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiEmpty.Merge(dst, src)
+}
+func (m *ApiEmpty) XXX_Size() int {
+	return xxx_messageInfo_ApiEmpty.Size(m)
+}
+func (m *ApiEmpty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiEmpty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiEmpty proto.InternalMessageInfo
+
+type ApiIdRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiIdRequest) Reset()         { *m = ApiIdRequest{} }
+func (m *ApiIdRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiIdRequest) ProtoMessage()    {}
+func (*ApiIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{1}
+}
+func (m *ApiIdRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiIdRequest.Unmarshal(m, b)
+}
+func (m *ApiIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiIdRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiIdRequest.Merge(dst, src)
+}
+func (m *ApiIdRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiIdRequest.Size(m)
+}
+func (m *ApiIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiIdRequest proto.InternalMessageInfo
+
+func (m *ApiIdRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ApiListRequest struct {
+	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Offset               string   `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiListRequest) Reset()         { *m = ApiListRequest{} }
+func (m *ApiListRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiListRequest) ProtoMessage()    {}
+func (*ApiListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{2}
+}
+func (m *ApiListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiListRequest.Unmarshal(m, b)
+}
+func (m *ApiListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiListRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiListRequest.Merge(dst, src)
+}
+func (m *ApiListRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiListRequest.Size(m)
+}
+func (m *ApiListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiListRequest proto.InternalMessageInfo
+
+func (m *ApiListRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ApiListRequest) GetOffset() string {
+	if m != nil {
+		return m.Offset
+	}
+	return ""
+}
+
+func (m *ApiListRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ApiSetNameRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiSetNameRequest) Reset()         { *m = ApiSetNameRequest{} }
+func (m *ApiSetNameRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiSetNameRequest) ProtoMessage()    {}
+func (*ApiSetNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{3}
+}
+func (m *ApiSetNameRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiSetNameRequest.Unmarshal(m, b)
+}
+func (m *ApiSetNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiSetNameRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiSetNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiSetNameRequest.Merge(dst, src)
+}
+func (m *ApiSetNameRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiSetNameRequest.Size(m)
+}
+func (m *ApiSetNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiSetNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiSetNameRequest proto.InternalMessageInfo
+
+func (m *ApiSetNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ApiAddMessageRequest struct {
+	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Body                 string   `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiAddMessageRequest) Reset()         { *m = ApiAddMessageRequest{} }
+func (m *ApiAddMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiAddMessageRequest) ProtoMessage()    {}
+func (*ApiAddMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{4}
+}
+func (m *ApiAddMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiAddMessageRequest.Unmarshal(m, b)
+}
+func (m *ApiAddMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiAddMessageRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiAddMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiAddMessageRequest.Merge(dst, src)
+}
+func (m *ApiAddMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiAddMessageRequest.Size(m)
+}
+func (m *ApiAddMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiAddMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiAddMessageRequest proto.InternalMessageInfo
+
+func (m *ApiAddMessageRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ApiAddMessageRequest) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+type ApiSearchContactsRequest struct {
+	Query                *ContactQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Options              *QueryOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ApiSearchContactsRequest) Reset()         { *m = ApiSearchContactsRequest{} }
+func (m *ApiSearchContactsRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiSearchContactsRequest) ProtoMessage()    {}
+func (*ApiSearchContactsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{5}
+}
+func (m *ApiSearchContactsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiSearchContactsRequest.Unmarshal(m, b)
+}
+func (m *ApiSearchContactsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiSearchContactsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiSearchContactsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiSearchContactsRequest.Merge(dst, src)
+}
+func (m *ApiSearchContactsRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiSearchContactsRequest.Size(m)
+}
+func (m *ApiSearchContactsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiSearchContactsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiSearchContactsRequest proto.InternalMessageInfo
+
+func (m *ApiSearchContactsRequest) GetQuery() *ContactQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *ApiSearchContactsRequest) GetOptions() *QueryOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type ApiSearchThreadSnapshotsRequest struct {
+	Query                *ThreadSnapshotQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Options              *QueryOptions        `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ApiSearchThreadSnapshotsRequest) Reset()         { *m = ApiSearchThreadSnapshotsRequest{} }
+func (m *ApiSearchThreadSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiSearchThreadSnapshotsRequest) ProtoMessage()    {}
+func (*ApiSearchThreadSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{6}
+}
+func (m *ApiSearchThreadSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiSearchThreadSnapshotsRequest.Unmarshal(m, b)
+}
+func (m *ApiSearchThreadSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiSearchThreadSnapshotsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiSearchThreadSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiSearchThreadSnapshotsRequest.Merge(dst, src)
+}
+func (m *ApiSearchThreadSnapshotsRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiSearchThreadSnapshotsRequest.Size(m)
+}
+func (m *ApiSearchThreadSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiSearchThreadSnapshotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiSearchThreadSnapshotsRequest proto.InternalMessageInfo
+
+func (m *ApiSearchThreadSnapshotsRequest) GetQuery() *ThreadSnapshotQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *ApiSearchThreadSnapshotsRequest) GetOptions() *QueryOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type ApiSubscribeRequest struct {
+	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Types                []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiSubscribeRequest) Reset()         { *m = ApiSubscribeRequest{} }
+func (m *ApiSubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiSubscribeRequest) ProtoMessage()    {}
+func (*ApiSubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{7}
+}
+func (m *ApiSubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiSubscribeRequest.Unmarshal(m, b)
+}
+func (m *ApiSubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiSubscribeRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiSubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiSubscribeRequest.Merge(dst, src)
+}
+func (m *ApiSubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiSubscribeRequest.Size(m)
+}
+func (m *ApiSubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiSubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiSubscribeRequest proto.InternalMessageInfo
+
+func (m *ApiSubscribeRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ApiSubscribeRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+type ApiValue struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiValue) Reset()         { *m = ApiValue{} }
+func (m *ApiValue) String() string { return proto.CompactTextString(m) }
+func (*ApiValue) ProtoMessage()    {}
+func (*ApiValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{8}
+}
+func (m *ApiValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiValue.Unmarshal(m, b)
+}
+func (m *ApiValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiValue.Marshal(b, m, deterministic)
+}
+func (dst *ApiValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiValue.Merge(dst, src)
+}
+func (m *ApiValue) XXX_Size() int {
+	return xxx_messageInfo_ApiValue.Size(m)
+}
+func (m *ApiValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiValue proto.InternalMessageInfo
+
+func (m *ApiValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type ApiValueList struct {
+	Items                []string `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiValueList) Reset()         { *m = ApiValueList{} }
+func (m *ApiValueList) String() string { return proto.CompactTextString(m) }
+func (*ApiValueList) ProtoMessage()    {}
+func (*ApiValueList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{9}
+}
+func (m *ApiValueList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiValueList.Unmarshal(m, b)
+}
+func (m *ApiValueList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiValueList.Marshal(b, m, deterministic)
+}
+func (dst *ApiValueList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiValueList.Merge(dst, src)
+}
+func (m *ApiValueList) XXX_Size() int {
+	return xxx_messageInfo_ApiValueList.Size(m)
+}
+func (m *ApiValueList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiValueList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiValueList proto.InternalMessageInfo
+
+func (m *ApiValueList) GetItems() []string {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ApiCount struct {
+	Count                int32    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiCount) Reset()         { *m = ApiCount{} }
+func (m *ApiCount) String() string { return proto.CompactTextString(m) }
+func (*ApiCount) ProtoMessage()    {}
+func (*ApiCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{10}
+}
+func (m *ApiCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiCount.Unmarshal(m, b)
+}
+func (m *ApiCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiCount.Marshal(b, m, deterministic)
+}
+func (dst *ApiCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiCount.Merge(dst, src)
+}
+func (m *ApiCount) XXX_Size() int {
+	return xxx_messageInfo_ApiCount.Size(m)
+}
+func (m *ApiCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiCount proto.InternalMessageInfo
+
+func (m *ApiCount) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ApiData struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiData) Reset()         { *m = ApiData{} }
+func (m *ApiData) String() string { return proto.CompactTextString(m) }
+func (*ApiData) ProtoMessage()    {}
+func (*ApiData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{11}
+}
+func (m *ApiData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiData.Unmarshal(m, b)
+}
+func (m *ApiData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiData.Marshal(b, m, deterministic)
+}
+func (dst *ApiData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiData.Merge(dst, src)
+}
+func (m *ApiData) XXX_Size() int {
+	return xxx_messageInfo_ApiData.Size(m)
+}
+func (m *ApiData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiData proto.InternalMessageInfo
+
+func (m *ApiData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ApiJson struct {
+	Json                 string   `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiJson) Reset()         { *m = ApiJson{} }
+func (m *ApiJson) String() string { return proto.CompactTextString(m) }
+func (*ApiJson) ProtoMessage()    {}
+func (*ApiJson) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{12}
+}
+func (m *ApiJson) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiJson.Unmarshal(m, b)
+}
+func (m *ApiJson) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiJson.Marshal(b, m, deterministic)
+}
+func (dst *ApiJson) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiJson.Merge(dst, src)
+}
+func (m *ApiJson) XXX_Size() int {
+	return xxx_messageInfo_ApiJson.Size(m)
+}
+func (m *ApiJson) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiJson.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiJson proto.InternalMessageInfo
+
+func (m *ApiJson) GetJson() string {
+	if m != nil {
+		return m.Json
+	}
+	return ""
+}
+
+type ApiMillRequest struct {
+	Mill                 string            `protobuf:"bytes,1,opt,name=mill,proto3" json:"mill,omitempty"`
+	Opts                 map[string]string `protobuf:"bytes,2,rep,name=opts,proto3" json:"opts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Data                 []byte            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Name                 string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Use                  string            `protobuf:"bytes,5,opt,name=use,proto3" json:"use,omitempty"`
+	Plaintext            bool              `protobuf:"varint,6,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ApiMillRequest) Reset()         { *m = ApiMillRequest{} }
+func (m *ApiMillRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiMillRequest) ProtoMessage()    {}
+func (*ApiMillRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{13}
+}
+func (m *ApiMillRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiMillRequest.Unmarshal(m, b)
+}
+func (m *ApiMillRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiMillRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiMillRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiMillRequest.Merge(dst, src)
+}
+func (m *ApiMillRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiMillRequest.Size(m)
+}
+func (m *ApiMillRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiMillRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiMillRequest proto.InternalMessageInfo
+
+func (m *ApiMillRequest) GetMill() string {
+	if m != nil {
+		return m.Mill
+	}
+	return ""
+}
+
+func (m *ApiMillRequest) GetOpts() map[string]string {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *ApiMillRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ApiMillRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiMillRequest) GetUse() string {
+	if m != nil {
+		return m.Use
+	}
+	return ""
+}
+
+func (m *ApiMillRequest) GetPlaintext() bool {
+	if m != nil {
+		return m.Plaintext
+	}
+	return false
+}
+
+type ApiAddOrUpdateThreadRequest struct {
+	Thread               *Thread  `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Restore              bool     `protobuf:"varint,2,opt,name=restore,proto3" json:"restore,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiAddOrUpdateThreadRequest) Reset()         { *m = ApiAddOrUpdateThreadRequest{} }
+func (m *ApiAddOrUpdateThreadRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiAddOrUpdateThreadRequest) ProtoMessage()    {}
+func (*ApiAddOrUpdateThreadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{14}
+}
+func (m *ApiAddOrUpdateThreadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiAddOrUpdateThreadRequest.Unmarshal(m, b)
+}
+func (m *ApiAddOrUpdateThreadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiAddOrUpdateThreadRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiAddOrUpdateThreadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiAddOrUpdateThreadRequest.Merge(dst, src)
+}
+func (m *ApiAddOrUpdateThreadRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiAddOrUpdateThreadRequest.Size(m)
+}
+func (m *ApiAddOrUpdateThreadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiAddOrUpdateThreadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiAddOrUpdateThreadRequest proto.InternalMessageInfo
+
+func (m *ApiAddOrUpdateThreadRequest) GetThread() *Thread {
+	if m != nil {
+		return m.Thread
+	}
+	return nil
+}
+
+func (m *ApiAddOrUpdateThreadRequest) GetRestore() bool {
+	if m != nil {
+		return m.Restore
+	}
+	return false
+}
+
+type ApiRenameThreadRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiRenameThreadRequest) Reset()         { *m = ApiRenameThreadRequest{} }
+func (m *ApiRenameThreadRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiRenameThreadRequest) ProtoMessage()    {}
+func (*ApiRenameThreadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{15}
+}
+func (m *ApiRenameThreadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiRenameThreadRequest.Unmarshal(m, b)
+}
+func (m *ApiRenameThreadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiRenameThreadRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiRenameThreadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiRenameThreadRequest.Merge(dst, src)
+}
+func (m *ApiRenameThreadRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiRenameThreadRequest.Size(m)
+}
+func (m *ApiRenameThreadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiRenameThreadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiRenameThreadRequest proto.InternalMessageInfo
+
+func (m *ApiRenameThreadRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApiRenameThreadRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ApiAddCommentRequest struct {
+	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Body                 string   `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiAddCommentRequest) Reset()         { *m = ApiAddCommentRequest{} }
+func (m *ApiAddCommentRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiAddCommentRequest) ProtoMessage()    {}
+func (*ApiAddCommentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{16}
+}
+func (m *ApiAddCommentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiAddCommentRequest.Unmarshal(m, b)
+}
+func (m *ApiAddCommentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiAddCommentRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiAddCommentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiAddCommentRequest.Merge(dst, src)
+}
+func (m *ApiAddCommentRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiAddCommentRequest.Size(m)
+}
+func (m *ApiAddCommentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiAddCommentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiAddCommentRequest proto.InternalMessageInfo
+
+func (m *ApiAddCommentRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *ApiAddCommentRequest) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+type ApiAddFilesRequest struct {
+	Thread               string         `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Caption              string         `protobuf:"bytes,2,opt,name=caption,proto3" json:"caption,omitempty"`
+	Dirs                 *DirectoryList `protobuf:"bytes,3,opt,name=dirs,proto3" json:"dirs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ApiAddFilesRequest) Reset()         { *m = ApiAddFilesRequest{} }
+func (m *ApiAddFilesRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiAddFilesRequest) ProtoMessage()    {}
+func (*ApiAddFilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{17}
+}
+func (m *ApiAddFilesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiAddFilesRequest.Unmarshal(m, b)
+}
+func (m *ApiAddFilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiAddFilesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiAddFilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiAddFilesRequest.Merge(dst, src)
+}
+func (m *ApiAddFilesRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiAddFilesRequest.Size(m)
+}
+func (m *ApiAddFilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiAddFilesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiAddFilesRequest proto.InternalMessageInfo
+
+func (m *ApiAddFilesRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ApiAddFilesRequest) GetCaption() string {
+	if m != nil {
+		return m.Caption
+	}
+	return ""
+}
+
+func (m *ApiAddFilesRequest) GetDirs() *DirectoryList {
+	if m != nil {
+		return m.Dirs
+	}
+	return nil
+}
+
+type ApiAddInviteRequest struct {
+	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiAddInviteRequest) Reset()         { *m = ApiAddInviteRequest{} }
+func (m *ApiAddInviteRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiAddInviteRequest) ProtoMessage()    {}
+func (*ApiAddInviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{18}
+}
+func (m *ApiAddInviteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiAddInviteRequest.Unmarshal(m, b)
+}
+func (m *ApiAddInviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiAddInviteRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiAddInviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiAddInviteRequest.Merge(dst, src)
+}
+func (m *ApiAddInviteRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiAddInviteRequest.Size(m)
+}
+func (m *ApiAddInviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiAddInviteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiAddInviteRequest proto.InternalMessageInfo
+
+func (m *ApiAddInviteRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ApiAddInviteRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type ApiAcceptInviteRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiAcceptInviteRequest) Reset()         { *m = ApiAcceptInviteRequest{} }
+func (m *ApiAcceptInviteRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiAcceptInviteRequest) ProtoMessage()    {}
+func (*ApiAcceptInviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{19}
+}
+func (m *ApiAcceptInviteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiAcceptInviteRequest.Unmarshal(m, b)
+}
+func (m *ApiAcceptInviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiAcceptInviteRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiAcceptInviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiAcceptInviteRequest.Merge(dst, src)
+}
+func (m *ApiAcceptInviteRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiAcceptInviteRequest.Size(m)
+}
+func (m *ApiAcceptInviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiAcceptInviteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiAcceptInviteRequest proto.InternalMessageInfo
+
+func (m *ApiAcceptInviteRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApiAcceptInviteRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ApiWebhook struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Secret               string   `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Threads              []string `protobuf:"bytes,4,rep,name=threads,proto3" json:"threads,omitempty"`
+	Types                []string `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiWebhook) Reset()         { *m = ApiWebhook{} }
+func (m *ApiWebhook) String() string { return proto.CompactTextString(m) }
+func (*ApiWebhook) ProtoMessage()    {}
+func (*ApiWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{20}
+}
+func (m *ApiWebhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiWebhook.Unmarshal(m, b)
+}
+func (m *ApiWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiWebhook.Marshal(b, m, deterministic)
+}
+func (dst *ApiWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiWebhook.Merge(dst, src)
+}
+func (m *ApiWebhook) XXX_Size() int {
+	return xxx_messageInfo_ApiWebhook.Size(m)
+}
+func (m *ApiWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiWebhook proto.InternalMessageInfo
+
+func (m *ApiWebhook) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApiWebhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ApiWebhook) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *ApiWebhook) GetThreads() []string {
+	if m != nil {
+		return m.Threads
+	}
+	return nil
+}
+
+func (m *ApiWebhook) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+type ApiWebhookList struct {
+	Items                []*ApiWebhook `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ApiWebhookList) Reset()         { *m = ApiWebhookList{} }
+func (m *ApiWebhookList) String() string { return proto.CompactTextString(m) }
+func (*ApiWebhookList) ProtoMessage()    {}
+func (*ApiWebhookList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{21}
+}
+func (m *ApiWebhookList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiWebhookList.Unmarshal(m, b)
+}
+func (m *ApiWebhookList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiWebhookList.Marshal(b, m, deterministic)
+}
+func (dst *ApiWebhookList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiWebhookList.Merge(dst, src)
+}
+func (m *ApiWebhookList) XXX_Size() int {
+	return xxx_messageInfo_ApiWebhookList.Size(m)
+}
+func (m *ApiWebhookList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiWebhookList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiWebhookList proto.InternalMessageInfo
+
+func (m *ApiWebhookList) GetItems() []*ApiWebhook {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ApiAddCafeRequest struct {
+	Host                 string   `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiAddCafeRequest) Reset()         { *m = ApiAddCafeRequest{} }
+func (m *ApiAddCafeRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiAddCafeRequest) ProtoMessage()    {}
+func (*ApiAddCafeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{22}
+}
+func (m *ApiAddCafeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiAddCafeRequest.Unmarshal(m, b)
+}
+func (m *ApiAddCafeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiAddCafeRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiAddCafeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiAddCafeRequest.Merge(dst, src)
+}
+func (m *ApiAddCafeRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiAddCafeRequest.Size(m)
+}
+func (m *ApiAddCafeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiAddCafeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiAddCafeRequest proto.InternalMessageInfo
+
+func (m *ApiAddCafeRequest) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *ApiAddCafeRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type ApiUpdateCafeRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Priority             int32    `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiUpdateCafeRequest) Reset()         { *m = ApiUpdateCafeRequest{} }
+func (m *ApiUpdateCafeRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiUpdateCafeRequest) ProtoMessage()    {}
+func (*ApiUpdateCafeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{23}
+}
+func (m *ApiUpdateCafeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiUpdateCafeRequest.Unmarshal(m, b)
+}
+func (m *ApiUpdateCafeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiUpdateCafeRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiUpdateCafeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiUpdateCafeRequest.Merge(dst, src)
+}
+func (m *ApiUpdateCafeRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiUpdateCafeRequest.Size(m)
+}
+func (m *ApiUpdateCafeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiUpdateCafeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiUpdateCafeRequest proto.InternalMessageInfo
+
+func (m *ApiUpdateCafeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApiUpdateCafeRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type ApiCafeRequestsRequest struct {
+	Offset               string   `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Failed               bool     `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiCafeRequestsRequest) Reset()         { *m = ApiCafeRequestsRequest{} }
+func (m *ApiCafeRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiCafeRequestsRequest) ProtoMessage()    {}
+func (*ApiCafeRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{24}
+}
+func (m *ApiCafeRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiCafeRequestsRequest.Unmarshal(m, b)
+}
+func (m *ApiCafeRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiCafeRequestsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiCafeRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiCafeRequestsRequest.Merge(dst, src)
+}
+func (m *ApiCafeRequestsRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiCafeRequestsRequest.Size(m)
+}
+func (m *ApiCafeRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiCafeRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiCafeRequestsRequest proto.InternalMessageInfo
+
+func (m *ApiCafeRequestsRequest) GetOffset() string {
+	if m != nil {
+		return m.Offset
+	}
+	return ""
+}
+
+func (m *ApiCafeRequestsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ApiCafeRequestsRequest) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+type ApiCreateApiKeyRequest struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes               []string             `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Expires              *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expires,proto3" json:"expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ApiCreateApiKeyRequest) Reset()         { *m = ApiCreateApiKeyRequest{} }
+func (m *ApiCreateApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiCreateApiKeyRequest) ProtoMessage()    {}
+func (*ApiCreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{25}
+}
+func (m *ApiCreateApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiCreateApiKeyRequest.Unmarshal(m, b)
+}
+func (m *ApiCreateApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiCreateApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiCreateApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiCreateApiKeyRequest.Merge(dst, src)
+}
+func (m *ApiCreateApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiCreateApiKeyRequest.Size(m)
+}
+func (m *ApiCreateApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiCreateApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiCreateApiKeyRequest proto.InternalMessageInfo
+
+func (m *ApiCreateApiKeyRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiCreateApiKeyRequest) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *ApiCreateApiKeyRequest) GetExpires() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+type ApiCreateTokenRequest struct {
+	Token                string               `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Store                bool                 `protobuf:"varint,2,opt,name=store,proto3" json:"store,omitempty"`
+	Scopes               []string             `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Plan                 string               `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`
+	Label                string               `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	Expires              *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expires,proto3" json:"expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ApiCreateTokenRequest) Reset()         { *m = ApiCreateTokenRequest{} }
+func (m *ApiCreateTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiCreateTokenRequest) ProtoMessage()    {}
+func (*ApiCreateTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{26}
+}
+func (m *ApiCreateTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiCreateTokenRequest.Unmarshal(m, b)
+}
+func (m *ApiCreateTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiCreateTokenRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiCreateTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiCreateTokenRequest.Merge(dst, src)
+}
+func (m *ApiCreateTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiCreateTokenRequest.Size(m)
+}
+func (m *ApiCreateTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiCreateTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiCreateTokenRequest proto.InternalMessageInfo
+
+func (m *ApiCreateTokenRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ApiCreateTokenRequest) GetStore() bool {
+	if m != nil {
+		return m.Store
+	}
+	return false
+}
+
+func (m *ApiCreateTokenRequest) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *ApiCreateTokenRequest) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *ApiCreateTokenRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ApiCreateTokenRequest) GetExpires() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+type ApiIpfsCatRequest struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiIpfsCatRequest) Reset()         { *m = ApiIpfsCatRequest{} }
+func (m *ApiIpfsCatRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiIpfsCatRequest) ProtoMessage()    {}
+func (*ApiIpfsCatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{27}
+}
+func (m *ApiIpfsCatRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiIpfsCatRequest.Unmarshal(m, b)
+}
+func (m *ApiIpfsCatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiIpfsCatRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiIpfsCatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiIpfsCatRequest.Merge(dst, src)
+}
+func (m *ApiIpfsCatRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiIpfsCatRequest.Size(m)
+}
+func (m *ApiIpfsCatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiIpfsCatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiIpfsCatRequest proto.InternalMessageInfo
+
+func (m *ApiIpfsCatRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ApiIpfsCatRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ApiIpfsSwarmPeersRequest struct {
+	Verbose              bool     `protobuf:"varint,1,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	Latency              bool     `protobuf:"varint,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	Streams              bool     `protobuf:"varint,3,opt,name=streams,proto3" json:"streams,omitempty"`
+	Direction            bool     `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiIpfsSwarmPeersRequest) Reset()         { *m = ApiIpfsSwarmPeersRequest{} }
+func (m *ApiIpfsSwarmPeersRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiIpfsSwarmPeersRequest) ProtoMessage()    {}
+func (*ApiIpfsSwarmPeersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{28}
+}
+func (m *ApiIpfsSwarmPeersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiIpfsSwarmPeersRequest.Unmarshal(m, b)
+}
+func (m *ApiIpfsSwarmPeersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiIpfsSwarmPeersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiIpfsSwarmPeersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiIpfsSwarmPeersRequest.Merge(dst, src)
+}
+func (m *ApiIpfsSwarmPeersRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiIpfsSwarmPeersRequest.Size(m)
+}
+func (m *ApiIpfsSwarmPeersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiIpfsSwarmPeersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiIpfsSwarmPeersRequest proto.InternalMessageInfo
+
+func (m *ApiIpfsSwarmPeersRequest) GetVerbose() bool {
+	if m != nil {
+		return m.Verbose
+	}
+	return false
+}
+
+func (m *ApiIpfsSwarmPeersRequest) GetLatency() bool {
+	if m != nil {
+		return m.Latency
+	}
+	return false
+}
+
+func (m *ApiIpfsSwarmPeersRequest) GetStreams() bool {
+	if m != nil {
+		return m.Streams
+	}
+	return false
+}
+
+func (m *ApiIpfsSwarmPeersRequest) GetDirection() bool {
+	if m != nil {
+		return m.Direction
+	}
+	return false
+}
+
+type ApiIpfsSwarmPeer struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Peer                 string   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Latency              string   `protobuf:"bytes,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	Muxer                string   `protobuf:"bytes,4,opt,name=muxer,proto3" json:"muxer,omitempty"`
+	Direction            int32    `protobuf:"varint,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	Streams              []string `protobuf:"bytes,6,rep,name=streams,proto3" json:"streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiIpfsSwarmPeer) Reset()         { *m = ApiIpfsSwarmPeer{} }
+func (m *ApiIpfsSwarmPeer) String() string { return proto.CompactTextString(m) }
+func (*ApiIpfsSwarmPeer) ProtoMessage()    {}
+func (*ApiIpfsSwarmPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{29}
+}
+func (m *ApiIpfsSwarmPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiIpfsSwarmPeer.Unmarshal(m, b)
+}
+func (m *ApiIpfsSwarmPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiIpfsSwarmPeer.Marshal(b, m, deterministic)
+}
+func (dst *ApiIpfsSwarmPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiIpfsSwarmPeer.Merge(dst, src)
+}
+func (m *ApiIpfsSwarmPeer) XXX_Size() int {
+	return xxx_messageInfo_ApiIpfsSwarmPeer.Size(m)
+}
+func (m *ApiIpfsSwarmPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiIpfsSwarmPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiIpfsSwarmPeer proto.InternalMessageInfo
+
+func (m *ApiIpfsSwarmPeer) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ApiIpfsSwarmPeer) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *ApiIpfsSwarmPeer) GetLatency() string {
+	if m != nil {
+		return m.Latency
+	}
+	return ""
+}
+
+func (m *ApiIpfsSwarmPeer) GetMuxer() string {
+	if m != nil {
+		return m.Muxer
+	}
+	return ""
+}
+
+func (m *ApiIpfsSwarmPeer) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ApiIpfsSwarmPeer) GetStreams() []string {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+type ApiIpfsSwarmPeerList struct {
+	Items                []*ApiIpfsSwarmPeer `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ApiIpfsSwarmPeerList) Reset()         { *m = ApiIpfsSwarmPeerList{} }
+func (m *ApiIpfsSwarmPeerList) String() string { return proto.CompactTextString(m) }
+func (*ApiIpfsSwarmPeerList) ProtoMessage()    {}
+func (*ApiIpfsSwarmPeerList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{30}
+}
+func (m *ApiIpfsSwarmPeerList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiIpfsSwarmPeerList.Unmarshal(m, b)
+}
+func (m *ApiIpfsSwarmPeerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiIpfsSwarmPeerList.Marshal(b, m, deterministic)
+}
+func (dst *ApiIpfsSwarmPeerList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiIpfsSwarmPeerList.Merge(dst, src)
+}
+func (m *ApiIpfsSwarmPeerList) XXX_Size() int {
+	return xxx_messageInfo_ApiIpfsSwarmPeerList.Size(m)
+}
+func (m *ApiIpfsSwarmPeerList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiIpfsSwarmPeerList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiIpfsSwarmPeerList proto.InternalMessageInfo
+
+func (m *ApiIpfsSwarmPeerList) GetItems() []*ApiIpfsSwarmPeer {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ApiLogsRequest struct {
+	Subsystem            string   `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	TexOnly              bool     `protobuf:"varint,3,opt,name=tex_only,json=texOnly,proto3" json:"tex_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiLogsRequest) Reset()         { *m = ApiLogsRequest{} }
+func (m *ApiLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*ApiLogsRequest) ProtoMessage()    {}
+func (*ApiLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{31}
+}
+func (m *ApiLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiLogsRequest.Unmarshal(m, b)
+}
+func (m *ApiLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiLogsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApiLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiLogsRequest.Merge(dst, src)
+}
+func (m *ApiLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_ApiLogsRequest.Size(m)
+}
+func (m *ApiLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiLogsRequest proto.InternalMessageInfo
+
+func (m *ApiLogsRequest) GetSubsystem() string {
+	if m != nil {
+		return m.Subsystem
+	}
+	return ""
+}
+
+func (m *ApiLogsRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *ApiLogsRequest) GetTexOnly() bool {
+	if m != nil {
+		return m.TexOnly
+	}
+	return false
+}
+
+type ApiLogLevels struct {
+	Systems              map[string]string `protobuf:"bytes,1,rep,name=systems,proto3" json:"systems,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ApiLogLevels) Reset()         { *m = ApiLogLevels{} }
+func (m *ApiLogLevels) String() string { return proto.CompactTextString(m) }
+func (*ApiLogLevels) ProtoMessage()    {}
+func (*ApiLogLevels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_3e3634ec251ce803, []int{32}
+}
+func (m *ApiLogLevels) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiLogLevels.Unmarshal(m, b)
+}
+func (m *ApiLogLevels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiLogLevels.Marshal(b, m, deterministic)
+}
+func (dst *ApiLogLevels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiLogLevels.Merge(dst, src)
+}
+func (m *ApiLogLevels) XXX_Size() int {
+	return xxx_messageInfo_ApiLogLevels.Size(m)
+}
+func (m *ApiLogLevels) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiLogLevels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiLogLevels proto.InternalMessageInfo
+
+func (m *ApiLogLevels) GetSystems() map[string]string {
+	if m != nil {
+		return m.Systems
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ApiEmpty)(nil), "ApiEmpty")
+	proto.RegisterType((*ApiIdRequest)(nil), "ApiIdRequest")
+	proto.RegisterType((*ApiListRequest)(nil), "ApiListRequest")
+	proto.RegisterType((*ApiSetNameRequest)(nil), "ApiSetNameRequest")
+	proto.RegisterType((*ApiAddMessageRequest)(nil), "ApiAddMessageRequest")
+	proto.RegisterType((*ApiSearchContactsRequest)(nil), "ApiSearchContactsRequest")
+	proto.RegisterType((*ApiSearchThreadSnapshotsRequest)(nil), "ApiSearchThreadSnapshotsRequest")
+	proto.RegisterType((*ApiSubscribeRequest)(nil), "ApiSubscribeRequest")
+	proto.RegisterType((*ApiValue)(nil), "ApiValue")
+	proto.RegisterType((*ApiValueList)(nil), "ApiValueList")
+	proto.RegisterType((*ApiCount)(nil), "ApiCount")
+	proto.RegisterType((*ApiData)(nil), "ApiData")
+	proto.RegisterType((*ApiJson)(nil), "ApiJson")
+	proto.RegisterType((*ApiMillRequest)(nil), "ApiMillRequest")
+	proto.RegisterMapType((map[string]string)(nil), "ApiMillRequest.OptsEntry")
+	proto.RegisterType((*ApiAddOrUpdateThreadRequest)(nil), "ApiAddOrUpdateThreadRequest")
+	proto.RegisterType((*ApiRenameThreadRequest)(nil), "ApiRenameThreadRequest")
+	proto.RegisterType((*ApiAddCommentRequest)(nil), "ApiAddCommentRequest")
+	proto.RegisterType((*ApiAddFilesRequest)(nil), "ApiAddFilesRequest")
+	proto.RegisterType((*ApiAddInviteRequest)(nil), "ApiAddInviteRequest")
+	proto.RegisterType((*ApiAcceptInviteRequest)(nil), "ApiAcceptInviteRequest")
+	proto.RegisterType((*ApiWebhook)(nil), "ApiWebhook")
+	proto.RegisterType((*ApiWebhookList)(nil), "ApiWebhookList")
+	proto.RegisterType((*ApiAddCafeRequest)(nil), "ApiAddCafeRequest")
+	proto.RegisterType((*ApiUpdateCafeRequest)(nil), "ApiUpdateCafeRequest")
+	proto.RegisterType((*ApiCafeRequestsRequest)(nil), "ApiCafeRequestsRequest")
+	proto.RegisterType((*ApiCreateApiKeyRequest)(nil), "ApiCreateApiKeyRequest")
+	proto.RegisterType((*ApiCreateTokenRequest)(nil), "ApiCreateTokenRequest")
+	proto.RegisterType((*ApiIpfsCatRequest)(nil), "ApiIpfsCatRequest")
+	proto.RegisterType((*ApiIpfsSwarmPeersRequest)(nil), "ApiIpfsSwarmPeersRequest")
+	proto.RegisterType((*ApiIpfsSwarmPeer)(nil), "ApiIpfsSwarmPeer")
+	proto.RegisterType((*ApiIpfsSwarmPeerList)(nil), "ApiIpfsSwarmPeerList")
+	proto.RegisterType((*ApiLogsRequest)(nil), "ApiLogsRequest")
+	proto.RegisterType((*ApiLogLevels)(nil), "ApiLogLevels")
+	proto.RegisterMapType((map[string]string)(nil), "ApiLogLevels.SystemsEntry")
+}
+
+func init() { proto.RegisterFile("api.proto", fileDescriptor_api_3e3634ec251ce803) }
+
+var fileDescriptor_api_3e3634ec251ce803 = []byte{
+	// 2184 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x18, 0xed, 0x72, 0xdb, 0xc6,
+	0x71, 0x48, 0xf1, 0x73, 0x49, 0xc9, 0xd2, 0x99, 0x72, 0x69, 0xf8, 0x43, 0x12, 0x6c, 0x4b, 0xb2,
+	0x5d, 0x9d, 0x55, 0x39, 0x33, 0x4d, 0xdc, 0x74, 0x5a, 0x5a, 0x71, 0x3a, 0x6a, 0x9c, 0x58, 0x85,
+	0x14, 0xb5, 0xd3, 0x1f, 0xcd, 0x80, 0xc4, 0x51, 0xba, 0x08, 0x04, 0x10, 0xe0, 0x48, 0x8b, 0xf9,
+	0xd5, 0x5f, 0x7d, 0x81, 0xbe, 0x41, 0x5f, 0xa3, 0xaf, 0xd3, 0x07, 0xe9, 0xdc, 0x17, 0x70, 0x00,
+	0x21, 0xc9, 0xed, 0x2f, 0xdc, 0x7e, 0xdc, 0xde, 0xee, 0xde, 0xee, 0xed, 0x2e, 0xa0, 0xed, 0x46,
+	0x14, 0x47, 0x71, 0xc8, 0x42, 0x6b, 0xe3, 0x3c, 0x0c, 0xcf, 0x7d, 0xf2, 0x4a, 0x40, 0xc3, 0xe9,
+	0xf8, 0x15, 0xa3, 0x13, 0x92, 0x30, 0x77, 0x12, 0x29, 0x86, 0xce, 0x24, 0xf4, 0x88, 0xaf, 0x00,
+	0x98, 0x51, 0xf2, 0x51, 0x13, 0x7e, 0x9a, 0x92, 0x78, 0x2e, 0x01, 0x1b, 0xa0, 0x35, 0x88, 0xe8,
+	0xbb, 0x49, 0xc4, 0xe6, 0xf6, 0x63, 0xe8, 0x0e, 0x22, 0x7a, 0xe4, 0x39, 0xe4, 0xa7, 0x29, 0x49,
+	0x18, 0x5a, 0x81, 0x2a, 0xf5, 0xfa, 0x95, 0xcd, 0xca, 0x6e, 0xdb, 0xa9, 0x52, 0xcf, 0x3e, 0x83,
+	0x95, 0x41, 0x44, 0xdf, 0xd3, 0x84, 0x69, 0x8e, 0x7b, 0xd0, 0x60, 0x17, 0x31, 0x71, 0x35, 0x97,
+	0x82, 0x38, 0x3e, 0x1c, 0x8f, 0x13, 0xc2, 0xfa, 0x55, 0x89, 0x97, 0x10, 0xea, 0x41, 0xdd, 0xa7,
+	0x13, 0xca, 0xfa, 0x4b, 0x9b, 0x95, 0xdd, 0xba, 0x23, 0x01, 0x7b, 0x07, 0xd6, 0x06, 0x11, 0x3d,
+	0x21, 0xec, 0x3b, 0x77, 0x42, 0xb4, 0x68, 0x04, 0xb5, 0xc0, 0x9d, 0x10, 0x25, 0x58, 0xac, 0xed,
+	0xb7, 0xd0, 0x1b, 0x44, 0x74, 0xe0, 0x79, 0xdf, 0x92, 0x24, 0x71, 0xcf, 0xc9, 0x6d, 0x6a, 0x20,
+	0xa8, 0x0d, 0x43, 0x6f, 0xae, 0x94, 0x10, 0x6b, 0xfb, 0x02, 0xfa, 0xe2, 0x30, 0x37, 0x1e, 0x5d,
+	0x1c, 0x86, 0x01, 0x73, 0x47, 0x2c, 0xd1, 0x72, 0x9e, 0x40, 0x5d, 0xf8, 0x46, 0x88, 0xe9, 0x1c,
+	0x2c, 0x63, 0xc5, 0xf0, 0x27, 0x8e, 0x74, 0x24, 0x0d, 0xed, 0x40, 0x33, 0x8c, 0x18, 0x0d, 0x83,
+	0xa4, 0x5f, 0x55, 0x6c, 0x82, 0xfe, 0x41, 0x22, 0x1d, 0x4d, 0xb5, 0x67, 0xb0, 0x91, 0x9e, 0x74,
+	0x2a, 0x14, 0x3a, 0x09, 0xdc, 0x28, 0xb9, 0x08, 0xb3, 0x03, 0x5f, 0xe4, 0x0f, 0xec, 0xe1, 0x3c,
+	0xdf, 0xff, 0x77, 0xee, 0x21, 0xdc, 0xe5, 0xe7, 0x4e, 0x87, 0xc9, 0x28, 0xa6, 0xc3, 0x5b, 0x9d,
+	0xd4, 0x83, 0x3a, 0x9b, 0x47, 0x84, 0x4b, 0x5d, 0xda, 0x6d, 0x3b, 0x12, 0xb0, 0x37, 0x45, 0x5c,
+	0x9c, 0xb9, 0xfe, 0x94, 0x70, 0x8e, 0x19, 0x5f, 0xa8, 0x8d, 0x12, 0xb0, 0x9f, 0x42, 0x57, 0x73,
+	0xf0, 0x90, 0xe0, 0x5c, 0x94, 0x91, 0x49, 0xd2, 0xaf, 0x48, 0x39, 0x02, 0x50, 0x72, 0x0e, 0xc3,
+	0x69, 0x20, 0x38, 0x46, 0x7c, 0x21, 0xe4, 0xd4, 0x1d, 0x09, 0xd8, 0x8f, 0xa0, 0x39, 0x88, 0xe8,
+	0x57, 0x2e, 0x73, 0xf9, 0x7d, 0x79, 0x2e, 0x73, 0x05, 0xbd, 0xeb, 0x88, 0xb5, 0x22, 0xff, 0x31,
+	0x09, 0x03, 0x4e, 0xfe, 0x31, 0x09, 0x03, 0x1d, 0x12, 0x7c, 0x6d, 0xff, 0xa7, 0x22, 0x82, 0xf2,
+	0x5b, 0xea, 0xfb, 0x46, 0xe4, 0x4c, 0xa8, 0xef, 0x6b, 0x36, 0xbe, 0x46, 0x7b, 0x50, 0x0b, 0x23,
+	0x26, 0x6d, 0xec, 0x1c, 0xdc, 0xc7, 0xf9, 0x2d, 0xf8, 0x43, 0xc4, 0x92, 0x77, 0x01, 0x8b, 0xe7,
+	0x8e, 0x60, 0x4b, 0x15, 0x59, 0xca, 0x14, 0x49, 0x03, 0xb2, 0x96, 0x05, 0x24, 0x5a, 0x85, 0xa5,
+	0x69, 0x42, 0xfa, 0x75, 0x81, 0xe2, 0x4b, 0xf4, 0x10, 0xda, 0x91, 0xef, 0xd2, 0x80, 0x91, 0x2b,
+	0xd6, 0x6f, 0x6c, 0x56, 0x76, 0x5b, 0x4e, 0x86, 0xb0, 0x7e, 0x0d, 0xed, 0xf4, 0x28, 0xbe, 0xf9,
+	0x92, 0xcc, 0x95, 0x9a, 0x7c, 0x99, 0x39, 0xba, 0x6a, 0x38, 0xfa, 0x4d, 0xf5, 0xf3, 0x8a, 0xfd,
+	0x17, 0x78, 0x20, 0x23, 0xff, 0x43, 0xfc, 0x7d, 0xe4, 0xb9, 0x8c, 0xc8, 0x40, 0xd1, 0x26, 0x6f,
+	0xe4, 0xee, 0xb6, 0x73, 0xd0, 0x54, 0x81, 0x94, 0x5e, 0x72, 0x1f, 0x9a, 0x31, 0x49, 0x58, 0x18,
+	0x4b, 0xd9, 0x2d, 0x47, 0x83, 0xf6, 0x97, 0x70, 0x6f, 0x10, 0x51, 0x87, 0x70, 0x7b, 0xf2, 0x42,
+	0x0b, 0xe9, 0x9f, 0x3a, 0xa0, 0x6a, 0x64, 0xe4, 0xef, 0x75, 0x46, 0x1e, 0x86, 0x93, 0x09, 0x09,
+	0xd2, 0x87, 0xa1, 0x07, 0xf5, 0xa1, 0x1f, 0x8e, 0x2e, 0x75, 0xc8, 0x08, 0xa0, 0x34, 0x1f, 0x7f,
+	0x04, 0x24, 0x25, 0x7c, 0x4d, 0x7d, 0x92, 0xdc, 0x16, 0xac, 0x7d, 0x68, 0x8e, 0x5c, 0x11, 0xe7,
+	0x4a, 0x88, 0x06, 0x91, 0x0d, 0x35, 0x8f, 0xc6, 0x89, 0xb8, 0xb2, 0xce, 0xc1, 0x0a, 0xfe, 0x8a,
+	0xc6, 0x64, 0xc4, 0xc2, 0x78, 0x2e, 0xde, 0x2b, 0x41, 0xb3, 0xff, 0x20, 0x32, 0x63, 0xe0, 0x79,
+	0x47, 0xc1, 0x8c, 0x32, 0xf2, 0x09, 0x87, 0xb9, 0x9e, 0x17, 0x93, 0x24, 0xd1, 0x87, 0x29, 0xd0,
+	0x7e, 0x23, 0x9c, 0x36, 0x18, 0x8d, 0x48, 0xc4, 0xf2, 0xb2, 0x8a, 0x4e, 0x53, 0x97, 0x5c, 0x4d,
+	0x2f, 0xd9, 0x9e, 0x01, 0x0c, 0x22, 0xfa, 0x67, 0x32, 0xbc, 0x08, 0xc3, 0xcb, 0x32, 0xfe, 0x69,
+	0xec, 0x6b, 0xfe, 0x69, 0xec, 0x73, 0xed, 0x12, 0x32, 0x8a, 0x89, 0x7c, 0x34, 0xdb, 0x8e, 0x82,
+	0xb8, 0x76, 0x52, 0xcf, 0xa4, 0x5f, 0x13, 0x19, 0xa7, 0xc1, 0x2c, 0xa3, 0xeb, 0x66, 0x46, 0xbf,
+	0x86, 0x95, 0xec, 0x5c, 0x91, 0xb1, 0x5b, 0x66, 0xc6, 0x76, 0x0e, 0x3a, 0x38, 0xa3, 0xeb, 0xf4,
+	0xfd, 0x2d, 0xac, 0xa9, 0xfb, 0x75, 0xc7, 0xe6, 0xd3, 0x7c, 0x11, 0x26, 0x4c, 0x27, 0x18, 0x5f,
+	0x8b, 0x33, 0xc3, 0x4b, 0xa2, 0xaf, 0x45, 0x02, 0xea, 0xc1, 0x96, 0x11, 0x6b, 0x4a, 0x28, 0x5a,
+	0x6d, 0x41, 0x2b, 0x8a, 0x69, 0x18, 0x53, 0x26, 0x5d, 0x55, 0x77, 0x52, 0xd8, 0xfe, 0x9b, 0xf0,
+	0xb5, 0xb1, 0xdb, 0x0c, 0x12, 0x55, 0x65, 0x2a, 0xe5, 0x55, 0xa6, 0x6a, 0x54, 0x19, 0xce, 0x3d,
+	0x76, 0xa9, 0x4f, 0x3c, 0xe1, 0xc7, 0x96, 0xa3, 0x20, 0xfb, 0x67, 0x29, 0x3f, 0x26, 0x2e, 0x23,
+	0x83, 0x88, 0x7e, 0x43, 0xe6, 0x37, 0x94, 0x20, 0x71, 0x1b, 0xa3, 0x30, 0x7b, 0x2e, 0x15, 0x84,
+	0x3e, 0x83, 0x26, 0xb9, 0x8a, 0x68, 0x4c, 0x74, 0x04, 0x5a, 0x58, 0x16, 0x68, 0xac, 0x0b, 0x34,
+	0x3e, 0xd5, 0x05, 0xda, 0xd1, 0xac, 0xf6, 0xbf, 0x2b, 0xb0, 0x9e, 0x1e, 0x7e, 0xca, 0x5d, 0x66,
+	0x24, 0x90, 0xf4, 0x67, 0xc5, 0xf0, 0x27, 0xc7, 0x9a, 0x49, 0x2c, 0x01, 0x43, 0xa7, 0xa5, 0x9c,
+	0x4e, 0x08, 0x6a, 0x91, 0xef, 0x06, 0xfa, 0xc5, 0xe2, 0x6b, 0xe1, 0x1b, 0x77, 0x48, 0x7c, 0xf5,
+	0x66, 0x49, 0xc0, 0xd4, 0xbe, 0xf1, 0xe9, 0xda, 0x7f, 0x21, 0x82, 0xe3, 0x28, 0x1a, 0x27, 0x87,
+	0x2e, 0x33, 0x9c, 0x16, 0xb9, 0xec, 0x42, 0x3b, 0x8d, 0xaf, 0x4b, 0x92, 0xe0, 0x1f, 0x15, 0xe8,
+	0xab, 0xbd, 0x27, 0x1f, 0xdd, 0x78, 0x72, 0x4c, 0x48, 0x9c, 0xde, 0x6b, 0x1f, 0x9a, 0x33, 0x12,
+	0x0f, 0xc3, 0x44, 0xba, 0xbe, 0xe5, 0x68, 0x90, 0x53, 0x7c, 0x97, 0x91, 0x60, 0x34, 0xd7, 0xcf,
+	0x98, 0x02, 0x39, 0x25, 0x61, 0x31, 0x71, 0x27, 0x89, 0xba, 0x5e, 0x0d, 0xf2, 0x17, 0xd9, 0x13,
+	0x6f, 0x01, 0x0d, 0xa5, 0x2b, 0x5a, 0x4e, 0x86, 0xb0, 0xff, 0x55, 0x81, 0xd5, 0xa2, 0x22, 0xdc,
+	0x06, 0x9e, 0xe9, 0xda, 0x06, 0xbe, 0x16, 0x76, 0x11, 0x12, 0xeb, 0xb7, 0x8b, 0xaf, 0x4d, 0x75,
+	0x64, 0x6e, 0xa6, 0xea, 0xf4, 0xa0, 0x3e, 0x99, 0x5e, 0x91, 0x58, 0xf9, 0x5e, 0x02, 0x79, 0x55,
+	0xea, 0x22, 0x38, 0x33, 0x84, 0x69, 0x42, 0x43, 0x26, 0xb4, 0x02, 0xed, 0xdf, 0x41, 0xaf, 0xa8,
+	0xa3, 0x48, 0xe0, 0x9d, 0x7c, 0x02, 0xaf, 0xe1, 0x22, 0x97, 0x4e, 0xe3, 0x1f, 0x64, 0xe7, 0x16,
+	0x9e, 0xa7, 0x3e, 0x7e, 0x08, 0xed, 0x64, 0x3a, 0x4c, 0xe6, 0x09, 0x23, 0x13, 0x65, 0x67, 0x86,
+	0x10, 0x51, 0x42, 0x66, 0x44, 0xbf, 0x43, 0x12, 0x40, 0xf7, 0xa1, 0xc5, 0xc8, 0xd5, 0x0f, 0x61,
+	0xe0, 0xcf, 0xb5, 0x93, 0x19, 0xb9, 0xfa, 0x10, 0xf8, 0x73, 0xfb, 0xef, 0x15, 0xd1, 0x0d, 0xbc,
+	0x0f, 0xcf, 0xdf, 0x73, 0x56, 0x91, 0x0f, 0x52, 0x96, 0x56, 0xce, 0xc2, 0x26, 0x1d, 0x9f, 0x48,
+	0xa2, 0x2c, 0xba, 0x9a, 0xd5, 0x7a, 0x03, 0x5d, 0x93, 0xf0, 0xbf, 0x94, 0xc8, 0x83, 0x7f, 0x3e,
+	0x80, 0xa5, 0x41, 0x44, 0xd1, 0x63, 0x68, 0x9e, 0x4c, 0x27, 0x13, 0x37, 0x9e, 0xa3, 0x36, 0xd6,
+	0xbd, 0xad, 0xd5, 0xc2, 0x1a, 0xb9, 0x09, 0xb5, 0x63, 0x1a, 0x9c, 0xa3, 0x65, 0x6c, 0x36, 0xbb,
+	0x56, 0x1b, 0xeb, 0x6e, 0x86, 0x4b, 0x18, 0x8c, 0x44, 0x73, 0x92, 0x97, 0xa0, 0xba, 0x41, 0xf4,
+	0x04, 0x3a, 0x8a, 0x7e, 0x42, 0x88, 0x67, 0xf2, 0x18, 0x42, 0xb6, 0x61, 0x45, 0x31, 0x0d, 0x64,
+	0xd1, 0xb8, 0x86, 0xef, 0x01, 0x34, 0x8f, 0xe3, 0x70, 0x4c, 0x7d, 0x62, 0x32, 0xd4, 0xb1, 0x08,
+	0xc4, 0xa7, 0xd0, 0x54, 0x6d, 0x31, 0x42, 0x78, 0xa1, 0x47, 0xd6, 0x5c, 0x8f, 0xa0, 0x7d, 0x42,
+	0xd8, 0x60, 0xe6, 0x32, 0x37, 0x2e, 0x11, 0xf2, 0x04, 0x5a, 0x4a, 0xf3, 0x9c, 0x0e, 0x5d, 0x6d,
+	0x8f, 0x08, 0x25, 0x1b, 0x9a, 0xda, 0xbc, 0x82, 0x63, 0x32, 0xbb, 0xb7, 0x00, 0x44, 0xa5, 0x97,
+	0x50, 0x8a, 0xb7, 0x32, 0xa1, 0xe8, 0x39, 0x2c, 0x3b, 0x64, 0x12, 0xce, 0xc8, 0x35, 0xc2, 0x0c,
+	0xd6, 0xdf, 0xc0, 0x4a, 0xbe, 0x0b, 0x47, 0xf7, 0xf1, 0x75, 0x9d, 0xb9, 0xd5, 0x95, 0xbd, 0xae,
+	0x43, 0x92, 0xa9, 0xcf, 0xf6, 0x2b, 0xe8, 0x09, 0xd4, 0x78, 0xff, 0x86, 0xee, 0x14, 0x3a, 0x39,
+	0x0b, 0x30, 0xef, 0x23, 0x8e, 0x02, 0x8f, 0x5c, 0xa1, 0x6d, 0x68, 0x0f, 0x3c, 0x4f, 0x36, 0x35,
+	0x68, 0x15, 0xa7, 0xeb, 0xc3, 0x30, 0x18, 0xd3, 0x73, 0x4b, 0x37, 0x49, 0xe8, 0x4b, 0x58, 0x5b,
+	0xe8, 0xac, 0xd0, 0x43, 0x7c, 0x43, 0xc3, 0x65, 0xda, 0x71, 0x00, 0x5d, 0xb3, 0x7b, 0x42, 0xbf,
+	0xc0, 0xe5, 0xfd, 0x94, 0xb9, 0x67, 0x0b, 0x9a, 0xa7, 0xaa, 0x58, 0x1b, 0x37, 0xd2, 0x51, 0x0a,
+	0x89, 0x0b, 0xd9, 0x84, 0x86, 0x12, 0x58, 0x70, 0x61, 0xaa, 0xf6, 0x0e, 0x74, 0xe4, 0x4a, 0x3c,
+	0x9e, 0x8b, 0x9e, 0x4e, 0x9f, 0x89, 0x5d, 0xe8, 0xca, 0x4b, 0x29, 0x17, 0x68, 0xe8, 0xb5, 0x07,
+	0xeb, 0xaa, 0x16, 0xe5, 0xe7, 0x95, 0x85, 0xd8, 0x95, 0xec, 0x47, 0xb0, 0x5e, 0x3a, 0xde, 0xa0,
+	0x4d, 0x7c, 0xcb, 0xe4, 0xb3, 0x70, 0xa1, 0xcf, 0xa0, 0xf1, 0x96, 0xf7, 0x88, 0x89, 0xbc, 0x52,
+	0x63, 0xc8, 0xb4, 0x00, 0x0b, 0x8a, 0x30, 0x65, 0x1b, 0x5a, 0x02, 0x38, 0xa3, 0x3f, 0x2f, 0x32,
+	0xb6, 0x71, 0x4a, 0x7b, 0x0c, 0x75, 0xb1, 0x2e, 0xda, 0xda, 0x90, 0x2c, 0xe8, 0x29, 0x74, 0xa4,
+	0x4b, 0x6e, 0xe4, 0xda, 0x03, 0xc8, 0x5a, 0x5b, 0xb4, 0x8e, 0xcb, 0x5a, 0x5d, 0x91, 0x1f, 0x92,
+	0x61, 0x07, 0x5a, 0x6a, 0xb9, 0x70, 0x1b, 0x5d, 0xcd, 0x94, 0x25, 0x9b, 0xdc, 0x53, 0x92, 0x6c,
+	0x92, 0xb0, 0x01, 0xcd, 0x81, 0xe7, 0xbd, 0xa7, 0x97, 0xa4, 0xc8, 0x53, 0xc7, 0x02, 0xbb, 0x05,
+	0x75, 0xfe, 0x2d, 0xb9, 0x78, 0x8e, 0x16, 0xe7, 0x3c, 0x84, 0xda, 0x0d, 0x02, 0x5e, 0x00, 0x64,
+	0xa3, 0x74, 0x6a, 0x5d, 0x7e, 0xb4, 0xb6, 0xea, 0xf8, 0x94, 0x5c, 0x09, 0xbf, 0x2b, 0x42, 0x52,
+	0xe6, 0x77, 0xce, 0x23, 0x4e, 0xdc, 0x80, 0xa6, 0x16, 0xb8, 0x70, 0xa8, 0x10, 0xb4, 0x03, 0x2d,
+	0xdd, 0xeb, 0xa3, 0xbb, 0x78, 0xb1, 0xf3, 0xb7, 0x1a, 0x58, 0x12, 0x9f, 0x42, 0x5d, 0x2e, 0x4a,
+	0xe2, 0x41, 0x10, 0xc4, 0x79, 0x8f, 0xa0, 0xc6, 0x81, 0xc5, 0x0b, 0x94, 0x7b, 0x9f, 0x41, 0x8b,
+	0x2f, 0xc4, 0x70, 0xb9, 0xe0, 0x69, 0x35, 0x75, 0xee, 0x57, 0xd0, 0xa6, 0x64, 0xfb, 0x86, 0xcc,
+	0x93, 0x45, 0xb5, 0x05, 0x76, 0x0b, 0x6a, 0x5f, 0x13, 0xe2, 0xa1, 0x2e, 0xe6, 0x9f, 0xcc, 0x70,
+	0x0e, 0x1d, 0x31, 0x32, 0xd9, 0xaf, 0x20, 0x0c, 0xed, 0x74, 0xe6, 0x46, 0x3d, 0x5c, 0x32, 0x82,
+	0xe7, 0xf9, 0x0f, 0xc4, 0xeb, 0x24, 0xa7, 0x07, 0xc9, 0x5f, 0x1c, 0x4c, 0xac, 0x3b, 0xf8, 0xdd,
+	0x15, 0x23, 0x71, 0xe0, 0xfa, 0x8a, 0xed, 0x19, 0x34, 0xe5, 0x2a, 0x97, 0x91, 0x77, 0xb0, 0x44,
+	0x9e, 0x51, 0xf2, 0x51, 0x78, 0xe5, 0x15, 0x74, 0xcd, 0xd9, 0x44, 0x3e, 0x49, 0x25, 0xd3, 0x4a,
+	0x1a, 0xe8, 0xbb, 0xd0, 0x3d, 0x3a, 0x0f, 0xc2, 0x98, 0xa8, 0x0d, 0xd7, 0xbf, 0x10, 0x9f, 0xc3,
+	0xf2, 0x77, 0x21, 0xa3, 0x63, 0x3a, 0x72, 0xc5, 0xdf, 0x06, 0xd4, 0xc3, 0x26, 0xac, 0x77, 0xac,
+	0xe5, 0xb0, 0x42, 0xa9, 0x5f, 0xc2, 0xaa, 0x43, 0x5c, 0xcf, 0xc4, 0xdf, 0x70, 0x0e, 0x06, 0x24,
+	0x13, 0xf4, 0x13, 0xf9, 0xb7, 0x45, 0x30, 0xeb, 0xa9, 0xca, 0x1c, 0x65, 0x2c, 0x13, 0xe0, 0x81,
+	0xac, 0x96, 0x05, 0x17, 0x16, 0x66, 0xa3, 0xb4, 0x90, 0xe9, 0x8d, 0xd7, 0x1f, 0xfd, 0x52, 0x64,
+	0x2a, 0x1f, 0x50, 0x64, 0x91, 0xce, 0x4f, 0x4b, 0x3c, 0xf5, 0xdd, 0x31, 0x39, 0x21, 0x49, 0xc2,
+	0x2d, 0x78, 0x09, 0x5d, 0x03, 0xcc, 0xe9, 0xb0, 0x6a, 0x32, 0x0a, 0x25, 0x5e, 0x40, 0xc7, 0xdc,
+	0xbb, 0xf8, 0xa6, 0x18, 0xc4, 0x5f, 0x01, 0x64, 0x73, 0x96, 0xcc, 0xe6, 0x85, 0xb9, 0xab, 0xb0,
+	0x65, 0x1b, 0x40, 0x15, 0x6b, 0xbe, 0xe5, 0x7a, 0x03, 0x9f, 0xc3, 0xda, 0xe1, 0x05, 0x19, 0x5d,
+	0x72, 0xb6, 0xf4, 0x15, 0x28, 0xaf, 0x08, 0x5f, 0x48, 0xf3, 0x94, 0x90, 0x44, 0x46, 0x5e, 0xc9,
+	0xec, 0xa6, 0x8c, 0x55, 0x90, 0x30, 0x76, 0x0f, 0xd6, 0x1c, 0xc2, 0xe2, 0x79, 0x6e, 0x7f, 0x99,
+	0x52, 0xf2, 0x67, 0xd2, 0x1e, 0xac, 0x1d, 0x4f, 0xe3, 0x73, 0xf2, 0x89, 0xec, 0xfb, 0xd0, 0x35,
+	0x47, 0x3c, 0xa5, 0xd8, 0xe2, 0xd0, 0x67, 0x35, 0xb1, 0xe2, 0xd8, 0x12, 0x3f, 0x9e, 0x44, 0xf6,
+	0xe7, 0x6a, 0xb4, 0x44, 0xe6, 0x0b, 0xab, 0xda, 0x72, 0xbd, 0x0b, 0xf7, 0xa1, 0x63, 0x0c, 0x79,
+	0xe8, 0x1e, 0x2e, 0x9d, 0xfa, 0xcc, 0xbe, 0x70, 0x17, 0x1a, 0x82, 0x94, 0x3b, 0xfd, 0xae, 0xf0,
+	0x9a, 0xc0, 0x7f, 0xcf, 0xaf, 0x41, 0x87, 0xea, 0x99, 0xeb, 0x53, 0x2f, 0x95, 0x7e, 0xbd, 0x1a,
+	0x3b, 0xba, 0xec, 0xdd, 0xc6, 0xf8, 0x5c, 0x46, 0xde, 0xa1, 0x4f, 0x45, 0x35, 0xcb, 0x65, 0x4a,
+	0x46, 0x10, 0xc7, 0xbf, 0x84, 0x3b, 0xef, 0x66, 0x74, 0xc4, 0x32, 0xf4, 0x0d, 0x72, 0x5f, 0xc3,
+	0xaa, 0x21, 0x57, 0xd8, 0x60, 0x0a, 0xef, 0x19, 0xc2, 0x33, 0x03, 0x0f, 0xe0, 0x4e, 0x01, 0x5d,
+	0x3c, 0x61, 0xb5, 0xb8, 0x0f, 0x7d, 0x26, 0xf7, 0x38, 0x24, 0xf2, 0xf5, 0xe3, 0x61, 0x9c, 0x73,
+	0x0f, 0x17, 0x88, 0x27, 0xcc, 0x65, 0xd3, 0x04, 0x3d, 0x86, 0x06, 0x9f, 0x9f, 0x8e, 0xae, 0x6b,
+	0xea, 0x9f, 0x43, 0x53, 0x8d, 0xbb, 0x32, 0xd5, 0xf3, 0xb3, 0x6f, 0xae, 0xa6, 0xec, 0xc3, 0x6a,
+	0x3a, 0x8a, 0x1d, 0x86, 0x41, 0x40, 0x16, 0x9b, 0xe1, 0x65, 0x9c, 0xfb, 0x81, 0xfa, 0x16, 0x56,
+	0xf2, 0xf3, 0xb0, 0x6c, 0x88, 0x4b, 0x67, 0x64, 0x6b, 0x1d, 0x97, 0x4e, 0x84, 0xdb, 0x50, 0xe3,
+	0x53, 0x9e, 0x2a, 0x9a, 0xd9, 0xbc, 0x67, 0x2d, 0xe7, 0xc6, 0x2f, 0xb4, 0x05, 0x0d, 0xd9, 0x05,
+	0x97, 0x96, 0x45, 0xf1, 0xb7, 0x75, 0x53, 0x4c, 0x15, 0x8a, 0x2b, 0x45, 0x9b, 0x97, 0x69, 0x43,
+	0xe7, 0xd8, 0x65, 0xa3, 0x8b, 0x1b, 0x78, 0xde, 0xde, 0x85, 0x65, 0x1a, 0x62, 0xfe, 0xf3, 0x93,
+	0xf2, 0xbf, 0x09, 0xc3, 0xbf, 0x56, 0xa3, 0xe1, 0xb0, 0x21, 0xfe, 0x2a, 0xbc, 0xfe, 0xef, 0x00,
+	0x51, 0x1a, 0x5c, 0x84, 0xcf, 0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ApiClient is the client API for Api service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApiClient interface {
+	Summary(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*Summary, error)
+	Ping(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiValue, error)
+	// account
+	Account(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*Contact, error)
+	AccountSeed(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiValue, error)
+	AccountAddress(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiValue, error)
+	// profile
+	Profile(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*Peer, error)
+	SetName(ctx context.Context, in *ApiSetNameRequest, opts ...grpc.CallOption) (*Peer, error)
+	SetAvatar(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*Peer, error)
+	// contacts
+	Contacts(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ContactList, error)
+	Contact(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Contact, error)
+	AddContact(ctx context.Context, in *Contact, opts ...grpc.CallOption) (*ApiEmpty, error)
+	RemoveContact(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	SearchContacts(ctx context.Context, in *ApiSearchContactsRequest, opts ...grpc.CallOption) (Api_SearchContactsClient, error)
+	// mills
+	Mill(ctx context.Context, in *ApiMillRequest, opts ...grpc.CallOption) (*FileIndex, error)
+	// threads
+	AddThread(ctx context.Context, in *AddThreadConfig, opts ...grpc.CallOption) (*Thread, error)
+	AddOrUpdateThread(ctx context.Context, in *ApiAddOrUpdateThreadRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	RenameThread(ctx context.Context, in *ApiRenameThreadRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	Threads(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ThreadList, error)
+	Thread(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Thread, error)
+	ThreadPeers(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*PeerList, error)
+	RemoveThread(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	// snapshots
+	CreateThreadSnapshots(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiEmpty, error)
+	SearchThreadSnapshots(ctx context.Context, in *ApiSearchThreadSnapshotsRequest, opts ...grpc.CallOption) (Api_SearchThreadSnapshotsClient, error)
+	// blocks
+	Blocks(ctx context.Context, in *ApiListRequest, opts ...grpc.CallOption) (*BlockList, error)
+	BlockViz(ctx context.Context, in *ApiListRequest, opts ...grpc.CallOption) (*BlockViz, error)
+	Block(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Block, error)
+	RemoveBlock(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Block, error)
+	// comments
+	AddComment(ctx context.Context, in *ApiAddCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	Comments(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*CommentList, error)
+	Comment(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Comment, error)
+	// likes
+	AddLike(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Like, error)
+	Likes(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*LikeList, error)
+	Like(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Like, error)
+	// messages
+	AddMessage(ctx context.Context, in *ApiAddMessageRequest, opts ...grpc.CallOption) (*Text, error)
+	Messages(ctx context.Context, in *ApiListRequest, opts ...grpc.CallOption) (*TextList, error)
+	Message(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Text, error)
+	// files
+	AddFiles(ctx context.Context, in *ApiAddFilesRequest, opts ...grpc.CallOption) (*Files, error)
+	Files(ctx context.Context, in *ApiListRequest, opts ...grpc.CallOption) (*FilesList, error)
+	File(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Files, error)
+	FileData(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (Api_FileDataClient, error)
+	FileKeys(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Keys, error)
+	// feed
+	Feed(ctx context.Context, in *FeedRequest, opts ...grpc.CallOption) (Api_FeedClient, error)
+	Subscribe(ctx context.Context, in *ApiSubscribeRequest, opts ...grpc.CallOption) (Api_SubscribeClient, error)
+	// invites
+	AddInvite(ctx context.Context, in *ApiAddInviteRequest, opts ...grpc.CallOption) (*ExternalInvite, error)
+	Invites(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*InviteViewList, error)
+	AcceptInvite(ctx context.Context, in *ApiAcceptInviteRequest, opts ...grpc.CallOption) (*Block, error)
+	IgnoreInvite(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	// notifications
+	Notifications(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*NotificationList, error)
+	ReadNotification(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	RemoveNotification(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	// webhooks
+	AddWebhook(ctx context.Context, in *ApiWebhook, opts ...grpc.CallOption) (*ApiWebhook, error)
+	Webhooks(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiWebhookList, error)
+	RemoveWebhook(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	// cafes
+	AddCafe(ctx context.Context, in *ApiAddCafeRequest, opts ...grpc.CallOption) (*CafeSession, error)
+	CafeSessions(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*CafeSessionList, error)
+	CafeSession(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*CafeSession, error)
+	UpdateCafe(ctx context.Context, in *ApiUpdateCafeRequest, opts ...grpc.CallOption) (*CafeSession, error)
+	RemoveCafe(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	CheckCafeMessages(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiEmpty, error)
+	// cafe requests
+	CafeRequests(ctx context.Context, in *ApiCafeRequestsRequest, opts ...grpc.CallOption) (*CafeRequestList, error)
+	RetryCafeRequests(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiCount, error)
+	PurgeCafeRequests(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiCount, error)
+	// api keys
+	CreateApiKey(ctx context.Context, in *ApiCreateApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error)
+	ApiKeys(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiKeyList, error)
+	RemoveApiKey(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	// cafe tokens
+	CreateToken(ctx context.Context, in *ApiCreateTokenRequest, opts ...grpc.CallOption) (*ApiValue, error)
+	Tokens(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*CafeTokenUsageList, error)
+	ValidateToken(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	RemoveToken(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	// cafe admin
+	CafeClients(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*CafeClientList, error)
+	EvictCafeClient(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error)
+	CafeClientsUsage(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*CafeClientUsageList, error)
+	CafeClientUsage(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*CafeClientUsage, error)
+	CafeReplication(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*CafeReplicationStatus, error)
+	// ipfs
+	IpfsId(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiValue, error)
+	IpfsCat(ctx context.Context, in *ApiIpfsCatRequest, opts ...grpc.CallOption) (Api_IpfsCatClient, error)
+	IpfsSwarmConnect(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiValueList, error)
+	IpfsSwarmPeers(ctx context.Context, in *ApiIpfsSwarmPeersRequest, opts ...grpc.CallOption) (*ApiIpfsSwarmPeerList, error)
+	// logs
+	Logs(ctx context.Context, in *ApiLogsRequest, opts ...grpc.CallOption) (*ApiLogLevels, error)
+	// config
+	Config(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiJson, error)
+	SetConfig(ctx context.Context, in *ApiJson, opts ...grpc.CallOption) (*ApiEmpty, error)
+	PatchConfig(ctx context.Context, in *ApiJson, opts ...grpc.CallOption) (*ApiEmpty, error)
+}
+
+type apiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApiClient(cc *grpc.ClientConn) ApiClient {
+	return &apiClient{cc}
+}
+
+func (c *apiClient) Summary(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*Summary, error) {
+	out := new(Summary)
+	err := c.cc.Invoke(ctx, "/Api/Summary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Ping(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiValue, error) {
+	out := new(ApiValue)
+	err := c.cc.Invoke(ctx, "/Api/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Account(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*Contact, error) {
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, "/Api/Account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AccountSeed(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiValue, error) {
+	out := new(ApiValue)
+	err := c.cc.Invoke(ctx, "/Api/AccountSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AccountAddress(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiValue, error) {
+	out := new(ApiValue)
+	err := c.cc.Invoke(ctx, "/Api/AccountAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Profile(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*Peer, error) {
+	out := new(Peer)
+	err := c.cc.Invoke(ctx, "/Api/Profile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) SetName(ctx context.Context, in *ApiSetNameRequest, opts ...grpc.CallOption) (*Peer, error) {
+	out := new(Peer)
+	err := c.cc.Invoke(ctx, "/Api/SetName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) SetAvatar(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*Peer, error) {
+	out := new(Peer)
+	err := c.cc.Invoke(ctx, "/Api/SetAvatar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Contacts(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ContactList, error) {
+	out := new(ContactList)
+	err := c.cc.Invoke(ctx, "/Api/Contacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Contact(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Contact, error) {
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, "/Api/Contact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddContact(ctx context.Context, in *Contact, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/AddContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveContact(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/RemoveContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) SearchContacts(ctx context.Context, in *ApiSearchContactsRequest, opts ...grpc.CallOption) (Api_SearchContactsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[0], "/Api/SearchContacts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiSearchContactsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Api_SearchContactsClient interface {
+	Recv() (*QueryResult, error)
+	grpc.ClientStream
+}
+
+type apiSearchContactsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiSearchContactsClient) Recv() (*QueryResult, error) {
+	m := new(QueryResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiClient) Mill(ctx context.Context, in *ApiMillRequest, opts ...grpc.CallOption) (*FileIndex, error) {
+	out := new(FileIndex)
+	err := c.cc.Invoke(ctx, "/Api/Mill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddThread(ctx context.Context, in *AddThreadConfig, opts ...grpc.CallOption) (*Thread, error) {
+	out := new(Thread)
+	err := c.cc.Invoke(ctx, "/Api/AddThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddOrUpdateThread(ctx context.Context, in *ApiAddOrUpdateThreadRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/AddOrUpdateThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RenameThread(ctx context.Context, in *ApiRenameThreadRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/RenameThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Threads(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ThreadList, error) {
+	out := new(ThreadList)
+	err := c.cc.Invoke(ctx, "/Api/Threads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Thread(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Thread, error) {
+	out := new(Thread)
+	err := c.cc.Invoke(ctx, "/Api/Thread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ThreadPeers(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*PeerList, error) {
+	out := new(PeerList)
+	err := c.cc.Invoke(ctx, "/Api/ThreadPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveThread(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/RemoveThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CreateThreadSnapshots(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/CreateThreadSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) SearchThreadSnapshots(ctx context.Context, in *ApiSearchThreadSnapshotsRequest, opts ...grpc.CallOption) (Api_SearchThreadSnapshotsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[1], "/Api/SearchThreadSnapshots", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiSearchThreadSnapshotsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Api_SearchThreadSnapshotsClient interface {
+	Recv() (*QueryResult, error)
+	grpc.ClientStream
+}
+
+type apiSearchThreadSnapshotsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiSearchThreadSnapshotsClient) Recv() (*QueryResult, error) {
+	m := new(QueryResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiClient) Blocks(ctx context.Context, in *ApiListRequest, opts ...grpc.CallOption) (*BlockList, error) {
+	out := new(BlockList)
+	err := c.cc.Invoke(ctx, "/Api/Blocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) BlockViz(ctx context.Context, in *ApiListRequest, opts ...grpc.CallOption) (*BlockViz, error) {
+	out := new(BlockViz)
+	err := c.cc.Invoke(ctx, "/Api/BlockViz", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Block(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/Api/Block", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveBlock(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/Api/RemoveBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddComment(ctx context.Context, in *ApiAddCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, "/Api/AddComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Comments(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*CommentList, error) {
+	out := new(CommentList)
+	err := c.cc.Invoke(ctx, "/Api/Comments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Comment(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, "/Api/Comment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddLike(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Like, error) {
+	out := new(Like)
+	err := c.cc.Invoke(ctx, "/Api/AddLike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Likes(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*LikeList, error) {
+	out := new(LikeList)
+	err := c.cc.Invoke(ctx, "/Api/Likes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Like(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Like, error) {
+	out := new(Like)
+	err := c.cc.Invoke(ctx, "/Api/Like", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddMessage(ctx context.Context, in *ApiAddMessageRequest, opts ...grpc.CallOption) (*Text, error) {
+	out := new(Text)
+	err := c.cc.Invoke(ctx, "/Api/AddMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Messages(ctx context.Context, in *ApiListRequest, opts ...grpc.CallOption) (*TextList, error) {
+	out := new(TextList)
+	err := c.cc.Invoke(ctx, "/Api/Messages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Message(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Text, error) {
+	out := new(Text)
+	err := c.cc.Invoke(ctx, "/Api/Message", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddFiles(ctx context.Context, in *ApiAddFilesRequest, opts ...grpc.CallOption) (*Files, error) {
+	out := new(Files)
+	err := c.cc.Invoke(ctx, "/Api/AddFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Files(ctx context.Context, in *ApiListRequest, opts ...grpc.CallOption) (*FilesList, error) {
+	out := new(FilesList)
+	err := c.cc.Invoke(ctx, "/Api/Files", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) File(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Files, error) {
+	out := new(Files)
+	err := c.cc.Invoke(ctx, "/Api/File", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) FileData(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (Api_FileDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[2], "/Api/FileData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiFileDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Api_FileDataClient interface {
+	Recv() (*ApiData, error)
+	grpc.ClientStream
+}
+
+type apiFileDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiFileDataClient) Recv() (*ApiData, error) {
+	m := new(ApiData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiClient) FileKeys(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*Keys, error) {
+	out := new(Keys)
+	err := c.cc.Invoke(ctx, "/Api/FileKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Feed(ctx context.Context, in *FeedRequest, opts ...grpc.CallOption) (Api_FeedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[3], "/Api/Feed", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiFeedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Api_FeedClient interface {
+	Recv() (*FeedItem, error)
+	grpc.ClientStream
+}
+
+type apiFeedClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiFeedClient) Recv() (*FeedItem, error) {
+	m := new(FeedItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiClient) Subscribe(ctx context.Context, in *ApiSubscribeRequest, opts ...grpc.CallOption) (Api_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[4], "/Api/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Api_SubscribeClient interface {
+	Recv() (*FeedItem, error)
+	grpc.ClientStream
+}
+
+type apiSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiSubscribeClient) Recv() (*FeedItem, error) {
+	m := new(FeedItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiClient) AddInvite(ctx context.Context, in *ApiAddInviteRequest, opts ...grpc.CallOption) (*ExternalInvite, error) {
+	out := new(ExternalInvite)
+	err := c.cc.Invoke(ctx, "/Api/AddInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Invites(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*InviteViewList, error) {
+	out := new(InviteViewList)
+	err := c.cc.Invoke(ctx, "/Api/Invites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AcceptInvite(ctx context.Context, in *ApiAcceptInviteRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/Api/AcceptInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) IgnoreInvite(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/IgnoreInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Notifications(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*NotificationList, error) {
+	out := new(NotificationList)
+	err := c.cc.Invoke(ctx, "/Api/Notifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ReadNotification(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/ReadNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveNotification(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/RemoveNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddWebhook(ctx context.Context, in *ApiWebhook, opts ...grpc.CallOption) (*ApiWebhook, error) {
+	out := new(ApiWebhook)
+	err := c.cc.Invoke(ctx, "/Api/AddWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Webhooks(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiWebhookList, error) {
+	out := new(ApiWebhookList)
+	err := c.cc.Invoke(ctx, "/Api/Webhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveWebhook(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/RemoveWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddCafe(ctx context.Context, in *ApiAddCafeRequest, opts ...grpc.CallOption) (*CafeSession, error) {
+	out := new(CafeSession)
+	err := c.cc.Invoke(ctx, "/Api/AddCafe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CafeSessions(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*CafeSessionList, error) {
+	out := new(CafeSessionList)
+	err := c.cc.Invoke(ctx, "/Api/CafeSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CafeSession(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*CafeSession, error) {
+	out := new(CafeSession)
+	err := c.cc.Invoke(ctx, "/Api/CafeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) UpdateCafe(ctx context.Context, in *ApiUpdateCafeRequest, opts ...grpc.CallOption) (*CafeSession, error) {
+	out := new(CafeSession)
+	err := c.cc.Invoke(ctx, "/Api/UpdateCafe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveCafe(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/RemoveCafe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CheckCafeMessages(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/CheckCafeMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CafeRequests(ctx context.Context, in *ApiCafeRequestsRequest, opts ...grpc.CallOption) (*CafeRequestList, error) {
+	out := new(CafeRequestList)
+	err := c.cc.Invoke(ctx, "/Api/CafeRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RetryCafeRequests(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiCount, error) {
+	out := new(ApiCount)
+	err := c.cc.Invoke(ctx, "/Api/RetryCafeRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) PurgeCafeRequests(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiCount, error) {
+	out := new(ApiCount)
+	err := c.cc.Invoke(ctx, "/Api/PurgeCafeRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CreateApiKey(ctx context.Context, in *ApiCreateApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error) {
+	out := new(ApiKey)
+	err := c.cc.Invoke(ctx, "/Api/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ApiKeys(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiKeyList, error) {
+	out := new(ApiKeyList)
+	err := c.cc.Invoke(ctx, "/Api/ApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveApiKey(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/RemoveApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CreateToken(ctx context.Context, in *ApiCreateTokenRequest, opts ...grpc.CallOption) (*ApiValue, error) {
+	out := new(ApiValue)
+	err := c.cc.Invoke(ctx, "/Api/CreateToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Tokens(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*CafeTokenUsageList, error) {
+	out := new(CafeTokenUsageList)
+	err := c.cc.Invoke(ctx, "/Api/Tokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ValidateToken(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/ValidateToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveToken(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/RemoveToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CafeClients(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*CafeClientList, error) {
+	out := new(CafeClientList)
+	err := c.cc.Invoke(ctx, "/Api/CafeClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) EvictCafeClient(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/EvictCafeClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CafeClientsUsage(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*CafeClientUsageList, error) {
+	out := new(CafeClientUsageList)
+	err := c.cc.Invoke(ctx, "/Api/CafeClientsUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CafeClientUsage(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*CafeClientUsage, error) {
+	out := new(CafeClientUsage)
+	err := c.cc.Invoke(ctx, "/Api/CafeClientUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CafeReplication(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*CafeReplicationStatus, error) {
+	out := new(CafeReplicationStatus)
+	err := c.cc.Invoke(ctx, "/Api/CafeReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) IpfsId(ctx context.Context, in *ApiEmpty, opts ...grpc.CallOption) (*ApiValue, error) {
+	out := new(ApiValue)
+	err := c.cc.Invoke(ctx, "/Api/IpfsId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) IpfsCat(ctx context.Context, in *ApiIpfsCatRequest, opts ...grpc.CallOption) (Api_IpfsCatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[5], "/Api/IpfsCat", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiIpfsCatClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Api_IpfsCatClient interface {
+	Recv() (*ApiData, error)
+	grpc.ClientStream
+}
+
+type apiIpfsCatClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiIpfsCatClient) Recv() (*ApiData, error) {
+	m := new(ApiData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiClient) IpfsSwarmConnect(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiValueList, error) {
+	out := new(ApiValueList)
+	err := c.cc.Invoke(ctx, "/Api/IpfsSwarmConnect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) IpfsSwarmPeers(ctx context.Context, in *ApiIpfsSwarmPeersRequest, opts ...grpc.CallOption) (*ApiIpfsSwarmPeerList, error) {
+	out := new(ApiIpfsSwarmPeerList)
+	err := c.cc.Invoke(ctx, "/Api/IpfsSwarmPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Logs(ctx context.Context, in *ApiLogsRequest, opts ...grpc.CallOption) (*ApiLogLevels, error) {
+	out := new(ApiLogLevels)
+	err := c.cc.Invoke(ctx, "/Api/Logs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Config(ctx context.Context, in *ApiIdRequest, opts ...grpc.CallOption) (*ApiJson, error) {
+	out := new(ApiJson)
+	err := c.cc.Invoke(ctx, "/Api/Config", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) SetConfig(ctx context.Context, in *ApiJson, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/SetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) PatchConfig(ctx context.Context, in *ApiJson, opts ...grpc.CallOption) (*ApiEmpty, error) {
+	out := new(ApiEmpty)
+	err := c.cc.Invoke(ctx, "/Api/PatchConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApiServer is the server API for Api service.
+type ApiServer interface {
+	Summary(context.Context, *ApiEmpty) (*Summary, error)
+	Ping(context.Context, *ApiIdRequest) (*ApiValue, error)
+	// account
+	Account(context.Context, *ApiEmpty) (*Contact, error)
+	AccountSeed(context.Context, *ApiEmpty) (*ApiValue, error)
+	AccountAddress(context.Context, *ApiEmpty) (*ApiValue, error)
+	// profile
+	Profile(context.Context, *ApiEmpty) (*Peer, error)
+	SetName(context.Context, *ApiSetNameRequest) (*Peer, error)
+	SetAvatar(context.Context, *ApiEmpty) (*Peer, error)
+	// contacts
+	Contacts(context.Context, *ApiEmpty) (*ContactList, error)
+	Contact(context.Context, *ApiIdRequest) (*Contact, error)
+	AddContact(context.Context, *Contact) (*ApiEmpty, error)
+	RemoveContact(context.Context, *ApiIdRequest) (*ApiEmpty, error)
+	SearchContacts(*ApiSearchContactsRequest, Api_SearchContactsServer) error
+	// mills
+	Mill(context.Context, *ApiMillRequest) (*FileIndex, error)
+	// threads
+	AddThread(context.Context, *AddThreadConfig) (*Thread, error)
+	AddOrUpdateThread(context.Context, *ApiAddOrUpdateThreadRequest) (*ApiEmpty, error)
+	RenameThread(context.Context, *ApiRenameThreadRequest) (*ApiEmpty, error)
+	Threads(context.Context, *ApiEmpty) (*ThreadList, error)
+	Thread(context.Context, *ApiIdRequest) (*Thread, error)
+	ThreadPeers(context.Context, *ApiIdRequest) (*PeerList, error)
+	RemoveThread(context.Context, *ApiIdRequest) (*ApiEmpty, error)
+	// snapshots
+	CreateThreadSnapshots(context.Context, *ApiEmpty) (*ApiEmpty, error)
+	SearchThreadSnapshots(*ApiSearchThreadSnapshotsRequest, Api_SearchThreadSnapshotsServer) error
+	// blocks
+	Blocks(context.Context, *ApiListRequest) (*BlockList, error)
+	BlockViz(context.Context, *ApiListRequest) (*BlockViz, error)
+	Block(context.Context, *ApiIdRequest) (*Block, error)
+	RemoveBlock(context.Context, *ApiIdRequest) (*Block, error)
+	// comments
+	AddComment(context.Context, *ApiAddCommentRequest) (*Comment, error)
+	Comments(context.Context, *ApiIdRequest) (*CommentList, error)
+	Comment(context.Context, *ApiIdRequest) (*Comment, error)
+	// likes
+	AddLike(context.Context, *ApiIdRequest) (*Like, error)
+	Likes(context.Context, *ApiIdRequest) (*LikeList, error)
+	Like(context.Context, *ApiIdRequest) (*Like, error)
+	// messages
+	AddMessage(context.Context, *ApiAddMessageRequest) (*Text, error)
+	Messages(context.Context, *ApiListRequest) (*TextList, error)
+	Message(context.Context, *ApiIdRequest) (*Text, error)
+	// files
+	AddFiles(context.Context, *ApiAddFilesRequest) (*Files, error)
+	Files(context.Context, *ApiListRequest) (*FilesList, error)
+	File(context.Context, *ApiIdRequest) (*Files, error)
+	FileData(*ApiIdRequest, Api_FileDataServer) error
+	FileKeys(context.Context, *ApiIdRequest) (*Keys, error)
+	// feed
+	Feed(*FeedRequest, Api_FeedServer) error
+	Subscribe(*ApiSubscribeRequest, Api_SubscribeServer) error
+	// invites
+	AddInvite(context.Context, *ApiAddInviteRequest) (*ExternalInvite, error)
+	Invites(context.Context, *ApiEmpty) (*InviteViewList, error)
+	AcceptInvite(context.Context, *ApiAcceptInviteRequest) (*Block, error)
+	IgnoreInvite(context.Context, *ApiIdRequest) (*ApiEmpty, error)
+	// notifications
+	Notifications(context.Context, *NotificationRequest) (*NotificationList, error)
+	ReadNotification(context.Context, *ApiIdRequest) (*ApiEmpty, error)
+	RemoveNotification(context.Context, *ApiIdRequest) (*ApiEmpty, error)
+	// webhooks
+	AddWebhook(context.Context, *ApiWebhook) (*ApiWebhook, error)
+	Webhooks(context.Context, *ApiEmpty) (*ApiWebhookList, error)
+	RemoveWebhook(context.Context, *ApiIdRequest) (*ApiEmpty, error)
+	// cafes
+	AddCafe(context.Context, *ApiAddCafeRequest) (*CafeSession, error)
+	CafeSessions(context.Context, *ApiEmpty) (*CafeSessionList, error)
+	CafeSession(context.Context, *ApiIdRequest) (*CafeSession, error)
+	UpdateCafe(context.Context, *ApiUpdateCafeRequest) (*CafeSession, error)
+	RemoveCafe(context.Context, *ApiIdRequest) (*ApiEmpty, error)
+	CheckCafeMessages(context.Context, *ApiEmpty) (*ApiEmpty, error)
+	// cafe requests
+	CafeRequests(context.Context, *ApiCafeRequestsRequest) (*CafeRequestList, error)
+	RetryCafeRequests(context.Context, *ApiIdRequest) (*ApiCount, error)
+	PurgeCafeRequests(context.Context, *ApiIdRequest) (*ApiCount, error)
+	// api keys
+	CreateApiKey(context.Context, *ApiCreateApiKeyRequest) (*ApiKey, error)
+	ApiKeys(context.Context, *ApiEmpty) (*ApiKeyList, error)
+	RemoveApiKey(context.Context, *ApiIdRequest) (*ApiEmpty, error)
+	// cafe tokens
+	CreateToken(context.Context, *ApiCreateTokenRequest) (*ApiValue, error)
+	Tokens(context.Context, *ApiEmpty) (*CafeTokenUsageList, error)
+	ValidateToken(context.Context, *ApiIdRequest) (*ApiEmpty, error)
+	RemoveToken(context.Context, *ApiIdRequest) (*ApiEmpty, error)
+	// cafe admin
+	CafeClients(context.Context, *ApiEmpty) (*CafeClientList, error)
+	EvictCafeClient(context.Context, *ApiIdRequest) (*ApiEmpty, error)
+	CafeClientsUsage(context.Context, *ApiEmpty) (*CafeClientUsageList, error)
+	CafeClientUsage(context.Context, *ApiIdRequest) (*CafeClientUsage, error)
+	CafeReplication(context.Context, *ApiEmpty) (*CafeReplicationStatus, error)
+	// ipfs
+	IpfsId(context.Context, *ApiEmpty) (*ApiValue, error)
+	IpfsCat(*ApiIpfsCatRequest, Api_IpfsCatServer) error
+	IpfsSwarmConnect(context.Context, *ApiIdRequest) (*ApiValueList, error)
+	IpfsSwarmPeers(context.Context, *ApiIpfsSwarmPeersRequest) (*ApiIpfsSwarmPeerList, error)
+	// logs
+	Logs(context.Context, *ApiLogsRequest) (*ApiLogLevels, error)
+	// config
+	Config(context.Context, *ApiIdRequest) (*ApiJson, error)
+	SetConfig(context.Context, *ApiJson) (*ApiEmpty, error)
+	PatchConfig(context.Context, *ApiJson) (*ApiEmpty, error)
+}
+
+func RegisterApiServer(s *grpc.Server, srv ApiServer) {
+	s.RegisterService(&_Api_serviceDesc, srv)
+}
+
+func _Api_Summary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Summary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Summary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Summary(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Ping(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Account(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AccountSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AccountSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AccountSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AccountSeed(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AccountAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AccountAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AccountAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AccountAddress(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Profile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Profile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Profile(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_SetName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiSetNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).SetName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/SetName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).SetName(ctx, req.(*ApiSetNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_SetAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).SetAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/SetAvatar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).SetAvatar(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Contacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Contacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Contacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Contacts(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Contact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Contact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Contact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Contact(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Contact)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddContact(ctx, req.(*Contact))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RemoveContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RemoveContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RemoveContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RemoveContact(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_SearchContacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApiSearchContactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServer).SearchContacts(m, &apiSearchContactsServer{stream})
+}
+
+type Api_SearchContactsServer interface {
+	Send(*QueryResult) error
+	grpc.ServerStream
+}
+
+type apiSearchContactsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiSearchContactsServer) Send(m *QueryResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Api_Mill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiMillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Mill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Mill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Mill(ctx, req.(*ApiMillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddThreadConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddThread(ctx, req.(*AddThreadConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddOrUpdateThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiAddOrUpdateThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddOrUpdateThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddOrUpdateThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddOrUpdateThread(ctx, req.(*ApiAddOrUpdateThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RenameThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiRenameThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RenameThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RenameThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RenameThread(ctx, req.(*ApiRenameThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Threads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Threads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Threads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Threads(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Thread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Thread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Thread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Thread(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ThreadPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ThreadPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ThreadPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ThreadPeers(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RemoveThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RemoveThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RemoveThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RemoveThread(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CreateThreadSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CreateThreadSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CreateThreadSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CreateThreadSnapshots(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_SearchThreadSnapshots_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApiSearchThreadSnapshotsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServer).SearchThreadSnapshots(m, &apiSearchThreadSnapshotsServer{stream})
+}
+
+type Api_SearchThreadSnapshotsServer interface {
+	Send(*QueryResult) error
+	grpc.ServerStream
+}
+
+type apiSearchThreadSnapshotsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiSearchThreadSnapshotsServer) Send(m *QueryResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Api_Blocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Blocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Blocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Blocks(ctx, req.(*ApiListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_BlockViz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).BlockViz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/BlockViz",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).BlockViz(ctx, req.(*ApiListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Block",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Block(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RemoveBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RemoveBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RemoveBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RemoveBlock(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiAddCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddComment(ctx, req.(*ApiAddCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Comments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Comments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Comments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Comments(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Comment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Comment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Comment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Comment(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddLike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddLike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddLike(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Likes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Likes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Likes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Likes(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Like_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Like(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Like",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Like(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiAddMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddMessage(ctx, req.(*ApiAddMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Messages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Messages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Messages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Messages(ctx, req.(*ApiListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Message_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Message(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Message",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Message(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiAddFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddFiles(ctx, req.(*ApiAddFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Files_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Files(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Files",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Files(ctx, req.(*ApiListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_File_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).File(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/File",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).File(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_FileData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApiIdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServer).FileData(m, &apiFileDataServer{stream})
+}
+
+type Api_FileDataServer interface {
+	Send(*ApiData) error
+	grpc.ServerStream
+}
+
+type apiFileDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiFileDataServer) Send(m *ApiData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Api_FileKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).FileKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/FileKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).FileKeys(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Feed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FeedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServer).Feed(m, &apiFeedServer{stream})
+}
+
+type Api_FeedServer interface {
+	Send(*FeedItem) error
+	grpc.ServerStream
+}
+
+type apiFeedServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiFeedServer) Send(m *FeedItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Api_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApiSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServer).Subscribe(m, &apiSubscribeServer{stream})
+}
+
+type Api_SubscribeServer interface {
+	Send(*FeedItem) error
+	grpc.ServerStream
+}
+
+type apiSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiSubscribeServer) Send(m *FeedItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Api_AddInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiAddInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddInvite(ctx, req.(*ApiAddInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Invites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Invites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Invites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Invites(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiAcceptInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AcceptInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AcceptInvite(ctx, req.(*ApiAcceptInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_IgnoreInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).IgnoreInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/IgnoreInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).IgnoreInvite(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Notifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Notifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Notifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Notifications(ctx, req.(*NotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ReadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ReadNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ReadNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ReadNotification(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RemoveNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RemoveNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RemoveNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RemoveNotification(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiWebhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddWebhook(ctx, req.(*ApiWebhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Webhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Webhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Webhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Webhooks(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RemoveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RemoveWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RemoveWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RemoveWebhook(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddCafe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiAddCafeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddCafe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddCafe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddCafe(ctx, req.(*ApiAddCafeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CafeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CafeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CafeSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CafeSessions(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CafeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CafeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CafeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CafeSession(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_UpdateCafe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiUpdateCafeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).UpdateCafe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/UpdateCafe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).UpdateCafe(ctx, req.(*ApiUpdateCafeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RemoveCafe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RemoveCafe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RemoveCafe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RemoveCafe(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CheckCafeMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CheckCafeMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CheckCafeMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CheckCafeMessages(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CafeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiCafeRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CafeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CafeRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CafeRequests(ctx, req.(*ApiCafeRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RetryCafeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RetryCafeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RetryCafeRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RetryCafeRequests(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_PurgeCafeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).PurgeCafeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/PurgeCafeRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).PurgeCafeRequests(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiCreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CreateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CreateApiKey(ctx, req.(*ApiCreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ApiKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ApiKeys(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RemoveApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RemoveApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RemoveApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RemoveApiKey(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiCreateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CreateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CreateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CreateToken(ctx, req.(*ApiCreateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Tokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Tokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Tokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Tokens(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ValidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ValidateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ValidateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ValidateToken(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RemoveToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RemoveToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RemoveToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RemoveToken(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CafeClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CafeClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CafeClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CafeClients(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_EvictCafeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).EvictCafeClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/EvictCafeClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).EvictCafeClient(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CafeClientsUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CafeClientsUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CafeClientsUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CafeClientsUsage(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CafeClientUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CafeClientUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CafeClientUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CafeClientUsage(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CafeReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CafeReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CafeReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CafeReplication(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_IpfsId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).IpfsId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/IpfsId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).IpfsId(ctx, req.(*ApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_IpfsCat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApiIpfsCatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServer).IpfsCat(m, &apiIpfsCatServer{stream})
+}
+
+type Api_IpfsCatServer interface {
+	Send(*ApiData) error
+	grpc.ServerStream
+}
+
+type apiIpfsCatServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiIpfsCatServer) Send(m *ApiData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Api_IpfsSwarmConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).IpfsSwarmConnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/IpfsSwarmConnect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).IpfsSwarmConnect(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_IpfsSwarmPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIpfsSwarmPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).IpfsSwarmPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/IpfsSwarmPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).IpfsSwarmPeers(ctx, req.(*ApiIpfsSwarmPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Logs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Logs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Logs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Logs(ctx, req.(*ApiLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Config(ctx, req.(*ApiIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_SetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiJson)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).SetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/SetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).SetConfig(ctx, req.(*ApiJson))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_PatchConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiJson)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).PatchConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/PatchConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).PatchConfig(ctx, req.(*ApiJson))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Api_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Api",
+	HandlerType: (*ApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Summary",
+			Handler:    _Api_Summary_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _Api_Ping_Handler,
+		},
+		{
+			MethodName: "Account",
+			Handler:    _Api_Account_Handler,
+		},
+		{
+			MethodName: "AccountSeed",
+			Handler:    _Api_AccountSeed_Handler,
+		},
+		{
+			MethodName: "AccountAddress",
+			Handler:    _Api_AccountAddress_Handler,
+		},
+		{
+			MethodName: "Profile",
+			Handler:    _Api_Profile_Handler,
+		},
+		{
+			MethodName: "SetName",
+			Handler:    _Api_SetName_Handler,
+		},
+		{
+			MethodName: "SetAvatar",
+			Handler:    _Api_SetAvatar_Handler,
+		},
+		{
+			MethodName: "Contacts",
+			Handler:    _Api_Contacts_Handler,
+		},
+		{
+			MethodName: "Contact",
+			Handler:    _Api_Contact_Handler,
+		},
+		{
+			MethodName: "AddContact",
+			Handler:    _Api_AddContact_Handler,
+		},
+		{
+			MethodName: "RemoveContact",
+			Handler:    _Api_RemoveContact_Handler,
+		},
+		{
+			MethodName: "Mill",
+			Handler:    _Api_Mill_Handler,
+		},
+		{
+			MethodName: "AddThread",
+			Handler:    _Api_AddThread_Handler,
+		},
+		{
+			MethodName: "AddOrUpdateThread",
+			Handler:    _Api_AddOrUpdateThread_Handler,
+		},
+		{
+			MethodName: "RenameThread",
+			Handler:    _Api_RenameThread_Handler,
+		},
+		{
+			MethodName: "Threads",
+			Handler:    _Api_Threads_Handler,
+		},
+		{
+			MethodName: "Thread",
+			Handler:    _Api_Thread_Handler,
+		},
+		{
+			MethodName: "ThreadPeers",
+			Handler:    _Api_ThreadPeers_Handler,
+		},
+		{
+			MethodName: "RemoveThread",
+			Handler:    _Api_RemoveThread_Handler,
+		},
+		{
+			MethodName: "CreateThreadSnapshots",
+			Handler:    _Api_CreateThreadSnapshots_Handler,
+		},
+		{
+			MethodName: "Blocks",
+			Handler:    _Api_Blocks_Handler,
+		},
+		{
+			MethodName: "BlockViz",
+			Handler:    _Api_BlockViz_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _Api_Block_Handler,
+		},
+		{
+			MethodName: "RemoveBlock",
+			Handler:    _Api_RemoveBlock_Handler,
+		},
+		{
+			MethodName: "AddComment",
+			Handler:    _Api_AddComment_Handler,
+		},
+		{
+			MethodName: "Comments",
+			Handler:    _Api_Comments_Handler,
+		},
+		{
+			MethodName: "Comment",
+			Handler:    _Api_Comment_Handler,
+		},
+		{
+			MethodName: "AddLike",
+			Handler:    _Api_AddLike_Handler,
+		},
+		{
+			MethodName: "Likes",
+			Handler:    _Api_Likes_Handler,
+		},
+		{
+			MethodName: "Like",
+			Handler:    _Api_Like_Handler,
+		},
+		{
+			MethodName: "AddMessage",
+			Handler:    _Api_AddMessage_Handler,
+		},
+		{
+			MethodName: "Messages",
+			Handler:    _Api_Messages_Handler,
+		},
+		{
+			MethodName: "Message",
+			Handler:    _Api_Message_Handler,
+		},
+		{
+			MethodName: "AddFiles",
+			Handler:    _Api_AddFiles_Handler,
+		},
+		{
+			MethodName: "Files",
+			Handler:    _Api_Files_Handler,
+		},
+		{
+			MethodName: "File",
+			Handler:    _Api_File_Handler,
+		},
+		{
+			MethodName: "FileKeys",
+			Handler:    _Api_FileKeys_Handler,
+		},
+		{
+			MethodName: "AddInvite",
+			Handler:    _Api_AddInvite_Handler,
+		},
+		{
+			MethodName: "Invites",
+			Handler:    _Api_Invites_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _Api_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "IgnoreInvite",
+			Handler:    _Api_IgnoreInvite_Handler,
+		},
+		{
+			MethodName: "Notifications",
+			Handler:    _Api_Notifications_Handler,
+		},
+		{
+			MethodName: "ReadNotification",
+			Handler:    _Api_ReadNotification_Handler,
+		},
+		{
+			MethodName: "RemoveNotification",
+			Handler:    _Api_RemoveNotification_Handler,
+		},
+		{
+			MethodName: "AddWebhook",
+			Handler:    _Api_AddWebhook_Handler,
+		},
+		{
+			MethodName: "Webhooks",
+			Handler:    _Api_Webhooks_Handler,
+		},
+		{
+			MethodName: "RemoveWebhook",
+			Handler:    _Api_RemoveWebhook_Handler,
+		},
+		{
+			MethodName: "AddCafe",
+			Handler:    _Api_AddCafe_Handler,
+		},
+		{
+			MethodName: "CafeSessions",
+			Handler:    _Api_CafeSessions_Handler,
+		},
+		{
+			MethodName: "CafeSession",
+			Handler:    _Api_CafeSession_Handler,
+		},
+		{
+			MethodName: "UpdateCafe",
+			Handler:    _Api_UpdateCafe_Handler,
+		},
+		{
+			MethodName: "RemoveCafe",
+			Handler:    _Api_RemoveCafe_Handler,
+		},
+		{
+			MethodName: "CheckCafeMessages",
+			Handler:    _Api_CheckCafeMessages_Handler,
+		},
+		{
+			MethodName: "CafeRequests",
+			Handler:    _Api_CafeRequests_Handler,
+		},
+		{
+			MethodName: "RetryCafeRequests",
+			Handler:    _Api_RetryCafeRequests_Handler,
+		},
+		{
+			MethodName: "PurgeCafeRequests",
+			Handler:    _Api_PurgeCafeRequests_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _Api_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ApiKeys",
+			Handler:    _Api_ApiKeys_Handler,
+		},
+		{
+			MethodName: "RemoveApiKey",
+			Handler:    _Api_RemoveApiKey_Handler,
+		},
+		{
+			MethodName: "CreateToken",
+			Handler:    _Api_CreateToken_Handler,
+		},
+		{
+			MethodName: "Tokens",
+			Handler:    _Api_Tokens_Handler,
+		},
+		{
+			MethodName: "ValidateToken",
+			Handler:    _Api_ValidateToken_Handler,
+		},
+		{
+			MethodName: "RemoveToken",
+			Handler:    _Api_RemoveToken_Handler,
+		},
+		{
+			MethodName: "CafeClients",
+			Handler:    _Api_CafeClients_Handler,
+		},
+		{
+			MethodName: "EvictCafeClient",
+			Handler:    _Api_EvictCafeClient_Handler,
+		},
+		{
+			MethodName: "CafeClientsUsage",
+			Handler:    _Api_CafeClientsUsage_Handler,
+		},
+		{
+			MethodName: "CafeClientUsage",
+			Handler:    _Api_CafeClientUsage_Handler,
+		},
+		{
+			MethodName: "CafeReplication",
+			Handler:    _Api_CafeReplication_Handler,
+		},
+		{
+			MethodName: "IpfsId",
+			Handler:    _Api_IpfsId_Handler,
+		},
+		{
+			MethodName: "IpfsSwarmConnect",
+			Handler:    _Api_IpfsSwarmConnect_Handler,
+		},
+		{
+			MethodName: "IpfsSwarmPeers",
+			Handler:    _Api_IpfsSwarmPeers_Handler,
+		},
+		{
+			MethodName: "Logs",
+			Handler:    _Api_Logs_Handler,
+		},
+		{
+			MethodName: "Config",
+			Handler:    _Api_Config_Handler,
+		},
+		{
+			MethodName: "SetConfig",
+			Handler:    _Api_SetConfig_Handler,
+		},
+		{
+			MethodName: "PatchConfig",
+			Handler:    _Api_PatchConfig_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchContacts",
+			Handler:       _Api_SearchContacts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchThreadSnapshots",
+			Handler:       _Api_SearchThreadSnapshots_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FileData",
+			Handler:       _Api_FileData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Feed",
+			Handler:       _Api_Feed_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Api_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "IpfsCat",
+			Handler:       _Api_IpfsCat_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api.proto",
+}
@@ -29,7 +29,7 @@ func (m *CafeChallenge) Reset()         { *m = CafeChallenge{} }
 func (m *CafeChallenge) String() string { return proto.CompactTextString(m) }
 func (*CafeChallenge) ProtoMessage()    {}
 func (*CafeChallenge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{0}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{0}
 }
 func (m *CafeChallenge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeChallenge.Unmarshal(m, b)
@@ -67,7 +67,7 @@ func (m *CafeNonce) Reset()         { *m = CafeNonce{} }
 func (m *CafeNonce) String() string { return proto.CompactTextString(m) }
 func (*CafeNonce) ProtoMessage()    {}
 func (*CafeNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{1}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{1}
 }
 func (m *CafeNonce) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeNonce.Unmarshal(m, b)
@@ -109,7 +109,7 @@ func (m *CafeRegistration) Reset()         { *m = CafeRegistration{} }
 func (m *CafeRegistration) String() string { return proto.CompactTextString(m) }
 func (*CafeRegistration) ProtoMessage()    {}
 func (*CafeRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{2}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{2}
 }
 func (m *CafeRegistration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeRegistration.Unmarshal(m, b)
@@ -175,7 +175,7 @@ func (m *CafeDeregistration) Reset()         { *m = CafeDeregistration{} }
 func (m *CafeDeregistration) String() string { return proto.CompactTextString(m) }
 func (*CafeDeregistration) ProtoMessage()    {}
 func (*CafeDeregistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{3}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{3}
 }
 func (m *CafeDeregistration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeregistration.Unmarshal(m, b)
@@ -213,7 +213,7 @@ func (m *CafeDeregistrationAck) Reset()         { *m = CafeDeregistrationAck{} }
 func (m *CafeDeregistrationAck) String() string { return proto.CompactTextString(m) }
 func (*CafeDeregistrationAck) ProtoMessage()    {}
 func (*CafeDeregistrationAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{4}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{4}
 }
 func (m *CafeDeregistrationAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeregistrationAck.Unmarshal(m, b)
@@ -252,7 +252,7 @@ func (m *CafeRefreshSession) Reset()         { *m = CafeRefreshSession{} }
 func (m *CafeRefreshSession) String() string { return proto.CompactTextString(m) }
 func (*CafeRefreshSession) ProtoMessage()    {}
 func (*CafeRefreshSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{5}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{5}
 }
 func (m *CafeRefreshSession) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeRefreshSession.Unmarshal(m, b)
@@ -298,7 +298,7 @@ func (m *CafePublishPeer) Reset()         { *m = CafePublishPeer{} }
 func (m *CafePublishPeer) String() string { return proto.CompactTextString(m) }
 func (*CafePublishPeer) ProtoMessage()    {}
 func (*CafePublishPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{6}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{6}
 }
 func (m *CafePublishPeer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafePublishPeer.Unmarshal(m, b)
@@ -343,7 +343,7 @@ func (m *CafePublishPeerAck) Reset()         { *m = CafePublishPeerAck{} }
 func (m *CafePublishPeerAck) String() string { return proto.CompactTextString(m) }
 func (*CafePublishPeerAck) ProtoMessage()    {}
 func (*CafePublishPeerAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{7}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{7}
 }
 func (m *CafePublishPeerAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafePublishPeerAck.Unmarshal(m, b)
@@ -382,7 +382,7 @@ func (m *CafeStore) Reset()         { *m = CafeStore{} }
 func (m *CafeStore) String() string { return proto.CompactTextString(m) }
 func (*CafeStore) ProtoMessage()    {}
 func (*CafeStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{8}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{8}
 }
 func (m *CafeStore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStore.Unmarshal(m, b)
@@ -427,7 +427,7 @@ func (m *CafeStoreAck) Reset()         { *m = CafeStoreAck{} }
 func (m *CafeStoreAck) String() string { return proto.CompactTextString(m) }
 func (*CafeStoreAck) ProtoMessage()    {}
 func (*CafeStoreAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{9}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{9}
 }
 func (m *CafeStoreAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStoreAck.Unmarshal(m, b)
@@ -466,7 +466,7 @@ func (m *CafeUnstore) Reset()         { *m = CafeUnstore{} }
 func (m *CafeUnstore) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstore) ProtoMessage()    {}
 func (*CafeUnstore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{10}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{10}
 }
 func (m *CafeUnstore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstore.Unmarshal(m, b)
@@ -511,7 +511,7 @@ func (m *CafeUnstoreAck) Reset()         { *m = CafeUnstoreAck{} }
 func (m *CafeUnstoreAck) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstoreAck) ProtoMessage()    {}
 func (*CafeUnstoreAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{11}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{11}
 }
 func (m *CafeUnstoreAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstoreAck.Unmarshal(m, b)
@@ -549,7 +549,7 @@ func (m *CafeObjectList) Reset()         { *m = CafeObjectList{} }
 func (m *CafeObjectList) String() string { return proto.CompactTextString(m) }
 func (*CafeObjectList) ProtoMessage()    {}
 func (*CafeObjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{12}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{12}
 }
 func (m *CafeObjectList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeObjectList.Unmarshal(m, b)
@@ -590,7 +590,7 @@ func (m *CafeObject) Reset()         { *m = CafeObject{} }
 func (m *CafeObject) String() string { return proto.CompactTextString(m) }
 func (*CafeObject) ProtoMessage()    {}
 func (*CafeObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{13}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{13}
 }
 func (m *CafeObject) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeObject.Unmarshal(m, b)
@@ -651,7 +651,7 @@ func (m *CafeStoreThread) Reset()         { *m = CafeStoreThread{} }
 func (m *CafeStoreThread) String() string { return proto.CompactTextString(m) }
 func (*CafeStoreThread) ProtoMessage()    {}
 func (*CafeStoreThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{14}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{14}
 }
 func (m *CafeStoreThread) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStoreThread.Unmarshal(m, b)
@@ -703,7 +703,7 @@ func (m *CafeStoreThreadAck) Reset()         { *m = CafeStoreThreadAck{} }
 func (m *CafeStoreThreadAck) String() string { return proto.CompactTextString(m) }
 func (*CafeStoreThreadAck) ProtoMessage()    {}
 func (*CafeStoreThreadAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{15}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{15}
 }
 func (m *CafeStoreThreadAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStoreThreadAck.Unmarshal(m, b)
@@ -742,7 +742,7 @@ func (m *CafeUnstoreThread) Reset()         { *m = CafeUnstoreThread{} }
 func (m *CafeUnstoreThread) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstoreThread) ProtoMessage()    {}
 func (*CafeUnstoreThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{16}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{16}
 }
 func (m *CafeUnstoreThread) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstoreThread.Unmarshal(m, b)
@@ -787,7 +787,7 @@ func (m *CafeUnstoreThreadAck) Reset()         { *m = CafeUnstoreThreadAck{} }
 func (m *CafeUnstoreThreadAck) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstoreThreadAck) ProtoMessage()    {}
 func (*CafeUnstoreThreadAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{17}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{17}
 }
 func (m *CafeUnstoreThreadAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstoreThreadAck.Unmarshal(m, b)
@@ -817,6 +817,7 @@ func (m *CafeUnstoreThreadAck) GetId() string {
 type CafeDeliverMessage struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Client               string   `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Size                 int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -826,7 +827,7 @@ func (m *CafeDeliverMessage) Reset()         { *m = CafeDeliverMessage{} }
 func (m *CafeDeliverMessage) String() string { return proto.CompactTextString(m) }
 func (*CafeDeliverMessage) ProtoMessage()    {}
 func (*CafeDeliverMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{18}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{18}
 }
 func (m *CafeDeliverMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeliverMessage.Unmarshal(m, b)
@@ -860,6 +861,183 @@ func (m *CafeDeliverMessage) GetClient() string {
 	return ""
 }
 
+func (m *CafeDeliverMessage) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type CafeMessageDropped struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Client               string   `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeMessageDropped) Reset()         { *m = CafeMessageDropped{} }
+func (m *CafeMessageDropped) String() string { return proto.CompactTextString(m) }
+func (*CafeMessageDropped) ProtoMessage()    {}
+func (*CafeMessageDropped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{19}
+}
+func (m *CafeMessageDropped) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeMessageDropped.Unmarshal(m, b)
+}
+func (m *CafeMessageDropped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeMessageDropped.Marshal(b, m, deterministic)
+}
+func (dst *CafeMessageDropped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeMessageDropped.Merge(dst, src)
+}
+func (m *CafeMessageDropped) XXX_Size() int {
+	return xxx_messageInfo_CafeMessageDropped.Size(m)
+}
+func (m *CafeMessageDropped) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeMessageDropped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeMessageDropped proto.InternalMessageInfo
+
+func (m *CafeMessageDropped) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeMessageDropped) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *CafeMessageDropped) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type CafeReplicate struct {
+	Type                 CafeReplication_Type `protobuf:"varint,1,opt,name=type,proto3,enum=CafeReplication_Type" json:"type,omitempty"`
+	Client               string               `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Target               string               `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Pin                  *CafeClientPin       `protobuf:"bytes,4,opt,name=pin,proto3" json:"pin,omitempty"`
+	Thread               *CafeClientThread    `protobuf:"bytes,5,opt,name=thread,proto3" json:"thread,omitempty"`
+	Message              *CafeClientMessage   `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeReplicate) Reset()         { *m = CafeReplicate{} }
+func (m *CafeReplicate) String() string { return proto.CompactTextString(m) }
+func (*CafeReplicate) ProtoMessage()    {}
+func (*CafeReplicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{20}
+}
+func (m *CafeReplicate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplicate.Unmarshal(m, b)
+}
+func (m *CafeReplicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplicate.Marshal(b, m, deterministic)
+}
+func (dst *CafeReplicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplicate.Merge(dst, src)
+}
+func (m *CafeReplicate) XXX_Size() int {
+	return xxx_messageInfo_CafeReplicate.Size(m)
+}
+func (m *CafeReplicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplicate proto.InternalMessageInfo
+
+func (m *CafeReplicate) GetType() CafeReplication_Type {
+	if m != nil {
+		return m.Type
+	}
+	return CafeReplication_PIN
+}
+
+func (m *CafeReplicate) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *CafeReplicate) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *CafeReplicate) GetPin() *CafeClientPin {
+	if m != nil {
+		return m.Pin
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetThread() *CafeClientThread {
+	if m != nil {
+		return m.Thread
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetMessage() *CafeClientMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type CafeReplicateAck struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeReplicateAck) Reset()         { *m = CafeReplicateAck{} }
+func (m *CafeReplicateAck) String() string { return proto.CompactTextString(m) }
+func (*CafeReplicateAck) ProtoMessage()    {}
+func (*CafeReplicateAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{21}
+}
+func (m *CafeReplicateAck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplicateAck.Unmarshal(m, b)
+}
+func (m *CafeReplicateAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplicateAck.Marshal(b, m, deterministic)
+}
+func (dst *CafeReplicateAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplicateAck.Merge(dst, src)
+}
+func (m *CafeReplicateAck) XXX_Size() int {
+	return xxx_messageInfo_CafeReplicateAck.Size(m)
+}
+func (m *CafeReplicateAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplicateAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplicateAck proto.InternalMessageInfo
+
+func (m *CafeReplicateAck) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 type CafeCheckMessages struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -871,7 +1049,7 @@ func (m *CafeCheckMessages) Reset()         { *m = CafeCheckMessages{} }
 func (m *CafeCheckMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeCheckMessages) ProtoMessage()    {}
 func (*CafeCheckMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{19}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{22}
 }
 func (m *CafeCheckMessages) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeCheckMessages.Unmarshal(m, b)
@@ -909,7 +1087,7 @@ func (m *CafeMessages) Reset()         { *m = CafeMessages{} }
 func (m *CafeMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeMessages) ProtoMessage()    {}
 func (*CafeMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{20}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{23}
 }
 func (m *CafeMessages) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeMessages.Unmarshal(m, b)
@@ -947,7 +1125,7 @@ func (m *CafeDeleteMessages) Reset()         { *m = CafeDeleteMessages{} }
 func (m *CafeDeleteMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeDeleteMessages) ProtoMessage()    {}
 func (*CafeDeleteMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{21}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{24}
 }
 func (m *CafeDeleteMessages) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeleteMessages.Unmarshal(m, b)
@@ -985,7 +1163,7 @@ func (m *CafeDeleteMessagesAck) Reset()         { *m = CafeDeleteMessagesAck{} }
 func (m *CafeDeleteMessagesAck) String() string { return proto.CompactTextString(m) }
 func (*CafeDeleteMessagesAck) ProtoMessage()    {}
 func (*CafeDeleteMessagesAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{22}
+	return fileDescriptor_cafe_service_4b2b90cb76d89fe0, []int{25}
 }
 func (m *CafeDeleteMessagesAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeleteMessagesAck.Unmarshal(m, b)
@@ -1032,48 +1210,59 @@ func init() {
 	proto.RegisterType((*CafeUnstoreThread)(nil), "CafeUnstoreThread")
 	proto.RegisterType((*CafeUnstoreThreadAck)(nil), "CafeUnstoreThreadAck")
 	proto.RegisterType((*CafeDeliverMessage)(nil), "CafeDeliverMessage")
+	proto.RegisterType((*CafeMessageDropped)(nil), "CafeMessageDropped")
+	proto.RegisterType((*CafeReplicate)(nil), "CafeReplicate")
+	proto.RegisterType((*CafeReplicateAck)(nil), "CafeReplicateAck")
 	proto.RegisterType((*CafeCheckMessages)(nil), "CafeCheckMessages")
 	proto.RegisterType((*CafeMessages)(nil), "CafeMessages")
 	proto.RegisterType((*CafeDeleteMessages)(nil), "CafeDeleteMessages")
 	proto.RegisterType((*CafeDeleteMessagesAck)(nil), "CafeDeleteMessagesAck")
 }
 
-func init() { proto.RegisterFile("cafe_service.proto", fileDescriptor_cafe_service_d48ef92619d0cc32) }
-
-var fileDescriptor_cafe_service_d48ef92619d0cc32 = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdf, 0x6b, 0x13, 0x41,
-	0x10, 0xc7, 0xb9, 0x4b, 0x53, 0x9b, 0x49, 0x5a, 0xe3, 0x59, 0xe5, 0xf4, 0xa1, 0xc4, 0xa5, 0x68,
-	0xaa, 0x90, 0x87, 0x8a, 0xa8, 0xe0, 0x8b, 0x8d, 0xf8, 0xe4, 0x8f, 0x72, 0x55, 0x04, 0x11, 0x64,
-	0xb3, 0x37, 0xc9, 0xad, 0xb9, 0xdc, 0x86, 0xdd, 0x6d, 0xf0, 0xd1, 0x3f, 0x5d, 0xf6, 0xc7, 0x25,
-	0x8b, 0xb9, 0x20, 0x7d, 0x9b, 0xd9, 0xfb, 0xcc, 0x77, 0x66, 0x67, 0x66, 0x0f, 0x12, 0x46, 0xa7,
-	0xf8, 0x53, 0xa1, 0x5c, 0x71, 0x86, 0xa3, 0xa5, 0x14, 0x5a, 0x3c, 0xec, 0x2e, 0x44, 0x8e, 0xa5,
-	0x73, 0xc8, 0x19, 0x1c, 0x8e, 0xe9, 0x14, 0xc7, 0x05, 0x2d, 0x4b, 0xac, 0x66, 0x98, 0xa4, 0x70,
-	0x8b, 0xe6, 0xb9, 0x44, 0xa5, 0xd2, 0x68, 0x10, 0x0d, 0x3b, 0x59, 0xed, 0x92, 0x47, 0xd0, 0x31,
-	0xe8, 0x27, 0x51, 0x31, 0x4c, 0x8e, 0xa1, 0xbd, 0xa2, 0xe5, 0x35, 0x7a, 0xc8, 0x39, 0xe4, 0x4f,
-	0x04, 0x7d, 0xc3, 0x64, 0x38, 0xe3, 0x4a, 0x4b, 0xaa, 0xb9, 0xa8, 0x76, 0x2b, 0x6e, 0x44, 0xe2,
-	0x40, 0xc4, 0x9c, 0x56, 0x26, 0x47, 0xda, 0x72, 0xa7, 0xd6, 0x49, 0xfa, 0xd0, 0x52, 0x7c, 0x96,
-	0xee, 0x0d, 0xa2, 0x61, 0x2f, 0x33, 0xa6, 0xe1, 0xb4, 0x98, 0x63, 0x95, 0xb6, 0x1d, 0x67, 0x1d,
-	0xf2, 0x14, 0x12, 0x53, 0xc1, 0x3b, 0x94, 0x61, 0x0d, 0x6b, 0x36, 0x0a, 0xd9, 0x27, 0x70, 0x6f,
-	0x9b, 0x7d, 0xcb, 0xe6, 0xc9, 0x11, 0xc4, 0x3c, 0xf7, 0x6c, 0xcc, 0x73, 0xf2, 0xde, 0x89, 0x66,
-	0x38, 0x95, 0xa8, 0x8a, 0x2b, 0x54, 0xca, 0x88, 0xde, 0x87, 0x7d, 0xca, 0xd8, 0xe6, 0x5e, 0xde,
-	0x33, 0x17, 0x96, 0x8e, 0xf4, 0x17, 0xab, 0x5d, 0x72, 0x01, 0xb7, 0x8d, 0xce, 0xe5, 0xf5, 0xa4,
-	0xe4, 0xaa, 0xb8, 0x44, 0x94, 0xcd, 0x95, 0x25, 0x0f, 0x60, 0x6f, 0x89, 0x28, 0x6d, 0x7c, 0xf7,
-	0xbc, 0x3d, 0x32, 0x68, 0x66, 0x8f, 0xc8, 0xa9, 0xab, 0x25, 0xd0, 0x68, 0xaa, 0xf8, 0x85, 0x1b,
-	0xd6, 0x95, 0x16, 0x12, 0x77, 0xe4, 0x48, 0x60, 0x8f, 0xf1, 0x5c, 0xa5, 0xf1, 0xa0, 0x35, 0xec,
-	0x64, 0xd6, 0x26, 0x27, 0xd0, 0x5b, 0x87, 0x35, 0xc9, 0xbe, 0x84, 0xae, 0xf9, 0xfe, 0xb5, 0x52,
-	0x37, 0x14, 0x3e, 0x85, 0xa3, 0x20, 0xd0, 0x48, 0xd7, 0x54, 0xb4, 0x4d, 0x7d, 0x9e, 0xfc, 0x42,
-	0xa6, 0x3f, 0x70, 0xa5, 0x1b, 0xa9, 0x1f, 0x00, 0x1b, 0x6a, 0x47, 0x0d, 0x7d, 0x68, 0x31, 0x9e,
-	0xfb, 0xfe, 0x1b, 0xd3, 0x28, 0xe5, 0x54, 0x53, 0xbb, 0x55, 0xbd, 0xcc, 0xda, 0xe6, 0xac, 0x12,
-	0x39, 0xfa, 0xad, 0xb2, 0x36, 0xf9, 0xe6, 0x66, 0x64, 0x5b, 0xf0, 0xa5, 0x90, 0x48, 0xf3, 0x1d,
-	0x29, 0x5c, 0x6f, 0xe2, 0xba, 0x37, 0xc9, 0x09, 0x00, 0xe3, 0xcb, 0x02, 0xa5, 0xc6, 0xdf, 0xda,
-	0xa7, 0x09, 0x4e, 0xea, 0xc1, 0x05, 0xc2, 0x4d, 0x1d, 0x7e, 0x0d, 0x77, 0x82, 0x46, 0xdd, 0xa4,
-	0x00, 0xf2, 0x18, 0x8e, 0xb7, 0x42, 0x9b, 0x52, 0xbc, 0xa9, 0x9f, 0x48, 0xc9, 0x57, 0x28, 0x3f,
-	0xa2, 0x52, 0x74, 0x86, 0xff, 0x52, 0x66, 0xbb, 0x59, 0xc9, 0xb1, 0xd2, 0x3e, 0x83, 0xf7, 0xc8,
-	0x99, 0x2b, 0x70, 0x5c, 0x20, 0x9b, 0xfb, 0x58, 0xb5, 0xe3, 0x7d, 0xbd, 0x72, 0xdb, 0xb4, 0xa6,
-	0x86, 0x70, 0xb0, 0xf0, 0xb6, 0x1d, 0x68, 0xf7, 0xbc, 0x37, 0x0a, 0x80, 0x6c, 0xfd, 0x75, 0xf3,
-	0x8a, 0x4b, 0xd4, 0xf8, 0x9f, 0x2c, 0xcf, 0xea, 0x57, 0x1c, 0xb2, 0x7e, 0xc3, 0x16, 0x42, 0xba,
-	0x5f, 0xd4, 0x41, 0x66, 0xed, 0x8b, 0xbb, 0x70, 0xc8, 0xc5, 0xc8, 0xcc, 0x83, 0x97, 0x38, 0x5a,
-	0x4e, 0xbe, 0xc7, 0xcb, 0xc9, 0x64, 0xdf, 0xfe, 0x0b, 0x9f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xc4, 0x0f, 0x1d, 0x56, 0x2e, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("cafe_service.proto", fileDescriptor_cafe_service_4b2b90cb76d89fe0) }
+
+var fileDescriptor_cafe_service_4b2b90cb76d89fe0 = []byte{
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x5d, 0x6f, 0xd3, 0x3c,
+	0x14, 0xc7, 0xd5, 0x97, 0x75, 0xdb, 0x69, 0xd7, 0x75, 0x7e, 0xb6, 0x29, 0xcf, 0x73, 0x31, 0xf5,
+	0xb1, 0x26, 0x68, 0x01, 0xe5, 0xa2, 0x08, 0x01, 0x97, 0xac, 0x13, 0x57, 0xbc, 0x54, 0xde, 0x10,
+	0x12, 0x42, 0x42, 0x6e, 0x72, 0xd6, 0x9a, 0x65, 0x49, 0x64, 0x7b, 0x13, 0xe3, 0x8a, 0x2f, 0xcb,
+	0xf7, 0x40, 0x7e, 0x49, 0x1b, 0xad, 0x29, 0xb0, 0xbb, 0x73, 0xec, 0x9f, 0xff, 0xc7, 0xfe, 0xc7,
+	0xc7, 0x01, 0x12, 0xf1, 0x0b, 0xfc, 0xa2, 0x50, 0xde, 0x88, 0x08, 0xc3, 0x5c, 0x66, 0x3a, 0xfb,
+	0xaf, 0x7d, 0x95, 0xc5, 0x98, 0xb8, 0x84, 0x0e, 0x61, 0x67, 0xcc, 0x2f, 0x70, 0x3c, 0xe7, 0x49,
+	0x82, 0xe9, 0x0c, 0x49, 0x00, 0x9b, 0x3c, 0x8e, 0x25, 0x2a, 0x15, 0xd4, 0xfa, 0xb5, 0xc1, 0x36,
+	0x2b, 0x52, 0xfa, 0x3f, 0x6c, 0x1b, 0xf4, 0x5d, 0x96, 0x46, 0x48, 0xf6, 0x61, 0xe3, 0x86, 0x27,
+	0xd7, 0xe8, 0x21, 0x97, 0xd0, 0x1f, 0x35, 0xe8, 0x19, 0x86, 0xe1, 0x4c, 0x28, 0x2d, 0xb9, 0x16,
+	0x59, 0xba, 0x5e, 0x71, 0x29, 0x52, 0x2f, 0x89, 0x98, 0xd1, 0xd4, 0xd4, 0x08, 0x1a, 0x6e, 0xd4,
+	0x26, 0xa4, 0x07, 0x0d, 0x25, 0x66, 0x41, 0xb3, 0x5f, 0x1b, 0x74, 0x98, 0x09, 0x0d, 0xa7, 0xb3,
+	0x4b, 0x4c, 0x83, 0x0d, 0xc7, 0xd9, 0x84, 0x3e, 0x02, 0x62, 0x76, 0x70, 0x8a, 0xb2, 0xbc, 0x87,
+	0x05, 0x5b, 0x2b, 0xb3, 0x0f, 0xe1, 0x60, 0x95, 0x7d, 0x15, 0x5d, 0x92, 0x2e, 0xd4, 0x45, 0xec,
+	0xd9, 0xba, 0x88, 0xe9, 0x6b, 0x27, 0xca, 0xf0, 0x42, 0xa2, 0x9a, 0x9f, 0xa1, 0x52, 0x46, 0xf4,
+	0x10, 0x5a, 0x3c, 0x8a, 0x96, 0xe7, 0xf2, 0x99, 0x39, 0xb0, 0x74, 0xa4, 0x3f, 0x58, 0x91, 0xd2,
+	0x13, 0xd8, 0x35, 0x3a, 0x93, 0xeb, 0x69, 0x22, 0xd4, 0x7c, 0x82, 0x28, 0xab, 0x77, 0x46, 0xfe,
+	0x85, 0x66, 0x8e, 0x28, 0xed, 0xfa, 0xf6, 0x68, 0x23, 0x34, 0x28, 0xb3, 0x43, 0xf4, 0x18, 0xc8,
+	0x1d, 0x8d, 0xaa, 0x1d, 0x3f, 0x73, 0x1f, 0xeb, 0x4c, 0x67, 0x12, 0xd7, 0xd4, 0x20, 0xd0, 0x8c,
+	0x44, 0xac, 0x82, 0x7a, 0xbf, 0x31, 0xd8, 0x66, 0x36, 0xa6, 0x47, 0xd0, 0x59, 0x2c, 0xab, 0x92,
+	0x7d, 0x0e, 0x6d, 0x33, 0xff, 0x21, 0x55, 0xf7, 0x14, 0x3e, 0x86, 0x6e, 0x69, 0xa1, 0x91, 0x2e,
+	0xa8, 0xda, 0x2a, 0xf5, 0x7e, 0xfa, 0x15, 0x23, 0xfd, 0x46, 0x28, 0x5d, 0x49, 0x7d, 0x06, 0x58,
+	0x52, 0x6b, 0xf6, 0xd0, 0x83, 0x46, 0x24, 0x62, 0xef, 0xbf, 0x09, 0x8d, 0x52, 0xcc, 0x35, 0xb7,
+	0xb7, 0xaa, 0xc3, 0x6c, 0x6c, 0xc6, 0xd2, 0x2c, 0x46, 0x7f, 0xab, 0x6c, 0x4c, 0x3f, 0xc2, 0xee,
+	0xc2, 0x82, 0xf3, 0xb9, 0x44, 0x1e, 0xaf, 0x29, 0xe1, 0xbc, 0xa9, 0x17, 0xde, 0x90, 0x23, 0x80,
+	0x48, 0xe4, 0x73, 0x94, 0x1a, 0xbf, 0x69, 0x5f, 0xa6, 0x34, 0x52, 0x7c, 0xb8, 0x92, 0x70, 0x95,
+	0xc3, 0x2f, 0x61, 0xaf, 0x64, 0xd4, 0x7d, 0x36, 0x40, 0x1f, 0xc0, 0xfe, 0xca, 0xd2, 0xaa, 0x12,
+	0x93, 0xa2, 0x45, 0x12, 0x71, 0x83, 0xf2, 0x2d, 0x2a, 0xc5, 0x67, 0x78, 0x97, 0x32, 0xb7, 0x3b,
+	0x4a, 0x04, 0xa6, 0xda, 0x57, 0xf0, 0x99, 0xf1, 0x4c, 0x89, 0xef, 0xae, 0x3b, 0x1b, 0xcc, 0xc6,
+	0xf4, 0xdc, 0x29, 0x7a, 0xa9, 0x53, 0x99, 0xe5, 0x39, 0xc6, 0x7f, 0xad, 0x78, 0x08, 0x2d, 0x89,
+	0x5c, 0x65, 0xa9, 0xef, 0x78, 0x9f, 0xd1, 0x9f, 0x35, 0xf7, 0x38, 0x31, 0xcc, 0x13, 0x11, 0x71,
+	0x8d, 0x64, 0x08, 0x4d, 0x7d, 0x9b, 0xbb, 0x47, 0xa7, 0x3b, 0x3a, 0x08, 0xcb, 0xb3, 0x22, 0x4b,
+	0xc3, 0xf3, 0xdb, 0x1c, 0x99, 0x45, 0x7e, 0x57, 0x4c, 0x73, 0x39, 0x43, 0x5d, 0x14, 0x73, 0x19,
+	0xe9, 0x43, 0x23, 0x17, 0xa9, 0xbd, 0x09, 0xed, 0x51, 0xd7, 0x2a, 0x8f, 0xed, 0x8a, 0x89, 0x48,
+	0x99, 0x99, 0x22, 0x43, 0x68, 0x69, 0xeb, 0xa9, 0x7d, 0x70, 0xda, 0xa3, 0xbd, 0x12, 0xe4, 0xcc,
+	0x66, 0x1e, 0x20, 0x4f, 0x60, 0xf3, 0xca, 0x79, 0x11, 0xb4, 0x2c, 0x4b, 0x4a, 0xac, 0x77, 0x89,
+	0x15, 0x08, 0xa5, 0xd0, 0x2b, 0x1f, 0xa4, 0xb2, 0xf1, 0x86, 0xee, 0x5a, 0x8c, 0xe7, 0x18, 0x5d,
+	0x7a, 0x01, 0xb5, 0xe6, 0x55, 0x7b, 0xe1, 0x7a, 0x78, 0x41, 0x0d, 0x60, 0xcb, 0x57, 0x72, 0x6d,
+	0xd4, 0x1e, 0x75, 0xc2, 0x12, 0xc0, 0x16, 0xb3, 0xcb, 0xb7, 0x33, 0x41, 0x8d, 0x7f, 0xa8, 0xf2,
+	0x18, 0x0e, 0x56, 0x59, 0xdf, 0xd7, 0x57, 0x99, 0x74, 0xdf, 0x68, 0x8b, 0xd9, 0xf8, 0xe4, 0x1f,
+	0xd8, 0x11, 0x59, 0x68, 0xba, 0x40, 0x24, 0x18, 0xe6, 0xd3, 0x4f, 0xf5, 0x7c, 0x3a, 0x6d, 0xd9,
+	0x3f, 0xd0, 0xd3, 0x5f, 0x03, 0x00, 0xd0, 0x50, 0xda, 0xca, 0xa4, 0x06, 0x00, 0x00,
 }
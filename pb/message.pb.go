@@ -43,6 +43,9 @@ const (
 	Message_CAFE_UNSTORE_THREAD           Message_Type = 77
 	Message_CAFE_UNSTORE_THREAD_ACK       Message_Type = 78
 	Message_CAFE_DELIVER_MESSAGE          Message_Type = 60
+	Message_CAFE_MESSAGE_DROPPED          Message_Type = 79
+	Message_CAFE_REPLICATE                Message_Type = 80
+	Message_CAFE_REPLICATE_ACK            Message_Type = 81
 	Message_CAFE_CHECK_MESSAGES           Message_Type = 61
 	Message_CAFE_MESSAGES                 Message_Type = 62
 	Message_CAFE_DELETE_MESSAGES          Message_Type = 63
@@ -52,6 +55,7 @@ const (
 	Message_CAFE_PUBLISH_PEER_ACK         Message_Type = 67
 	Message_CAFE_QUERY                    Message_Type = 70
 	Message_CAFE_QUERY_RES                Message_Type = 71
+	Message_CAFE_FEDERATED_QUERY          Message_Type = 82
 	Message_CAFE_PUBSUB_QUERY             Message_Type = 102
 	Message_CAFE_PUBSUB_QUERY_RES         Message_Type = 103
 	Message_ERROR                         Message_Type = 500
@@ -83,6 +87,9 @@ var Message_Type_name = map[int32]string{
 	77:  "CAFE_UNSTORE_THREAD",
 	78:  "CAFE_UNSTORE_THREAD_ACK",
 	60:  "CAFE_DELIVER_MESSAGE",
+	79:  "CAFE_MESSAGE_DROPPED",
+	80:  "CAFE_REPLICATE",
+	81:  "CAFE_REPLICATE_ACK",
 	61:  "CAFE_CHECK_MESSAGES",
 	62:  "CAFE_MESSAGES",
 	63:  "CAFE_DELETE_MESSAGES",
@@ -92,6 +99,7 @@ var Message_Type_name = map[int32]string{
 	67:  "CAFE_PUBLISH_PEER_ACK",
 	70:  "CAFE_QUERY",
 	71:  "CAFE_QUERY_RES",
+	82:  "CAFE_FEDERATED_QUERY",
 	102: "CAFE_PUBSUB_QUERY",
 	103: "CAFE_PUBSUB_QUERY_RES",
 	500: "ERROR",
@@ -122,6 +130,9 @@ var Message_Type_value = map[string]int32{
 	"CAFE_UNSTORE_THREAD":           77,
 	"CAFE_UNSTORE_THREAD_ACK":       78,
 	"CAFE_DELIVER_MESSAGE":          60,
+	"CAFE_MESSAGE_DROPPED":          79,
+	"CAFE_REPLICATE":                80,
+	"CAFE_REPLICATE_ACK":            81,
 	"CAFE_CHECK_MESSAGES":           61,
 	"CAFE_MESSAGES":                 62,
 	"CAFE_DELETE_MESSAGES":          63,
@@ -131,6 +142,7 @@ var Message_Type_value = map[string]int32{
 	"CAFE_PUBLISH_PEER_ACK":         67,
 	"CAFE_QUERY":                    70,
 	"CAFE_QUERY_RES":                71,
+	"CAFE_FEDERATED_QUERY":          82,
 	"CAFE_PUBSUB_QUERY":             102,
 	"CAFE_PUBSUB_QUERY_RES":         103,
 	"ERROR":                         500,
@@ -144,7 +156,7 @@ func (x Message_Type) String() string {
 	return proto.EnumName(Message_Type_name, int32(x))
 }
 func (Message_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_message_5b718c2b9c44701c, []int{0, 0}
+	return fileDescriptor_message_f8a8e5c8bc76d0d7, []int{0, 0}
 }
 
 type Message struct {
@@ -161,7 +173,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_5b718c2b9c44701c, []int{0}
+	return fileDescriptor_message_f8a8e5c8bc76d0d7, []int{0}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Message.Unmarshal(m, b)
@@ -221,7 +233,7 @@ func (m *Envelope) Reset()         { *m = Envelope{} }
 func (m *Envelope) String() string { return proto.CompactTextString(m) }
 func (*Envelope) ProtoMessage()    {}
 func (*Envelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_5b718c2b9c44701c, []int{1}
+	return fileDescriptor_message_f8a8e5c8bc76d0d7, []int{1}
 }
 func (m *Envelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Envelope.Unmarshal(m, b)
@@ -267,7 +279,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_5b718c2b9c44701c, []int{2}
+	return fileDescriptor_message_f8a8e5c8bc76d0d7, []int{2}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Error.Unmarshal(m, b)
@@ -308,48 +320,50 @@ func init() {
 	proto.RegisterEnum("Message_Type", Message_Type_name, Message_Type_value)
 }
 
-func init() { proto.RegisterFile("message.proto", fileDescriptor_message_5b718c2b9c44701c) }
+func init() { proto.RegisterFile("message.proto", fileDescriptor_message_f8a8e5c8bc76d0d7) }
 
-var fileDescriptor_message_5b718c2b9c44701c = []byte{
-	// 635 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x6f, 0x4f, 0xe2, 0x4a,
-	0x14, 0xc6, 0x2f, 0x8a, 0x82, 0x47, 0xd1, 0xf1, 0xf8, 0xaf, 0x7a, 0xbd, 0x06, 0x49, 0x6e, 0xc2,
-	0xab, 0x9a, 0xe0, 0xf5, 0xee, 0xff, 0x5d, 0x4b, 0x39, 0xd2, 0x6a, 0x69, 0xd9, 0x69, 0x31, 0x71,
-	0xdf, 0x34, 0xb0, 0x54, 0x42, 0xe2, 0xd2, 0x2e, 0xc5, 0xcd, 0xf2, 0x49, 0xf7, 0x8b, 0xf8, 0x01,
-	0x36, 0x4c, 0xcb, 0x58, 0xa3, 0xfb, 0x6e, 0xe6, 0x79, 0x9e, 0xf3, 0x9b, 0x39, 0x33, 0x99, 0x81,
-	0xd2, 0xb7, 0x20, 0x8e, 0xbb, 0x83, 0x40, 0x8d, 0xc6, 0xe1, 0x24, 0x3c, 0xd8, 0x1f, 0x84, 0xe1,
-	0xe0, 0x2e, 0x38, 0x11, 0xb3, 0xde, 0xfd, 0xed, 0x49, 0x77, 0x34, 0x4d, 0xac, 0xca, 0x43, 0x01,
-	0x0a, 0xad, 0x24, 0x8c, 0xc7, 0x90, 0x9f, 0x4c, 0xa3, 0x40, 0xc9, 0x95, 0x73, 0xd5, 0xf5, 0x5a,
-	0x49, 0x4d, 0x75, 0xd5, 0x9b, 0x46, 0x01, 0x17, 0x16, 0xaa, 0x50, 0x88, 0xba, 0xd3, 0xbb, 0xb0,
-	0xdb, 0x57, 0x16, 0xca, 0xb9, 0xea, 0x6a, 0x6d, 0x5b, 0x4d, 0xd8, 0xea, 0x9c, 0xad, 0x6a, 0xa3,
-	0x29, 0x9f, 0x87, 0xf0, 0x10, 0x56, 0xc6, 0xc1, 0xf7, 0xfb, 0x20, 0x9e, 0x98, 0x7d, 0x65, 0xb1,
-	0x9c, 0xab, 0x2e, 0xf1, 0x47, 0x01, 0x8f, 0x00, 0x86, 0x31, 0x0f, 0xe2, 0x28, 0x1c, 0xc5, 0x81,
-	0x92, 0x2f, 0xe7, 0xaa, 0x45, 0x9e, 0x51, 0x2a, 0xbf, 0x96, 0x21, 0x3f, 0x5b, 0x1c, 0x8b, 0x90,
-	0x6f, 0x9b, 0x76, 0x93, 0xfd, 0x25, 0x46, 0x8e, 0xdd, 0x64, 0x39, 0xdc, 0x82, 0x0d, 0xcf, 0xe0,
-	0xa4, 0x35, 0x7c, 0xb2, 0xaf, 0xc9, 0x72, 0xda, 0xc4, 0x00, 0x11, 0xd6, 0x75, 0xed, 0x82, 0x7c,
-	0xdd, 0xd0, 0x2c, 0x8b, 0xec, 0x26, 0xb1, 0x1a, 0xae, 0x03, 0x08, 0xcd, 0x76, 0x6c, 0x9d, 0xd8,
-	0x29, 0xee, 0xc0, 0xa6, 0x98, 0x73, 0x6a, 0x9a, 0xae, 0xc7, 0x35, 0xcf, 0x74, 0x6c, 0xf6, 0x1f,
-	0xee, 0xc1, 0x96, 0x90, 0x1b, 0xf4, 0xc4, 0x30, 0xf0, 0x6f, 0xd8, 0x7b, 0xc1, 0xf0, 0x35, 0xfd,
-	0x8a, 0x99, 0xc8, 0x60, 0x4d, 0x98, 0x2e, 0xb9, 0xee, 0x2c, 0x7e, 0x86, 0x0a, 0x6c, 0xa7, 0xf8,
-	0x0b, 0x4e, 0xae, 0x21, 0x9d, 0xff, 0xe5, 0x46, 0x5c, 0xcf, 0xe1, 0xc4, 0x5e, 0xc9, 0xcd, 0x8a,
-	0xb9, 0xe0, 0xbd, 0x93, 0xbc, 0x8e, 0x9d, 0xa4, 0x2e, 0x71, 0x1b, 0x58, 0x56, 0x11, 0xb9, 0x2b,
-	0xdc, 0x80, 0x55, 0xa1, 0x3a, 0xf5, 0x4b, 0xd2, 0x3d, 0xf6, 0x5a, 0xc6, 0x12, 0xc1, 0xb7, 0x4c,
-	0xd7, 0x63, 0x6f, 0x64, 0xaf, 0x49, 0x69, 0x72, 0x5e, 0xec, 0x2d, 0xee, 0xc3, 0xce, 0x33, 0x59,
-	0x80, 0x2d, 0x79, 0x0c, 0xf3, 0xe5, 0xd2, 0x9a, 0x96, 0x3c, 0x86, 0xa7, 0x86, 0xa8, 0xb2, 0x65,
-	0xd3, 0x0d, 0xb2, 0xcc, 0x6b, 0xe2, 0x7e, 0x8b, 0x5c, 0x57, 0x6b, 0x12, 0x7b, 0x2f, 0x79, 0xba,
-	0x41, 0xfa, 0xd5, 0x5c, 0x77, 0xd9, 0x07, 0xdc, 0x84, 0x92, 0x30, 0xa4, 0xf4, 0x31, 0x4b, 0x21,
-	0x2f, 0xe3, 0x7c, 0xc2, 0x43, 0x50, 0x5e, 0x72, 0xc4, 0xea, 0xe7, 0xb8, 0x0b, 0x28, 0xdc, 0x1b,
-	0xa7, 0xe3, 0x1b, 0xda, 0x35, 0xf9, 0x2d, 0xcd, 0xb4, 0x98, 0x26, 0xbb, 0x6f, 0x77, 0xea, 0x96,
-	0xe9, 0x1a, 0x7e, 0x9b, 0x88, 0xb3, 0xba, 0xec, 0x3e, 0x2b, 0x0b, 0x92, 0x2e, 0xaf, 0xe8, 0x73,
-	0x87, 0xf8, 0x0d, 0xbb, 0x90, 0x57, 0x24, 0xe6, 0x3e, 0x27, 0x97, 0x35, 0xb3, 0x54, 0xb7, 0x53,
-	0x4f, 0xa3, 0xb7, 0x59, 0xaa, 0x94, 0x45, 0xc5, 0x00, 0x01, 0x96, 0x88, 0x73, 0x87, 0xb3, 0x87,
-	0x45, 0x3c, 0x48, 0xf7, 0xaa, 0x3b, 0xb6, 0xa7, 0xe9, 0x5e, 0x5a, 0xde, 0x38, 0x58, 0x28, 0xe6,
-	0xf0, 0x08, 0x76, 0x9f, 0x7b, 0x82, 0x41, 0xc2, 0x3f, 0x86, 0xfd, 0xec, 0x12, 0x4f, 0x11, 0x7d,
-	0x11, 0xf9, 0x17, 0xfe, 0xf9, 0x63, 0x44, 0x90, 0x82, 0x59, 0xac, 0x72, 0x0e, 0x45, 0x1a, 0xfd,
-	0x08, 0xee, 0xc2, 0x28, 0xc0, 0x0a, 0x14, 0xd2, 0xef, 0x42, 0xbc, 0xfc, 0xd5, 0x5a, 0x71, 0xfe,
-	0xf2, 0xf9, 0xdc, 0x40, 0x06, 0x8b, 0xf1, 0x70, 0x20, 0xde, 0xfc, 0x1a, 0x9f, 0x0d, 0x2b, 0x67,
-	0xb0, 0x44, 0xe3, 0x71, 0x38, 0x46, 0x84, 0xfc, 0xd7, 0xb0, 0x9f, 0xd4, 0x96, 0xb8, 0x18, 0xa3,
-	0xf2, 0x88, 0x9c, 0x95, 0xac, 0x48, 0x50, 0x7d, 0x0b, 0x4a, 0xc3, 0x50, 0x9d, 0x04, 0x3f, 0x27,
-	0xc3, 0xd9, 0xa7, 0xd1, 0xfb, 0xb2, 0x10, 0xf5, 0x7a, 0xcb, 0xe2, 0xf3, 0x38, 0xfd, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0xb6, 0xae, 0x03, 0x3a, 0xb7, 0x04, 0x00, 0x00,
+var fileDescriptor_message_f8a8e5c8bc76d0d7 = []byte{
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xeb, 0x4e, 0xdb, 0x4c,
+	0x10, 0xfd, 0x02, 0xe1, 0x4b, 0x18, 0x08, 0x2c, 0xc3, 0x2d, 0x50, 0x8a, 0x42, 0xa4, 0x4a, 0xf9,
+	0x65, 0xa4, 0x50, 0x7a, 0xbf, 0xe0, 0xd8, 0x93, 0xc4, 0xe0, 0xd8, 0x66, 0xed, 0x20, 0xd1, 0x3f,
+	0x56, 0xd2, 0x98, 0x28, 0x12, 0x8d, 0xd3, 0x38, 0x54, 0xcd, 0x93, 0xf5, 0x45, 0xfa, 0x28, 0x7d,
+	0x80, 0x2a, 0x6b, 0x67, 0x31, 0x82, 0xfe, 0xdb, 0x39, 0xe7, 0xcc, 0x99, 0xcb, 0x4a, 0x03, 0x85,
+	0x6f, 0x41, 0x14, 0x75, 0xfa, 0x81, 0x32, 0x1a, 0x87, 0x93, 0x70, 0x7f, 0xaf, 0x1f, 0x86, 0xfd,
+	0xdb, 0xe0, 0x58, 0x44, 0xdd, 0xbb, 0x9b, 0xe3, 0xce, 0x70, 0x1a, 0x53, 0xe5, 0xdf, 0x79, 0xc8,
+	0xb5, 0x62, 0x31, 0x1e, 0x41, 0x76, 0x32, 0x1d, 0x05, 0xc5, 0x4c, 0x29, 0x53, 0x59, 0xab, 0x16,
+	0x94, 0x04, 0x57, 0xbc, 0xe9, 0x28, 0xe0, 0x82, 0x42, 0x05, 0x72, 0xa3, 0xce, 0xf4, 0x36, 0xec,
+	0xf4, 0x8a, 0x0b, 0xa5, 0x4c, 0x65, 0xa5, 0xba, 0xa5, 0xc4, 0xde, 0xca, 0xdc, 0x5b, 0x51, 0x87,
+	0x53, 0x3e, 0x17, 0xe1, 0x01, 0x2c, 0x8f, 0x83, 0xef, 0x77, 0x41, 0x34, 0x31, 0x7a, 0xc5, 0xc5,
+	0x52, 0xa6, 0xb2, 0xc4, 0xef, 0x01, 0x3c, 0x04, 0x18, 0x44, 0x3c, 0x88, 0x46, 0xe1, 0x30, 0x0a,
+	0x8a, 0xd9, 0x52, 0xa6, 0x92, 0xe7, 0x29, 0xa4, 0xfc, 0x2b, 0x07, 0xd9, 0x59, 0x71, 0xcc, 0x43,
+	0xd6, 0x31, 0xac, 0x06, 0xfb, 0x4f, 0xbc, 0x6c, 0xab, 0xc1, 0x32, 0xb8, 0x09, 0xeb, 0x5e, 0x93,
+	0x93, 0xaa, 0xfb, 0x64, 0x5d, 0x91, 0x69, 0x3b, 0xc4, 0x00, 0x11, 0xd6, 0x34, 0xb5, 0x4e, 0xbe,
+	0xd6, 0x54, 0x4d, 0x93, 0xac, 0x06, 0xb1, 0x2a, 0xae, 0x01, 0x08, 0xcc, 0xb2, 0x2d, 0x8d, 0xd8,
+	0x09, 0x6e, 0xc3, 0x86, 0x88, 0x39, 0x35, 0x0c, 0xd7, 0xe3, 0xaa, 0x67, 0xd8, 0x16, 0x7b, 0x89,
+	0xbb, 0xb0, 0x29, 0x60, 0x9d, 0x1e, 0x10, 0x4d, 0x7c, 0x06, 0xbb, 0x4f, 0x10, 0xbe, 0xaa, 0x5d,
+	0x30, 0x03, 0x19, 0xac, 0x0a, 0xd2, 0x25, 0xd7, 0x9d, 0xc9, 0x4f, 0xb1, 0x08, 0x5b, 0x89, 0x7d,
+	0x9d, 0x93, 0xdb, 0x94, 0xcc, 0x2b, 0xd9, 0x88, 0xeb, 0xd9, 0x9c, 0xd8, 0x6b, 0xd9, 0xac, 0x88,
+	0x85, 0xdf, 0x7b, 0xe9, 0xd7, 0xb6, 0x62, 0xd5, 0x39, 0x6e, 0x01, 0x4b, 0x23, 0x42, 0x77, 0x81,
+	0xeb, 0xb0, 0x22, 0x50, 0xbb, 0x76, 0x4e, 0x9a, 0xc7, 0xde, 0x48, 0x59, 0x0c, 0xf8, 0xa6, 0xe1,
+	0x7a, 0xec, 0xad, 0x9c, 0x35, 0x4e, 0x8d, 0xf7, 0xc5, 0xde, 0xe1, 0x1e, 0x6c, 0x3f, 0x82, 0x85,
+	0xb1, 0x29, 0xd7, 0xd0, 0xb6, 0xd2, 0x24, 0x6b, 0xc9, 0x35, 0xb4, 0xad, 0x47, 0x59, 0x96, 0x1c,
+	0x5a, 0x27, 0xd3, 0xb8, 0x22, 0xee, 0xb7, 0xc8, 0x75, 0xd5, 0x06, 0xb1, 0x0f, 0x92, 0x49, 0x10,
+	0x5f, 0xe7, 0xb6, 0xe3, 0x90, 0xce, 0x6c, 0x39, 0x3e, 0x27, 0xc7, 0x34, 0x34, 0xd5, 0x23, 0xe6,
+	0xe0, 0x0e, 0xe0, 0x43, 0x4c, 0xf8, 0x5f, 0xca, 0xae, 0xb4, 0x26, 0x69, 0x17, 0x73, 0x2f, 0x97,
+	0x7d, 0xc4, 0x0d, 0x28, 0xa4, 0xed, 0x5d, 0xf6, 0x29, 0xdd, 0x0b, 0x79, 0x29, 0xe6, 0x33, 0x1e,
+	0x40, 0xf1, 0x29, 0x46, 0xd4, 0x38, 0x93, 0xb5, 0xaf, 0xed, 0xb6, 0xdf, 0x54, 0xaf, 0xc8, 0x6f,
+	0xa9, 0x86, 0xc9, 0x54, 0xb9, 0x43, 0xa7, 0x5d, 0x33, 0x0d, 0xb7, 0xe9, 0x3b, 0x44, 0x9c, 0xd5,
+	0xe4, 0x0e, 0xd3, 0xb0, 0x70, 0xd2, 0xe4, 0x47, 0x5f, 0xb6, 0x89, 0x5f, 0xb3, 0xba, 0x9c, 0x54,
+	0xc4, 0x3e, 0x27, 0x97, 0x35, 0x64, 0x97, 0x75, 0xd2, 0x89, 0xab, 0x1e, 0xe9, 0x89, 0x9a, 0xa7,
+	0xeb, 0xb9, 0xed, 0x5a, 0x02, 0xdf, 0xa4, 0xeb, 0x49, 0x58, 0x78, 0xf5, 0x11, 0x60, 0x89, 0x38,
+	0xb7, 0x39, 0xfb, 0xb3, 0x88, 0xfb, 0xc9, 0x14, 0x9a, 0x6d, 0x79, 0xaa, 0xe6, 0x25, 0xe9, 0xfa,
+	0xfe, 0x42, 0x3e, 0x83, 0x87, 0xb0, 0xf3, 0x98, 0x13, 0x1e, 0x24, 0xf8, 0x23, 0xd8, 0x4b, 0x97,
+	0x78, 0x68, 0xd1, 0x13, 0x92, 0x17, 0xf0, 0xfc, 0x9f, 0x12, 0xe1, 0x14, 0xcc, 0x64, 0xe5, 0x33,
+	0xc8, 0xd3, 0xf0, 0x47, 0x70, 0x1b, 0x8e, 0x02, 0x2c, 0x43, 0x2e, 0x39, 0x47, 0xe2, 0xb2, 0xac,
+	0x54, 0xf3, 0xf3, 0xcb, 0xc2, 0xe7, 0x04, 0x32, 0x58, 0x8c, 0x06, 0x7d, 0x71, 0x53, 0x56, 0xf9,
+	0xec, 0x59, 0x3e, 0x85, 0x25, 0x1a, 0x8f, 0xc3, 0x31, 0x22, 0x64, 0xbf, 0x86, 0xbd, 0x38, 0xb7,
+	0xc0, 0xc5, 0x1b, 0x8b, 0xf7, 0x96, 0xb3, 0x94, 0x65, 0x69, 0x54, 0xdb, 0x84, 0xc2, 0x20, 0x54,
+	0x26, 0xc1, 0xcf, 0xc9, 0x60, 0x76, 0x94, 0xba, 0x5f, 0x16, 0x46, 0xdd, 0xee, 0xff, 0xe2, 0x38,
+	0x9d, 0xfc, 0x1d, 0x00, 0xed, 0xce, 0x88, 0x76, 0x17, 0x05, 0x00, 0x00,
 }
@@ -47,7 +47,7 @@ func (x Thread_Type) String() string {
 	return proto.EnumName(Thread_Type_name, int32(x))
 }
 func (Thread_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{5, 0}
+	return fileDescriptor_model_aaa56015cda79db6, []int{5, 0}
 }
 
 // Sharing controls if (Y/N) a thread can be shared
@@ -74,7 +74,7 @@ func (x Thread_Sharing) String() string {
 	return proto.EnumName(Thread_Sharing_name, int32(x))
 }
 func (Thread_Sharing) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{5, 1}
+	return fileDescriptor_model_aaa56015cda79db6, []int{5, 1}
 }
 
 // State indicates the loading state
@@ -101,7 +101,7 @@ func (x Thread_State) String() string {
 	return proto.EnumName(Thread_State_name, int32(x))
 }
 func (Thread_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{5, 2}
+	return fileDescriptor_model_aaa56015cda79db6, []int{5, 2}
 }
 
 type Block_BlockType int32
@@ -151,7 +151,7 @@ func (x Block_BlockType) String() string {
 	return proto.EnumName(Block_BlockType_name, int32(x))
 }
 func (Block_BlockType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{8, 0}
+	return fileDescriptor_model_aaa56015cda79db6, []int{8, 0}
 }
 
 type Notification_Type int32
@@ -192,7 +192,33 @@ func (x Notification_Type) String() string {
 	return proto.EnumName(Notification_Type_name, int32(x))
 }
 func (Notification_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{16, 0}
+	return fileDescriptor_model_aaa56015cda79db6, []int{16, 0}
+}
+
+type CafeHealth_Status int32
+
+const (
+	CafeHealth_UNKNOWN   CafeHealth_Status = 0
+	CafeHealth_HEALTHY   CafeHealth_Status = 1
+	CafeHealth_UNHEALTHY CafeHealth_Status = 2
+)
+
+var CafeHealth_Status_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "HEALTHY",
+	2: "UNHEALTHY",
+}
+var CafeHealth_Status_value = map[string]int32{
+	"UNKNOWN":   0,
+	"HEALTHY":   1,
+	"UNHEALTHY": 2,
+}
+
+func (x CafeHealth_Status) String() string {
+	return proto.EnumName(CafeHealth_Status_name, int32(x))
+}
+func (CafeHealth_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_aaa56015cda79db6, []int{21, 0}
 }
 
 type CafeRequest_Type int32
@@ -224,7 +250,7 @@ func (x CafeRequest_Type) String() string {
 	return proto.EnumName(CafeRequest_Type_name, int32(x))
 }
 func (CafeRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{21, 0}
+	return fileDescriptor_model_aaa56015cda79db6, []int{23, 0}
 }
 
 type CafeRequest_Status int32
@@ -233,24 +259,27 @@ const (
 	CafeRequest_NEW      CafeRequest_Status = 0
 	CafeRequest_PENDING  CafeRequest_Status = 1
 	CafeRequest_COMPLETE CafeRequest_Status = 2
+	CafeRequest_FAILED   CafeRequest_Status = 3
 )
 
 var CafeRequest_Status_name = map[int32]string{
 	0: "NEW",
 	1: "PENDING",
 	2: "COMPLETE",
+	3: "FAILED",
 }
 var CafeRequest_Status_value = map[string]int32{
 	"NEW":      0,
 	"PENDING":  1,
 	"COMPLETE": 2,
+	"FAILED":   3,
 }
 
 func (x CafeRequest_Status) String() string {
 	return proto.EnumName(CafeRequest_Status_name, int32(x))
 }
 func (CafeRequest_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{21, 1}
+	return fileDescriptor_model_aaa56015cda79db6, []int{23, 1}
 }
 
 type CafeHTTPRequest_Type int32
@@ -259,24 +288,62 @@ const (
 	CafeHTTPRequest_PUT    CafeHTTPRequest_Type = 0
 	CafeHTTPRequest_POST   CafeHTTPRequest_Type = 1
 	CafeHTTPRequest_DELETE CafeHTTPRequest_Type = 2
+	CafeHTTPRequest_PATCH  CafeHTTPRequest_Type = 3
 )
 
 var CafeHTTPRequest_Type_name = map[int32]string{
 	0: "PUT",
 	1: "POST",
 	2: "DELETE",
+	3: "PATCH",
 }
 var CafeHTTPRequest_Type_value = map[string]int32{
 	"PUT":    0,
 	"POST":   1,
 	"DELETE": 2,
+	"PATCH":  3,
 }
 
 func (x CafeHTTPRequest_Type) String() string {
 	return proto.EnumName(CafeHTTPRequest_Type_name, int32(x))
 }
 func (CafeHTTPRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{24, 0}
+	return fileDescriptor_model_aaa56015cda79db6, []int{26, 0}
+}
+
+type CafeReplication_Type int32
+
+const (
+	CafeReplication_PIN       CafeReplication_Type = 0
+	CafeReplication_UNPIN     CafeReplication_Type = 1
+	CafeReplication_THREAD    CafeReplication_Type = 2
+	CafeReplication_UNTHREAD  CafeReplication_Type = 3
+	CafeReplication_MESSAGE   CafeReplication_Type = 4
+	CafeReplication_UNMESSAGE CafeReplication_Type = 5
+)
+
+var CafeReplication_Type_name = map[int32]string{
+	0: "PIN",
+	1: "UNPIN",
+	2: "THREAD",
+	3: "UNTHREAD",
+	4: "MESSAGE",
+	5: "UNMESSAGE",
+}
+var CafeReplication_Type_value = map[string]int32{
+	"PIN":       0,
+	"UNPIN":     1,
+	"THREAD":    2,
+	"UNTHREAD":  3,
+	"MESSAGE":   4,
+	"UNMESSAGE": 5,
+}
+
+func (x CafeReplication_Type) String() string {
+	return proto.EnumName(CafeReplication_Type_name, int32(x))
+}
+func (CafeReplication_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_aaa56015cda79db6, []int{35, 0}
 }
 
 type Peer struct {
@@ -296,7 +363,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{0}
+	return fileDescriptor_model_aaa56015cda79db6, []int{0}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peer.Unmarshal(m, b)
@@ -376,7 +443,7 @@ func (m *PeerList) Reset()         { *m = PeerList{} }
 func (m *PeerList) String() string { return proto.CompactTextString(m) }
 func (*PeerList) ProtoMessage()    {}
 func (*PeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{1}
+	return fileDescriptor_model_aaa56015cda79db6, []int{1}
 }
 func (m *PeerList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerList.Unmarshal(m, b)
@@ -416,7 +483,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{2}
+	return fileDescriptor_model_aaa56015cda79db6, []int{2}
 }
 func (m *User) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_User.Unmarshal(m, b)
@@ -472,7 +539,7 @@ func (m *Contact) Reset()         { *m = Contact{} }
 func (m *Contact) String() string { return proto.CompactTextString(m) }
 func (*Contact) ProtoMessage()    {}
 func (*Contact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{3}
+	return fileDescriptor_model_aaa56015cda79db6, []int{3}
 }
 func (m *Contact) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Contact.Unmarshal(m, b)
@@ -538,7 +605,7 @@ func (m *ContactList) Reset()         { *m = ContactList{} }
 func (m *ContactList) String() string { return proto.CompactTextString(m) }
 func (*ContactList) ProtoMessage()    {}
 func (*ContactList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{4}
+	return fileDescriptor_model_aaa56015cda79db6, []int{4}
 }
 func (m *ContactList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContactList.Unmarshal(m, b)
@@ -591,7 +658,7 @@ func (m *Thread) Reset()         { *m = Thread{} }
 func (m *Thread) String() string { return proto.CompactTextString(m) }
 func (*Thread) ProtoMessage()    {}
 func (*Thread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{5}
+	return fileDescriptor_model_aaa56015cda79db6, []int{5}
 }
 func (m *Thread) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Thread.Unmarshal(m, b)
@@ -727,7 +794,7 @@ func (m *ThreadList) Reset()         { *m = ThreadList{} }
 func (m *ThreadList) String() string { return proto.CompactTextString(m) }
 func (*ThreadList) ProtoMessage()    {}
 func (*ThreadList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{6}
+	return fileDescriptor_model_aaa56015cda79db6, []int{6}
 }
 func (m *ThreadList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadList.Unmarshal(m, b)
@@ -767,7 +834,7 @@ func (m *ThreadPeer) Reset()         { *m = ThreadPeer{} }
 func (m *ThreadPeer) String() string { return proto.CompactTextString(m) }
 func (*ThreadPeer) ProtoMessage()    {}
 func (*ThreadPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{7}
+	return fileDescriptor_model_aaa56015cda79db6, []int{7}
 }
 func (m *ThreadPeer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadPeer.Unmarshal(m, b)
@@ -828,7 +895,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{8}
+	return fileDescriptor_model_aaa56015cda79db6, []int{8}
 }
 func (m *Block) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Block.Unmarshal(m, b)
@@ -913,6 +980,8 @@ func (m *Block) GetUser() *User {
 
 type BlockList struct {
 	Items                []*Block `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Next                 string   `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -922,7 +991,7 @@ func (m *BlockList) Reset()         { *m = BlockList{} }
 func (m *BlockList) String() string { return proto.CompactTextString(m) }
 func (*BlockList) ProtoMessage()    {}
 func (*BlockList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{9}
+	return fileDescriptor_model_aaa56015cda79db6, []int{9}
 }
 func (m *BlockList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockList.Unmarshal(m, b)
@@ -949,6 +1018,20 @@ func (m *BlockList) GetItems() []*Block {
 	return nil
 }
 
+func (m *BlockList) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *BlockList) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
 type BlockMessage struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
@@ -963,7 +1046,7 @@ func (m *BlockMessage) Reset()         { *m = BlockMessage{} }
 func (m *BlockMessage) String() string { return proto.CompactTextString(m) }
 func (*BlockMessage) ProtoMessage()    {}
 func (*BlockMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{10}
+	return fileDescriptor_model_aaa56015cda79db6, []int{10}
 }
 func (m *BlockMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockMessage.Unmarshal(m, b)
@@ -1026,7 +1109,7 @@ func (m *Invite) Reset()         { *m = Invite{} }
 func (m *Invite) String() string { return proto.CompactTextString(m) }
 func (*Invite) ProtoMessage()    {}
 func (*Invite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{11}
+	return fileDescriptor_model_aaa56015cda79db6, []int{11}
 }
 func (m *Invite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Invite.Unmarshal(m, b)
@@ -1092,7 +1175,7 @@ func (m *InviteList) Reset()         { *m = InviteList{} }
 func (m *InviteList) String() string { return proto.CompactTextString(m) }
 func (*InviteList) ProtoMessage()    {}
 func (*InviteList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{12}
+	return fileDescriptor_model_aaa56015cda79db6, []int{12}
 }
 func (m *InviteList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteList.Unmarshal(m, b)
@@ -1141,7 +1224,7 @@ func (m *FileIndex) Reset()         { *m = FileIndex{} }
 func (m *FileIndex) String() string { return proto.CompactTextString(m) }
 func (*FileIndex) ProtoMessage()    {}
 func (*FileIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{13}
+	return fileDescriptor_model_aaa56015cda79db6, []int{13}
 }
 func (m *FileIndex) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileIndex.Unmarshal(m, b)
@@ -1262,7 +1345,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{14}
+	return fileDescriptor_model_aaa56015cda79db6, []int{14}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Node.Unmarshal(m, b)
@@ -1347,7 +1430,7 @@ func (m *Link) Reset()         { *m = Link{} }
 func (m *Link) String() string { return proto.CompactTextString(m) }
 func (*Link) ProtoMessage()    {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{15}
+	return fileDescriptor_model_aaa56015cda79db6, []int{15}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Link.Unmarshal(m, b)
@@ -1431,7 +1514,7 @@ func (m *Notification) Reset()         { *m = Notification{} }
 func (m *Notification) String() string { return proto.CompactTextString(m) }
 func (*Notification) ProtoMessage()    {}
 func (*Notification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{16}
+	return fileDescriptor_model_aaa56015cda79db6, []int{16}
 }
 func (m *Notification) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Notification.Unmarshal(m, b)
@@ -1539,7 +1622,7 @@ func (m *NotificationList) Reset()         { *m = NotificationList{} }
 func (m *NotificationList) String() string { return proto.CompactTextString(m) }
 func (*NotificationList) ProtoMessage()    {}
 func (*NotificationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{17}
+	return fileDescriptor_model_aaa56015cda79db6, []int{17}
 }
 func (m *NotificationList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotificationList.Unmarshal(m, b)
@@ -1566,6 +1649,84 @@ func (m *NotificationList) GetItems() []*Notification {
 	return nil
 }
 
+type WebhookMessage struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Webhook              string               `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Body                 string               `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Attempts             int32                `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Next                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=next,proto3" json:"next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *WebhookMessage) Reset()         { *m = WebhookMessage{} }
+func (m *WebhookMessage) String() string { return proto.CompactTextString(m) }
+func (*WebhookMessage) ProtoMessage()    {}
+func (*WebhookMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_aaa56015cda79db6, []int{18}
+}
+func (m *WebhookMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookMessage.Unmarshal(m, b)
+}
+func (m *WebhookMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookMessage.Marshal(b, m, deterministic)
+}
+func (dst *WebhookMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookMessage.Merge(dst, src)
+}
+func (m *WebhookMessage) XXX_Size() int {
+	return xxx_messageInfo_WebhookMessage.Size(m)
+}
+func (m *WebhookMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookMessage proto.InternalMessageInfo
+
+func (m *WebhookMessage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WebhookMessage) GetWebhook() string {
+	if m != nil {
+		return m.Webhook
+	}
+	return ""
+}
+
+func (m *WebhookMessage) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *WebhookMessage) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *WebhookMessage) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *WebhookMessage) GetNext() *timestamp.Timestamp {
+	if m != nil {
+		return m.Next
+	}
+	return nil
+}
+
 type Cafe struct {
 	Peer                 string   `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
 	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -1582,7 +1743,7 @@ func (m *Cafe) Reset()         { *m = Cafe{} }
 func (m *Cafe) String() string { return proto.CompactTextString(m) }
 func (*Cafe) ProtoMessage()    {}
 func (*Cafe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{18}
+	return fileDescriptor_model_aaa56015cda79db6, []int{19}
 }
 func (m *Cafe) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Cafe.Unmarshal(m, b)
@@ -1653,6 +1814,8 @@ type CafeSession struct {
 	Subject              string               `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
 	Type                 string               `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
 	Cafe                 *Cafe                `protobuf:"bytes,8,opt,name=cafe,proto3" json:"cafe,omitempty"`
+	Priority             int32                `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	Health               *CafeHealth          `protobuf:"bytes,10,opt,name=health,proto3" json:"health,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1662,7 +1825,7 @@ func (m *CafeSession) Reset()         { *m = CafeSession{} }
 func (m *CafeSession) String() string { return proto.CompactTextString(m) }
 func (*CafeSession) ProtoMessage()    {}
 func (*CafeSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{19}
+	return fileDescriptor_model_aaa56015cda79db6, []int{20}
 }
 func (m *CafeSession) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeSession.Unmarshal(m, b)
@@ -1738,6 +1901,90 @@ func (m *CafeSession) GetCafe() *Cafe {
 	return nil
 }
 
+func (m *CafeSession) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *CafeSession) GetHealth() *CafeHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+type CafeHealth struct {
+	Status               CafeHealth_Status    `protobuf:"varint,1,opt,name=status,proto3,enum=CafeHealth_Status" json:"status,omitempty"`
+	Failures             int32                `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
+	Latency              int64                `protobuf:"varint,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	Checked              *timestamp.Timestamp `protobuf:"bytes,4,opt,name=checked,proto3" json:"checked,omitempty"`
+	Error                string               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeHealth) Reset()         { *m = CafeHealth{} }
+func (m *CafeHealth) String() string { return proto.CompactTextString(m) }
+func (*CafeHealth) ProtoMessage()    {}
+func (*CafeHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_aaa56015cda79db6, []int{21}
+}
+func (m *CafeHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeHealth.Unmarshal(m, b)
+}
+func (m *CafeHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeHealth.Marshal(b, m, deterministic)
+}
+func (dst *CafeHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeHealth.Merge(dst, src)
+}
+func (m *CafeHealth) XXX_Size() int {
+	return xxx_messageInfo_CafeHealth.Size(m)
+}
+func (m *CafeHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeHealth proto.InternalMessageInfo
+
+func (m *CafeHealth) GetStatus() CafeHealth_Status {
+	if m != nil {
+		return m.Status
+	}
+	return CafeHealth_UNKNOWN
+}
+
+func (m *CafeHealth) GetFailures() int32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *CafeHealth) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *CafeHealth) GetChecked() *timestamp.Timestamp {
+	if m != nil {
+		return m.Checked
+	}
+	return nil
+}
+
+func (m *CafeHealth) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type CafeSessionList struct {
 	Items                []*CafeSession `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -1749,7 +1996,7 @@ func (m *CafeSessionList) Reset()         { *m = CafeSessionList{} }
 func (m *CafeSessionList) String() string { return proto.CompactTextString(m) }
 func (*CafeSessionList) ProtoMessage()    {}
 func (*CafeSessionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{20}
+	return fileDescriptor_model_aaa56015cda79db6, []int{22}
 }
 func (m *CafeSessionList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeSessionList.Unmarshal(m, b)
@@ -1786,6 +2033,8 @@ type CafeRequest struct {
 	Group                string               `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
 	Status               CafeRequest_Status   `protobuf:"varint,9,opt,name=status,proto3,enum=CafeRequest_Status" json:"status,omitempty"`
+	Attempts             int32                `protobuf:"varint,10,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Next                 *timestamp.Timestamp `protobuf:"bytes,11,opt,name=next,proto3" json:"next,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1795,7 +2044,7 @@ func (m *CafeRequest) Reset()         { *m = CafeRequest{} }
 func (m *CafeRequest) String() string { return proto.CompactTextString(m) }
 func (*CafeRequest) ProtoMessage()    {}
 func (*CafeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{21}
+	return fileDescriptor_model_aaa56015cda79db6, []int{23}
 }
 func (m *CafeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeRequest.Unmarshal(m, b)
@@ -1878,6 +2127,20 @@ func (m *CafeRequest) GetStatus() CafeRequest_Status {
 	return CafeRequest_NEW
 }
 
+func (m *CafeRequest) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *CafeRequest) GetNext() *timestamp.Timestamp {
+	if m != nil {
+		return m.Next
+	}
+	return nil
+}
+
 type CafeRequestList struct {
 	Items                []*CafeRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -1889,7 +2152,7 @@ func (m *CafeRequestList) Reset()         { *m = CafeRequestList{} }
 func (m *CafeRequestList) String() string { return proto.CompactTextString(m) }
 func (*CafeRequestList) ProtoMessage()    {}
 func (*CafeRequestList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{22}
+	return fileDescriptor_model_aaa56015cda79db6, []int{24}
 }
 func (m *CafeRequestList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeRequestList.Unmarshal(m, b)
@@ -1923,6 +2186,8 @@ type CafeRequestGroupStatus struct {
 	SizeTotal            int64    `protobuf:"varint,4,opt,name=size_total,json=sizeTotal,proto3" json:"size_total,omitempty"`
 	SizePending          int64    `protobuf:"varint,5,opt,name=size_pending,json=sizePending,proto3" json:"size_pending,omitempty"`
 	SizeComplete         int64    `protobuf:"varint,6,opt,name=size_complete,json=sizeComplete,proto3" json:"size_complete,omitempty"`
+	NumFailed            int32    `protobuf:"varint,7,opt,name=num_failed,json=numFailed,proto3" json:"num_failed,omitempty"`
+	SizeFailed           int64    `protobuf:"varint,8,opt,name=size_failed,json=sizeFailed,proto3" json:"size_failed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1932,7 +2197,7 @@ func (m *CafeRequestGroupStatus) Reset()         { *m = CafeRequestGroupStatus{}
 func (m *CafeRequestGroupStatus) String() string { return proto.CompactTextString(m) }
 func (*CafeRequestGroupStatus) ProtoMessage()    {}
 func (*CafeRequestGroupStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{23}
+	return fileDescriptor_model_aaa56015cda79db6, []int{25}
 }
 func (m *CafeRequestGroupStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeRequestGroupStatus.Unmarshal(m, b)
@@ -1994,11 +2259,26 @@ func (m *CafeRequestGroupStatus) GetSizeComplete() int64 {
 	return 0
 }
 
+func (m *CafeRequestGroupStatus) GetNumFailed() int32 {
+	if m != nil {
+		return m.NumFailed
+	}
+	return 0
+}
+
+func (m *CafeRequestGroupStatus) GetSizeFailed() int64 {
+	if m != nil {
+		return m.SizeFailed
+	}
+	return 0
+}
+
 type CafeHTTPRequest struct {
 	Type                 CafeHTTPRequest_Type `protobuf:"varint,1,opt,name=type,proto3,enum=CafeHTTPRequest_Type" json:"type,omitempty"`
 	Url                  string               `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	Headers              map[string]string    `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Body                 []byte               `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Chunks               []*CafeHTTPRequest   `protobuf:"bytes,5,rep,name=chunks,proto3" json:"chunks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2008,7 +2288,7 @@ func (m *CafeHTTPRequest) Reset()         { *m = CafeHTTPRequest{} }
 func (m *CafeHTTPRequest) String() string { return proto.CompactTextString(m) }
 func (*CafeHTTPRequest) ProtoMessage()    {}
 func (*CafeHTTPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{24}
+	return fileDescriptor_model_aaa56015cda79db6, []int{26}
 }
 func (m *CafeHTTPRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeHTTPRequest.Unmarshal(m, b)
@@ -2056,6 +2336,13 @@ func (m *CafeHTTPRequest) GetBody() []byte {
 	return nil
 }
 
+func (m *CafeHTTPRequest) GetChunks() []*CafeHTTPRequest {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
 type CafeMessage struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
@@ -2070,7 +2357,7 @@ func (m *CafeMessage) Reset()         { *m = CafeMessage{} }
 func (m *CafeMessage) String() string { return proto.CompactTextString(m) }
 func (*CafeMessage) ProtoMessage()    {}
 func (*CafeMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{25}
+	return fileDescriptor_model_aaa56015cda79db6, []int{27}
 }
 func (m *CafeMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeMessage.Unmarshal(m, b)
@@ -2131,7 +2418,7 @@ func (m *CafeClientNonce) Reset()         { *m = CafeClientNonce{} }
 func (m *CafeClientNonce) String() string { return proto.CompactTextString(m) }
 func (*CafeClientNonce) ProtoMessage()    {}
 func (*CafeClientNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{26}
+	return fileDescriptor_model_aaa56015cda79db6, []int{28}
 }
 func (m *CafeClientNonce) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeClientNonce.Unmarshal(m, b)
@@ -2187,7 +2474,7 @@ func (m *CafeClient) Reset()         { *m = CafeClient{} }
 func (m *CafeClient) String() string { return proto.CompactTextString(m) }
 func (*CafeClient) ProtoMessage()    {}
 func (*CafeClient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{27}
+	return fileDescriptor_model_aaa56015cda79db6, []int{29}
 }
 func (m *CafeClient) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeClient.Unmarshal(m, b)
@@ -2253,7 +2540,7 @@ func (m *CafeClientList) Reset()         { *m = CafeClientList{} }
 func (m *CafeClientList) String() string { return proto.CompactTextString(m) }
 func (*CafeClientList) ProtoMessage()    {}
 func (*CafeClientList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{28}
+	return fileDescriptor_model_aaa56015cda79db6, []int{30}
 }
 func (m *CafeClientList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeClientList.Unmarshal(m, b)
@@ -2284,6 +2571,10 @@ type CafeToken struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Value                []byte               `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Scopes               []string             `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Plan                 string               `protobuf:"bytes,5,opt,name=plan,proto3" json:"plan,omitempty"`
+	Expires              *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expires,proto3" json:"expires,omitempty"`
+	Label                string               `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2293,7 +2584,7 @@ func (m *CafeToken) Reset()         { *m = CafeToken{} }
 func (m *CafeToken) String() string { return proto.CompactTextString(m) }
 func (*CafeToken) ProtoMessage()    {}
 func (*CafeToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{29}
+	return fileDescriptor_model_aaa56015cda79db6, []int{31}
 }
 func (m *CafeToken) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeToken.Unmarshal(m, b)
@@ -2334,20 +2625,50 @@ func (m *CafeToken) GetDate() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *CafeToken) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *CafeToken) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *CafeToken) GetExpires() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+func (m *CafeToken) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type CafeClientThread struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Client               string   `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
-	Ciphertext           []byte   `protobuf:"bytes,3,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Client               string               `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Ciphertext           []byte               `protobuf:"bytes,3,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Version              int32                `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *CafeClientThread) Reset()         { *m = CafeClientThread{} }
 func (m *CafeClientThread) String() string { return proto.CompactTextString(m) }
 func (*CafeClientThread) ProtoMessage()    {}
 func (*CafeClientThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{30}
+	return fileDescriptor_model_aaa56015cda79db6, []int{32}
 }
 func (m *CafeClientThread) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeClientThread.Unmarshal(m, b)
@@ -2388,11 +2709,26 @@ func (m *CafeClientThread) GetCiphertext() []byte {
 	return nil
 }
 
+func (m *CafeClientThread) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CafeClientThread) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
 type CafeClientMessage struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
 	Client               string               `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Size                 int64                `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2402,7 +2738,7 @@ func (m *CafeClientMessage) Reset()         { *m = CafeClientMessage{} }
 func (m *CafeClientMessage) String() string { return proto.CompactTextString(m) }
 func (*CafeClientMessage) ProtoMessage()    {}
 func (*CafeClientMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{31}
+	return fileDescriptor_model_aaa56015cda79db6, []int{33}
 }
 func (m *CafeClientMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeClientMessage.Unmarshal(m, b)
@@ -2450,6 +2786,277 @@ func (m *CafeClientMessage) GetDate() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *CafeClientMessage) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type CafeClientPin struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Client               string               `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Size                 int64                `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeClientPin) Reset()         { *m = CafeClientPin{} }
+func (m *CafeClientPin) String() string { return proto.CompactTextString(m) }
+func (*CafeClientPin) ProtoMessage()    {}
+func (*CafeClientPin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_aaa56015cda79db6, []int{34}
+}
+func (m *CafeClientPin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientPin.Unmarshal(m, b)
+}
+func (m *CafeClientPin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientPin.Marshal(b, m, deterministic)
+}
+func (dst *CafeClientPin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientPin.Merge(dst, src)
+}
+func (m *CafeClientPin) XXX_Size() int {
+	return xxx_messageInfo_CafeClientPin.Size(m)
+}
+func (m *CafeClientPin) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientPin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientPin proto.InternalMessageInfo
+
+func (m *CafeClientPin) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeClientPin) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *CafeClientPin) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CafeClientPin) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type CafeReplication struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 CafeReplication_Type `protobuf:"varint,2,opt,name=type,proto3,enum=CafeReplication_Type" json:"type,omitempty"`
+	Client               string               `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Target               string               `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Attempts             int32                `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Neighbor             string               `protobuf:"bytes,7,opt,name=neighbor,proto3" json:"neighbor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeReplication) Reset()         { *m = CafeReplication{} }
+func (m *CafeReplication) String() string { return proto.CompactTextString(m) }
+func (*CafeReplication) ProtoMessage()    {}
+func (*CafeReplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_aaa56015cda79db6, []int{35}
+}
+func (m *CafeReplication) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplication.Unmarshal(m, b)
+}
+func (m *CafeReplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplication.Marshal(b, m, deterministic)
+}
+func (dst *CafeReplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplication.Merge(dst, src)
+}
+func (m *CafeReplication) XXX_Size() int {
+	return xxx_messageInfo_CafeReplication.Size(m)
+}
+func (m *CafeReplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplication proto.InternalMessageInfo
+
+func (m *CafeReplication) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeReplication) GetType() CafeReplication_Type {
+	if m != nil {
+		return m.Type
+	}
+	return CafeReplication_PIN
+}
+
+func (m *CafeReplication) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *CafeReplication) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *CafeReplication) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *CafeReplication) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *CafeReplication) GetNeighbor() string {
+	if m != nil {
+		return m.Neighbor
+	}
+	return ""
+}
+
+type ApiKey struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes               []string             `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Expires              *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	Token                string               `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ApiKey) Reset()         { *m = ApiKey{} }
+func (m *ApiKey) String() string { return proto.CompactTextString(m) }
+func (*ApiKey) ProtoMessage()    {}
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_aaa56015cda79db6, []int{36}
+}
+func (m *ApiKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKey.Unmarshal(m, b)
+}
+func (m *ApiKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKey.Marshal(b, m, deterministic)
+}
+func (dst *ApiKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKey.Merge(dst, src)
+}
+func (m *ApiKey) XXX_Size() int {
+	return xxx_messageInfo_ApiKey.Size(m)
+}
+func (m *ApiKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKey proto.InternalMessageInfo
+
+func (m *ApiKey) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApiKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiKey) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *ApiKey) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *ApiKey) GetExpires() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+func (m *ApiKey) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type ApiKeyList struct {
+	Items                []*ApiKey `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ApiKeyList) Reset()         { *m = ApiKeyList{} }
+func (m *ApiKeyList) String() string { return proto.CompactTextString(m) }
+func (*ApiKeyList) ProtoMessage()    {}
+func (*ApiKeyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_aaa56015cda79db6, []int{37}
+}
+func (m *ApiKeyList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKeyList.Unmarshal(m, b)
+}
+func (m *ApiKeyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKeyList.Marshal(b, m, deterministic)
+}
+func (dst *ApiKeyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKeyList.Merge(dst, src)
+}
+func (m *ApiKeyList) XXX_Size() int {
+	return xxx_messageInfo_ApiKeyList.Size(m)
+}
+func (m *ApiKeyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKeyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKeyList proto.InternalMessageInfo
+
+func (m *ApiKeyList) GetItems() []*ApiKey {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Peer)(nil), "Peer")
 	proto.RegisterType((*PeerList)(nil), "PeerList")
@@ -2472,8 +3079,10 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "Link.OptsEntry")
 	proto.RegisterType((*Notification)(nil), "Notification")
 	proto.RegisterType((*NotificationList)(nil), "NotificationList")
+	proto.RegisterType((*WebhookMessage)(nil), "WebhookMessage")
 	proto.RegisterType((*Cafe)(nil), "Cafe")
 	proto.RegisterType((*CafeSession)(nil), "CafeSession")
+	proto.RegisterType((*CafeHealth)(nil), "CafeHealth")
 	proto.RegisterType((*CafeSessionList)(nil), "CafeSessionList")
 	proto.RegisterType((*CafeRequest)(nil), "CafeRequest")
 	proto.RegisterType((*CafeRequestList)(nil), "CafeRequestList")
@@ -2487,154 +3096,189 @@ func init() {
 	proto.RegisterType((*CafeToken)(nil), "CafeToken")
 	proto.RegisterType((*CafeClientThread)(nil), "CafeClientThread")
 	proto.RegisterType((*CafeClientMessage)(nil), "CafeClientMessage")
+	proto.RegisterType((*CafeClientPin)(nil), "CafeClientPin")
+	proto.RegisterType((*CafeReplication)(nil), "CafeReplication")
+	proto.RegisterType((*ApiKey)(nil), "ApiKey")
+	proto.RegisterType((*ApiKeyList)(nil), "ApiKeyList")
 	proto.RegisterEnum("Thread_Type", Thread_Type_name, Thread_Type_value)
 	proto.RegisterEnum("Thread_Sharing", Thread_Sharing_name, Thread_Sharing_value)
 	proto.RegisterEnum("Thread_State", Thread_State_name, Thread_State_value)
 	proto.RegisterEnum("Block_BlockType", Block_BlockType_name, Block_BlockType_value)
 	proto.RegisterEnum("Notification_Type", Notification_Type_name, Notification_Type_value)
+	proto.RegisterEnum("CafeHealth_Status", CafeHealth_Status_name, CafeHealth_Status_value)
 	proto.RegisterEnum("CafeRequest_Type", CafeRequest_Type_name, CafeRequest_Type_value)
 	proto.RegisterEnum("CafeRequest_Status", CafeRequest_Status_name, CafeRequest_Status_value)
 	proto.RegisterEnum("CafeHTTPRequest_Type", CafeHTTPRequest_Type_name, CafeHTTPRequest_Type_value)
-}
-
-func init() { proto.RegisterFile("model.proto", fileDescriptor_model_fe102913065d6e40) }
-
-var fileDescriptor_model_fe102913065d6e40 = []byte{
-	// 2174 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x18, 0x4d, 0x93, 0xdb, 0x48,
-	0x35, 0xb2, 0x24, 0xdb, 0x7a, 0xf6, 0xcc, 0x68, 0x3b, 0x21, 0xab, 0x9d, 0x24, 0x9b, 0x59, 0x85,
-	0x84, 0xa4, 0xb2, 0x78, 0xa9, 0x59, 0x20, 0xa9, 0xbd, 0x50, 0x8e, 0x47, 0x99, 0x18, 0x3c, 0xb6,
-	0x4b, 0xf6, 0x84, 0x65, 0x2f, 0x2e, 0x8d, 0xdc, 0x33, 0xd6, 0x8e, 0x2d, 0x79, 0x25, 0x39, 0x9b,
-	0x50, 0x45, 0xed, 0x05, 0x28, 0xae, 0x14, 0x47, 0xfe, 0x05, 0xbf, 0x81, 0x1f, 0xc1, 0x0d, 0x8a,
-	0x1b, 0x77, 0x8a, 0x23, 0x45, 0xbd, 0xd7, 0xdd, 0xb6, 0x9c, 0x99, 0x0c, 0x33, 0xd4, 0x72, 0xb1,
-	0xfb, 0x7d, 0x74, 0xbf, 0xd7, 0xef, 0xbb, 0x05, 0xb5, 0x59, 0x32, 0xe6, 0xd3, 0xc6, 0x3c, 0x4d,
-	0xf2, 0x64, 0xfb, 0xee, 0x49, 0x92, 0x9c, 0x4c, 0xf9, 0x27, 0x04, 0x1d, 0x2d, 0x8e, 0x3f, 0xc9,
-	0xa3, 0x19, 0xcf, 0xf2, 0x60, 0x36, 0x97, 0x0c, 0xb7, 0xdf, 0x66, 0xc8, 0xf2, 0x74, 0x11, 0xe6,
-	0x92, 0xba, 0x31, 0xe3, 0x59, 0x16, 0x9c, 0x70, 0x01, 0xba, 0xff, 0xd0, 0xc0, 0xe8, 0x73, 0x9e,
-	0xb2, 0x4d, 0x28, 0x45, 0x63, 0x47, 0xdb, 0xd1, 0x1e, 0x5a, 0x7e, 0x29, 0x1a, 0x33, 0x07, 0x2a,
-	0xc1, 0x78, 0x9c, 0xf2, 0x2c, 0x73, 0x4a, 0x84, 0x54, 0x20, 0x63, 0x60, 0xc4, 0xc1, 0x8c, 0x3b,
-	0x3a, 0xa1, 0x69, 0xcd, 0x6e, 0x42, 0x39, 0x78, 0x15, 0xe4, 0x41, 0xea, 0x18, 0x84, 0x95, 0x10,
-	0xbb, 0x0b, 0x95, 0x28, 0x3e, 0x4a, 0x5e, 0xf3, 0xcc, 0x31, 0x77, 0xf4, 0x87, 0xb5, 0x5d, 0xb3,
-	0xd1, 0x0a, 0x8e, 0xb9, 0xaf, 0xb0, 0xec, 0x87, 0x50, 0x09, 0x53, 0x1e, 0xe4, 0x7c, 0xec, 0x94,
-	0x77, 0xb4, 0x87, 0xb5, 0xdd, 0xed, 0x86, 0x50, 0xbf, 0xa1, 0xd4, 0x6f, 0x0c, 0xd5, 0xfd, 0x7c,
-	0xc5, 0x8a, 0xbb, 0x16, 0xf3, 0x31, 0xed, 0xaa, 0xfc, 0xf7, 0x5d, 0x92, 0xd5, 0xfd, 0x1e, 0x54,
-	0xf1, 0xaa, 0x9d, 0x28, 0xcb, 0xd9, 0x2d, 0x30, 0xa3, 0x9c, 0xcf, 0x32, 0x47, 0x93, 0x6a, 0x21,
-	0xc5, 0x17, 0x38, 0xb7, 0x03, 0xc6, 0x61, 0xc6, 0xd3, 0xa2, 0x0d, 0xb4, 0xf3, 0x6d, 0x50, 0x3a,
-	0xd7, 0x06, 0x7a, 0xd1, 0x06, 0xee, 0x6f, 0x35, 0xa8, 0xb4, 0x92, 0x38, 0x0f, 0xc2, 0xfc, 0xdb,
-	0x39, 0x11, 0x95, 0x9f, 0x73, 0x9e, 0x66, 0x8e, 0xb1, 0xa6, 0x3c, 0xe1, 0x50, 0x44, 0x3e, 0x49,
-	0x79, 0x30, 0x16, 0x26, 0xb7, 0x7c, 0x05, 0xba, 0xdf, 0x87, 0x9a, 0xd4, 0x83, 0x4c, 0xf0, 0xe1,
-	0xba, 0x09, 0xaa, 0x0d, 0x49, 0x54, 0x56, 0xf8, 0xbb, 0x01, 0xe5, 0x21, 0x6d, 0x3d, 0x13, 0x1c,
-	0x36, 0xe8, 0xa7, 0xfc, 0x8d, 0xd4, 0x15, 0x97, 0xc8, 0x91, 0x9d, 0x92, 0x9a, 0x75, 0xbf, 0x94,
-	0x9d, 0x2e, 0xaf, 0x63, 0xac, 0x5f, 0x27, 0x0b, 0x27, 0x7c, 0x16, 0x38, 0xa6, 0xb8, 0x8e, 0x80,
-	0xd8, 0x6d, 0xb0, 0xa2, 0x38, 0xca, 0xa3, 0x20, 0x4f, 0x52, 0x8a, 0x02, 0xcb, 0x5f, 0x21, 0xd8,
-	0x0e, 0x18, 0xf9, 0x9b, 0x39, 0x27, 0x47, 0x6f, 0xee, 0xd6, 0x1b, 0x42, 0xa5, 0xc6, 0xf0, 0xcd,
-	0x9c, 0xfb, 0x44, 0x61, 0x8f, 0xa0, 0x92, 0x4d, 0x82, 0x34, 0x8a, 0x4f, 0x9c, 0x2a, 0x31, 0x6d,
-	0x29, 0xa6, 0x81, 0x40, 0xfb, 0x8a, 0x8e, 0xa2, 0xbe, 0x9e, 0x44, 0x39, 0x9f, 0x46, 0x59, 0xee,
-	0x58, 0x64, 0x9e, 0x15, 0x82, 0xdd, 0x03, 0x33, 0xcb, 0x83, 0x9c, 0x3b, 0x40, 0xc7, 0x6c, 0x2c,
-	0x8f, 0x41, 0xa4, 0x2f, 0x68, 0x78, 0xb3, 0x09, 0x0f, 0xc6, 0x4e, 0x4d, 0xdc, 0x0c, 0xd7, 0xec,
-	0x3e, 0x00, 0xfe, 0x8f, 0x8e, 0xa6, 0x49, 0x78, 0xea, 0x70, 0x0a, 0xc9, 0x72, 0xe3, 0x19, 0x42,
-	0xbe, 0x85, 0x14, 0x5a, 0xb2, 0x07, 0x50, 0x13, 0x57, 0x1e, 0xc5, 0xc9, 0x98, 0x3b, 0xc7, 0xc4,
-	0x67, 0x36, 0xba, 0xc9, 0x98, 0xfb, 0x20, 0x28, 0xb8, 0x66, 0x77, 0xa1, 0x46, 0x27, 0x8d, 0xc2,
-	0x64, 0x11, 0xe7, 0xce, 0xc9, 0x8e, 0xf6, 0xd0, 0xf4, 0x81, 0x50, 0x2d, 0xc4, 0xb0, 0x3b, 0x00,
-	0xe8, 0x6c, 0x49, 0x9f, 0x10, 0xdd, 0x42, 0x0c, 0x91, 0xdd, 0xa7, 0x60, 0xa0, 0x79, 0x58, 0x0d,
-	0x2a, 0x7d, 0xbf, 0xfd, 0xb2, 0x39, 0xf4, 0xec, 0x6b, 0x6c, 0x03, 0x2c, 0xdf, 0x6b, 0xee, 0x8d,
-	0x7a, 0xdd, 0xce, 0x2f, 0x6c, 0x8d, 0x01, 0x94, 0xfb, 0x87, 0xcf, 0x3a, 0xed, 0x96, 0x5d, 0x62,
-	0x55, 0x30, 0x7a, 0x7d, 0xaf, 0x6b, 0xeb, 0xee, 0x8f, 0xa1, 0x22, 0x6d, 0xc6, 0x36, 0x01, 0xba,
-	0xbd, 0xe1, 0x68, 0xf0, 0xa2, 0xe9, 0x7b, 0x7b, 0xf6, 0x35, 0xb6, 0x05, 0xb5, 0x76, 0xf7, 0x65,
-	0x7b, 0xe8, 0x15, 0x4e, 0x90, 0xc4, 0x92, 0xfb, 0x04, 0x4c, 0x32, 0x12, 0xb3, 0xa1, 0xde, 0xe9,
-	0x35, 0xf7, 0xda, 0xdd, 0xfd, 0xd1, 0xb0, 0xd9, 0xee, 0xd8, 0xd7, 0x90, 0x0d, 0x31, 0xde, 0x9e,
-	0xad, 0x15, 0xa9, 0x2f, 0xbc, 0x26, 0x6e, 0x7c, 0x0c, 0x20, 0x8c, 0x4c, 0x21, 0x79, 0x67, 0x3d,
-	0x24, 0x2b, 0xd2, 0x01, 0x2a, 0x22, 0xfb, 0x8a, 0xf9, 0xdc, 0x8a, 0x75, 0x13, 0xca, 0x22, 0xd2,
-	0x65, 0x5c, 0x4a, 0x88, 0x6d, 0x43, 0xf5, 0x6b, 0x3e, 0x0d, 0x93, 0x19, 0x1f, 0x53, 0x80, 0x56,
-	0xfd, 0x25, 0xec, 0xfe, 0x46, 0x07, 0x53, 0xf8, 0xe6, 0xb2, 0xa7, 0x61, 0x4e, 0x2e, 0xf2, 0x49,
-	0xb2, 0xca, 0x49, 0x82, 0xd8, 0x77, 0x65, 0x98, 0x1a, 0x14, 0x3a, 0xb6, 0x70, 0xbe, 0xf8, 0x2d,
-	0x84, 0x6a, 0x03, 0x0c, 0xac, 0x45, 0x94, 0x00, 0x17, 0x57, 0x2d, 0xe2, 0xc3, 0x64, 0x9e, 0x07,
-	0x29, 0x8f, 0xf3, 0xcc, 0x29, 0x8b, 0x64, 0x96, 0x20, 0xe9, 0x17, 0xa4, 0x27, 0x3c, 0xa7, 0xc4,
-	0x40, 0xfd, 0x08, 0xc2, 0xf0, 0x3c, 0x4a, 0xc6, 0x6f, 0x28, 0x13, 0x2c, 0x9f, 0xd6, 0xec, 0x03,
-	0x30, 0x16, 0x19, 0x4f, 0x65, 0x60, 0x9a, 0x0d, 0x2c, 0x6e, 0x3e, 0xa1, 0xdc, 0x5f, 0x6b, 0x60,
-	0x2d, 0x95, 0x64, 0x16, 0x98, 0x07, 0x9e, 0xbf, 0xef, 0x09, 0xb7, 0xb5, 0xf7, 0xbb, 0x3d, 0xdf,
-	0xb3, 0x35, 0x8c, 0x8f, 0xe7, 0x9d, 0xe6, 0xbe, 0x88, 0x94, 0x9f, 0xf6, 0xda, 0x5d, 0x5b, 0x67,
-	0x75, 0xa8, 0x36, 0xbb, 0xdd, 0xde, 0x61, 0xb7, 0xe5, 0xd9, 0x06, 0x6e, 0xec, 0x78, 0xcd, 0x97,
-	0x9e, 0x6d, 0x22, 0xcb, 0xd0, 0xfb, 0x7c, 0x68, 0x97, 0x11, 0xf9, 0xbc, 0xdd, 0xf1, 0x06, 0x76,
-	0x05, 0x23, 0xb1, 0xd5, 0x3b, 0x38, 0xf0, 0xba, 0x43, 0xbb, 0x8a, 0x1c, 0x9d, 0xf6, 0xcf, 0x3c,
-	0xdb, 0x62, 0x15, 0xd0, 0x9b, 0x7b, 0x7b, 0xf6, 0xae, 0xfb, 0x48, 0x6a, 0x41, 0x51, 0x70, 0x7b,
-	0x3d, 0x0a, 0x54, 0x22, 0xc9, 0x20, 0xf8, 0x06, 0xea, 0x04, 0x1f, 0x88, 0x3e, 0x76, 0xc6, 0x71,
-	0x0c, 0x0c, 0xcc, 0x04, 0x55, 0x48, 0x71, 0xcd, 0x6e, 0x81, 0xce, 0xe3, 0x57, 0xe4, 0xb1, 0xda,
-	0xae, 0xd5, 0xf0, 0xe2, 0x57, 0x7c, 0x9a, 0xcc, 0xb9, 0x8f, 0xd8, 0xa5, 0x4f, 0x8c, 0xcb, 0xf9,
-	0xc4, 0xfd, 0x83, 0x06, 0xe5, 0x76, 0xfc, 0x2a, 0xca, 0xcf, 0xca, 0xbe, 0x01, 0xa6, 0x28, 0x01,
-	0x25, 0x2a, 0x84, 0x02, 0x38, 0xb7, 0x61, 0x52, 0x63, 0xc4, 0x33, 0x52, 0x29, 0x57, 0x16, 0x71,
-	0x85, 0xbd, 0x6a, 0xa4, 0x60, 0x22, 0x09, 0xa5, 0xce, 0x4f, 0x24, 0x41, 0x53, 0x36, 0xfc, 0x73,
-	0x09, 0xac, 0xe7, 0xd1, 0x94, 0xb7, 0xe3, 0x31, 0x7f, 0x8d, 0xfa, 0xcd, 0xa2, 0xe9, 0x54, 0xde,
-	0x83, 0xd6, 0x98, 0x34, 0xe1, 0x84, 0x87, 0xa7, 0xd9, 0x62, 0x26, 0x2d, 0xb9, 0x84, 0xa9, 0x8e,
-	0x27, 0x8b, 0x34, 0x54, 0x37, 0x92, 0x10, 0x9e, 0x93, 0xcc, 0xf3, 0x4c, 0xd5, 0x7c, 0x5c, 0x53,
-	0xb5, 0x0c, 0xb2, 0x89, 0xac, 0xf8, 0xb4, 0x56, 0xdd, 0xa3, 0xbc, 0xea, 0x1e, 0x37, 0xc0, 0x9c,
-	0xf1, 0x71, 0x14, 0xc8, 0x58, 0x16, 0xc0, 0xd2, 0x6e, 0xd5, 0x82, 0xdd, 0x18, 0x18, 0x59, 0xf4,
-	0x4b, 0xee, 0x58, 0x3b, 0xda, 0x43, 0xdd, 0xa7, 0x35, 0xfb, 0x01, 0x98, 0xc1, 0x78, 0xcc, 0xc7,
-	0x54, 0xb6, 0x2f, 0xb6, 0x95, 0x60, 0x64, 0x8f, 0xc1, 0x98, 0xf1, 0x3c, 0xa0, 0x1a, 0x5e, 0xdb,
-	0x7d, 0xff, 0xcc, 0x86, 0x01, 0x4d, 0x4c, 0x3e, 0x31, 0x51, 0x43, 0xa5, 0xdc, 0xca, 0x9c, 0xba,
-	0x6c, 0xa8, 0x02, 0x74, 0xff, 0x5a, 0x02, 0x83, 0x0a, 0xb6, 0xd2, 0x54, 0x2b, 0x68, 0x6a, 0x83,
-	0x3e, 0x8f, 0x62, 0x32, 0x5e, 0xd5, 0xc7, 0x25, 0x36, 0x9f, 0xf9, 0x34, 0x88, 0xe2, 0x9c, 0xbf,
-	0xce, 0x65, 0x25, 0x5a, 0x21, 0x96, 0x5e, 0x30, 0x0a, 0x5e, 0xb8, 0x27, 0x2d, 0x2a, 0x66, 0xa7,
-	0x2d, 0xea, 0x14, 0x8d, 0xde, 0x3c, 0xcf, 0xbc, 0x38, 0x4f, 0xdf, 0x48, 0x13, 0x3f, 0x85, 0xda,
-	0x97, 0x59, 0x12, 0x8f, 0x64, 0x6f, 0x2d, 0x5f, 0x7c, 0x27, 0x40, 0xde, 0x81, 0x68, 0xbc, 0x0f,
-	0xc0, 0x9c, 0x46, 0xf1, 0x69, 0xe6, 0x54, 0xe9, 0x7c, 0x5b, 0x9c, 0xdf, 0x41, 0x94, 0x10, 0x20,
-	0xc8, 0xdb, 0x4f, 0xc0, 0x5a, 0x0a, 0x55, 0xde, 0xd3, 0xd6, 0xbc, 0xf7, 0x2a, 0x98, 0x2e, 0xd4,
-	0xec, 0x22, 0x80, 0xcf, 0x4a, 0x4f, 0xb5, 0xed, 0x9f, 0x00, 0xac, 0x4e, 0x3b, 0x67, 0xe7, 0xad,
-	0xe2, 0x4e, 0xcc, 0x01, 0xe4, 0x2e, 0x1c, 0xe0, 0xfe, 0x53, 0x03, 0x03, 0x71, 0xb8, 0x77, 0x91,
-	0x29, 0x03, 0xe3, 0xf2, 0xff, 0x62, 0x5f, 0x14, 0xf5, 0xed, 0xd9, 0xf7, 0x7f, 0xb6, 0x9b, 0xfb,
-	0x17, 0x1d, 0xea, 0xdd, 0x24, 0x8f, 0x8e, 0xa3, 0x30, 0xc8, 0xa3, 0x24, 0x3e, 0x53, 0x68, 0x54,
-	0x75, 0x28, 0x5d, 0xb2, 0x8f, 0xdc, 0x00, 0x33, 0x08, 0xf3, 0x65, 0xd3, 0x12, 0x00, 0x46, 0x76,
-	0xb6, 0x38, 0xfa, 0x92, 0x87, 0xb9, 0xb4, 0x8a, 0x02, 0xd9, 0x47, 0x50, 0x97, 0xcb, 0xd1, 0x98,
-	0x67, 0xa1, 0x4c, 0xdf, 0x9a, 0xc4, 0xed, 0xf1, 0x2c, 0x5c, 0xd5, 0x3a, 0x91, 0xc7, 0xb2, 0xd6,
-	0xbd, 0xab, 0x2d, 0x3d, 0x90, 0xed, 0x51, 0x0c, 0x68, 0xac, 0x51, 0xbc, 0x5d, 0x71, 0x96, 0x53,
-	0xed, 0xcb, 0x2a, 0xb4, 0x2f, 0x06, 0x06, 0x35, 0x62, 0x20, 0x97, 0xd2, 0xfa, 0xa2, 0x96, 0xf6,
-	0x47, 0x4d, 0x8e, 0x3f, 0xd7, 0x61, 0x4b, 0x4e, 0x2c, 0xbe, 0xd7, 0xf2, 0xda, 0x2f, 0x69, 0x8c,
-	0x79, 0x1f, 0xae, 0x37, 0x5b, 0xad, 0xde, 0x61, 0x77, 0x38, 0xea, 0x7b, 0x9e, 0x3f, 0xc2, 0x76,
-	0x46, 0xb3, 0xc9, 0x16, 0xd4, 0x8a, 0x88, 0x12, 0x0e, 0x4c, 0x84, 0xe8, 0x78, 0xcf, 0x87, 0xb6,
-	0xce, 0xde, 0x83, 0x8d, 0x03, 0x6f, 0x30, 0x68, 0xee, 0x7b, 0xa3, 0xe6, 0x1e, 0x8e, 0x33, 0x06,
-	0x6e, 0xa1, 0x06, 0x27, 0x11, 0x26, 0xf2, 0xc8, 0x36, 0x27, 0x51, 0x65, 0x1c, 0xa3, 0xb0, 0xd9,
-	0x49, 0xb8, 0xe2, 0x3e, 0x01, 0xbb, 0x78, 0xf7, 0x8e, 0x9c, 0x3b, 0x8b, 0xd5, 0x7a, 0x63, 0xcd,
-	0x3a, 0xaa, 0x66, 0xff, 0x4e, 0x03, 0x03, 0xdf, 0x4e, 0xcb, 0x06, 0xa7, 0x15, 0x1a, 0xdc, 0xbb,
-	0x5f, 0x6b, 0x36, 0xe8, 0xc1, 0x3c, 0x92, 0x7e, 0xc7, 0x25, 0x96, 0x76, 0x8a, 0x93, 0x30, 0x51,
-	0xc9, 0xb0, 0x84, 0xa9, 0x90, 0xe1, 0x68, 0x2a, 0xcb, 0x35, 0xae, 0x29, 0xf5, 0xd2, 0xa9, 0x2a,
-	0xd7, 0x8b, 0x74, 0xea, 0xfe, 0x4b, 0x83, 0x1a, 0xaa, 0x32, 0xe0, 0x59, 0x76, 0x5e, 0x74, 0xe2,
-	0x8c, 0x14, 0x86, 0x2b, 0x65, 0x24, 0xc4, 0x3e, 0x06, 0x9d, 0xbf, 0x9e, 0xcb, 0x36, 0x7c, 0x51,
-	0xd0, 0x22, 0x1b, 0xde, 0x29, 0xe5, 0xc7, 0x29, 0xcf, 0x26, 0x2a, 0x3a, 0x25, 0x88, 0xd1, 0x9f,
-	0xe2, 0x41, 0x97, 0xe8, 0x8d, 0xa9, 0x3c, 0x49, 0xc5, 0x79, 0x79, 0x3d, 0xce, 0x59, 0xe1, 0x71,
-	0x61, 0xc9, 0x10, 0xfc, 0x00, 0x8c, 0x30, 0x38, 0x16, 0xa1, 0xba, 0x7c, 0xb0, 0x12, 0xca, 0xfd,
-	0x11, 0x6c, 0x15, 0xee, 0x4d, 0xbe, 0x73, 0xd7, 0x7d, 0x57, 0x6f, 0x14, 0x18, 0x94, 0xeb, 0x7e,
-	0xaf, 0x0b, 0x7b, 0xf9, 0xfc, 0xab, 0x05, 0xcf, 0xf2, 0x4b, 0x8d, 0x2c, 0xab, 0x44, 0xd2, 0xd7,
-	0x12, 0x49, 0x69, 0x67, 0x9c, 0xd1, 0x8e, 0xdd, 0x97, 0x97, 0x31, 0x29, 0xc7, 0xde, 0x6b, 0x14,
-	0x44, 0xbe, 0x95, 0x62, 0xd4, 0x42, 0x2b, 0x85, 0x16, 0x7a, 0x03, 0xcc, 0x93, 0x34, 0x59, 0xcc,
-	0x65, 0xaf, 0x15, 0xc0, 0xb2, 0xca, 0x94, 0x2f, 0x59, 0x65, 0x1e, 0x43, 0x19, 0xdf, 0x48, 0x8b,
-	0x8c, 0xd2, 0x77, 0x73, 0xf7, 0xfa, 0x9a, 0x0a, 0x03, 0x22, 0xf9, 0x92, 0xc5, 0xed, 0xc9, 0x2c,
-	0xb5, 0xc0, 0x1c, 0x0c, 0x71, 0xce, 0xbc, 0x86, 0x53, 0xe2, 0x61, 0x57, 0x00, 0x3a, 0xbe, 0x15,
-	0x68, 0x39, 0x1a, 0xbe, 0xc0, 0x77, 0x8b, 0xad, 0x31, 0x06, 0x9b, 0x92, 0xac, 0x70, 0x34, 0x78,
-	0xb6, 0xbb, 0xcf, 0x7a, 0x9f, 0xdb, 0x25, 0xf7, 0x63, 0x28, 0x0b, 0x11, 0x38, 0x56, 0x76, 0xbd,
-	0x9f, 0x8b, 0x03, 0xfb, 0x5e, 0x17, 0xdf, 0x1b, 0xb6, 0x86, 0x13, 0x6b, 0xab, 0x77, 0xd0, 0xef,
-	0x78, 0x43, 0xcf, 0x2e, 0x29, 0x57, 0x4a, 0xe5, 0xde, 0xed, 0x4a, 0xc9, 0xa0, 0x5c, 0xf9, 0x37,
-	0x0d, 0x6e, 0x16, 0xd0, 0xfb, 0x68, 0x27, 0x29, 0xf5, 0x16, 0x58, 0xf1, 0x62, 0x36, 0xca, 0x93,
-	0x3c, 0x10, 0xb3, 0x94, 0xe9, 0x57, 0xe3, 0xc5, 0x6c, 0x88, 0x30, 0x3e, 0xe9, 0x90, 0x38, 0xe7,
-	0xf1, 0x18, 0xdf, 0xa9, 0x25, 0xf1, 0xa4, 0x8b, 0x17, 0xb3, 0xbe, 0xc0, 0x60, 0xc5, 0x45, 0x86,
-	0x30, 0x99, 0xcd, 0xa7, 0x3c, 0x17, 0xa3, 0x95, 0xe9, 0xe3, 0xa6, 0x96, 0x44, 0xe1, 0xab, 0x0f,
-	0x9d, 0x25, 0x25, 0x18, 0xe4, 0x3e, 0x0b, 0x31, 0x42, 0x04, 0xd6, 0x6c, 0x24, 0x2b, 0x19, 0x26,
-	0x31, 0xd4, 0x10, 0xa7, 0x84, 0xdc, 0x83, 0x0d, 0x62, 0x59, 0x4a, 0x29, 0x13, 0x0f, 0xed, 0x53,
-	0x62, 0xdc, 0x7f, 0x6b, 0xc2, 0x34, 0x2f, 0x86, 0xc3, 0xbe, 0x8a, 0xd8, 0x47, 0x32, 0xb4, 0x34,
-	0xf2, 0xeb, 0x77, 0x1a, 0x6f, 0xd1, 0x8b, 0xe1, 0x25, 0xcb, 0x45, 0x69, 0x59, 0x2e, 0xd8, 0x13,
-	0xa8, 0xe0, 0x1b, 0x98, 0xa7, 0x99, 0xa3, 0x93, 0x65, 0xef, 0x9c, 0xd9, 0xff, 0x42, 0xd0, 0x45,
-	0xdb, 0x55, 0xdc, 0xcb, 0x66, 0x60, 0xd0, 0x34, 0x4d, 0xeb, 0xed, 0xcf, 0xa0, 0x5e, 0x64, 0xbe,
-	0x52, 0x5b, 0xbd, 0x2f, 0x43, 0xae, 0x02, 0x7a, 0xff, 0x70, 0x68, 0x5f, 0xc3, 0x97, 0x48, 0xbf,
-	0x37, 0x18, 0x8a, 0xc7, 0xec, 0x9e, 0x27, 0x43, 0xe3, 0x57, 0x22, 0x5b, 0xaf, 0xf2, 0xc0, 0x50,
-	0x99, 0xa2, 0x5f, 0x32, 0x53, 0xb6, 0xa1, 0x1a, 0xe4, 0x39, 0x9f, 0xa9, 0x71, 0xd9, 0xf4, 0x97,
-	0xb0, 0xfb, 0x95, 0x30, 0x7f, 0x6b, 0x1a, 0xf1, 0x38, 0xef, 0x26, 0x71, 0xc8, 0x57, 0x57, 0xd2,
-	0x0a, 0x57, 0xba, 0xa0, 0xe8, 0x5f, 0x51, 0x1d, 0xf7, 0x4f, 0x1a, 0xc0, 0x4a, 0xe6, 0x15, 0xbe,
-	0x05, 0x16, 0x3e, 0xdf, 0xe9, 0x97, 0xff, 0x7c, 0xd7, 0x00, 0x23, 0xe3, 0x3c, 0xbe, 0xcc, 0x8b,
-	0x0b, 0xf9, 0xf0, 0xfa, 0x79, 0x72, 0xca, 0x63, 0xd9, 0x96, 0x04, 0xe0, 0x7e, 0x0a, 0x9b, 0x2b,
-	0x9d, 0x29, 0x81, 0x3f, 0x5a, 0x4f, 0xe0, 0x5a, 0x63, 0x45, 0x57, 0xf9, 0x1b, 0x80, 0x85, 0xc8,
-	0x21, 0x9e, 0x70, 0xde, 0xf3, 0x6d, 0x15, 0x39, 0x75, 0x65, 0xe6, 0xab, 0x1a, 0xf3, 0x0b, 0xb0,
-	0x57, 0x72, 0xdf, 0xf1, 0x01, 0xed, 0x26, 0x94, 0x43, 0xa2, 0xab, 0x0e, 0x29, 0x20, 0xf6, 0x21,
-	0x40, 0x18, 0xcd, 0x27, 0x3c, 0x5d, 0xce, 0xb0, 0x75, 0xbf, 0x80, 0x71, 0xbf, 0x81, 0xf7, 0x56,
-	0x67, 0x5f, 0x25, 0x40, 0x57, 0x02, 0xf5, 0x35, 0x81, 0x57, 0x7c, 0xfc, 0x3e, 0xbb, 0x0e, 0x1b,
-	0x51, 0xd2, 0x40, 0x5d, 0x22, 0x64, 0x3b, 0xfa, 0xa2, 0x34, 0x3f, 0x3a, 0x2a, 0x13, 0xfb, 0xa7,
-	0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x45, 0xa3, 0x56, 0x83, 0xa8, 0x16, 0x00, 0x00,
+	proto.RegisterEnum("CafeReplication_Type", CafeReplication_Type_name, CafeReplication_Type_value)
+}
+
+func init() { proto.RegisterFile("model.proto", fileDescriptor_model_aaa56015cda79db6) }
+
+var fileDescriptor_model_aaa56015cda79db6 = []byte{
+	// 2637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x19, 0x4d, 0x93, 0x1b, 0x47,
+	0xd5, 0xf3, 0x25, 0x69, 0x9e, 0x76, 0xed, 0xc9, 0xd8, 0x38, 0x93, 0x75, 0x3e, 0x9c, 0x31, 0x09,
+	0x0e, 0x06, 0x25, 0x38, 0x80, 0x5d, 0xb9, 0x50, 0xb2, 0x76, 0xec, 0x15, 0x91, 0x47, 0xaa, 0x59,
+	0xad, 0x13, 0xb8, 0xa8, 0x66, 0x47, 0xbd, 0xab, 0xc9, 0x4a, 0x33, 0xca, 0xcc, 0xc8, 0xf1, 0x42,
+	0x51, 0x5c, 0x80, 0xe2, 0x9e, 0x23, 0x17, 0x0e, 0xfc, 0x02, 0xa8, 0x82, 0x2b, 0x07, 0x4e, 0xfc,
+	0x02, 0x38, 0x51, 0xc5, 0x2d, 0x07, 0x6e, 0xfc, 0x00, 0xea, 0xbd, 0xee, 0x1e, 0x8d, 0xbc, 0xbb,
+	0xb1, 0x94, 0x0a, 0x97, 0xdd, 0x7e, 0x1f, 0xd3, 0xfd, 0xde, 0xeb, 0xf7, 0xd9, 0x82, 0xe6, 0x2c,
+	0x1d, 0xb3, 0x69, 0x6b, 0x9e, 0xa5, 0x45, 0xba, 0xf3, 0xc6, 0x71, 0x9a, 0x1e, 0x4f, 0xd9, 0xbb,
+	0x04, 0x1d, 0x2e, 0x8e, 0xde, 0x2d, 0xe2, 0x19, 0xcb, 0x8b, 0x70, 0x36, 0x17, 0x0c, 0xaf, 0x3e,
+	0xcf, 0x90, 0x17, 0xd9, 0x22, 0x2a, 0x04, 0x75, 0x7b, 0xc6, 0xf2, 0x3c, 0x3c, 0x66, 0x1c, 0x74,
+	0xbf, 0x50, 0x40, 0x1f, 0x30, 0x96, 0xd9, 0x97, 0x41, 0x8d, 0xc7, 0x8e, 0x72, 0x53, 0xb9, 0x6d,
+	0x06, 0x6a, 0x3c, 0xb6, 0x1d, 0xa8, 0x87, 0xe3, 0x71, 0xc6, 0xf2, 0xdc, 0x51, 0x09, 0x29, 0x41,
+	0xdb, 0x06, 0x3d, 0x09, 0x67, 0xcc, 0xd1, 0x08, 0x4d, 0x6b, 0xfb, 0x3a, 0xd4, 0xc2, 0xa7, 0x61,
+	0x11, 0x66, 0x8e, 0x4e, 0x58, 0x01, 0xd9, 0x6f, 0x40, 0x3d, 0x4e, 0x0e, 0xd3, 0x67, 0x2c, 0x77,
+	0x8c, 0x9b, 0xda, 0xed, 0xe6, 0x5d, 0xa3, 0xd5, 0x09, 0x8f, 0x58, 0x20, 0xb1, 0xf6, 0xf7, 0xa1,
+	0x1e, 0x65, 0x2c, 0x2c, 0xd8, 0xd8, 0xa9, 0xdd, 0x54, 0x6e, 0x37, 0xef, 0xee, 0xb4, 0xb8, 0xf8,
+	0x2d, 0x29, 0x7e, 0x6b, 0x28, 0xf5, 0x0b, 0x24, 0x2b, 0x7e, 0xb5, 0x98, 0x8f, 0xe9, 0xab, 0xfa,
+	0x8b, 0xbf, 0x12, 0xac, 0xee, 0xb7, 0xa0, 0x81, 0xaa, 0xf6, 0xe2, 0xbc, 0xb0, 0x6f, 0x80, 0x11,
+	0x17, 0x6c, 0x96, 0x3b, 0x8a, 0x10, 0x0b, 0x29, 0x01, 0xc7, 0xb9, 0x3d, 0xd0, 0x0f, 0x72, 0x96,
+	0x55, 0x6d, 0xa0, 0x9c, 0x6f, 0x03, 0xf5, 0x5c, 0x1b, 0x68, 0x55, 0x1b, 0xb8, 0xbf, 0x51, 0xa0,
+	0xde, 0x49, 0x93, 0x22, 0x8c, 0x8a, 0xaf, 0x67, 0x47, 0x14, 0x7e, 0xce, 0x58, 0x96, 0x3b, 0xfa,
+	0x8a, 0xf0, 0x84, 0xc3, 0x23, 0x8a, 0x49, 0xc6, 0xc2, 0x31, 0x37, 0xb9, 0x19, 0x48, 0xd0, 0xfd,
+	0x2e, 0x34, 0x85, 0x1c, 0x64, 0x82, 0xd7, 0x57, 0x4d, 0xd0, 0x68, 0x09, 0xa2, 0xb4, 0xc2, 0xbf,
+	0x75, 0xa8, 0x0d, 0xe9, 0xd3, 0x33, 0xce, 0x61, 0x81, 0x76, 0xc2, 0x4e, 0x85, 0xac, 0xb8, 0x44,
+	0x8e, 0xfc, 0x84, 0xc4, 0xdc, 0x0a, 0xd4, 0xfc, 0xa4, 0x54, 0x47, 0x5f, 0x55, 0x27, 0x8f, 0x26,
+	0x6c, 0x16, 0x3a, 0x06, 0x57, 0x87, 0x43, 0xf6, 0xab, 0x60, 0xc6, 0x49, 0x5c, 0xc4, 0x61, 0x91,
+	0x66, 0xe4, 0x05, 0x66, 0xb0, 0x44, 0xd8, 0x37, 0x41, 0x2f, 0x4e, 0xe7, 0x8c, 0x2e, 0xfa, 0xf2,
+	0xdd, 0xad, 0x16, 0x17, 0xa9, 0x35, 0x3c, 0x9d, 0xb3, 0x80, 0x28, 0xf6, 0x3b, 0x50, 0xcf, 0x27,
+	0x61, 0x16, 0x27, 0xc7, 0x4e, 0x83, 0x98, 0xae, 0x48, 0xa6, 0x7d, 0x8e, 0x0e, 0x24, 0x1d, 0x8f,
+	0xfa, 0x6c, 0x12, 0x17, 0x6c, 0x1a, 0xe7, 0x85, 0x63, 0x92, 0x79, 0x96, 0x08, 0xfb, 0x16, 0x18,
+	0x79, 0x11, 0x16, 0xcc, 0x01, 0xda, 0x66, 0xbb, 0xdc, 0x06, 0x91, 0x01, 0xa7, 0xa1, 0x66, 0x13,
+	0x16, 0x8e, 0x9d, 0x26, 0xd7, 0x0c, 0xd7, 0xf6, 0x5b, 0x00, 0xf8, 0x7f, 0x74, 0x38, 0x4d, 0xa3,
+	0x13, 0x87, 0x91, 0x4b, 0xd6, 0x5a, 0x0f, 0x10, 0x0a, 0x4c, 0xa4, 0xd0, 0xd2, 0x7e, 0x1b, 0x9a,
+	0x5c, 0xe5, 0x51, 0x92, 0x8e, 0x99, 0x73, 0x44, 0x7c, 0x46, 0xcb, 0x4f, 0xc7, 0x2c, 0x00, 0x4e,
+	0xc1, 0xb5, 0xfd, 0x06, 0x34, 0x69, 0xa7, 0x51, 0x94, 0x2e, 0x92, 0xc2, 0x39, 0xbe, 0xa9, 0xdc,
+	0x36, 0x02, 0x20, 0x54, 0x07, 0x31, 0xf6, 0x6b, 0x00, 0x78, 0xd9, 0x82, 0x3e, 0x21, 0xba, 0x89,
+	0x18, 0x22, 0xbb, 0xf7, 0x41, 0x47, 0xf3, 0xd8, 0x4d, 0xa8, 0x0f, 0x82, 0xee, 0x93, 0xf6, 0xd0,
+	0xb3, 0x2e, 0xd9, 0xdb, 0x60, 0x06, 0x5e, 0x7b, 0x77, 0xd4, 0xf7, 0x7b, 0x3f, 0xb1, 0x14, 0x1b,
+	0xa0, 0x36, 0x38, 0x78, 0xd0, 0xeb, 0x76, 0x2c, 0xd5, 0x6e, 0x80, 0xde, 0x1f, 0x78, 0xbe, 0xa5,
+	0xb9, 0x3f, 0x84, 0xba, 0xb0, 0x99, 0x7d, 0x19, 0xc0, 0xef, 0x0f, 0x47, 0xfb, 0x7b, 0xed, 0xc0,
+	0xdb, 0xb5, 0x2e, 0xd9, 0x57, 0xa0, 0xd9, 0xf5, 0x9f, 0x74, 0x87, 0x5e, 0x65, 0x07, 0x41, 0x54,
+	0xdd, 0x7b, 0x60, 0x90, 0x91, 0x6c, 0x0b, 0xb6, 0x7a, 0xfd, 0xf6, 0x6e, 0xd7, 0x7f, 0x34, 0x1a,
+	0xb6, 0xbb, 0x3d, 0xeb, 0x12, 0xb2, 0x21, 0xc6, 0xdb, 0xb5, 0x94, 0x2a, 0x75, 0xcf, 0x6b, 0xe3,
+	0x87, 0x77, 0x00, 0xb8, 0x91, 0xc9, 0x25, 0x5f, 0x5b, 0x75, 0xc9, 0xba, 0xb8, 0x00, 0xe9, 0x91,
+	0x03, 0xc9, 0x7c, 0x6e, 0xc6, 0xba, 0x0e, 0x35, 0xee, 0xe9, 0xc2, 0x2f, 0x05, 0x64, 0xef, 0x40,
+	0xe3, 0x33, 0x36, 0x8d, 0xd2, 0x19, 0x1b, 0x93, 0x83, 0x36, 0x82, 0x12, 0x76, 0x7f, 0xad, 0x81,
+	0xc1, 0xef, 0x66, 0xdd, 0xdd, 0x30, 0x26, 0x17, 0xc5, 0x24, 0x5d, 0xc6, 0x24, 0x41, 0xf6, 0x37,
+	0x85, 0x9b, 0xea, 0xe4, 0x3a, 0x16, 0xbf, 0x7c, 0xfe, 0xb7, 0xe2, 0xaa, 0x2d, 0xd0, 0x31, 0x17,
+	0x39, 0xc6, 0x0b, 0xb3, 0x16, 0xf1, 0x61, 0x30, 0xcf, 0xc3, 0x8c, 0x25, 0x45, 0xee, 0xd4, 0x78,
+	0x30, 0x0b, 0x90, 0xe4, 0x0b, 0xb3, 0x63, 0x56, 0x38, 0x75, 0x21, 0x1f, 0x41, 0xe8, 0x9e, 0x87,
+	0xe9, 0xf8, 0x94, 0x22, 0xc1, 0x0c, 0x68, 0x6d, 0xbf, 0x02, 0xfa, 0x22, 0x67, 0x99, 0x70, 0x4c,
+	0xa3, 0x85, 0xc9, 0x2d, 0x20, 0x94, 0xfb, 0x2b, 0x05, 0xcc, 0x52, 0x48, 0xdb, 0x04, 0xe3, 0xb1,
+	0x17, 0x3c, 0xf2, 0xf8, 0xb5, 0x75, 0x1f, 0xf9, 0xfd, 0xc0, 0xb3, 0x14, 0xf4, 0x8f, 0x87, 0xbd,
+	0xf6, 0x23, 0xee, 0x29, 0x3f, 0xee, 0x77, 0x7d, 0x4b, 0xb3, 0xb7, 0xa0, 0xd1, 0xf6, 0xfd, 0xfe,
+	0x81, 0xdf, 0xf1, 0x2c, 0x1d, 0x3f, 0xec, 0x79, 0xed, 0x27, 0x9e, 0x65, 0x20, 0xcb, 0xd0, 0xfb,
+	0x78, 0x68, 0xd5, 0x10, 0xf9, 0xb0, 0xdb, 0xf3, 0xf6, 0xad, 0x3a, 0x7a, 0x62, 0xa7, 0xff, 0xf8,
+	0xb1, 0xe7, 0x0f, 0xad, 0x06, 0x72, 0xf4, 0xba, 0x1f, 0x7a, 0x96, 0x69, 0xd7, 0x41, 0x6b, 0xef,
+	0xee, 0x5a, 0x77, 0xdd, 0x7d, 0x21, 0x05, 0x79, 0xc1, 0xab, 0xab, 0x5e, 0x20, 0x03, 0x89, 0x23,
+	0xed, 0x6b, 0x60, 0x70, 0xb7, 0x57, 0xc9, 0xed, 0x39, 0x40, 0xf9, 0x86, 0x3d, 0x2b, 0xca, 0xa2,
+	0xc4, 0x9e, 0x15, 0xee, 0x2f, 0x61, 0x8b, 0xbe, 0x7c, 0xcc, 0x2b, 0xde, 0x99, 0x2b, 0xb6, 0x41,
+	0xc7, 0x98, 0x91, 0x29, 0x17, 0xd7, 0xf6, 0x0d, 0xd0, 0x58, 0xf2, 0x94, 0xb6, 0x69, 0xde, 0x35,
+	0x5b, 0x5e, 0xf2, 0x94, 0x4d, 0xd3, 0x39, 0x0b, 0x10, 0x5b, 0xde, 0x9e, 0xbe, 0xde, 0xed, 0xb9,
+	0x9f, 0x2b, 0x50, 0xeb, 0x26, 0x4f, 0xe3, 0xe2, 0xec, 0xd9, 0xd7, 0xc0, 0xe0, 0xc9, 0x42, 0xa5,
+	0x94, 0xc9, 0x81, 0x73, 0x4b, 0x2b, 0x95, 0x50, 0xdc, 0x23, 0x13, 0xe7, 0x8a, 0x74, 0x2f, 0xb1,
+	0x9b, 0xfa, 0x14, 0x86, 0x1c, 0x17, 0xea, 0xfc, 0x90, 0xe3, 0x34, 0x19, 0x72, 0x7f, 0x53, 0xc1,
+	0x7c, 0x18, 0x4f, 0x59, 0x37, 0x19, 0xb3, 0x67, 0x28, 0xdf, 0x2c, 0x9e, 0x4e, 0x85, 0x1e, 0xb4,
+	0xc6, 0xf0, 0x8a, 0x26, 0x2c, 0x3a, 0xc9, 0x17, 0x33, 0x61, 0xc9, 0x12, 0xa6, 0x8c, 0x9f, 0x2e,
+	0xb2, 0x48, 0x6a, 0x24, 0x20, 0xdc, 0x27, 0x9d, 0x17, 0xb9, 0xac, 0x0e, 0xb8, 0xa6, 0xbc, 0x1a,
+	0xe6, 0x13, 0x51, 0x1b, 0x68, 0x2d, 0xeb, 0x4c, 0x6d, 0x59, 0x67, 0xae, 0x81, 0x31, 0x63, 0xe3,
+	0x38, 0x14, 0x5e, 0xcf, 0x81, 0xd2, 0x6e, 0x8d, 0x8a, 0xdd, 0x6c, 0xd0, 0xf3, 0xf8, 0x67, 0xcc,
+	0x31, 0x6f, 0x2a, 0xb7, 0xb5, 0x80, 0xd6, 0xf6, 0x7b, 0x60, 0x84, 0xe3, 0x31, 0x1b, 0x3b, 0xf0,
+	0x42, 0x5b, 0x71, 0x46, 0xfb, 0x0e, 0xe8, 0x33, 0x56, 0x84, 0x94, 0xed, 0x9b, 0x77, 0x5f, 0x3e,
+	0xf3, 0xc1, 0x3e, 0xf5, 0x56, 0x01, 0x31, 0x51, 0xe9, 0xa5, 0x28, 0xcc, 0x9d, 0x2d, 0x51, 0x7a,
+	0x39, 0xe8, 0xfe, 0x4b, 0x05, 0x9d, 0x52, 0xbb, 0x94, 0x54, 0xa9, 0x48, 0x6a, 0x81, 0x36, 0x8f,
+	0x13, 0x32, 0x5e, 0x23, 0xc0, 0x25, 0x96, 0xa9, 0xf9, 0x34, 0x8c, 0x93, 0x42, 0xba, 0x74, 0x23,
+	0x58, 0x22, 0xca, 0x5b, 0xd0, 0x2b, 0xb7, 0x70, 0x4b, 0x58, 0x94, 0x77, 0x59, 0x57, 0xa8, 0xa6,
+	0xb4, 0xfa, 0xf3, 0x22, 0xf7, 0x92, 0x22, 0x3b, 0x15, 0x26, 0xbe, 0x0f, 0xcd, 0x4f, 0xf2, 0x34,
+	0x19, 0x89, 0x2a, 0x5c, 0xfb, 0x72, 0x9d, 0x00, 0x79, 0xf7, 0x89, 0xd5, 0x7e, 0x1b, 0x8c, 0x69,
+	0x9c, 0x9c, 0xe4, 0x4e, 0x83, 0xf6, 0xb7, 0xf8, 0xfe, 0x3d, 0x44, 0xf1, 0x03, 0x38, 0x79, 0xe7,
+	0x1e, 0x98, 0xe5, 0xa1, 0xf2, 0xf6, 0x94, 0x95, 0xdb, 0x7b, 0x1a, 0x4e, 0x17, 0xb2, 0xcb, 0xe1,
+	0xc0, 0x07, 0xea, 0x7d, 0x65, 0xe7, 0x47, 0x00, 0xcb, 0xdd, 0xce, 0xf9, 0xf2, 0x46, 0xf5, 0x4b,
+	0x8c, 0x01, 0xe4, 0xae, 0x6c, 0xe0, 0xfe, 0x57, 0x01, 0x1d, 0x71, 0xf8, 0xed, 0x22, 0x97, 0x06,
+	0xc6, 0xe5, 0xff, 0xc5, 0xbe, 0x78, 0xd4, 0xd7, 0x67, 0xdf, 0xaf, 0x6c, 0x37, 0xf7, 0x1f, 0x1a,
+	0x6c, 0xf9, 0x69, 0x11, 0x1f, 0xc5, 0x51, 0x58, 0xc4, 0x69, 0x72, 0x26, 0xd1, 0xc8, 0xec, 0xa0,
+	0xae, 0x59, 0x71, 0xae, 0x81, 0x11, 0x46, 0x45, 0x59, 0xde, 0x38, 0x80, 0x9e, 0x9d, 0x2f, 0x0e,
+	0x3f, 0x61, 0x51, 0x21, 0xac, 0x22, 0x41, 0xfb, 0x4d, 0xd8, 0x12, 0xcb, 0xd1, 0x98, 0xe5, 0x91,
+	0x08, 0xdf, 0xa6, 0xc0, 0xed, 0xb2, 0x3c, 0x5a, 0xe6, 0x3a, 0x1e, 0xc7, 0x1c, 0xb8, 0xb0, 0x80,
+	0xbd, 0x2d, 0x0a, 0x29, 0x6f, 0xe5, 0xec, 0x56, 0x55, 0xbb, 0x6a, 0xd7, 0x27, 0x0b, 0x9d, 0x59,
+	0x29, 0x74, 0x36, 0xe8, 0x54, 0xb2, 0x81, 0xae, 0x94, 0xd6, 0x5f, 0x56, 0xfc, 0x7e, 0xa7, 0x88,
+	0x46, 0xe9, 0x2a, 0x5c, 0x11, 0xbd, 0x4d, 0xe0, 0x75, 0xbc, 0xee, 0x13, 0x6a, 0x78, 0x5e, 0x86,
+	0xab, 0xed, 0x4e, 0xa7, 0x7f, 0xe0, 0x0f, 0x47, 0x03, 0xcf, 0x0b, 0x46, 0x58, 0xf8, 0xa8, 0x8b,
+	0xb9, 0x02, 0xcd, 0x2a, 0x42, 0xc5, 0xd6, 0x8a, 0x10, 0x3d, 0xef, 0xe1, 0xd0, 0xd2, 0xec, 0x97,
+	0x60, 0xfb, 0xb1, 0xb7, 0xbf, 0xdf, 0x7e, 0xe4, 0x8d, 0xda, 0xbb, 0xd8, 0xf8, 0xe8, 0xf8, 0x09,
+	0x95, 0x42, 0x81, 0x30, 0x90, 0x47, 0x14, 0x44, 0x81, 0xaa, 0x61, 0xc3, 0x85, 0x65, 0x51, 0xc0,
+	0x75, 0xf7, 0x1e, 0x58, 0x55, 0xdd, 0x7b, 0xa2, 0x43, 0xad, 0x66, 0xeb, 0xed, 0x15, 0xeb, 0xc8,
+	0x9c, 0xfd, 0x77, 0x05, 0x2e, 0x7f, 0xc4, 0x0e, 0x27, 0x69, 0x7a, 0x61, 0xe9, 0x73, 0xa0, 0xfe,
+	0x19, 0xe7, 0x90, 0xd3, 0x9d, 0x00, 0x4b, 0xb3, 0x6a, 0x15, 0xb3, 0x6e, 0x58, 0xf7, 0xb0, 0x24,
+	0x84, 0x45, 0xc1, 0x66, 0x3c, 0x60, 0xb0, 0x4a, 0x97, 0x30, 0xee, 0x45, 0x85, 0xfa, 0xc5, 0xd3,
+	0x1e, 0x2f, 0xe2, 0xbf, 0x55, 0x40, 0xc7, 0x91, 0xb1, 0xac, 0xd6, 0x4a, 0xa5, 0x5a, 0x5f, 0x3c,
+	0xa4, 0x5a, 0xa0, 0x85, 0xf3, 0x58, 0x68, 0x81, 0x4b, 0x14, 0x8a, 0x0e, 0x89, 0x52, 0x19, 0xd9,
+	0x25, 0x4c, 0x59, 0x19, 0x3b, 0x72, 0x51, 0x7b, 0x70, 0x4d, 0x79, 0x24, 0x9b, 0xca, 0xda, 0xb3,
+	0xc8, 0xa6, 0xee, 0x5f, 0x54, 0x68, 0xa2, 0x28, 0xfb, 0x2c, 0xcf, 0xcf, 0x0b, 0x35, 0x6c, 0x0d,
+	0xa3, 0x68, 0x29, 0x8c, 0x80, 0xec, 0xef, 0x80, 0xc6, 0x9e, 0xcd, 0x1d, 0xed, 0x85, 0x1a, 0x23,
+	0x1b, 0xea, 0x94, 0xb1, 0xa3, 0x8c, 0xe5, 0x13, 0x19, 0x6a, 0x02, 0x44, 0xd3, 0x65, 0xb8, 0xd1,
+	0x1a, 0x85, 0x3e, 0x13, 0x3b, 0xc9, 0xa0, 0xad, 0xad, 0x06, 0xad, 0x5d, 0x99, 0xa9, 0x4c, 0x11,
+	0x4f, 0xaf, 0x80, 0x1e, 0x85, 0x47, 0x3c, 0xee, 0xca, 0x39, 0x9d, 0x50, 0xdc, 0x74, 0x71, 0x9a,
+	0xc5, 0x05, 0x0f, 0x37, 0x23, 0x28, 0x61, 0xfb, 0x16, 0xd4, 0x26, 0x2c, 0x9c, 0x16, 0x13, 0x51,
+	0x53, 0x9b, 0xf4, 0xe1, 0x1e, 0xa1, 0x02, 0x41, 0x72, 0xff, 0xa3, 0x00, 0x2c, 0xd1, 0xf6, 0xb7,
+	0xa1, 0x86, 0xb3, 0xd4, 0x82, 0x0f, 0xc1, 0x18, 0xe4, 0x4b, 0x22, 0x0d, 0x5b, 0x8b, 0x3c, 0x10,
+	0x1c, 0x78, 0xf6, 0x51, 0x18, 0x4f, 0x17, 0x19, 0xcb, 0x45, 0xc7, 0x57, 0xc2, 0xa8, 0xe0, 0x34,
+	0x2c, 0x58, 0x12, 0x71, 0x77, 0xd5, 0x02, 0x09, 0xd2, 0xb3, 0x02, 0x36, 0x21, 0x6c, 0xbc, 0x86,
+	0xd3, 0x4a, 0x56, 0x4c, 0x54, 0x2c, 0xcb, 0xd2, 0x4c, 0xf8, 0x01, 0x07, 0xdc, 0xef, 0x41, 0x8d,
+	0xcb, 0x84, 0x5d, 0xec, 0x81, 0xff, 0xa1, 0xdf, 0xff, 0xc8, 0xb7, 0x2e, 0x21, 0xb0, 0xe7, 0xb5,
+	0x7b, 0xc3, 0x3d, 0x9c, 0x85, 0xb6, 0xc1, 0x3c, 0xf0, 0x25, 0xa8, 0xba, 0x3f, 0x80, 0x2b, 0x15,
+	0x47, 0xa1, 0xc8, 0x75, 0x57, 0x23, 0x77, 0xab, 0x55, 0x61, 0x90, 0x81, 0xfb, 0x85, 0xc6, 0x1d,
+	0x2c, 0x60, 0x9f, 0x2e, 0x58, 0x5e, 0xac, 0xd5, 0xb0, 0x2e, 0xd3, 0xa8, 0xb6, 0x92, 0x46, 0xe5,
+	0x75, 0xea, 0x67, 0xaf, 0xf3, 0x2d, 0x71, 0xfb, 0x06, 0x19, 0xff, 0xa5, 0x56, 0xe5, 0xc8, 0xe7,
+	0x12, 0x2c, 0x35, 0x50, 0xf5, 0x4a, 0x03, 0x75, 0x0d, 0x8c, 0xe3, 0x2c, 0x5d, 0xcc, 0x45, 0xa7,
+	0xc5, 0x81, 0x32, 0x3f, 0xd4, 0xd6, 0xcc, 0x0f, 0x77, 0xca, 0xfb, 0x37, 0x49, 0x84, 0xab, 0x2b,
+	0x22, 0x9c, 0x75, 0x80, 0x32, 0x99, 0xc0, 0x05, 0xc9, 0xa4, 0xb9, 0x66, 0x32, 0xe9, 0x8b, 0x7c,
+	0x6f, 0x82, 0xb1, 0x3f, 0xc4, 0xd9, 0xe6, 0x12, 0xbf, 0x53, 0x0e, 0x68, 0x38, 0x9f, 0xd2, 0x72,
+	0x34, 0xdc, 0xc3, 0x59, 0xd9, 0x52, 0x6c, 0x1b, 0x2e, 0x1f, 0xf8, 0x2b, 0x38, 0x1a, 0x76, 0xba,
+	0xfe, 0x83, 0xfe, 0xc7, 0x96, 0xea, 0xde, 0x2f, 0x7d, 0xa3, 0x0e, 0x9a, 0xef, 0x7d, 0xc4, 0x37,
+	0x1c, 0x78, 0x3e, 0xce, 0xb8, 0x96, 0x82, 0x53, 0x52, 0xa7, 0xff, 0x78, 0xd0, 0xf3, 0x86, 0x9e,
+	0xa5, 0xe2, 0x4c, 0xf5, 0xb0, 0xdd, 0xed, 0x79, 0xbb, 0x96, 0x26, 0x5d, 0x44, 0x28, 0x7d, 0xb1,
+	0x8b, 0x08, 0x06, 0xe9, 0x22, 0xbf, 0x57, 0xe1, 0x7a, 0x05, 0xfd, 0x08, 0xed, 0x2f, 0x24, 0xb8,
+	0x01, 0x66, 0xb2, 0x98, 0x8d, 0x8a, 0xb4, 0x08, 0x79, 0x87, 0x6e, 0x04, 0x8d, 0x64, 0x31, 0x1b,
+	0x22, 0x8c, 0x4f, 0x0a, 0x48, 0x9c, 0xb3, 0x64, 0x8c, 0xef, 0x24, 0x3c, 0x92, 0x20, 0x59, 0xcc,
+	0x06, 0x1c, 0x83, 0x75, 0x1c, 0x19, 0xa2, 0x74, 0x36, 0x9f, 0xb2, 0x82, 0x37, 0xec, 0x46, 0x80,
+	0x1f, 0x75, 0x04, 0x0a, 0x5f, 0x1d, 0xd0, 0x09, 0xc4, 0x09, 0x3a, 0xb9, 0x85, 0x89, 0x18, 0x7e,
+	0x04, 0x76, 0x02, 0x48, 0x96, 0x67, 0x18, 0xc4, 0xd0, 0x44, 0x9c, 0x3c, 0xe4, 0x16, 0x6c, 0x13,
+	0x4b, 0x79, 0x4a, 0x8d, 0x78, 0xe8, 0xbb, 0xea, 0x31, 0x28, 0x09, 0x46, 0xb9, 0x78, 0xdf, 0x33,
+	0x02, 0xd4, 0xec, 0x21, 0x21, 0x50, 0x13, 0xda, 0x43, 0xd0, 0x1b, 0xb4, 0x03, 0x09, 0xc6, 0x19,
+	0xdc, 0x3f, 0xa9, 0xdc, 0xb4, 0x7b, 0xc3, 0xe1, 0x40, 0x46, 0xd2, 0x3b, 0xc2, 0xe5, 0x79, 0xbe,
+	0xf9, 0x46, 0xeb, 0x39, 0x7a, 0xd5, 0xed, 0x45, 0xde, 0x57, 0xcb, 0xbc, 0x6f, 0xdf, 0x83, 0x3a,
+	0xbe, 0xe1, 0xe0, 0x83, 0x9b, 0x46, 0x37, 0xf3, 0xda, 0x99, 0xef, 0xf7, 0x38, 0x9d, 0x37, 0x83,
+	0x92, 0xbb, 0xac, 0xa5, 0x3a, 0xcd, 0x78, 0xb4, 0xb6, 0x6f, 0x43, 0x2d, 0x9a, 0x2c, 0x92, 0x13,
+	0xd9, 0x4a, 0x5a, 0xcf, 0xef, 0x15, 0x08, 0xfa, 0xce, 0x07, 0xb0, 0x55, 0xdd, 0x76, 0xa3, 0xb6,
+	0xf0, 0x3d, 0xe1, 0xe8, 0x75, 0xd0, 0x06, 0x07, 0x43, 0xeb, 0x12, 0xce, 0xdc, 0x83, 0xfe, 0xfe,
+	0x90, 0x3f, 0xdb, 0xec, 0x7a, 0xc2, 0x21, 0x4d, 0x30, 0x06, 0xed, 0x61, 0x67, 0xcf, 0xd2, 0xdc,
+	0x5f, 0xf0, 0xd4, 0xb3, 0xc9, 0xac, 0x2c, 0xc3, 0x5e, 0xfb, 0x0a, 0x6d, 0x81, 0xbe, 0x1a, 0xc9,
+	0xee, 0xa7, 0xfc, 0xce, 0x3a, 0xd3, 0x98, 0x25, 0x85, 0x9f, 0x26, 0x11, 0x5b, 0x6a, 0xa7, 0x54,
+	0xb4, 0xfb, 0x92, 0x92, 0xbf, 0xa1, 0x38, 0xee, 0x1f, 0x45, 0x51, 0xe2, 0x67, 0x6e, 0xf0, 0x00,
+	0x5e, 0x79, 0xb3, 0xd6, 0xd6, 0x7f, 0xb3, 0x6e, 0x81, 0x9e, 0x33, 0x96, 0xac, 0xd3, 0x44, 0x21,
+	0x1f, 0xaa, 0x5f, 0xa4, 0x27, 0x2c, 0x91, 0xc5, 0x88, 0x00, 0xf7, 0x7d, 0xb8, 0xbc, 0x94, 0x99,
+	0xb2, 0xc6, 0x9b, 0xab, 0x59, 0xa3, 0xd9, 0x5a, 0xd2, 0x65, 0xd2, 0xf8, 0xa7, 0x02, 0x26, 0x62,
+	0x87, 0xb8, 0xc5, 0x79, 0x4f, 0x11, 0x4b, 0x2f, 0xda, 0x92, 0x76, 0xde, 0xf4, 0x72, 0xe9, 0x71,
+	0x37, 0x9d, 0x33, 0xfe, 0x28, 0x6d, 0x06, 0x02, 0x22, 0xc7, 0x99, 0x86, 0x52, 0x0b, 0x5a, 0xa3,
+	0x01, 0xd9, 0xb3, 0x79, 0x8c, 0x25, 0x7d, 0x8d, 0x47, 0x7f, 0xc1, 0x8a, 0x72, 0x4e, 0xc3, 0x43,
+	0x36, 0x95, 0xa3, 0x3f, 0x01, 0xee, 0x1f, 0x14, 0xb0, 0x96, 0x1a, 0x5f, 0xf0, 0x5e, 0x7d, 0x1d,
+	0x6a, 0x11, 0xd1, 0x65, 0x67, 0xc6, 0x21, 0xfb, 0x75, 0x80, 0x28, 0x9e, 0x4f, 0x58, 0x56, 0x0e,
+	0x82, 0x5b, 0x41, 0x05, 0x83, 0x3e, 0xf0, 0x94, 0x65, 0x58, 0xa2, 0x85, 0xc3, 0x4a, 0x70, 0xe3,
+	0x47, 0x97, 0xcf, 0x15, 0x78, 0x69, 0x29, 0xe6, 0x26, 0x51, 0xb6, 0x94, 0x5d, 0x5b, 0x91, 0x7d,
+	0xd3, 0xa6, 0x5c, 0x96, 0x73, 0x63, 0x59, 0xce, 0xdd, 0x9f, 0xc3, 0xf6, 0x52, 0xa8, 0x41, 0x9c,
+	0xac, 0x6d, 0x38, 0xb9, 0x99, 0xb6, 0xdc, 0x6c, 0xe3, 0xd7, 0xb1, 0x3f, 0xab, 0xb2, 0x04, 0xce,
+	0xa7, 0x17, 0x4d, 0xaf, 0x32, 0x6f, 0xab, 0x95, 0xbc, 0x5d, 0xe1, 0xaf, 0xe6, 0xed, 0x8b, 0xec,
+	0xb4, 0x6c, 0x90, 0xf4, 0x95, 0x06, 0x69, 0xd3, 0xa7, 0xd8, 0x6a, 0xf6, 0xaa, 0x3d, 0xd7, 0x87,
+	0xec, 0x40, 0x23, 0x61, 0xf1, 0xf1, 0xe4, 0x30, 0xcd, 0x84, 0x77, 0x96, 0xb0, 0x3b, 0xa8, 0xa4,
+	0xe2, 0x2e, 0x36, 0x8e, 0x26, 0x18, 0x07, 0x3e, 0x2e, 0x29, 0x17, 0x8b, 0xae, 0x42, 0xc5, 0x56,
+	0xe1, 0xc0, 0x17, 0x90, 0x86, 0x5d, 0x84, 0x98, 0x21, 0x2d, 0x9d, 0x77, 0x97, 0x12, 0x34, 0xdc,
+	0xbf, 0x2a, 0x50, 0x6b, 0xcf, 0xe3, 0x0f, 0xd9, 0xe9, 0x19, 0x7b, 0x5d, 0xf0, 0x2b, 0x92, 0x88,
+	0x4c, 0x6d, 0x25, 0x32, 0x37, 0x75, 0xa0, 0x4a, 0xd4, 0x1a, 0x1b, 0x45, 0x2d, 0x4f, 0x63, 0xb5,
+	0x6a, 0x1a, 0xbb, 0x03, 0xc0, 0x35, 0x38, 0xff, 0x0d, 0x92, 0xd3, 0x44, 0xfa, 0x7a, 0x70, 0x15,
+	0xb6, 0xe3, 0xb4, 0x85, 0x01, 0x19, 0xe3, 0x61, 0x87, 0x3f, 0x55, 0xe7, 0x87, 0x87, 0x35, 0x3a,
+	0xf4, 0xfd, 0xff, 0x0d, 0x00, 0x81, 0x24, 0x8c, 0xe2, 0x1c, 0x1d, 0x00, 0x00,
 }
@@ -40,7 +40,7 @@ func (x QueryOptions_FilterType) String() string {
 	return proto.EnumName(QueryOptions_FilterType_name, int32(x))
 }
 func (QueryOptions_FilterType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{0, 0}
+	return fileDescriptor_query_f9c50463899ce48c, []int{0, 0}
 }
 
 type Query_Type int32
@@ -63,7 +63,7 @@ func (x Query_Type) String() string {
 	return proto.EnumName(Query_Type_name, int32(x))
 }
 func (Query_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{1, 0}
+	return fileDescriptor_query_f9c50463899ce48c, []int{1, 0}
 }
 
 type PubSubQuery_ResponseType int32
@@ -86,7 +86,7 @@ func (x PubSubQuery_ResponseType) String() string {
 	return proto.EnumName(PubSubQuery_ResponseType_name, int32(x))
 }
 func (PubSubQuery_ResponseType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{2, 0}
+	return fileDescriptor_query_f9c50463899ce48c, []int{2, 0}
 }
 
 type QueryOptions struct {
@@ -96,6 +96,7 @@ type QueryOptions struct {
 	Wait                 int32                   `protobuf:"varint,3,opt,name=wait,proto3" json:"wait,omitempty"`
 	Filter               QueryOptions_FilterType `protobuf:"varint,4,opt,name=filter,proto3,enum=QueryOptions_FilterType" json:"filter,omitempty"`
 	Exclude              []string                `protobuf:"bytes,5,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	Federated            bool                    `protobuf:"varint,7,opt,name=federated,proto3" json:"federated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -105,7 +106,7 @@ func (m *QueryOptions) Reset()         { *m = QueryOptions{} }
 func (m *QueryOptions) String() string { return proto.CompactTextString(m) }
 func (*QueryOptions) ProtoMessage()    {}
 func (*QueryOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{0}
+	return fileDescriptor_query_f9c50463899ce48c, []int{0}
 }
 func (m *QueryOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryOptions.Unmarshal(m, b)
@@ -167,6 +168,13 @@ func (m *QueryOptions) GetExclude() []string {
 	return nil
 }
 
+func (m *QueryOptions) GetFederated() bool {
+	if m != nil {
+		return m.Federated
+	}
+	return false
+}
+
 type Query struct {
 	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Token                string        `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
@@ -182,7 +190,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{1}
+	return fileDescriptor_query_f9c50463899ce48c, []int{1}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Query.Unmarshal(m, b)
@@ -254,7 +262,7 @@ func (m *PubSubQuery) Reset()         { *m = PubSubQuery{} }
 func (m *PubSubQuery) String() string { return proto.CompactTextString(m) }
 func (*PubSubQuery) ProtoMessage()    {}
 func (*PubSubQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{2}
+	return fileDescriptor_query_f9c50463899ce48c, []int{2}
 }
 func (m *PubSubQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PubSubQuery.Unmarshal(m, b)
@@ -323,6 +331,60 @@ func (m *PubSubQuery) GetTimeout() int32 {
 	return 0
 }
 
+type FederatedQuery struct {
+	Query                *Query   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Hops                 int32    `protobuf:"varint,2,opt,name=hops,proto3" json:"hops,omitempty"`
+	Visited              []string `protobuf:"bytes,3,rep,name=visited,proto3" json:"visited,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FederatedQuery) Reset()         { *m = FederatedQuery{} }
+func (m *FederatedQuery) String() string { return proto.CompactTextString(m) }
+func (*FederatedQuery) ProtoMessage()    {}
+func (*FederatedQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_query_f9c50463899ce48c, []int{3}
+}
+func (m *FederatedQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FederatedQuery.Unmarshal(m, b)
+}
+func (m *FederatedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FederatedQuery.Marshal(b, m, deterministic)
+}
+func (dst *FederatedQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FederatedQuery.Merge(dst, src)
+}
+func (m *FederatedQuery) XXX_Size() int {
+	return xxx_messageInfo_FederatedQuery.Size(m)
+}
+func (m *FederatedQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_FederatedQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FederatedQuery proto.InternalMessageInfo
+
+func (m *FederatedQuery) GetQuery() *Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *FederatedQuery) GetHops() int32 {
+	if m != nil {
+		return m.Hops
+	}
+	return 0
+}
+
+func (m *FederatedQuery) GetVisited() []string {
+	if m != nil {
+		return m.Visited
+	}
+	return nil
+}
+
 type QueryResult struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
@@ -337,7 +399,7 @@ func (m *QueryResult) Reset()         { *m = QueryResult{} }
 func (m *QueryResult) String() string { return proto.CompactTextString(m) }
 func (*QueryResult) ProtoMessage()    {}
 func (*QueryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{3}
+	return fileDescriptor_query_f9c50463899ce48c, []int{4}
 }
 func (m *QueryResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResult.Unmarshal(m, b)
@@ -397,7 +459,7 @@ func (m *QueryResults) Reset()         { *m = QueryResults{} }
 func (m *QueryResults) String() string { return proto.CompactTextString(m) }
 func (*QueryResults) ProtoMessage()    {}
 func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{4}
+	return fileDescriptor_query_f9c50463899ce48c, []int{5}
 }
 func (m *QueryResults) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
@@ -443,7 +505,7 @@ func (m *PubSubQueryResults) Reset()         { *m = PubSubQueryResults{} }
 func (m *PubSubQueryResults) String() string { return proto.CompactTextString(m) }
 func (*PubSubQueryResults) ProtoMessage()    {}
 func (*PubSubQueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{5}
+	return fileDescriptor_query_f9c50463899ce48c, []int{6}
 }
 func (m *PubSubQueryResults) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PubSubQueryResults.Unmarshal(m, b)
@@ -489,7 +551,7 @@ func (m *ContactQuery) Reset()         { *m = ContactQuery{} }
 func (m *ContactQuery) String() string { return proto.CompactTextString(m) }
 func (*ContactQuery) ProtoMessage()    {}
 func (*ContactQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{6}
+	return fileDescriptor_query_f9c50463899ce48c, []int{7}
 }
 func (m *ContactQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContactQuery.Unmarshal(m, b)
@@ -524,17 +586,20 @@ func (m *ContactQuery) GetName() string {
 }
 
 type ThreadSnapshotQuery struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Address              string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Thread               string               `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Version              int32                `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	AsOf                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *ThreadSnapshotQuery) Reset()         { *m = ThreadSnapshotQuery{} }
 func (m *ThreadSnapshotQuery) String() string { return proto.CompactTextString(m) }
 func (*ThreadSnapshotQuery) ProtoMessage()    {}
 func (*ThreadSnapshotQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{7}
+	return fileDescriptor_query_f9c50463899ce48c, []int{8}
 }
 func (m *ThreadSnapshotQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadSnapshotQuery.Unmarshal(m, b)
@@ -561,10 +626,32 @@ func (m *ThreadSnapshotQuery) GetAddress() string {
 	return ""
 }
 
+func (m *ThreadSnapshotQuery) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ThreadSnapshotQuery) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ThreadSnapshotQuery) GetAsOf() *timestamp.Timestamp {
+	if m != nil {
+		return m.AsOf
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryOptions)(nil), "QueryOptions")
 	proto.RegisterType((*Query)(nil), "Query")
 	proto.RegisterType((*PubSubQuery)(nil), "PubSubQuery")
+	proto.RegisterType((*FederatedQuery)(nil), "FederatedQuery")
 	proto.RegisterType((*QueryResult)(nil), "QueryResult")
 	proto.RegisterType((*QueryResults)(nil), "QueryResults")
 	proto.RegisterType((*PubSubQueryResults)(nil), "PubSubQueryResults")
@@ -575,48 +662,53 @@ func init() {
 	proto.RegisterEnum("PubSubQuery_ResponseType", PubSubQuery_ResponseType_name, PubSubQuery_ResponseType_value)
 }
 
-func init() { proto.RegisterFile("query.proto", fileDescriptor_query_e051b7f44626bdcd) }
-
-var fileDescriptor_query_e051b7f44626bdcd = []byte{
-	// 638 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc6, 0x4e, 0x1c, 0x93, 0xe3, 0x24, 0xb2, 0x06, 0x16, 0x06, 0x5d, 0x5d, 0x22, 0xdf, 0x05,
-	0x11, 0x57, 0x1a, 0xae, 0x72, 0xb7, 0xed, 0x22, 0x40, 0x10, 0x48, 0x94, 0xa4, 0x63, 0xb3, 0xe9,
-	0x06, 0x4d, 0xe2, 0x01, 0x46, 0x75, 0x3c, 0xae, 0x3d, 0x6e, 0xc9, 0x53, 0xf4, 0x05, 0xfa, 0x56,
-	0x7d, 0x90, 0xbe, 0x42, 0xe5, 0x19, 0xbb, 0x98, 0x3f, 0xb5, 0xbb, 0x9c, 0xf9, 0xbe, 0x1c, 0x7f,
-	0xdf, 0xf9, 0xce, 0x01, 0xe7, 0x53, 0xc1, 0xb2, 0x35, 0x4e, 0x33, 0x21, 0xc5, 0xee, 0xce, 0xad,
-	0x10, 0xb7, 0x31, 0x3b, 0x54, 0xd5, 0xa2, 0xb8, 0x39, 0xa4, 0x49, 0x0d, 0xed, 0x3d, 0x85, 0x24,
-	0x5f, 0xb1, 0x5c, 0xd2, 0x55, 0xaa, 0x09, 0xfe, 0x0f, 0x03, 0x7a, 0xef, 0xcb, 0x5e, 0xb3, 0x54,
-	0x72, 0x91, 0xe4, 0xe8, 0x2f, 0xe8, 0xc6, 0x62, 0x49, 0xe3, 0x59, 0x12, 0xaf, 0x3d, 0x63, 0x68,
-	0x8c, 0x36, 0xc9, 0xc3, 0x03, 0xfa, 0x1b, 0x20, 0x63, 0x2b, 0x21, 0x99, 0x82, 0x3b, 0x0a, 0x6e,
-	0xbc, 0xa0, 0x6d, 0xb0, 0x62, 0xbe, 0xe2, 0xd2, 0x33, 0x87, 0xc6, 0xc8, 0x22, 0xba, 0x40, 0x08,
-	0xda, 0x5f, 0x28, 0x97, 0x5e, 0x4b, 0x3d, 0xaa, 0xdf, 0xe8, 0x3f, 0xe8, 0xdc, 0xf0, 0x58, 0xb2,
-	0xcc, 0x6b, 0x0f, 0x8d, 0xd1, 0x60, 0xec, 0xe1, 0xa6, 0x0c, 0x7c, 0xaa, 0xb0, 0x70, 0x9d, 0x32,
-	0x52, 0xf1, 0x90, 0x07, 0x36, 0xbb, 0x5f, 0xc6, 0x45, 0xc4, 0x3c, 0x6b, 0xd8, 0x1a, 0x75, 0x49,
-	0x5d, 0xfa, 0xff, 0x02, 0x3c, 0xf0, 0x51, 0x1f, 0xba, 0x97, 0xb3, 0xeb, 0xd3, 0xf3, 0x8b, 0x70,
-	0x4a, 0xdc, 0x0d, 0x34, 0x00, 0x38, 0x3b, 0x3f, 0x99, 0x5e, 0xcf, 0x2e, 0x4e, 0xa6, 0xc4, 0x35,
-	0xfc, 0xef, 0x06, 0x58, 0xea, 0x53, 0x68, 0x00, 0x26, 0x8f, 0x94, 0xc7, 0x2e, 0x31, 0x79, 0x54,
-	0x8a, 0x97, 0xe2, 0x23, 0x4b, 0x94, 0xf8, 0x2e, 0xd1, 0x05, 0xda, 0x83, 0xb6, 0x5c, 0xa7, 0x4c,
-	0x89, 0x1f, 0x8c, 0x1d, 0x2d, 0x13, 0x2b, 0x65, 0x0a, 0x40, 0xfb, 0x60, 0x0b, 0xad, 0x5a, 0x59,
-	0x71, 0xc6, 0xfd, 0x47, 0x56, 0x48, 0x8d, 0x22, 0x0c, 0x76, 0x4a, 0xd7, 0xb1, 0xa0, 0x91, 0x67,
-	0x29, 0xe2, 0x36, 0xd6, 0xf1, 0xe0, 0x3a, 0x1e, 0x3c, 0x49, 0xd6, 0xa4, 0x26, 0xf9, 0x07, 0xd0,
-	0x56, 0x86, 0xb6, 0xc1, 0x0d, 0xcf, 0xc8, 0x74, 0x72, 0x72, 0x1d, 0x5c, 0x4e, 0xe6, 0xc1, 0xd9,
-	0x2c, 0x0c, 0xdc, 0x0d, 0xd4, 0x83, 0xcd, 0xe3, 0xd9, 0x65, 0x38, 0x39, 0x0e, 0x03, 0xd7, 0xf0,
-	0xbf, 0x99, 0xe0, 0xcc, 0x8b, 0x45, 0x50, 0x2c, 0x5e, 0xf6, 0x56, 0xbb, 0x30, 0x5f, 0x73, 0xd1,
-	0x10, 0xd7, 0xfa, 0x03, 0x71, 0xe8, 0x2d, 0xf4, 0x32, 0x96, 0xa7, 0x22, 0xc9, 0x59, 0xd9, 0xa5,
-	0x4a, 0x71, 0x07, 0x37, 0x44, 0x60, 0xd2, 0x20, 0x90, 0x47, 0xf4, 0xd7, 0xc3, 0xd4, 0x29, 0xa4,
-	0x7c, 0xa9, 0xb6, 0x4b, 0xa5, 0x90, 0xf2, 0x65, 0xc9, 0x2f, 0x57, 0x57, 0x14, 0xd2, 0xb3, 0xd5,
-	0x16, 0xd5, 0xa5, 0xff, 0x0f, 0xf4, 0x9a, 0xdf, 0x41, 0x36, 0xb4, 0xe6, 0xe3, 0xb9, 0xbb, 0x81,
-	0x00, 0x3a, 0xf3, 0xab, 0xa3, 0xe0, 0xea, 0xc8, 0x35, 0xfc, 0xaf, 0x06, 0x38, 0x4a, 0x13, 0x61,
-	0x79, 0x11, 0xcb, 0x67, 0xe3, 0xc1, 0xd0, 0x8e, 0xa8, 0xd4, 0xe3, 0x71, 0xc6, 0xbb, 0xcf, 0xac,
-	0x87, 0xf5, 0xd9, 0x10, 0xc5, 0x53, 0x7b, 0x5e, 0x1e, 0x85, 0x9a, 0xd5, 0x26, 0xd1, 0x05, 0x3a,
-	0x00, 0xeb, 0x33, 0x8d, 0x0b, 0x56, 0xed, 0xc1, 0xcb, 0x13, 0xd4, 0x14, 0x3f, 0xa8, 0xee, 0x4e,
-	0x0b, 0xca, 0x7f, 0x05, 0x64, 0xbc, 0x16, 0x90, 0x0f, 0x16, 0x97, 0x6c, 0x95, 0x7b, 0xe6, 0xb0,
-	0x35, 0x72, 0xc6, 0x3d, 0xdc, 0xf8, 0x3b, 0xd1, 0x90, 0xff, 0x0e, 0x50, 0x63, 0xfe, 0x75, 0xeb,
-	0xa7, 0x66, 0xf7, 0xc1, 0xce, 0x34, 0x54, 0xf9, 0xed, 0x37, 0x7b, 0xe5, 0xa4, 0x46, 0xfd, 0x37,
-	0xd0, 0x3b, 0x16, 0x89, 0xa4, 0x4b, 0xa9, 0x97, 0xca, 0x03, 0x9b, 0x46, 0x51, 0xc6, 0xf2, 0xbc,
-	0xea, 0x56, 0x97, 0xe5, 0x85, 0x27, 0x74, 0xc5, 0xaa, 0xcb, 0x51, 0xbf, 0xfd, 0x43, 0xd8, 0x0a,
-	0xef, 0x32, 0x46, 0xa3, 0x20, 0xa1, 0x69, 0x7e, 0x27, 0x7e, 0xd7, 0xe4, 0x68, 0x0b, 0xfa, 0x5c,
-	0x60, 0xc9, 0xee, 0x25, 0x2f, 0x87, 0xb6, 0xf8, 0x60, 0xa6, 0x8b, 0x45, 0x47, 0x0d, 0xef, 0xff,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xc5, 0xc0, 0x82, 0xf2, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("query.proto", fileDescriptor_query_f9c50463899ce48c) }
+
+var fileDescriptor_query_f9c50463899ce48c = []byte{
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x51, 0x4f, 0xdb, 0x3c,
+	0x14, 0x25, 0x69, 0xd3, 0xd2, 0x9b, 0x52, 0x55, 0x06, 0x7d, 0x0a, 0x08, 0x7d, 0x54, 0xd9, 0x03,
+	0x15, 0x93, 0xc2, 0xd4, 0xbd, 0x6e, 0x0f, 0x05, 0x8a, 0x40, 0x62, 0xa4, 0x73, 0xc2, 0xcb, 0x34,
+	0xa9, 0x72, 0x1b, 0x17, 0xac, 0xa5, 0x71, 0x96, 0x38, 0x8c, 0xfe, 0x88, 0x69, 0xd2, 0x9e, 0xf7,
+	0xaf, 0xf6, 0x87, 0xa6, 0xd8, 0x09, 0x04, 0x18, 0xb0, 0xb7, 0x5c, 0x9f, 0xe3, 0xdb, 0x73, 0xee,
+	0xf1, 0x2d, 0x98, 0x5f, 0x33, 0x9a, 0x2c, 0x9d, 0x38, 0xe1, 0x82, 0x6f, 0x6d, 0x5e, 0x72, 0x7e,
+	0x19, 0xd2, 0x7d, 0x59, 0x4d, 0xb3, 0xf9, 0x3e, 0x89, 0x4a, 0x68, 0xe7, 0x21, 0x24, 0xd8, 0x82,
+	0xa6, 0x82, 0x2c, 0x62, 0x45, 0xb0, 0xbf, 0xeb, 0xd0, 0xfe, 0x98, 0xf7, 0x72, 0x63, 0xc1, 0x78,
+	0x94, 0xa2, 0x6d, 0x68, 0x85, 0x7c, 0x46, 0x42, 0x37, 0x0a, 0x97, 0x96, 0xd6, 0xd3, 0xfa, 0xab,
+	0xf8, 0xee, 0x00, 0xfd, 0x0f, 0x90, 0xd0, 0x05, 0x17, 0x54, 0xc2, 0x0d, 0x09, 0x57, 0x4e, 0xd0,
+	0x06, 0x18, 0x21, 0x5b, 0x30, 0x61, 0xe9, 0x3d, 0xad, 0x6f, 0x60, 0x55, 0x20, 0x04, 0xf5, 0x6f,
+	0x84, 0x09, 0xab, 0x26, 0x0f, 0xe5, 0x37, 0x7a, 0x03, 0x8d, 0x39, 0x0b, 0x05, 0x4d, 0xac, 0x7a,
+	0x4f, 0xeb, 0x77, 0x06, 0x96, 0x53, 0x95, 0xe1, 0x1c, 0x4b, 0xcc, 0x5f, 0xc6, 0x14, 0x17, 0x3c,
+	0x64, 0x41, 0x93, 0xde, 0xcc, 0xc2, 0x2c, 0xa0, 0x96, 0xd1, 0xab, 0xf5, 0x5b, 0xb8, 0x2c, 0x73,
+	0xcd, 0x73, 0x1a, 0xd0, 0x84, 0x08, 0x1a, 0x58, 0x4d, 0xa5, 0xf9, 0xf6, 0xc0, 0x7e, 0x0d, 0x70,
+	0xd7, 0x0d, 0xad, 0x41, 0xeb, 0xdc, 0x9d, 0x1c, 0x9f, 0x9e, 0xf9, 0x23, 0xdc, 0x5d, 0x41, 0x1d,
+	0x80, 0x93, 0xd3, 0xa3, 0xd1, 0xc4, 0x3d, 0x3b, 0x1a, 0xe1, 0xae, 0x66, 0xff, 0xd6, 0xc0, 0x90,
+	0x42, 0x50, 0x07, 0x74, 0x16, 0xc8, 0x09, 0xb4, 0xb0, 0xce, 0x82, 0xdc, 0x9a, 0xe0, 0x5f, 0x68,
+	0x24, 0xad, 0xb5, 0xb0, 0x2a, 0xd0, 0x0e, 0xd4, 0xc5, 0x32, 0xa6, 0xd2, 0x5a, 0x67, 0x60, 0x2a,
+	0x13, 0x8e, 0xd4, 0x2d, 0x01, 0xb4, 0x0b, 0x4d, 0xae, 0x3c, 0x49, 0xa3, 0xe6, 0x60, 0xed, 0x9e,
+	0x51, 0x5c, 0xa2, 0xc8, 0x81, 0x66, 0x4c, 0x96, 0x21, 0x27, 0x81, 0x65, 0x48, 0xe2, 0x86, 0xa3,
+	0xc2, 0x73, 0xca, 0xf0, 0x9c, 0x61, 0xb4, 0xc4, 0x25, 0xc9, 0xde, 0x83, 0xba, 0x34, 0xb4, 0x01,
+	0x5d, 0xff, 0x04, 0x8f, 0x86, 0x47, 0x13, 0xef, 0x7c, 0x38, 0xf6, 0x4e, 0x5c, 0xdf, 0xeb, 0xae,
+	0xa0, 0x36, 0xac, 0x1e, 0xba, 0xe7, 0xfe, 0xf0, 0xd0, 0xf7, 0xba, 0x9a, 0xfd, 0x4b, 0x07, 0x73,
+	0x9c, 0x4d, 0xbd, 0x6c, 0xfa, 0x77, 0x6f, 0xa5, 0x0b, 0xfd, 0x29, 0x17, 0x15, 0x71, 0xb5, 0x7f,
+	0x10, 0x87, 0xde, 0x43, 0x3b, 0xa1, 0x69, 0xcc, 0xa3, 0x94, 0xe6, 0x5d, 0x8a, 0x8c, 0x37, 0x9d,
+	0x8a, 0x08, 0x07, 0x57, 0x08, 0xf8, 0x1e, 0xfd, 0x99, 0xa8, 0x65, 0x0a, 0x31, 0x9b, 0x59, 0x8d,
+	0x32, 0x85, 0x98, 0xcd, 0x72, 0x7e, 0xfe, 0xb0, 0x79, 0x26, 0x64, 0xfc, 0x06, 0x2e, 0x4b, 0xfb,
+	0x15, 0xb4, 0xab, 0xbf, 0x83, 0x9a, 0x50, 0x1b, 0x0f, 0xc6, 0xdd, 0x15, 0x04, 0xd0, 0x18, 0x5f,
+	0x1c, 0x78, 0x17, 0x07, 0x5d, 0xcd, 0xfe, 0x0c, 0x9d, 0xe3, 0xf2, 0xb9, 0xa8, 0x01, 0x6d, 0x83,
+	0x21, 0x37, 0x4c, 0xce, 0xc8, 0x1c, 0x34, 0xd4, 0x44, 0xb0, 0x3a, 0xcc, 0xdf, 0xf3, 0x15, 0x8f,
+	0xd3, 0xe2, 0x91, 0xcb, 0xef, 0x5c, 0xc2, 0x35, 0x4b, 0x59, 0xfe, 0x02, 0x6b, 0x4a, 0x72, 0x51,
+	0xda, 0x3f, 0x34, 0x30, 0xd5, 0x75, 0x9a, 0x66, 0xa1, 0x78, 0x34, 0x7c, 0x07, 0xea, 0x01, 0x11,
+	0x6a, 0xf8, 0xe6, 0x60, 0xeb, 0xd1, 0x60, 0xfd, 0x72, 0x65, 0xb1, 0xe4, 0xc9, 0x1d, 0xcb, 0x17,
+	0x52, 0x26, 0xb1, 0x8a, 0x55, 0x81, 0xf6, 0xc0, 0xb8, 0x26, 0x61, 0x46, 0xad, 0xfa, 0x33, 0xf9,
+	0x28, 0x8a, 0xed, 0x41, 0xbb, 0x22, 0x28, 0xbd, 0x8d, 0x5f, 0x7b, 0x2a, 0x7e, 0x1b, 0x0c, 0x26,
+	0xe8, 0x22, 0x77, 0x5c, 0xeb, 0x9b, 0x83, 0xb6, 0x53, 0xb9, 0x8e, 0x15, 0x64, 0x7f, 0x00, 0x54,
+	0x49, 0xb7, 0x6c, 0xfd, 0xd0, 0xec, 0x2e, 0x34, 0x13, 0x05, 0x59, 0x7a, 0x75, 0x1d, 0x0a, 0x3e,
+	0x2e, 0x51, 0xfb, 0x1d, 0xb4, 0x0f, 0x79, 0x24, 0xc8, 0x4c, 0xa8, 0x44, 0x2c, 0x68, 0x92, 0x20,
+	0x48, 0x68, 0x9a, 0x16, 0xdd, 0xca, 0x32, 0x4f, 0x23, 0x22, 0x0b, 0x5a, 0xec, 0xa5, 0xfc, 0xb6,
+	0x7f, 0x6a, 0xb0, 0xee, 0x5f, 0x25, 0x94, 0x04, 0x5e, 0x44, 0xe2, 0xf4, 0x8a, 0xbf, 0xd8, 0xe5,
+	0x3f, 0x68, 0x08, 0x79, 0xa1, 0xe8, 0x53, 0x54, 0x32, 0x57, 0x9a, 0xa4, 0x8c, 0x47, 0xc5, 0xdf,
+	0x57, 0x59, 0xa2, 0x7d, 0x30, 0x48, 0x3a, 0xe1, 0x73, 0xab, 0xfe, 0x72, 0x70, 0x24, 0x75, 0xe7,
+	0x07, 0xeb, 0xb0, 0xc6, 0xb8, 0x23, 0xe8, 0x8d, 0x60, 0x39, 0x6d, 0xfa, 0x49, 0x8f, 0xa7, 0xd3,
+	0x86, 0xa4, 0xbf, 0xfd, 0x33, 0x00, 0xee, 0x74, 0xd1, 0x23, 0xd2, 0x05, 0x00, 0x00,
 }
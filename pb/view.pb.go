@@ -46,7 +46,33 @@ func (x AddThreadConfig_Schema_Preset) String() string {
 	return proto.EnumName(AddThreadConfig_Schema_Preset_name, int32(x))
 }
 func (AddThreadConfig_Schema_Preset) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{0, 0, 0}
+	return fileDescriptor_view_280770d558a638a4, []int{0, 0, 0}
+}
+
+type NotificationRequest_ReadState int32
+
+const (
+	NotificationRequest_ANY    NotificationRequest_ReadState = 0
+	NotificationRequest_UNREAD NotificationRequest_ReadState = 1
+	NotificationRequest_READ   NotificationRequest_ReadState = 2
+)
+
+var NotificationRequest_ReadState_name = map[int32]string{
+	0: "ANY",
+	1: "UNREAD",
+	2: "READ",
+}
+var NotificationRequest_ReadState_value = map[string]int32{
+	"ANY":    0,
+	"UNREAD": 1,
+	"READ":   2,
+}
+
+func (x NotificationRequest_ReadState) String() string {
+	return proto.EnumName(NotificationRequest_ReadState_name, int32(x))
+}
+func (NotificationRequest_ReadState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_view_280770d558a638a4, []int{9, 0}
 }
 
 type FeedRequest_Mode int32
@@ -72,7 +98,7 @@ func (x FeedRequest_Mode) String() string {
 	return proto.EnumName(FeedRequest_Mode_name, int32(x))
 }
 func (FeedRequest_Mode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{9, 0}
+	return fileDescriptor_view_280770d558a638a4, []int{16, 0}
 }
 
 type WalletUpdate_Type int32
@@ -101,7 +127,7 @@ func (x WalletUpdate_Type) String() string {
 	return proto.EnumName(WalletUpdate_Type_name, int32(x))
 }
 func (WalletUpdate_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{27, 0}
+	return fileDescriptor_view_280770d558a638a4, []int{34, 0}
 }
 
 type LogLevel_Level int32
@@ -136,7 +162,7 @@ func (x LogLevel_Level) String() string {
 	return proto.EnumName(LogLevel_Level_name, int32(x))
 }
 func (LogLevel_Level) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{29, 0}
+	return fileDescriptor_view_280770d558a638a4, []int{36, 0}
 }
 
 type AddThreadConfig struct {
@@ -156,7 +182,7 @@ func (m *AddThreadConfig) Reset()         { *m = AddThreadConfig{} }
 func (m *AddThreadConfig) String() string { return proto.CompactTextString(m) }
 func (*AddThreadConfig) ProtoMessage()    {}
 func (*AddThreadConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{0}
+	return fileDescriptor_view_280770d558a638a4, []int{0}
 }
 func (m *AddThreadConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddThreadConfig.Unmarshal(m, b)
@@ -238,7 +264,7 @@ func (m *AddThreadConfig_Schema) Reset()         { *m = AddThreadConfig_Schema{}
 func (m *AddThreadConfig_Schema) String() string { return proto.CompactTextString(m) }
 func (*AddThreadConfig_Schema) ProtoMessage()    {}
 func (*AddThreadConfig_Schema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{0, 0}
+	return fileDescriptor_view_280770d558a638a4, []int{0, 0}
 }
 func (m *AddThreadConfig_Schema) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddThreadConfig_Schema.Unmarshal(m, b)
@@ -292,7 +318,7 @@ func (m *BlockViz) Reset()         { *m = BlockViz{} }
 func (m *BlockViz) String() string { return proto.CompactTextString(m) }
 func (*BlockViz) ProtoMessage()    {}
 func (*BlockViz) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{1}
+	return fileDescriptor_view_280770d558a638a4, []int{1}
 }
 func (m *BlockViz) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockViz.Unmarshal(m, b)
@@ -345,7 +371,7 @@ func (m *Step) Reset()         { *m = Step{} }
 func (m *Step) String() string { return proto.CompactTextString(m) }
 func (*Step) ProtoMessage()    {}
 func (*Step) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{2}
+	return fileDescriptor_view_280770d558a638a4, []int{2}
 }
 func (m *Step) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Step.Unmarshal(m, b)
@@ -390,7 +416,7 @@ func (m *Directory) Reset()         { *m = Directory{} }
 func (m *Directory) String() string { return proto.CompactTextString(m) }
 func (*Directory) ProtoMessage()    {}
 func (*Directory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{3}
+	return fileDescriptor_view_280770d558a638a4, []int{3}
 }
 func (m *Directory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Directory.Unmarshal(m, b)
@@ -428,7 +454,7 @@ func (m *DirectoryList) Reset()         { *m = DirectoryList{} }
 func (m *DirectoryList) String() string { return proto.CompactTextString(m) }
 func (*DirectoryList) ProtoMessage()    {}
 func (*DirectoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{4}
+	return fileDescriptor_view_280770d558a638a4, []int{4}
 }
 func (m *DirectoryList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DirectoryList.Unmarshal(m, b)
@@ -466,7 +492,7 @@ func (m *Keys) Reset()         { *m = Keys{} }
 func (m *Keys) String() string { return proto.CompactTextString(m) }
 func (*Keys) ProtoMessage()    {}
 func (*Keys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{5}
+	return fileDescriptor_view_280770d558a638a4, []int{5}
 }
 func (m *Keys) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Keys.Unmarshal(m, b)
@@ -507,7 +533,7 @@ func (m *InviteView) Reset()         { *m = InviteView{} }
 func (m *InviteView) String() string { return proto.CompactTextString(m) }
 func (*InviteView) ProtoMessage()    {}
 func (*InviteView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{6}
+	return fileDescriptor_view_280770d558a638a4, []int{6}
 }
 func (m *InviteView) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteView.Unmarshal(m, b)
@@ -566,7 +592,7 @@ func (m *InviteViewList) Reset()         { *m = InviteViewList{} }
 func (m *InviteViewList) String() string { return proto.CompactTextString(m) }
 func (*InviteViewList) ProtoMessage()    {}
 func (*InviteViewList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{7}
+	return fileDescriptor_view_280770d558a638a4, []int{7}
 }
 func (m *InviteViewList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteViewList.Unmarshal(m, b)
@@ -606,7 +632,7 @@ func (m *ExternalInvite) Reset()         { *m = ExternalInvite{} }
 func (m *ExternalInvite) String() string { return proto.CompactTextString(m) }
 func (*ExternalInvite) ProtoMessage()    {}
 func (*ExternalInvite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{8}
+	return fileDescriptor_view_280770d558a638a4, []int{8}
 }
 func (m *ExternalInvite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExternalInvite.Unmarshal(m, b)
@@ -647,6 +673,440 @@ func (m *ExternalInvite) GetInviter() string {
 	return ""
 }
 
+type NotificationRequest struct {
+	Offset               string                        `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32                         `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Thread               string                        `protobuf:"bytes,3,opt,name=thread,proto3" json:"thread,omitempty"`
+	Types                []Notification_Type           `protobuf:"varint,4,rep,packed,name=types,proto3,enum=Notification_Type" json:"types,omitempty"`
+	Read                 NotificationRequest_ReadState `protobuf:"varint,5,opt,name=read,proto3,enum=NotificationRequest_ReadState" json:"read,omitempty"`
+	Before               *timestamp.Timestamp          `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *NotificationRequest) Reset()         { *m = NotificationRequest{} }
+func (m *NotificationRequest) String() string { return proto.CompactTextString(m) }
+func (*NotificationRequest) ProtoMessage()    {}
+func (*NotificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_280770d558a638a4, []int{9}
+}
+func (m *NotificationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationRequest.Unmarshal(m, b)
+}
+func (m *NotificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationRequest.Marshal(b, m, deterministic)
+}
+func (dst *NotificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationRequest.Merge(dst, src)
+}
+func (m *NotificationRequest) XXX_Size() int {
+	return xxx_messageInfo_NotificationRequest.Size(m)
+}
+func (m *NotificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationRequest proto.InternalMessageInfo
+
+func (m *NotificationRequest) GetOffset() string {
+	if m != nil {
+		return m.Offset
+	}
+	return ""
+}
+
+func (m *NotificationRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *NotificationRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *NotificationRequest) GetTypes() []Notification_Type {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *NotificationRequest) GetRead() NotificationRequest_ReadState {
+	if m != nil {
+		return m.Read
+	}
+	return NotificationRequest_ANY
+}
+
+func (m *NotificationRequest) GetBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+type CafeClientUsage struct {
+	Client               *CafeClient `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ThreadCount          int32       `protobuf:"varint,2,opt,name=thread_count,json=threadCount,proto3" json:"thread_count,omitempty"`
+	InboxCount           int32       `protobuf:"varint,3,opt,name=inbox_count,json=inboxCount,proto3" json:"inbox_count,omitempty"`
+	PinCount             int32       `protobuf:"varint,4,opt,name=pin_count,json=pinCount,proto3" json:"pin_count,omitempty"`
+	PinSize              int64       `protobuf:"varint,5,opt,name=pin_size,json=pinSize,proto3" json:"pin_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CafeClientUsage) Reset()         { *m = CafeClientUsage{} }
+func (m *CafeClientUsage) String() string { return proto.CompactTextString(m) }
+func (*CafeClientUsage) ProtoMessage()    {}
+func (*CafeClientUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_280770d558a638a4, []int{10}
+}
+func (m *CafeClientUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientUsage.Unmarshal(m, b)
+}
+func (m *CafeClientUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientUsage.Marshal(b, m, deterministic)
+}
+func (dst *CafeClientUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientUsage.Merge(dst, src)
+}
+func (m *CafeClientUsage) XXX_Size() int {
+	return xxx_messageInfo_CafeClientUsage.Size(m)
+}
+func (m *CafeClientUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientUsage proto.InternalMessageInfo
+
+func (m *CafeClientUsage) GetClient() *CafeClient {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (m *CafeClientUsage) GetThreadCount() int32 {
+	if m != nil {
+		return m.ThreadCount
+	}
+	return 0
+}
+
+func (m *CafeClientUsage) GetInboxCount() int32 {
+	if m != nil {
+		return m.InboxCount
+	}
+	return 0
+}
+
+func (m *CafeClientUsage) GetPinCount() int32 {
+	if m != nil {
+		return m.PinCount
+	}
+	return 0
+}
+
+func (m *CafeClientUsage) GetPinSize() int64 {
+	if m != nil {
+		return m.PinSize
+	}
+	return 0
+}
+
+type CafeClientUsageList struct {
+	Items                []*CafeClientUsage `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CafeClientUsageList) Reset()         { *m = CafeClientUsageList{} }
+func (m *CafeClientUsageList) String() string { return proto.CompactTextString(m) }
+func (*CafeClientUsageList) ProtoMessage()    {}
+func (*CafeClientUsageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_280770d558a638a4, []int{11}
+}
+func (m *CafeClientUsageList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientUsageList.Unmarshal(m, b)
+}
+func (m *CafeClientUsageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientUsageList.Marshal(b, m, deterministic)
+}
+func (dst *CafeClientUsageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientUsageList.Merge(dst, src)
+}
+func (m *CafeClientUsageList) XXX_Size() int {
+	return xxx_messageInfo_CafeClientUsageList.Size(m)
+}
+func (m *CafeClientUsageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientUsageList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientUsageList proto.InternalMessageInfo
+
+func (m *CafeClientUsageList) GetItems() []*CafeClientUsage {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CafeTokenUsage struct {
+	Token                *CafeToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ClientCount          int32      `protobuf:"varint,2,opt,name=client_count,json=clientCount,proto3" json:"client_count,omitempty"`
+	ThreadCount          int32      `protobuf:"varint,3,opt,name=thread_count,json=threadCount,proto3" json:"thread_count,omitempty"`
+	InboxCount           int32      `protobuf:"varint,4,opt,name=inbox_count,json=inboxCount,proto3" json:"inbox_count,omitempty"`
+	PinCount             int32      `protobuf:"varint,5,opt,name=pin_count,json=pinCount,proto3" json:"pin_count,omitempty"`
+	PinSize              int64      `protobuf:"varint,6,opt,name=pin_size,json=pinSize,proto3" json:"pin_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CafeTokenUsage) Reset()         { *m = CafeTokenUsage{} }
+func (m *CafeTokenUsage) String() string { return proto.CompactTextString(m) }
+func (*CafeTokenUsage) ProtoMessage()    {}
+func (*CafeTokenUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_280770d558a638a4, []int{12}
+}
+func (m *CafeTokenUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeTokenUsage.Unmarshal(m, b)
+}
+func (m *CafeTokenUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeTokenUsage.Marshal(b, m, deterministic)
+}
+func (dst *CafeTokenUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeTokenUsage.Merge(dst, src)
+}
+func (m *CafeTokenUsage) XXX_Size() int {
+	return xxx_messageInfo_CafeTokenUsage.Size(m)
+}
+func (m *CafeTokenUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeTokenUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeTokenUsage proto.InternalMessageInfo
+
+func (m *CafeTokenUsage) GetToken() *CafeToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *CafeTokenUsage) GetClientCount() int32 {
+	if m != nil {
+		return m.ClientCount
+	}
+	return 0
+}
+
+func (m *CafeTokenUsage) GetThreadCount() int32 {
+	if m != nil {
+		return m.ThreadCount
+	}
+	return 0
+}
+
+func (m *CafeTokenUsage) GetInboxCount() int32 {
+	if m != nil {
+		return m.InboxCount
+	}
+	return 0
+}
+
+func (m *CafeTokenUsage) GetPinCount() int32 {
+	if m != nil {
+		return m.PinCount
+	}
+	return 0
+}
+
+func (m *CafeTokenUsage) GetPinSize() int64 {
+	if m != nil {
+		return m.PinSize
+	}
+	return 0
+}
+
+type CafeTokenUsageList struct {
+	Items                []*CafeTokenUsage `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CafeTokenUsageList) Reset()         { *m = CafeTokenUsageList{} }
+func (m *CafeTokenUsageList) String() string { return proto.CompactTextString(m) }
+func (*CafeTokenUsageList) ProtoMessage()    {}
+func (*CafeTokenUsageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_280770d558a638a4, []int{13}
+}
+func (m *CafeTokenUsageList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeTokenUsageList.Unmarshal(m, b)
+}
+func (m *CafeTokenUsageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeTokenUsageList.Marshal(b, m, deterministic)
+}
+func (dst *CafeTokenUsageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeTokenUsageList.Merge(dst, src)
+}
+func (m *CafeTokenUsageList) XXX_Size() int {
+	return xxx_messageInfo_CafeTokenUsageList.Size(m)
+}
+func (m *CafeTokenUsageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeTokenUsageList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeTokenUsageList proto.InternalMessageInfo
+
+func (m *CafeTokenUsageList) GetItems() []*CafeTokenUsage {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CafeReplicationStatus struct {
+	Enabled              bool                  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Pending              int32                 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Neighbors            []*CafeNeighborStatus `protobuf:"bytes,3,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CafeReplicationStatus) Reset()         { *m = CafeReplicationStatus{} }
+func (m *CafeReplicationStatus) String() string { return proto.CompactTextString(m) }
+func (*CafeReplicationStatus) ProtoMessage()    {}
+func (*CafeReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_280770d558a638a4, []int{14}
+}
+func (m *CafeReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplicationStatus.Unmarshal(m, b)
+}
+func (m *CafeReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplicationStatus.Marshal(b, m, deterministic)
+}
+func (dst *CafeReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplicationStatus.Merge(dst, src)
+}
+func (m *CafeReplicationStatus) XXX_Size() int {
+	return xxx_messageInfo_CafeReplicationStatus.Size(m)
+}
+func (m *CafeReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplicationStatus proto.InternalMessageInfo
+
+func (m *CafeReplicationStatus) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *CafeReplicationStatus) GetPending() int32 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *CafeReplicationStatus) GetNeighbors() []*CafeNeighborStatus {
+	if m != nil {
+		return m.Neighbors
+	}
+	return nil
+}
+
+type CafeNeighborStatus struct {
+	Url                  string               `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Pending              int32                `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	LastSuccess          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	LastError            string               `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorDate        *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_error_date,json=lastErrorDate,proto3" json:"last_error_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeNeighborStatus) Reset()         { *m = CafeNeighborStatus{} }
+func (m *CafeNeighborStatus) String() string { return proto.CompactTextString(m) }
+func (*CafeNeighborStatus) ProtoMessage()    {}
+func (*CafeNeighborStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_280770d558a638a4, []int{15}
+}
+func (m *CafeNeighborStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeNeighborStatus.Unmarshal(m, b)
+}
+func (m *CafeNeighborStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeNeighborStatus.Marshal(b, m, deterministic)
+}
+func (dst *CafeNeighborStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeNeighborStatus.Merge(dst, src)
+}
+func (m *CafeNeighborStatus) XXX_Size() int {
+	return xxx_messageInfo_CafeNeighborStatus.Size(m)
+}
+func (m *CafeNeighborStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeNeighborStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeNeighborStatus proto.InternalMessageInfo
+
+func (m *CafeNeighborStatus) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CafeNeighborStatus) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *CafeNeighborStatus) GetPending() int32 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *CafeNeighborStatus) GetLastSuccess() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSuccess
+	}
+	return nil
+}
+
+func (m *CafeNeighborStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *CafeNeighborStatus) GetLastErrorDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastErrorDate
+	}
+	return nil
+}
+
 type FeedRequest struct {
 	Thread               string           `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
 	Offset               string           `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
@@ -661,7 +1121,7 @@ func (m *FeedRequest) Reset()         { *m = FeedRequest{} }
 func (m *FeedRequest) String() string { return proto.CompactTextString(m) }
 func (*FeedRequest) ProtoMessage()    {}
 func (*FeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{9}
+	return fileDescriptor_view_280770d558a638a4, []int{16}
 }
 func (m *FeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeedRequest.Unmarshal(m, b)
@@ -722,7 +1182,7 @@ func (m *FeedItem) Reset()         { *m = FeedItem{} }
 func (m *FeedItem) String() string { return proto.CompactTextString(m) }
 func (*FeedItem) ProtoMessage()    {}
 func (*FeedItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{10}
+	return fileDescriptor_view_280770d558a638a4, []int{17}
 }
 func (m *FeedItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeedItem.Unmarshal(m, b)
@@ -776,7 +1236,7 @@ func (m *FeedItemList) Reset()         { *m = FeedItemList{} }
 func (m *FeedItemList) String() string { return proto.CompactTextString(m) }
 func (*FeedItemList) ProtoMessage()    {}
 func (*FeedItemList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{11}
+	return fileDescriptor_view_280770d558a638a4, []int{18}
 }
 func (m *FeedItemList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeedItemList.Unmarshal(m, b)
@@ -831,7 +1291,7 @@ func (m *Merge) Reset()         { *m = Merge{} }
 func (m *Merge) String() string { return proto.CompactTextString(m) }
 func (*Merge) ProtoMessage()    {}
 func (*Merge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{12}
+	return fileDescriptor_view_280770d558a638a4, []int{19}
 }
 func (m *Merge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Merge.Unmarshal(m, b)
@@ -893,7 +1353,7 @@ func (m *Ignore) Reset()         { *m = Ignore{} }
 func (m *Ignore) String() string { return proto.CompactTextString(m) }
 func (*Ignore) ProtoMessage()    {}
 func (*Ignore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{13}
+	return fileDescriptor_view_280770d558a638a4, []int{20}
 }
 func (m *Ignore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Ignore.Unmarshal(m, b)
@@ -955,7 +1415,7 @@ func (m *Flag) Reset()         { *m = Flag{} }
 func (m *Flag) String() string { return proto.CompactTextString(m) }
 func (*Flag) ProtoMessage()    {}
 func (*Flag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{14}
+	return fileDescriptor_view_280770d558a638a4, []int{21}
 }
 func (m *Flag) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Flag.Unmarshal(m, b)
@@ -1017,7 +1477,7 @@ func (m *Join) Reset()         { *m = Join{} }
 func (m *Join) String() string { return proto.CompactTextString(m) }
 func (*Join) ProtoMessage()    {}
 func (*Join) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{15}
+	return fileDescriptor_view_280770d558a638a4, []int{22}
 }
 func (m *Join) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Join.Unmarshal(m, b)
@@ -1078,7 +1538,7 @@ func (m *Announce) Reset()         { *m = Announce{} }
 func (m *Announce) String() string { return proto.CompactTextString(m) }
 func (*Announce) ProtoMessage()    {}
 func (*Announce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{16}
+	return fileDescriptor_view_280770d558a638a4, []int{23}
 }
 func (m *Announce) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Announce.Unmarshal(m, b)
@@ -1133,7 +1593,7 @@ func (m *Leave) Reset()         { *m = Leave{} }
 func (m *Leave) String() string { return proto.CompactTextString(m) }
 func (*Leave) ProtoMessage()    {}
 func (*Leave) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{17}
+	return fileDescriptor_view_280770d558a638a4, []int{24}
 }
 func (m *Leave) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Leave.Unmarshal(m, b)
@@ -1197,7 +1657,7 @@ func (m *Text) Reset()         { *m = Text{} }
 func (m *Text) String() string { return proto.CompactTextString(m) }
 func (*Text) ProtoMessage()    {}
 func (*Text) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{18}
+	return fileDescriptor_view_280770d558a638a4, []int{25}
 }
 func (m *Text) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Text.Unmarshal(m, b)
@@ -1261,6 +1721,8 @@ func (m *Text) GetLikes() []*Like {
 
 type TextList struct {
 	Items                []*Text  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Next                 string   `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1270,7 +1732,7 @@ func (m *TextList) Reset()         { *m = TextList{} }
 func (m *TextList) String() string { return proto.CompactTextString(m) }
 func (*TextList) ProtoMessage()    {}
 func (*TextList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{19}
+	return fileDescriptor_view_280770d558a638a4, []int{26}
 }
 func (m *TextList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TextList.Unmarshal(m, b)
@@ -1297,6 +1759,20 @@ func (m *TextList) GetItems() []*Text {
 	return nil
 }
 
+func (m *TextList) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *TextList) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
 type File struct {
 	Index                int32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	File                 *FileIndex            `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
@@ -1310,7 +1786,7 @@ func (m *File) Reset()         { *m = File{} }
 func (m *File) String() string { return proto.CompactTextString(m) }
 func (*File) ProtoMessage()    {}
 func (*File) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{20}
+	return fileDescriptor_view_280770d558a638a4, []int{27}
 }
 func (m *File) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_File.Unmarshal(m, b)
@@ -1370,7 +1846,7 @@ func (m *Files) Reset()         { *m = Files{} }
 func (m *Files) String() string { return proto.CompactTextString(m) }
 func (*Files) ProtoMessage()    {}
 func (*Files) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{21}
+	return fileDescriptor_view_280770d558a638a4, []int{28}
 }
 func (m *Files) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Files.Unmarshal(m, b)
@@ -1455,6 +1931,8 @@ func (m *Files) GetThreads() []string {
 
 type FilesList struct {
 	Items                []*Files `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Next                 string   `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1464,7 +1942,7 @@ func (m *FilesList) Reset()         { *m = FilesList{} }
 func (m *FilesList) String() string { return proto.CompactTextString(m) }
 func (*FilesList) ProtoMessage()    {}
 func (*FilesList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{22}
+	return fileDescriptor_view_280770d558a638a4, []int{29}
 }
 func (m *FilesList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilesList.Unmarshal(m, b)
@@ -1491,6 +1969,20 @@ func (m *FilesList) GetItems() []*Files {
 	return nil
 }
 
+func (m *FilesList) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *FilesList) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
 type Comment struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
@@ -1506,7 +1998,7 @@ func (m *Comment) Reset()         { *m = Comment{} }
 func (m *Comment) String() string { return proto.CompactTextString(m) }
 func (*Comment) ProtoMessage()    {}
 func (*Comment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{23}
+	return fileDescriptor_view_280770d558a638a4, []int{30}
 }
 func (m *Comment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Comment.Unmarshal(m, b)
@@ -1572,7 +2064,7 @@ func (m *CommentList) Reset()         { *m = CommentList{} }
 func (m *CommentList) String() string { return proto.CompactTextString(m) }
 func (*CommentList) ProtoMessage()    {}
 func (*CommentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{24}
+	return fileDescriptor_view_280770d558a638a4, []int{31}
 }
 func (m *CommentList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommentList.Unmarshal(m, b)
@@ -1613,7 +2105,7 @@ func (m *Like) Reset()         { *m = Like{} }
 func (m *Like) String() string { return proto.CompactTextString(m) }
 func (*Like) ProtoMessage()    {}
 func (*Like) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{25}
+	return fileDescriptor_view_280770d558a638a4, []int{32}
 }
 func (m *Like) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Like.Unmarshal(m, b)
@@ -1672,7 +2164,7 @@ func (m *LikeList) Reset()         { *m = LikeList{} }
 func (m *LikeList) String() string { return proto.CompactTextString(m) }
 func (*LikeList) ProtoMessage()    {}
 func (*LikeList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{26}
+	return fileDescriptor_view_280770d558a638a4, []int{33}
 }
 func (m *LikeList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LikeList.Unmarshal(m, b)
@@ -1712,7 +2204,7 @@ func (m *WalletUpdate) Reset()         { *m = WalletUpdate{} }
 func (m *WalletUpdate) String() string { return proto.CompactTextString(m) }
 func (*WalletUpdate) ProtoMessage()    {}
 func (*WalletUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{27}
+	return fileDescriptor_view_280770d558a638a4, []int{34}
 }
 func (m *WalletUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletUpdate.Unmarshal(m, b)
@@ -1769,7 +2261,7 @@ func (m *Summary) Reset()         { *m = Summary{} }
 func (m *Summary) String() string { return proto.CompactTextString(m) }
 func (*Summary) ProtoMessage()    {}
 func (*Summary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{28}
+	return fileDescriptor_view_280770d558a638a4, []int{35}
 }
 func (m *Summary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Summary.Unmarshal(m, b)
@@ -1842,7 +2334,7 @@ func (m *LogLevel) Reset()         { *m = LogLevel{} }
 func (m *LogLevel) String() string { return proto.CompactTextString(m) }
 func (*LogLevel) ProtoMessage()    {}
 func (*LogLevel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{29}
+	return fileDescriptor_view_280770d558a638a4, []int{36}
 }
 func (m *LogLevel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogLevel.Unmarshal(m, b)
@@ -1882,6 +2374,13 @@ func init() {
 	proto.RegisterType((*InviteView)(nil), "InviteView")
 	proto.RegisterType((*InviteViewList)(nil), "InviteViewList")
 	proto.RegisterType((*ExternalInvite)(nil), "ExternalInvite")
+	proto.RegisterType((*NotificationRequest)(nil), "NotificationRequest")
+	proto.RegisterType((*CafeClientUsage)(nil), "CafeClientUsage")
+	proto.RegisterType((*CafeClientUsageList)(nil), "CafeClientUsageList")
+	proto.RegisterType((*CafeTokenUsage)(nil), "CafeTokenUsage")
+	proto.RegisterType((*CafeTokenUsageList)(nil), "CafeTokenUsageList")
+	proto.RegisterType((*CafeReplicationStatus)(nil), "CafeReplicationStatus")
+	proto.RegisterType((*CafeNeighborStatus)(nil), "CafeNeighborStatus")
 	proto.RegisterType((*FeedRequest)(nil), "FeedRequest")
 	proto.RegisterType((*FeedItem)(nil), "FeedItem")
 	proto.RegisterType((*FeedItemList)(nil), "FeedItemList")
@@ -1906,104 +2405,131 @@ func init() {
 	proto.RegisterType((*LogLevel)(nil), "LogLevel")
 	proto.RegisterMapType((map[string]LogLevel_Level)(nil), "LogLevel.SystemsEntry")
 	proto.RegisterEnum("AddThreadConfig_Schema_Preset", AddThreadConfig_Schema_Preset_name, AddThreadConfig_Schema_Preset_value)
+	proto.RegisterEnum("NotificationRequest_ReadState", NotificationRequest_ReadState_name, NotificationRequest_ReadState_value)
 	proto.RegisterEnum("FeedRequest_Mode", FeedRequest_Mode_name, FeedRequest_Mode_value)
 	proto.RegisterEnum("WalletUpdate_Type", WalletUpdate_Type_name, WalletUpdate_Type_value)
 	proto.RegisterEnum("LogLevel_Level", LogLevel_Level_name, LogLevel_Level_value)
 }
 
-func init() { proto.RegisterFile("view.proto", fileDescriptor_view_8f9931836b8998c9) }
-
-var fileDescriptor_view_8f9931836b8998c9 = []byte{
-	// 1454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x5d, 0x6f, 0xdb, 0x54,
-	0x18, 0xae, 0x13, 0x3b, 0x1f, 0x6f, 0xd2, 0xce, 0x1c, 0xca, 0xf0, 0xba, 0x69, 0xcd, 0x3c, 0xb6,
-	0x75, 0x82, 0x79, 0xd0, 0x09, 0x34, 0x71, 0xe7, 0x26, 0xee, 0x16, 0x96, 0x26, 0xd3, 0x49, 0xba,
-	0x09, 0x2e, 0xa8, 0xdc, 0xf8, 0x34, 0x35, 0x71, 0xec, 0x60, 0x9f, 0x74, 0x0d, 0x17, 0x48, 0x48,
-	0x70, 0x33, 0xc1, 0x1d, 0x77, 0x48, 0xdc, 0xc3, 0x2f, 0xe0, 0x8a, 0x1f, 0xc0, 0x3f, 0xe0, 0xdf,
-	0xa0, 0xf3, 0xe1, 0x7c, 0x34, 0x19, 0xdb, 0x90, 0x0a, 0xdc, 0x44, 0x7e, 0x3f, 0x72, 0xce, 0xf3,
-	0x9e, 0xe7, 0xbc, 0x1f, 0x07, 0xe0, 0xc4, 0x27, 0xcf, 0xac, 0x61, 0x1c, 0xd1, 0x68, 0xe3, 0x52,
-	0x2f, 0x8a, 0x7a, 0x01, 0xb9, 0xcb, 0xa5, 0xc3, 0xd1, 0xd1, 0x5d, 0x37, 0x1c, 0x4b, 0xd3, 0xe6,
-	0x59, 0x13, 0xf5, 0x07, 0x24, 0xa1, 0xee, 0x60, 0x28, 0x1d, 0x4a, 0x83, 0xc8, 0x23, 0x81, 0x10,
-	0xcc, 0xe7, 0x59, 0xb8, 0x60, 0x7b, 0x5e, 0xe7, 0x38, 0x26, 0xae, 0x57, 0x8d, 0xc2, 0x23, 0xbf,
-	0x87, 0x74, 0xc8, 0xf6, 0xc9, 0xd8, 0x50, 0x2a, 0xca, 0x56, 0x11, 0xb3, 0x4f, 0x84, 0x40, 0x0d,
-	0xdd, 0x01, 0x31, 0x32, 0x5c, 0xc5, 0xbf, 0xd1, 0x5d, 0xc8, 0x25, 0xdd, 0x63, 0x32, 0x70, 0x8d,
-	0x6c, 0x45, 0xd9, 0x2a, 0x6d, 0xbf, 0x6d, 0x9d, 0x59, 0xc7, 0x6a, 0x73, 0x33, 0x96, 0x6e, 0xa8,
-	0x02, 0x2a, 0x1d, 0x0f, 0x89, 0xa1, 0x56, 0x94, 0xad, 0xb5, 0xed, 0xb2, 0x25, 0x7c, 0xad, 0xce,
-	0x78, 0x48, 0x30, 0xb7, 0xa0, 0xdb, 0x90, 0x4f, 0x8e, 0xdd, 0xd8, 0x0f, 0x7b, 0x86, 0xc6, 0x9d,
-	0x2e, 0xa4, 0x4e, 0x6d, 0xa1, 0xc6, 0xa9, 0x1d, 0x5d, 0x81, 0xe2, 0xb3, 0x63, 0x9f, 0x92, 0xc0,
-	0x4f, 0xa8, 0x91, 0xab, 0x64, 0xb7, 0x8a, 0x78, 0xaa, 0x40, 0xeb, 0xa0, 0x1d, 0x45, 0x71, 0x97,
-	0x18, 0xf9, 0x8a, 0xb2, 0x55, 0xc0, 0x42, 0xd8, 0xf8, 0x59, 0x81, 0x9c, 0xc0, 0x84, 0xd6, 0x20,
-	0xe3, 0x7b, 0x32, 0xc2, 0x8c, 0xef, 0xb1, 0x00, 0xbf, 0x48, 0xa2, 0x30, 0x0d, 0x90, 0x7d, 0xa3,
-	0x8f, 0x20, 0x37, 0x8c, 0x49, 0x42, 0x28, 0x0f, 0x70, 0x6d, 0xfb, 0xea, 0x0b, 0x02, 0xb4, 0x1e,
-	0x73, 0x2f, 0x2c, 0xbd, 0xcd, 0xfb, 0x90, 0x13, 0x1a, 0x54, 0x00, 0xb5, 0xd9, 0x6a, 0x3a, 0xfa,
-	0x0a, 0xfb, 0xda, 0x69, 0xb4, 0x76, 0x74, 0x05, 0x5d, 0x80, 0x52, 0xd5, 0xde, 0x73, 0xb0, 0x7d,
-	0x80, 0x5b, 0x8d, 0x86, 0x9e, 0x41, 0x45, 0xd0, 0xf6, 0x9c, 0x5a, 0xdd, 0xd6, 0xb3, 0xe6, 0x43,
-	0x28, 0xec, 0x04, 0x51, 0xb7, 0xff, 0xc4, 0xff, 0x8a, 0x21, 0xf2, 0x22, 0x9a, 0x48, 0x8c, 0xfc,
-	0x9b, 0x85, 0xd5, 0x8d, 0x46, 0x21, 0xe5, 0x30, 0x35, 0x2c, 0x04, 0x4e, 0x0e, 0x39, 0x15, 0x28,
-	0x19, 0x39, 0xe4, 0x94, 0x9a, 0x1f, 0x82, 0xda, 0xa6, 0x64, 0x38, 0x21, 0x4e, 0x99, 0x21, 0xee,
-	0x12, 0xa8, 0x81, 0x1f, 0xf6, 0xf9, 0x22, 0xa5, 0x6d, 0xcd, 0x6a, 0xf8, 0x61, 0x1f, 0x73, 0x95,
-	0xf9, 0x35, 0x14, 0x6b, 0x7e, 0x4c, 0xba, 0x34, 0x8a, 0xc7, 0xe8, 0x5d, 0xd0, 0x8e, 0xfc, 0x80,
-	0x30, 0x08, 0xd9, 0xad, 0xd2, 0xf6, 0x5b, 0xd6, 0xc4, 0x64, 0xed, 0x32, 0xbd, 0x13, 0xd2, 0x78,
-	0x8c, 0x85, 0xcf, 0x46, 0x0d, 0x60, 0xaa, 0x5c, 0x72, 0x83, 0x2a, 0xa0, 0x9d, 0xb8, 0xc1, 0x88,
-	0xc8, 0x5d, 0x81, 0x2f, 0x51, 0x0f, 0x3d, 0x72, 0x8a, 0x85, 0xe1, 0xe3, 0xcc, 0x7d, 0xc5, 0xfc,
-	0x00, 0x56, 0x27, 0x9b, 0x34, 0x18, 0x91, 0x15, 0xd0, 0x7c, 0x4a, 0x06, 0x29, 0x06, 0x98, 0x62,
-	0xc0, 0xc2, 0x60, 0x1e, 0x83, 0xfa, 0x88, 0x8c, 0x13, 0x74, 0x73, 0x1e, 0xad, 0x6e, 0x31, 0xed,
-	0x12, 0xa0, 0xf7, 0x5f, 0x02, 0x74, 0x7d, 0x16, 0x68, 0x71, 0x16, 0xdc, 0x37, 0x0a, 0x40, 0x3d,
-	0x3c, 0xf1, 0x29, 0x79, 0xe2, 0x93, 0x67, 0xcb, 0xae, 0xd0, 0x42, 0x8e, 0x6c, 0x42, 0xde, 0xe7,
-	0xff, 0x88, 0x65, 0x92, 0x68, 0xd6, 0x7e, 0x42, 0x62, 0x9c, 0x6a, 0x91, 0x05, 0xaa, 0xe7, 0x52,
-	0x91, 0x13, 0xa5, 0xed, 0x0d, 0x4b, 0xe4, 0xae, 0x95, 0xe6, 0xae, 0xd5, 0x49, 0x73, 0x17, 0x73,
-	0x3f, 0xf3, 0x1e, 0xac, 0x4d, 0x21, 0xf0, 0x13, 0xba, 0x36, 0x7f, 0x42, 0x25, 0x6b, 0x6a, 0x4f,
-	0x8f, 0xa8, 0x01, 0x6b, 0xce, 0x29, 0x25, 0x71, 0xe8, 0x06, 0xc2, 0xb8, 0x80, 0x5d, 0x1e, 0x43,
-	0x66, 0x7a, 0x0c, 0xc6, 0x3c, 0xf2, 0xe2, 0x04, 0xb2, 0xf9, 0x8b, 0x02, 0xa5, 0x5d, 0x42, 0x3c,
-	0x4c, 0xbe, 0x1c, 0x91, 0x84, 0xa2, 0x8b, 0x90, 0xa3, 0x3c, 0x29, 0xe4, 0x7a, 0x52, 0x62, 0xfa,
-	0xe8, 0xe8, 0x88, 0xa5, 0x8f, 0x58, 0x56, 0x4a, 0xec, 0x80, 0x03, 0x7f, 0xe0, 0x8b, 0xfb, 0xaa,
-	0x61, 0x21, 0xa0, 0x1b, 0xa0, 0xb2, 0xb2, 0x24, 0x8b, 0xc3, 0x1b, 0xd6, 0xcc, 0x0e, 0xd6, 0x5e,
-	0xe4, 0x11, 0xcc, 0xcd, 0xe6, 0x1d, 0x50, 0x99, 0x84, 0x00, 0x72, 0xd5, 0x87, 0xb8, 0xd5, 0x6c,
-	0xe9, 0x2b, 0x68, 0x15, 0x8a, 0x76, 0xb3, 0xd9, 0xea, 0xd8, 0x1d, 0xa7, 0xa6, 0x2b, 0xcc, 0xd4,
-	0xee, 0xd8, 0xd5, 0x47, 0x6d, 0x3d, 0x63, 0x1e, 0x43, 0x81, 0x2d, 0x54, 0xa7, 0x64, 0xc0, 0xf6,
-	0x3d, 0x64, 0xc9, 0x25, 0x61, 0x0a, 0x61, 0x06, 0x7d, 0x66, 0x0e, 0xbd, 0x05, 0xf9, 0xa1, 0x3b,
-	0x0e, 0x22, 0xd7, 0x93, 0xcc, 0xad, 0x2f, 0x70, 0x63, 0x87, 0x63, 0x9c, 0x3a, 0x99, 0x9f, 0x42,
-	0x39, 0xdd, 0x89, 0xd3, 0xb2, 0x39, 0x4f, 0x4b, 0xd1, 0x4a, 0xad, 0x92, 0x94, 0xd7, 0xc8, 0xe5,
-	0x1f, 0x14, 0xd0, 0xf6, 0x48, 0xdc, 0x23, 0x2f, 0x08, 0x21, 0xbd, 0x43, 0x99, 0x57, 0xbb, 0x43,
-	0x2c, 0xff, 0x47, 0xc9, 0xd9, 0x1b, 0xc9, 0x55, 0xe8, 0x3a, 0xe4, 0xa9, 0x1b, 0xf7, 0x08, 0x4d,
-	0x0c, 0xf5, 0x2c, 0xee, 0xd4, 0x62, 0x7e, 0xaf, 0x40, 0xae, 0xde, 0x0b, 0xa3, 0xf8, 0x5f, 0x00,
-	0x74, 0x0d, 0x72, 0x62, 0x5b, 0x99, 0x21, 0x33, 0x78, 0xa4, 0xc1, 0x7c, 0xae, 0x80, 0xba, 0x1b,
-	0xb8, 0xbd, 0xff, 0x05, 0x98, 0x6f, 0x15, 0x50, 0x3f, 0x89, 0xfc, 0xf0, 0xfc, 0xc1, 0x5c, 0x66,
-	0x69, 0xd4, 0x27, 0x29, 0x51, 0xac, 0x8c, 0xf7, 0x09, 0x16, 0x3a, 0xb3, 0x0f, 0x05, 0x3b, 0x0c,
-	0xa3, 0x51, 0xd8, 0x3d, 0x7f, 0x8e, 0xcc, 0xef, 0x14, 0xd0, 0x1a, 0xc4, 0x3d, 0x21, 0xff, 0x71,
-	0xd0, 0xbf, 0x2b, 0xa0, 0x76, 0xc8, 0x29, 0x3d, 0x7f, 0x18, 0x08, 0xd4, 0xc3, 0xc8, 0x1b, 0xf3,
-	0x6b, 0x50, 0xc4, 0xfc, 0x1b, 0xbd, 0x03, 0x85, 0x6e, 0x34, 0x18, 0x90, 0x90, 0x26, 0x86, 0xc6,
-	0xd1, 0x15, 0xac, 0xaa, 0x50, 0xe0, 0x89, 0x65, 0x1a, 0x40, 0x6e, 0x49, 0x00, 0xb7, 0xa0, 0xc0,
-	0xf0, 0xf3, 0xfa, 0x71, 0x79, 0xbe, 0x7e, 0x68, 0x16, 0xb3, 0xa4, 0x05, 0xfd, 0x57, 0x76, 0xe5,
-	0xfd, 0x80, 0x1f, 0xb8, 0xcf, 0x7a, 0x28, 0x8f, 0x54, 0xc3, 0x42, 0x40, 0x57, 0x41, 0x65, 0xbd,
-	0x6e, 0x49, 0xab, 0xe5, 0x7a, 0xd6, 0x2a, 0x59, 0xb7, 0x4f, 0x8c, 0xac, 0x6c, 0x95, 0xcc, 0x81,
-	0x8f, 0x01, 0x69, 0xab, 0xe4, 0x66, 0xd6, 0xd3, 0xa7, 0xca, 0x7f, 0xdc, 0xd3, 0x7f, 0xcc, 0x80,
-	0xc6, 0x3b, 0xee, 0xdf, 0x54, 0x60, 0x91, 0x55, 0x69, 0x05, 0xe6, 0xd2, 0x84, 0xaf, 0xec, 0x6b,
-	0xf2, 0xa5, 0x2e, 0xf2, 0x65, 0x40, 0xbe, 0xeb, 0x0e, 0xa9, 0x1f, 0x85, 0x7c, 0xae, 0x2c, 0xe2,
-	0x54, 0x64, 0xc7, 0x2c, 0xa6, 0x86, 0x94, 0x0f, 0x86, 0x54, 0x8e, 0x0a, 0x73, 0x94, 0xe6, 0x5f,
-	0x4e, 0x69, 0x61, 0x91, 0x52, 0xb6, 0xb3, 0x68, 0x28, 0x89, 0x51, 0xe4, 0x43, 0x6a, 0x2a, 0x9a,
-	0xb7, 0xa1, 0xc8, 0x4f, 0x85, 0xb3, 0x7d, 0x65, 0x9e, 0xed, 0x9c, 0x98, 0x5b, 0x52, 0xba, 0x7f,
-	0x52, 0x20, 0x2f, 0xf7, 0x5d, 0xe8, 0xdc, 0xe7, 0x7c, 0xab, 0xa7, 0x25, 0x4f, 0x7b, 0x51, 0xc9,
-	0xbb, 0x03, 0x25, 0x09, 0x8e, 0x87, 0x72, 0x75, 0x3e, 0x94, 0xe9, 0x89, 0xc9, 0x60, 0x58, 0x85,
-	0x64, 0x27, 0x74, 0x9e, 0x91, 0xbc, 0x42, 0xa1, 0xbe, 0x05, 0x05, 0x86, 0x62, 0x79, 0xae, 0x09,
-	0x06, 0x05, 0xde, 0xdf, 0x14, 0x28, 0x3f, 0x75, 0x83, 0x80, 0xd0, 0xfd, 0x21, 0xdf, 0xf7, 0xe5,
-	0xb3, 0xd3, 0x4d, 0xf9, 0xd0, 0x11, 0xcf, 0x06, 0x64, 0xcd, 0xfe, 0x7d, 0xe6, 0xb9, 0x63, 0x7e,
-	0x0e, 0x2a, 0x93, 0x90, 0x0e, 0xe5, 0xce, 0x43, 0xec, 0xd8, 0xb5, 0x03, 0xbb, 0x56, 0x73, 0x6a,
-	0xfa, 0x0a, 0x42, 0xb0, 0x26, 0x35, 0xd8, 0xd9, 0x6b, 0x3d, 0xe1, 0x73, 0xcd, 0x45, 0x40, 0x76,
-	0xb5, 0xda, 0xda, 0x6f, 0x76, 0x0e, 0x1e, 0x3b, 0x0e, 0x96, 0xbe, 0x19, 0x64, 0xc0, 0xfa, 0x9c,
-	0x3e, 0xfd, 0x47, 0xd6, 0xfc, 0x43, 0x81, 0x7c, 0x7b, 0x34, 0x18, 0xb8, 0xf1, 0x78, 0x01, 0xb5,
-	0x01, 0x79, 0xd7, 0xf3, 0x62, 0x92, 0x24, 0x12, 0x79, 0x2a, 0xa2, 0xf7, 0x00, 0xb9, 0x5d, 0x3e,
-	0x8d, 0x1c, 0x0c, 0x09, 0x89, 0x0f, 0xc4, 0x94, 0x22, 0x86, 0x35, 0x5d, 0x5a, 0x1e, 0x13, 0x12,
-	0x57, 0xf9, 0xc0, 0x72, 0x0d, 0xca, 0xe2, 0x46, 0x4b, 0x3f, 0x95, 0xfb, 0x95, 0xa8, 0x7c, 0x26,
-	0x31, 0x97, 0x4d, 0x28, 0xf1, 0x7c, 0x92, 0x1e, 0x1a, 0xf7, 0x00, 0xae, 0x12, 0x0e, 0xd7, 0x61,
-	0xb5, 0x1b, 0x85, 0xd4, 0xed, 0x52, 0xe9, 0x92, 0xe3, 0x2e, 0x65, 0xa9, 0xe4, 0x4e, 0xe6, 0x9f,
-	0x0a, 0x14, 0x1a, 0x51, 0xaf, 0x41, 0x4e, 0x48, 0x80, 0xde, 0x87, 0x7c, 0x32, 0x4e, 0x66, 0x38,
-	0xbb, 0x68, 0xa5, 0x36, 0xab, 0x2d, 0x0c, 0xa2, 0x92, 0xa5, 0x6e, 0x1b, 0x8f, 0xa0, 0x3c, 0x6b,
-	0x58, 0x52, 0xcd, 0x6e, 0xcc, 0x56, 0x33, 0xf6, 0xf4, 0x9c, 0xac, 0xc8, 0x7f, 0x67, 0x4b, 0x5a,
-	0x93, 0x35, 0x3c, 0x86, 0xa3, 0x0c, 0x85, 0x2a, 0xae, 0x77, 0xea, 0x55, 0xbb, 0xa1, 0xaf, 0xb0,
-	0x97, 0x9c, 0x83, 0x71, 0x0b, 0xeb, 0x0a, 0x2a, 0x41, 0xfe, 0xa9, 0x8d, 0x9b, 0xf5, 0xe6, 0x03,
-	0x3d, 0xc3, 0x26, 0xd2, 0x66, 0xab, 0x53, 0xaf, 0x3a, 0x7a, 0x96, 0x3d, 0x04, 0xeb, 0xcd, 0xdd,
-	0x96, 0xae, 0x32, 0xef, 0x9a, 0xb3, 0xb3, 0xff, 0x40, 0xd7, 0x76, 0xde, 0x84, 0x55, 0x3f, 0xb2,
-	0x28, 0x39, 0xa5, 0xac, 0x10, 0x0f, 0x0f, 0x3f, 0xcb, 0x0c, 0x0f, 0x0f, 0x73, 0xfc, 0xe6, 0xdf,
-	0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xfa, 0x1f, 0x76, 0xf7, 0x0f, 0x00, 0x00,
+func init() { proto.RegisterFile("view.proto", fileDescriptor_view_280770d558a638a4) }
+
+var fileDescriptor_view_280770d558a638a4 = []byte{
+	// 1866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x73, 0x1b, 0x49,
+	0xf5, 0xf7, 0x48, 0x33, 0x23, 0xe9, 0x49, 0xb6, 0xe7, 0xdb, 0xc9, 0xe6, 0xab, 0x38, 0x4b, 0xa2,
+	0x4c, 0x48, 0x30, 0x3f, 0x76, 0xc2, 0x6a, 0x0b, 0x2a, 0x05, 0xb5, 0x07, 0x59, 0x52, 0x36, 0x22,
+	0xb2, 0x14, 0x5a, 0x72, 0xb6, 0x96, 0x03, 0xae, 0x91, 0xd4, 0x92, 0x07, 0x8f, 0x66, 0xc4, 0x4c,
+	0xcb, 0xb1, 0x72, 0xd8, 0x2a, 0xaa, 0xe0, 0xb2, 0x05, 0x37, 0x2e, 0x14, 0x55, 0xdc, 0xe1, 0xc8,
+	0x89, 0x13, 0x7f, 0x00, 0x37, 0x8e, 0xfc, 0x23, 0x9c, 0xa9, 0xd7, 0xdd, 0x23, 0x69, 0x6c, 0x25,
+	0x4e, 0xa8, 0x32, 0x70, 0x51, 0xf5, 0xfb, 0x31, 0xdd, 0x9f, 0xf7, 0xb3, 0xfb, 0x09, 0xe0, 0xcc,
+	0x63, 0xaf, 0x9c, 0x59, 0x14, 0xf2, 0x70, 0xef, 0xf6, 0x24, 0x0c, 0x27, 0x3e, 0x7b, 0x2c, 0xa8,
+	0xc1, 0x7c, 0xfc, 0xd8, 0x0d, 0x16, 0x4a, 0x74, 0xef, 0xa2, 0x88, 0x7b, 0x53, 0x16, 0x73, 0x77,
+	0x3a, 0x53, 0x0a, 0xc5, 0x69, 0x38, 0x62, 0xbe, 0x24, 0xec, 0xaf, 0xb2, 0xb0, 0x5b, 0x1b, 0x8d,
+	0xfa, 0x27, 0x11, 0x73, 0x47, 0xf5, 0x30, 0x18, 0x7b, 0x13, 0x62, 0x41, 0xf6, 0x94, 0x2d, 0xca,
+	0x5a, 0x45, 0xdb, 0x2f, 0x50, 0x5c, 0x12, 0x02, 0x7a, 0xe0, 0x4e, 0x59, 0x39, 0x23, 0x58, 0x62,
+	0x4d, 0x1e, 0x83, 0x19, 0x0f, 0x4f, 0xd8, 0xd4, 0x2d, 0x67, 0x2b, 0xda, 0x7e, 0xb1, 0xfa, 0xff,
+	0xce, 0x85, 0x7d, 0x9c, 0x9e, 0x10, 0x53, 0xa5, 0x46, 0x2a, 0xa0, 0xf3, 0xc5, 0x8c, 0x95, 0xf5,
+	0x8a, 0xb6, 0xbf, 0x53, 0x2d, 0x39, 0x52, 0xd7, 0xe9, 0x2f, 0x66, 0x8c, 0x0a, 0x09, 0xf9, 0x26,
+	0xe4, 0xe2, 0x13, 0x37, 0xf2, 0x82, 0x49, 0xd9, 0x10, 0x4a, 0xbb, 0x89, 0x52, 0x4f, 0xb2, 0x69,
+	0x22, 0x27, 0x1f, 0x42, 0xe1, 0xd5, 0x89, 0xc7, 0x99, 0xef, 0xc5, 0xbc, 0x6c, 0x56, 0xb2, 0xfb,
+	0x05, 0xba, 0x62, 0x90, 0x9b, 0x60, 0x8c, 0xc3, 0x68, 0xc8, 0xca, 0xb9, 0x8a, 0xb6, 0x9f, 0xa7,
+	0x92, 0xd8, 0xfb, 0x83, 0x06, 0xa6, 0xc4, 0x44, 0x76, 0x20, 0xe3, 0x8d, 0x94, 0x85, 0x19, 0x6f,
+	0x84, 0x06, 0xfe, 0x2c, 0x0e, 0x83, 0xc4, 0x40, 0x5c, 0x93, 0xef, 0x83, 0x39, 0x8b, 0x58, 0xcc,
+	0xb8, 0x30, 0x70, 0xa7, 0x7a, 0xf7, 0x0d, 0x06, 0x3a, 0x2f, 0x84, 0x16, 0x55, 0xda, 0xf6, 0x13,
+	0x30, 0x25, 0x87, 0xe4, 0x41, 0xef, 0x74, 0x3b, 0x4d, 0x6b, 0x0b, 0x57, 0x07, 0xed, 0xee, 0x81,
+	0xa5, 0x91, 0x5d, 0x28, 0xd6, 0x6b, 0x87, 0x4d, 0x5a, 0x3b, 0xa6, 0xdd, 0x76, 0xdb, 0xca, 0x90,
+	0x02, 0x18, 0x87, 0xcd, 0x46, 0xab, 0x66, 0x65, 0xed, 0x67, 0x90, 0x3f, 0xf0, 0xc3, 0xe1, 0xe9,
+	0x4b, 0xef, 0x35, 0x22, 0x1a, 0x85, 0x3c, 0x56, 0x18, 0xc5, 0x1a, 0xcd, 0x1a, 0x86, 0xf3, 0x80,
+	0x0b, 0x98, 0x06, 0x95, 0x84, 0x08, 0x0e, 0x3b, 0x97, 0x28, 0x31, 0x38, 0xec, 0x9c, 0xdb, 0xdf,
+	0x03, 0xbd, 0xc7, 0xd9, 0x6c, 0x19, 0x38, 0x6d, 0x2d, 0x70, 0xb7, 0x41, 0xf7, 0xbd, 0xe0, 0x54,
+	0x6c, 0x52, 0xac, 0x1a, 0x4e, 0xdb, 0x0b, 0x4e, 0xa9, 0x60, 0xd9, 0x5f, 0x42, 0xa1, 0xe1, 0x45,
+	0x6c, 0xc8, 0xc3, 0x68, 0x41, 0xbe, 0x0d, 0xc6, 0xd8, 0xf3, 0x19, 0x42, 0xc8, 0xee, 0x17, 0xab,
+	0x1f, 0x38, 0x4b, 0x91, 0xf3, 0x14, 0xf9, 0xcd, 0x80, 0x47, 0x0b, 0x2a, 0x75, 0xf6, 0x1a, 0x00,
+	0x2b, 0xe6, 0x86, 0x0c, 0xaa, 0x80, 0x71, 0xe6, 0xfa, 0x73, 0xa6, 0x4e, 0x05, 0xb1, 0x45, 0x2b,
+	0x18, 0xb1, 0x73, 0x2a, 0x05, 0x3f, 0xc8, 0x3c, 0xd1, 0xec, 0x8f, 0x61, 0x7b, 0x79, 0x48, 0x1b,
+	0x03, 0x59, 0x01, 0xc3, 0xe3, 0x6c, 0x9a, 0x60, 0x80, 0x15, 0x06, 0x2a, 0x05, 0xf6, 0x09, 0xe8,
+	0xcf, 0xd9, 0x22, 0x26, 0x8f, 0xd2, 0x68, 0x2d, 0x07, 0xb9, 0x1b, 0x80, 0x3e, 0xb9, 0x02, 0xe8,
+	0xcd, 0x75, 0xa0, 0x85, 0x75, 0x70, 0xbf, 0xd0, 0x00, 0x5a, 0xc1, 0x99, 0xc7, 0xd9, 0x4b, 0x8f,
+	0xbd, 0xda, 0x94, 0x42, 0x97, 0x6a, 0xe4, 0x1e, 0xe4, 0x3c, 0xf1, 0x45, 0xa4, 0x8a, 0xc4, 0x70,
+	0x8e, 0x62, 0x16, 0xd1, 0x84, 0x4b, 0x1c, 0xd0, 0x47, 0x2e, 0x97, 0x35, 0x51, 0xac, 0xee, 0x39,
+	0xb2, 0x76, 0x9d, 0xa4, 0x76, 0x9d, 0x7e, 0x52, 0xbb, 0x54, 0xe8, 0xd9, 0x9f, 0xc0, 0xce, 0x0a,
+	0x82, 0xf0, 0xd0, 0xfd, 0xb4, 0x87, 0x8a, 0xce, 0x4a, 0x9e, 0xb8, 0xa8, 0x0d, 0x3b, 0xcd, 0x73,
+	0xce, 0xa2, 0xc0, 0xf5, 0xa5, 0xf0, 0x12, 0x76, 0xe5, 0x86, 0xcc, 0xca, 0x0d, 0xe5, 0x34, 0xf2,
+	0xc2, 0x12, 0xb2, 0xfd, 0xbb, 0x0c, 0xdc, 0xe8, 0x84, 0xdc, 0x1b, 0x7b, 0x43, 0x97, 0x7b, 0x61,
+	0x40, 0xd9, 0xcf, 0xe7, 0x2c, 0xe6, 0xe4, 0x16, 0x98, 0xe1, 0x78, 0x8c, 0xe5, 0x22, 0xf7, 0x55,
+	0x14, 0x3a, 0xd4, 0xf7, 0xa6, 0xde, 0x32, 0x69, 0x05, 0x81, 0xda, 0x5c, 0x94, 0x92, 0xda, 0x5e,
+	0x51, 0x64, 0x1f, 0x0c, 0x6c, 0x05, 0x71, 0x59, 0xaf, 0x64, 0xf7, 0x77, 0xaa, 0xc4, 0x59, 0x3f,
+	0x4a, 0xf6, 0x0a, 0xa9, 0x40, 0xaa, 0xa0, 0x8b, 0xef, 0x0d, 0x55, 0x9c, 0x1b, 0x30, 0x39, 0x94,
+	0xb9, 0xa3, 0x1e, 0x77, 0x39, 0xa3, 0x42, 0x97, 0x54, 0xc1, 0x1c, 0xb0, 0x71, 0x18, 0xb1, 0xb2,
+	0x79, 0xa5, 0xc3, 0x95, 0xa6, 0xfd, 0x2d, 0x28, 0x2c, 0xb7, 0x21, 0x39, 0xc8, 0xd6, 0x3a, 0x5f,
+	0x58, 0x5b, 0x04, 0xc0, 0x3c, 0xea, 0xd0, 0x66, 0xad, 0x61, 0x69, 0x58, 0xdc, 0x62, 0x95, 0xb1,
+	0xff, 0xac, 0xc1, 0x6e, 0xdd, 0x1d, 0xb3, 0xba, 0xef, 0xb1, 0x80, 0x1f, 0xc5, 0xee, 0x84, 0x91,
+	0x07, 0x60, 0x0e, 0x05, 0x29, 0xfc, 0x82, 0x11, 0x5a, 0x69, 0x50, 0x25, 0x22, 0xf7, 0xa1, 0x24,
+	0x1d, 0x70, 0xbc, 0x5e, 0xe0, 0x45, 0xae, 0xba, 0x0d, 0x96, 0xf9, 0x3d, 0x28, 0x7a, 0xc1, 0x20,
+	0x3c, 0x57, 0x1a, 0x59, 0xa1, 0x01, 0x82, 0x25, 0x15, 0xee, 0x40, 0x61, 0xe6, 0x05, 0x4a, 0xac,
+	0x0b, 0x71, 0x7e, 0xe6, 0x05, 0x52, 0x78, 0x1b, 0x70, 0x7d, 0x1c, 0x7b, 0xaf, 0x99, 0xf0, 0x58,
+	0x96, 0xe6, 0x66, 0x5e, 0xd0, 0xf3, 0x5e, 0x33, 0xfb, 0x53, 0xb8, 0x71, 0x01, 0xb3, 0x48, 0xac,
+	0x47, 0xe9, 0xc4, 0xb2, 0x9c, 0x0b, 0x4a, 0x49, 0x76, 0xfd, 0x5d, 0x83, 0x1d, 0x14, 0xf5, 0xc3,
+	0x53, 0x16, 0x48, 0x93, 0x2b, 0x60, 0x70, 0xa4, 0x94, 0xc5, 0xe0, 0x2c, 0xe5, 0x54, 0x0a, 0xd0,
+	0x5e, 0x69, 0x79, 0xda, 0x5e, 0xc9, 0x93, 0x88, 0x2f, 0xba, 0x24, 0x7b, 0xa5, 0x4b, 0xf4, 0xb7,
+	0xbb, 0xc4, 0x78, 0x8b, 0x4b, 0xcc, 0xb4, 0x4b, 0x7e, 0x08, 0x24, 0x6d, 0x92, 0xf0, 0xc8, 0xc3,
+	0xb4, 0x47, 0x76, 0x9d, 0xb4, 0x4e, 0xe2, 0x90, 0x2f, 0xe1, 0x03, 0x14, 0x50, 0x36, 0xf3, 0x55,
+	0x3a, 0x62, 0xee, 0xcc, 0x63, 0xac, 0x29, 0x16, 0xb8, 0x03, 0x9f, 0xc9, 0xd2, 0xcb, 0xd3, 0x84,
+	0x44, 0xc9, 0x8c, 0x05, 0x23, 0xbc, 0xf8, 0xa4, 0x27, 0x12, 0x92, 0x7c, 0x0c, 0x85, 0x80, 0x79,
+	0x93, 0x93, 0x41, 0x18, 0xc5, 0xe5, 0xac, 0x38, 0xf7, 0x86, 0x38, 0xb7, 0xa3, 0xb8, 0x72, 0x6f,
+	0xba, 0xd2, 0xb2, 0xff, 0xa9, 0x01, 0xb9, 0xac, 0x81, 0x35, 0x3e, 0x8f, 0xfc, 0xa4, 0xd5, 0xcd,
+	0x23, 0x1f, 0x3b, 0xd6, 0x8c, 0xb1, 0x28, 0xe9, 0x58, 0xb8, 0x5e, 0x47, 0x92, 0x4d, 0x23, 0xf9,
+	0x14, 0x4a, 0xbe, 0x1b, 0xf3, 0xe3, 0x78, 0x3e, 0x1c, 0xb2, 0x38, 0x7e, 0x87, 0x96, 0x55, 0x44,
+	0xfd, 0x9e, 0x54, 0x27, 0x5f, 0x03, 0x10, 0x9f, 0xb3, 0x28, 0x0a, 0x23, 0x11, 0x8b, 0x02, 0x2d,
+	0x20, 0xa7, 0x89, 0x0c, 0x72, 0x00, 0xbb, 0x2b, 0xf1, 0xb1, 0xe8, 0x89, 0x57, 0x97, 0xe8, 0xf6,
+	0xf2, 0xfb, 0x06, 0x36, 0xc7, 0x3f, 0x6a, 0x50, 0x7c, 0xca, 0xd8, 0x68, 0xad, 0x23, 0xa9, 0x1e,
+	0xa3, 0xa5, 0x7a, 0xcc, 0xaa, 0x53, 0x65, 0x36, 0x77, 0xaa, 0xec, 0x7a, 0xa7, 0x7a, 0x08, 0x3a,
+	0x3e, 0x98, 0xd4, 0xb3, 0xe5, 0xff, 0x9c, 0xb5, 0x13, 0x9c, 0xc3, 0x70, 0xc4, 0xa8, 0x10, 0xdb,
+	0x1f, 0x81, 0x8e, 0x14, 0x36, 0x86, 0xfa, 0x33, 0xda, 0xed, 0x74, 0xad, 0x2d, 0xb2, 0x0d, 0x85,
+	0x5a, 0xa7, 0xd3, 0xed, 0xd7, 0xfa, 0x4d, 0xec, 0x13, 0x00, 0x66, 0xaf, 0x5f, 0xab, 0x3f, 0xef,
+	0x59, 0x19, 0xfb, 0x04, 0xf2, 0xb8, 0x51, 0x8b, 0xb3, 0x29, 0x9e, 0x3b, 0xc0, 0x6b, 0x5f, 0xc1,
+	0x94, 0xc4, 0x1a, 0xfa, 0x4c, 0x0a, 0xbd, 0x03, 0xb9, 0x99, 0xbb, 0xf0, 0x43, 0xd5, 0x3a, 0x8b,
+	0xd5, 0x9b, 0x97, 0x3c, 0x54, 0x0b, 0x16, 0x34, 0x51, 0xb2, 0xbf, 0x80, 0x52, 0x72, 0x92, 0xc8,
+	0xe2, 0x7b, 0xe9, 0x2c, 0x2e, 0x38, 0x89, 0x54, 0xe5, 0xef, 0x7b, 0xbc, 0x32, 0x7e, 0xa3, 0x81,
+	0x71, 0xc8, 0xa2, 0x09, 0x7b, 0x83, 0x09, 0xc9, 0xed, 0x96, 0x79, 0xb7, 0xdb, 0x0d, 0x5f, 0x26,
+	0xf3, 0xf8, 0xe2, 0x5d, 0x29, 0x58, 0xe4, 0x01, 0xe4, 0xb8, 0x1b, 0x4d, 0x18, 0x97, 0x37, 0x43,
+	0x0a, 0x77, 0x22, 0xb1, 0x7f, 0xad, 0x81, 0xd9, 0x9a, 0x04, 0x61, 0xf4, 0x1f, 0x00, 0x74, 0x1f,
+	0x4c, 0x79, 0xac, 0x2a, 0x84, 0x35, 0x3c, 0x4a, 0x60, 0x7f, 0xa5, 0x81, 0xfe, 0xd4, 0x77, 0x27,
+	0xff, 0x13, 0x60, 0x7e, 0xa9, 0x81, 0xfe, 0xa3, 0xd0, 0x0b, 0xae, 0x1f, 0xcc, 0x1d, 0x2c, 0xa3,
+	0x53, 0x96, 0x04, 0x0a, 0x1f, 0x98, 0xa7, 0x8c, 0x4a, 0x9e, 0x7d, 0x0a, 0xf9, 0x5a, 0x10, 0x84,
+	0xf3, 0x60, 0x78, 0xfd, 0x31, 0xb2, 0x7f, 0xa5, 0x81, 0xd1, 0x66, 0xee, 0x19, 0xfb, 0x2f, 0x1b,
+	0xfd, 0x57, 0x0d, 0xf4, 0x3e, 0x3b, 0xe7, 0xd7, 0x0f, 0x83, 0x80, 0x3e, 0x08, 0x47, 0x0b, 0x91,
+	0x06, 0x05, 0x2a, 0xd6, 0xe4, 0xeb, 0x90, 0x1f, 0x86, 0xd3, 0x29, 0x0b, 0x78, 0x5c, 0x36, 0x04,
+	0xba, 0xbc, 0x53, 0x97, 0x0c, 0xba, 0x94, 0xac, 0x0c, 0x30, 0x37, 0x18, 0xf0, 0x63, 0xc8, 0x23,
+	0x7e, 0xd1, 0x3f, 0xee, 0xa4, 0xfb, 0x87, 0xe1, 0xa0, 0xe4, 0xfd, 0x7b, 0xc7, 0x9f, 0xb0, 0x38,
+	0x3c, 0x5f, 0x84, 0xc6, 0xc3, 0x39, 0x40, 0xf8, 0xc4, 0xa0, 0x92, 0x20, 0x77, 0x41, 0xc7, 0xf7,
+	0xfa, 0x86, 0x71, 0x41, 0xf0, 0xf1, 0x75, 0x82, 0x13, 0x4b, 0x72, 0x27, 0x5a, 0x42, 0x41, 0x8c,
+	0x32, 0xc9, 0x73, 0x5f, 0x88, 0x71, 0x2e, 0x59, 0x31, 0xff, 0xed, 0xb9, 0xe4, 0xb7, 0x19, 0x30,
+	0x50, 0x10, 0xbf, 0xa5, 0x57, 0xcb, 0xfa, 0x4b, 0x7a, 0xb5, 0xa0, 0x96, 0x91, 0xcd, 0xbe, 0x67,
+	0x64, 0xf5, 0xcb, 0x91, 0x2d, 0x43, 0x6e, 0xe8, 0xce, 0xf0, 0x35, 0xa1, 0x2e, 0xcf, 0x84, 0xc4,
+	0x80, 0xc8, 0xc9, 0x27, 0x89, 0x1c, 0x22, 0x55, 0xe3, 0x4e, 0x2a, 0xf8, 0xb9, 0xab, 0x83, 0x9f,
+	0xbf, 0x1c, 0x7c, 0x3c, 0x59, 0x5e, 0x3d, 0x71, 0xb9, 0x20, 0x06, 0xed, 0x84, 0xb4, 0x7b, 0x50,
+	0x10, 0x5e, 0x11, 0x79, 0xf1, 0x61, 0x3a, 0x2f, 0x4c, 0x39, 0x7b, 0xbd, 0x7f, 0x62, 0xfc, 0x5e,
+	0x83, 0x9c, 0x42, 0x78, 0x69, 0x4e, 0xb9, 0xe6, 0x4a, 0x59, 0xb5, 0x51, 0xe3, 0x4d, 0x6d, 0xf4,
+	0x23, 0x28, 0x2a, 0x70, 0xc2, 0xe8, 0xbb, 0x69, 0xa3, 0x57, 0xbe, 0x95, 0x6c, 0xd1, 0x75, 0xd1,
+	0x97, 0xd7, 0x69, 0xc9, 0x3b, 0x34, 0xff, 0x6f, 0x40, 0x1e, 0x51, 0x6c, 0xae, 0x5f, 0x19, 0x6b,
+	0x89, 0xf7, 0x2f, 0x1a, 0x94, 0x3e, 0x77, 0x7d, 0x9f, 0xf1, 0xa3, 0x99, 0x38, 0xf7, 0xea, 0x49,
+	0xf1, 0x91, 0xfa, 0x5b, 0x47, 0xfe, 0x49, 0x42, 0x9c, 0xf5, 0xcf, 0xd7, 0xfe, 0xdc, 0xb1, 0x7f,
+	0x0a, 0x3a, 0x52, 0xc4, 0x82, 0x52, 0xff, 0x19, 0xce, 0x4b, 0xc7, 0xb5, 0x46, 0xa3, 0xd9, 0xb0,
+	0xb6, 0x08, 0x81, 0x1d, 0xc5, 0xa1, 0xcd, 0xc3, 0xee, 0x4b, 0xf1, 0x56, 0xba, 0x05, 0xa4, 0x56,
+	0xaf, 0x77, 0x8f, 0x3a, 0xfd, 0xe3, 0x17, 0xcd, 0x26, 0x55, 0xba, 0x19, 0x52, 0x86, 0x9b, 0x29,
+	0x7e, 0xf2, 0x45, 0xd6, 0xfe, 0x9b, 0x06, 0xb9, 0xde, 0x7c, 0x3a, 0x75, 0xa3, 0xc5, 0x25, 0xd4,
+	0x65, 0xc8, 0xb9, 0xa3, 0x51, 0x84, 0xcf, 0x56, 0x89, 0x3c, 0x21, 0xc9, 0x77, 0x80, 0xb8, 0x43,
+	0x91, 0x8c, 0xc7, 0xf8, 0xfe, 0x4d, 0xcd, 0x1a, 0x96, 0x92, 0xbc, 0x60, 0x2c, 0xda, 0x3c, 0x93,
+	0xe8, 0x1b, 0x67, 0x12, 0x51, 0x79, 0xa9, 0xa1, 0x03, 0x04, 0x4b, 0x2a, 0x3c, 0x80, 0xed, 0x61,
+	0x18, 0x70, 0x77, 0x98, 0xcc, 0x3e, 0xa6, 0x50, 0x29, 0x29, 0xa6, 0x50, 0xb2, 0xff, 0xa1, 0x41,
+	0xbe, 0x1d, 0x4e, 0xda, 0xec, 0x8c, 0xf9, 0xe4, 0xbb, 0x90, 0x8b, 0x17, 0xf1, 0x5a, 0xcc, 0x6e,
+	0x39, 0x89, 0xcc, 0xe9, 0x49, 0x81, 0xec, 0x79, 0x89, 0xda, 0xde, 0x73, 0x28, 0xad, 0x0b, 0x36,
+	0xf4, 0xbd, 0x87, 0xeb, 0x7d, 0x0f, 0xff, 0x68, 0x5b, 0xee, 0x28, 0x7e, 0xd7, 0x9b, 0x5f, 0x07,
+	0x0c, 0x89, 0xa3, 0x04, 0xf9, 0x3a, 0x6d, 0xf5, 0x5b, 0xf5, 0x5a, 0xdb, 0xda, 0xc2, 0xff, 0xad,
+	0x9a, 0x94, 0x76, 0xa9, 0xa5, 0x91, 0x22, 0xe4, 0x3e, 0xaf, 0xd1, 0x4e, 0xab, 0xf3, 0x99, 0x95,
+	0xc1, 0x57, 0x6e, 0xa7, 0xdb, 0x6f, 0xd5, 0x9b, 0x56, 0x16, 0x27, 0xe3, 0x56, 0xe7, 0x69, 0xd7,
+	0xd2, 0x51, 0xbb, 0xd1, 0x3c, 0x38, 0xfa, 0xcc, 0x32, 0x0e, 0x6e, 0xc0, 0xb6, 0x17, 0x3a, 0x9c,
+	0x9d, 0x73, 0x6c, 0xd9, 0xb3, 0xc1, 0x4f, 0x32, 0xb3, 0xc1, 0xc0, 0x14, 0x99, 0xff, 0xc9, 0xbf,
+	0x06, 0x00, 0xba, 0x2c, 0x6c, 0x08, 0xe5, 0x14, 0x00, 0x00,
 }
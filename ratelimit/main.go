@@ -0,0 +1,101 @@
+// Package ratelimit implements keyed token-bucket rate limiters.
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// pruneInterval is how often buckets that have refilled are removed
+const pruneInterval = time.Minute
+
+// Limiter holds a token bucket for each key
+type Limiter struct {
+	rate    float64
+	burst   float64
+	buckets map[string]*bucket
+	pruned  time.Time
+	mux     sync.Mutex
+
+	now func() time.Time
+}
+
+// bucket tracks the tokens available to a single key
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// New returns a limiter that allows burst events at once,
+// refilling at rate events per second
+func New(rate float64, burst int) *Limiter {
+	return &Limiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Rate returns the refill rate in events per second
+func (l *Limiter) Rate() float64 {
+	return l.rate
+}
+
+// Burst returns the bucket size
+func (l *Limiter) Burst() int {
+	return int(l.burst)
+}
+
+// Allow takes a token from the key's bucket. When none are available,
+// it returns false and the time until the next token.
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = l.fill(b, now)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	if l.rate <= 0 {
+		return false, time.Duration(math.MaxInt64)
+	}
+	wait := (1 - b.tokens) / l.rate
+	return false, time.Duration(wait * float64(time.Second))
+}
+
+// fill returns the tokens in a bucket after refilling since its last use
+func (l *Limiter) fill(b *bucket, now time.Time) float64 {
+	tokens := b.tokens + now.Sub(b.last).Seconds()*l.rate
+	if tokens > l.burst {
+		tokens = l.burst
+	}
+	return tokens
+}
+
+// prune removes buckets that have completely refilled, which are
+// indistinguishable from new ones
+// note: caller must hold the lock
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.pruned) < pruneInterval {
+		return
+	}
+	l.pruned = now
+	for key, b := range l.buckets {
+		if l.fill(b, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Now()
+	l := New(1, 2)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if ok, _ := l.Allow("a"); !ok {
+			t.Fatalf("request %d should be allowed", i)
+		}
+	}
+	ok, wait := l.Allow("a")
+	if ok {
+		t.Fatal("request should be limited")
+	}
+	if wait != time.Second {
+		t.Errorf("wrong wait: %s", wait)
+	}
+
+	// other keys have their own bucket
+	if ok, _ := l.Allow("b"); !ok {
+		t.Fatal("other key should be allowed")
+	}
+
+	// refill
+	now = now.Add(time.Second)
+	if ok, _ := l.Allow("a"); !ok {
+		t.Fatal("request should be allowed after refill")
+	}
+	if ok, _ := l.Allow("a"); ok {
+		t.Fatal("request should be limited again")
+	}
+}
+
+func TestLimiter_Prune(t *testing.T) {
+	now := time.Now()
+	l := New(1, 2)
+	l.now = func() time.Time { return now }
+
+	l.Allow("a")
+	now = now.Add(pruneInterval)
+	l.Allow("b")
+	if _, ok := l.buckets["a"]; ok {
+		t.Error("refilled bucket should be pruned")
+	}
+	if _, ok := l.buckets["b"]; !ok {
+		t.Error("active bucket should not be pruned")
+	}
+}
+
+func TestLimiter_ZeroRate(t *testing.T) {
+	l := New(0, 1)
+	if ok, _ := l.Allow("a"); !ok {
+		t.Fatal("burst should be allowed")
+	}
+	if ok, _ := l.Allow("a"); ok {
+		t.Fatal("request should be limited")
+	}
+}
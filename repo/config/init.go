@@ -12,15 +12,18 @@ import (
 
 // Config is used to load textile config files.
 type Config struct {
-	Account   Account   // local node's account (public info only)
-	Addresses Addresses // local node's addresses
-	API       API       // local node's API settings
-	Gateway   Gateway   // local node's Gateway settings
-	Logs      Logs      // local node's log settings
-	Threads   Threads   // local node's thread settings
-	IsMobile  bool      // local node is setup for mobile
-	IsServer  bool      // local node is setup for a server w/ a public IP
-	Cafe      Cafe      // local node cafe settings
+	Account       Account       // local node's account (public info only)
+	Addresses     Addresses     // local node's addresses
+	API           API           // local node's API settings
+	Gateway       Gateway       // local node's Gateway settings
+	TLS           TLS           // local node's TLS settings for the cafe API and gateway
+	Logs          Logs          // local node's log settings
+	Threads       Threads       // local node's thread settings
+	Notifications Notifications // local node's notification settings
+	Webhooks      []Webhook     // local node's webhooks for thread updates
+	IsMobile      bool          // local node is setup for mobile
+	IsServer      bool          // local node is setup for a server w/ a public IP
+	Cafe          Cafe          // local node cafe settings
 }
 
 // Account store public account info
@@ -33,6 +36,7 @@ type Account struct {
 type Addresses struct {
 	API       string // bind address of the local REST API
 	CafeAPI   string // bind address of the cafe REST API
+	GRPC      string // bind address of the local gRPC API
 	Gateway   string // bind address of the IPFS object gateway
 	Profiling string // bind address of the profiling API
 }
@@ -49,6 +53,8 @@ type HTTPHeaders = map[string][]string
 type API struct {
 	HTTPHeaders HTTPHeaders
 	SizeLimit   int64 // Maximum file size limit to accept for POST requests in bytes
+	Metrics     bool  // When true, Prometheus metrics are served at /metrics
+	Auth        bool  // When true, requests must include an API key as a bearer token
 }
 
 // Gateway settings
@@ -56,6 +62,23 @@ type Gateway struct {
 	HTTPHeaders HTTPHeaders
 }
 
+// TLS settings
+type TLS struct {
+	Enabled  bool     // When true, the cafe API and gateway are served over HTTPS.
+	CertFile string   // Path to a PEM certificate, relative to the repo. A self-signed certificate is generated when missing.
+	KeyFile  string   // Path to the certificate's PEM private key, relative to the repo.
+	Hosts    []string // Host names and IPs the certificate covers. Defaults to the cafe URL host.
+	ACME     ACME     // Automatic certificates from an ACME certificate authority, e.g., Let's Encrypt.
+}
+
+// ACME settings
+type ACME struct {
+	Enabled      bool   // When true, certificates for Hosts are obtained and renewed automatically instead of using CertFile and KeyFile.
+	DirectoryURL string // ACME directory endpoint, defaults to Let's Encrypt.
+	Email        string // Contact address registered with the certificate authority.
+	CacheDir     string // Directory where issued certificates and the account key are kept, relative to the repo.
+}
+
 // Logs settings
 type Logs struct {
 	LogToDisk bool // when true, sends all logs to rolling files on disk
@@ -71,6 +94,22 @@ type ThreadDefaults struct {
 	ID string // default thread ID for reads/writes
 }
 
+// Notifications settings
+type Notifications struct {
+	MaxAge     int  // maximum age in days of kept notifications, 0 keeps all
+	MaxCount   int  // maximum number of kept notifications (oldest are pruned first), 0 keeps all
+	KeepUnread bool // when true, unread notifications are never pruned
+}
+
+// Webhook settings
+type Webhook struct {
+	ID      string   // unique webhook ID
+	URL     string   // endpoint that receives POSTed thread updates as JSON
+	Secret  string   // key used to sign request bodies (HMAC-SHA256), empty disables signing
+	Threads []string // thread IDs to match, empty matches all threads
+	Types   []string // block types to match (e.g., FILES, TEXT), empty matches all types
+}
+
 // Cafe settings
 type Cafe struct {
 	Host   CafeHost
@@ -83,6 +122,48 @@ type CafeHost struct {
 	URL         string // Override the resolved URL of this cafe, useful for load HTTPS and/or load balancers
 	NeighborURL string // Specifies the URL of a secondary cafe. Must return cafe info.
 	SizeLimit   int64  // Maximum file size limit to accept for POST requests in bytes.
+
+	NeighborURLs         []string // URLs of further neighbor cafes, along with NeighborURL. Each must return cafe info.
+	ReplicateToNeighbors bool     // When true, client pins, thread snapshots, and inbox messages are mirrored to each neighbor cafe.
+
+	FederatedPeers    []string // URLs of peer cafes that federated queries are forwarded to and accepted from. Each must return cafe info.
+	FederationMaxHops int      // Maximum number of times a federated query may be forwarded between cafes.
+
+	ClientStorageQuota int64 // Maximum bytes each client may store, 0 is unlimited.
+	ClientThreadQuota  int   // Maximum thread snapshots each client may store, 0 is unlimited.
+	TokenStorageQuota  int64 // Maximum bytes all clients registered with the same token may store, 0 is unlimited.
+
+	ThreadSnapshotHistory int // Number of previous snapshots kept for each client thread as restore points, 0 keeps none.
+
+	Plans map[string]CafePlan // Named quota classes that can be assigned to tokens, replacing the client quotas above.
+
+	InboxMaxMessages int   // Maximum inbox messages held for each client, 0 is unlimited.
+	InboxMaxAge      int   // Maximum age in days of inbox messages held for clients, 0 keeps all.
+	InboxMaxSize     int64 // Maximum total bytes of inbox messages held for each client, measured by fetching each message, 0 is unlimited.
+
+	PeerRateLimits map[string]CafeRateLimit // Limits applied to each peer, keyed by cafe message type (e.g., CAFE_REGISTRATION) or API route (e.g., POST /api/v1/inbox/:pid).
+	IPRateLimits   map[string]CafeRateLimit // Limits applied to each IP address, keyed like PeerRateLimits. Message type limits only apply to messages received over HTTP.
+	BannedPeers    []string                 // Peer IDs whose requests are always rejected.
+	BannedIPs      []string                 // IP addresses or CIDR ranges whose HTTP requests are always rejected.
+
+	TrustProxyHeaders bool // When true, client IPs are read from X-Forwarded-For and X-Real-Ip headers, e.g., when behind a load balancer.
+
+	AdminToken string // Bearer token required by the cafe API admin routes, which are disabled when empty.
+
+	Metrics bool // When true, Prometheus metrics are served at /metrics, guarded by AdminToken when set.
+}
+
+// CafePlan settings
+type CafePlan struct {
+	ClientStorageQuota int64 // Maximum bytes each client may store, 0 is unlimited.
+	ClientThreadQuota  int   // Maximum thread snapshots each client may store, 0 is unlimited.
+	TokenStorageQuota  int64 // Maximum bytes all clients registered with the same token may store, 0 is unlimited.
+}
+
+// CafeRateLimit settings
+type CafeRateLimit struct {
+	Rate  float64 // Requests allowed per second once the burst is used up.
+	Burst int     // Requests allowed at once.
 }
 
 // CafeClient settings
@@ -108,6 +189,7 @@ func Init() (*Config, error) {
 		Addresses: Addresses{
 			API:       "127.0.0.1:40600",
 			CafeAPI:   "0.0.0.0:40601",
+			GRPC:      "127.0.0.1:40602",
 			Gateway:   "127.0.0.1:5050",
 			Profiling: "127.0.0.1:6060",
 		},
@@ -134,6 +216,7 @@ func Init() (*Config, error) {
 					"X-Requested-With",
 					// reason why this is here is unknown
 					"Method",
+					"Authorization",
 					// textile custom headers
 					"X-Textile-Args",
 					"X-Textile-Opts",
@@ -144,6 +227,8 @@ func Init() (*Config, error) {
 				},
 			},
 			SizeLimit: 0,
+			Metrics:   false,
+			Auth:      true,
 		},
 		Gateway: Gateway{
 			HTTPHeaders: HTTPHeaders{
@@ -169,6 +254,18 @@ func Init() (*Config, error) {
 				},
 			},
 		},
+		TLS: TLS{
+			Enabled:  false,
+			CertFile: "tls/cert.pem",
+			KeyFile:  "tls/key.pem",
+			Hosts:    []string{},
+			ACME: ACME{
+				Enabled:      false,
+				DirectoryURL: "",
+				Email:        "",
+				CacheDir:     "tls/acme",
+			},
+		},
 		Logs: Logs{
 			LogToDisk: true,
 		},
@@ -177,12 +274,56 @@ func Init() (*Config, error) {
 				ID: "",
 			},
 		},
+		Notifications: Notifications{
+			MaxAge:     90,
+			MaxCount:   1000,
+			KeepUnread: true,
+		},
+		Webhooks: []Webhook{},
 		Cafe: Cafe{
 			Host: CafeHost{
 				Open:        false,
 				URL:         "",
 				NeighborURL: "",
 				SizeLimit:   0,
+
+				NeighborURLs:         []string{},
+				ReplicateToNeighbors: false,
+
+				FederatedPeers:    []string{},
+				FederationMaxHops: 3,
+
+				ClientStorageQuota: 0,
+				ClientThreadQuota:  0,
+				TokenStorageQuota:  0,
+
+				ThreadSnapshotHistory: 10,
+
+				Plans: make(map[string]CafePlan),
+
+				InboxMaxMessages: 0,
+				InboxMaxAge:      30,
+				InboxMaxSize:     0,
+
+				PeerRateLimits: map[string]CafeRateLimit{
+					"CAFE_CHALLENGE":       {Rate: 1, Burst: 10},
+					"CAFE_REGISTRATION":    {Rate: 1, Burst: 10},
+					"CAFE_DELIVER_MESSAGE": {Rate: 10, Burst: 100},
+					"CAFE_PUBSUB_QUERY":    {Rate: 1, Burst: 10},
+				},
+				IPRateLimits: map[string]CafeRateLimit{
+					"CAFE_CHALLENGE":          {Rate: 1, Burst: 10},
+					"CAFE_REGISTRATION":       {Rate: 1, Burst: 10},
+					"POST /api/v1/inbox/:pid": {Rate: 10, Burst: 100},
+				},
+				BannedPeers: []string{},
+				BannedIPs:   []string{},
+
+				TrustProxyHeaders: false,
+
+				AdminToken: "",
+
+				Metrics: false,
 			},
 			Client: CafeClient{
 				Mobile: MobileCafeClient{
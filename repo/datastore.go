@@ -26,6 +26,10 @@ type Datastore interface {
 	CafeTokens() CafeTokenStore
 	CafeClientThreads() CafeClientThreadStore
 	CafeClientMessages() CafeClientMessageStore
+	CafeClientPins() CafeClientPinStore
+	WebhookMessages() WebhookMessageStore
+	CafeReplications() CafeReplicationStore
+	ApiKeys() ApiKeyStore
 	Ping() error
 	Close()
 }
@@ -116,6 +120,7 @@ type BlockMessageStore interface {
 	Queryable
 	Add(msg *pb.BlockMessage) error
 	List(offset string, limit int) []pb.BlockMessage
+	Count() int
 	Delete(id string) error
 }
 
@@ -133,12 +138,23 @@ type NotificationStore interface {
 	Get(id string) *pb.Notification
 	Read(id string) error
 	ReadAll() error
-	List(offset string, limit int) *pb.NotificationList
+	ReadByQuery(query string, args ...interface{}) error
+	List(offset string, limit int, query string, args ...interface{}) *pb.NotificationList
 	CountUnread() int
 	Delete(id string) error
 	DeleteByActor(actorId string) error
 	DeleteBySubject(subjectId string) error
 	DeleteByBlock(blockId string) error
+	DeleteByQuery(query string, args ...interface{}) error
+}
+
+type WebhookMessageStore interface {
+	Queryable
+	Add(msg *pb.WebhookMessage) error
+	List(offset string, limit int) []pb.WebhookMessage
+	AddAttempt(id string, next time.Time) error
+	Delete(id string) error
+	DeleteByWebhook(webhookId string) error
 }
 
 // Cafe user-side stores
@@ -147,6 +163,8 @@ type CafeSessionStore interface {
 	AddOrUpdate(session *pb.CafeSession) error
 	Get(cafeId string) *pb.CafeSession
 	List() *pb.CafeSessionList
+	UpdatePriority(cafeId string, priority int) error
+	UpdateHealth(cafeId string, health *pb.CafeHealth) error
 	Delete(cafeId string) error
 }
 
@@ -155,19 +173,26 @@ type CafeRequestStore interface {
 	Add(req *pb.CafeRequest) error
 	Get(id string) *pb.CafeRequest
 	List(offset string, limit int) *pb.CafeRequestList
+	ListFailed(offset string, limit int) *pb.CafeRequestList
 	ListCompletedGroups() []string
 	CountByGroup(groupId string) int
+	CountByStatus(status pb.CafeRequest_Status) int
 	GroupStatus(groupId string) *pb.CafeRequestGroupStatus
 	UpdateStatus(id string, status pb.CafeRequest_Status) error
+	AddAttempt(id string, next time.Time) error
+	Retry(id string) (int, error)
 	Delete(id string) error
+	DeleteFailed(id string) (int, error)
 	DeleteByGroup(groupId string) error
 	DeleteByCafe(cafeId string) error
+	Reroute(fromCafeId string, to *pb.Cafe) (int, error)
 }
 
 type CafeMessageStore interface {
 	Queryable
 	Add(msg *pb.CafeMessage) error
 	List(offset string, limit int) []pb.CafeMessage
+	Count() int
 	AddAttempt(id string) error
 	Delete(id string) error
 }
@@ -192,22 +217,61 @@ type CafeClientStore interface {
 
 type CafeClientThreadStore interface {
 	AddOrUpdate(thrd *pb.CafeClientThread) error
+	Get(id string, clientId string) *pb.CafeClientThread
+	GetVersion(id string, clientId string, version int) *pb.CafeClientThread
+	GetAsOf(id string, clientId string, date time.Time) *pb.CafeClientThread
 	ListByClient(clientId string) []pb.CafeClientThread
+	ListVersions(id string, clientId string) []pb.CafeClientThread
+	CountByClient(clientId string) int
+	PruneVersions(id string, clientId string, keep int) error
 	Delete(id string, clientId string) error
 	DeleteByClient(clientId string) error
 }
 
 type CafeClientMessageStore interface {
 	AddOrUpdate(message *pb.CafeClientMessage) error
+	Get(id string, clientId string) *pb.CafeClientMessage
 	ListByClient(clientId string, limit int) []pb.CafeClientMessage
+	Count() int
 	CountByClient(clientId string) int
+	SizeByClient(clientId string) int64
 	Delete(id string, clientId string) error
 	DeleteByClient(clientId string, limit int) error
 }
 
+type CafeClientPinStore interface {
+	AddOrUpdate(pin *pb.CafeClientPin) error
+	Get(id string, clientId string) *pb.CafeClientPin
+	ListByClient(clientId string) []pb.CafeClientPin
+	CountByClient(clientId string) int
+	CountById(id string) int
+	SizeByClient(clientId string) int64
+	SizeByToken(tokenId string) int64
+	Delete(id string, clientId string) error
+	DeleteByClient(clientId string) error
+}
+
 type CafeTokenStore interface {
 	Add(token *pb.CafeToken) error
 	Get(id string) *pb.CafeToken
 	List() []pb.CafeToken
 	Delete(id string) error
 }
+
+type CafeReplicationStore interface {
+	Queryable
+	Add(rep *pb.CafeReplication) error
+	List(offset string, limit int) []pb.CafeReplication
+	Count() int
+	CountByNeighbor(neighbor string) int
+	AddAttempt(id string) error
+	Delete(id string) error
+}
+
+type ApiKeyStore interface {
+	Add(key *pb.ApiKey) error
+	Get(id string) *pb.ApiKey
+	List() *pb.ApiKeyList
+	Count() int
+	Delete(id string) error
+}
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type ApiKeyDB struct {
+	modelStore
+}
+
+func NewApiKeyStore(db *sql.DB, lock *sync.Mutex) repo.ApiKeyStore {
+	return &ApiKeyDB{modelStore{db, lock}}
+}
+
+func (c *ApiKeyDB) Add(key *pb.ApiKey) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert into api_keys(id, name, scopes, date, expires) values(?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	var expires int64
+	if key.Expires != nil {
+		expires = util.ProtoNanos(key.Expires)
+	}
+	_, err = stmt.Exec(
+		key.Id,
+		key.Name,
+		strings.Join(key.Scopes, ","),
+		util.ProtoNanos(key.Date),
+		expires,
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *ApiKeyDB) Get(id string) *pb.ApiKey {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from api_keys where id='" + id + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return res[0]
+}
+
+func (c *ApiKeyDB) List() *pb.ApiKeyList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from api_keys order by date desc;"
+	return &pb.ApiKeyList{Items: c.handleQuery(stm)}
+}
+
+func (c *ApiKeyDB) Count() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from api_keys;")
+	var count int
+	row.Scan(&count)
+	return count
+}
+
+func (c *ApiKeyDB) Delete(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from api_keys where id=?", id)
+	return err
+}
+
+func (c *ApiKeyDB) handleQuery(stm string) []*pb.ApiKey {
+	var list []*pb.ApiKey
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var id, name, scopes string
+		var dateInt, expiresInt int64
+		if err := rows.Scan(&id, &name, &scopes, &dateInt, &expiresInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		var expires *timestamp.Timestamp
+		if expiresInt > 0 {
+			expires = util.ProtoTs(expiresInt)
+		}
+		list = append(list, &pb.ApiKey{
+			Id:      id,
+			Name:    name,
+			Scopes:  util.SplitString(scopes, ","),
+			Date:    util.ProtoTs(dateInt),
+			Expires: expires,
+		})
+	}
+	return list
+}
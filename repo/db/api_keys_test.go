@@ -0,0 +1,93 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+var apiKeyStore repo.ApiKeyStore
+
+func init() {
+	setupApiKeyDB()
+}
+
+func setupApiKeyDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	apiKeyStore = NewApiKeyStore(conn, new(sync.Mutex))
+}
+
+func TestApiKeyDB_Add(t *testing.T) {
+	if err := apiKeyStore.Add(&pb.ApiKey{
+		Id:      "abcde",
+		Name:    "cli",
+		Scopes:  []string{"read", "write"},
+		Date:    ptypes.TimestampNow(),
+		Expires: util.ProtoTs(time.Now().Add(time.Hour).UnixNano()),
+	}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestApiKeyDB_Get(t *testing.T) {
+	key := apiKeyStore.Get("abcde")
+	if key == nil {
+		t.Error("could not get key")
+		return
+	}
+	if key.Name != "cli" || len(key.Scopes) != 2 || key.Scopes[1] != "write" {
+		t.Error("key has bad values")
+	}
+	if key.Expires == nil {
+		t.Error("key should have an expiry")
+	}
+}
+
+func TestApiKeyDB_List(t *testing.T) {
+	setupApiKeyDB()
+	for i := 0; i < 3; i++ {
+		if err := apiKeyStore.Add(&pb.ApiKey{
+			Id:     strconv.Itoa(i),
+			Scopes: []string{"read"},
+			Date:   util.ProtoTs(time.Now().Add(time.Minute * time.Duration(i)).UnixNano()),
+		}); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	list := apiKeyStore.List()
+	if len(list.Items) != 3 {
+		t.Errorf("expected 3 keys, got %d", len(list.Items))
+		return
+	}
+	if list.Items[0].Id != "2" {
+		t.Error("keys should be newest first")
+	}
+	if list.Items[0].Expires != nil {
+		t.Error("key should not have an expiry")
+	}
+}
+
+func TestApiKeyDB_Count(t *testing.T) {
+	if apiKeyStore.Count() != 3 {
+		t.Error("count incorrect")
+	}
+}
+
+func TestApiKeyDB_Delete(t *testing.T) {
+	if err := apiKeyStore.Delete("1"); err != nil {
+		t.Error(err)
+		return
+	}
+	if apiKeyStore.Get("1") != nil {
+		t.Error("key should be deleted")
+	}
+}
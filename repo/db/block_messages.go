@@ -65,6 +65,15 @@ func (c *BlockMessageDB) List(offset string, limit int) []pb.BlockMessage {
 	return c.handleQuery(stm)
 }
 
+func (c *BlockMessageDB) Count() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from block_messages;")
+	var count int
+	row.Scan(&count)
+	return count
+}
+
 func (c *BlockMessageDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -25,7 +25,7 @@ func (c *CafeClientMessagesDB) AddOrUpdate(message *pb.CafeClientMessage) error
 	if err != nil {
 		return err
 	}
-	stm := `insert or replace into cafe_client_messages(id, peerId, clientId, date) values(?,?,?,?)`
+	stm := `insert or replace into cafe_client_messages(id, peerId, clientId, date, size) values(?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -37,6 +37,7 @@ func (c *CafeClientMessagesDB) AddOrUpdate(message *pb.CafeClientMessage) error
 		message.Peer,
 		message.Client,
 		util.ProtoNanos(message.Date),
+		message.Size,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -46,6 +47,16 @@ func (c *CafeClientMessagesDB) AddOrUpdate(message *pb.CafeClientMessage) error
 	return nil
 }
 
+func (c *CafeClientMessagesDB) Get(id string, clientId string) *pb.CafeClientMessage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from cafe_client_messages where id='" + id + "' and clientId='" + clientId + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
 func (c *CafeClientMessagesDB) ListByClient(clientId string, limit int) []pb.CafeClientMessage {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -53,6 +64,15 @@ func (c *CafeClientMessagesDB) ListByClient(clientId string, limit int) []pb.Caf
 	return c.handleQuery(stm)
 }
 
+func (c *CafeClientMessagesDB) Count() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_client_messages;")
+	var count int
+	row.Scan(&count)
+	return count
+}
+
 func (c *CafeClientMessagesDB) CountByClient(clientId string) int {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -62,6 +82,15 @@ func (c *CafeClientMessagesDB) CountByClient(clientId string) int {
 	return count
 }
 
+func (c *CafeClientMessagesDB) SizeByClient(clientId string) int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select coalesce(sum(size), 0) from cafe_client_messages where clientId='" + clientId + "';")
+	var size int64
+	row.Scan(&size)
+	return size
+}
+
 func (c *CafeClientMessagesDB) Delete(id string, clientId string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -90,8 +119,8 @@ func (c *CafeClientMessagesDB) handleQuery(stm string) []pb.CafeClientMessage {
 	}
 	for rows.Next() {
 		var id, peerId, clientId string
-		var dateInt int64
-		if err := rows.Scan(&id, &peerId, &clientId, &dateInt); err != nil {
+		var dateInt, size int64
+		if err := rows.Scan(&id, &peerId, &clientId, &dateInt, &size); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
@@ -100,6 +129,7 @@ func (c *CafeClientMessagesDB) handleQuery(stm string) []pb.CafeClientMessage {
 			Peer:   peerId,
 			Client: clientId,
 			Date:   util.ProtoTs(dateInt),
+			Size:   size,
 		})
 	}
 	return list
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeClientMessageStore repo.CafeClientMessageStore
+
+func init() {
+	setupCafeClientMessageDB()
+}
+
+func setupCafeClientMessageDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	cafeClientMessageStore = NewCafeClientMessageStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientMessagesDB_AddOrUpdate(t *testing.T) {
+	for i, id := range []string{"Qm1", "Qm2", "Qm3"} {
+		if err := cafeClientMessageStore.AddOrUpdate(&pb.CafeClientMessage{
+			Id:     id,
+			Peer:   "peer",
+			Client: "client1",
+			Date:   ptypes.TimestampNow(),
+			Size:   int64(1024 * (i + 1)),
+		}); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	list := cafeClientMessageStore.ListByClient("client1", -1)
+	if len(list) != 3 {
+		t.Error("list by client failed")
+		return
+	}
+	if list[0].Id != "Qm1" || list[0].Size != 1024 {
+		t.Error("list by client returned bad message")
+	}
+}
+
+func TestCafeClientMessagesDB_Get(t *testing.T) {
+	msg := cafeClientMessageStore.Get("Qm2", "client1")
+	if msg == nil || msg.Size != 2048 {
+		t.Error("get failed")
+	}
+}
+
+func TestCafeClientMessagesDB_Count(t *testing.T) {
+	if cafeClientMessageStore.Count() != 3 {
+		t.Error("count failed")
+	}
+}
+
+func TestCafeClientMessagesDB_CountByClient(t *testing.T) {
+	if cafeClientMessageStore.CountByClient("client1") != 3 {
+		t.Error("count by client failed")
+	}
+}
+
+func TestCafeClientMessagesDB_SizeByClient(t *testing.T) {
+	if cafeClientMessageStore.SizeByClient("client1") != 1024*6 {
+		t.Error("size by client failed")
+	}
+	if cafeClientMessageStore.SizeByClient("client2") != 0 {
+		t.Error("size by unknown client should be zero")
+	}
+}
+
+func TestCafeClientMessagesDB_Delete(t *testing.T) {
+	if err := cafeClientMessageStore.Delete("Qm1", "client1"); err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientMessageStore.CountByClient("client1") != 2 {
+		t.Error("delete failed")
+	}
+}
+
+func TestCafeClientMessagesDB_DeleteByClient(t *testing.T) {
+	if err := cafeClientMessageStore.DeleteByClient("client1", -1); err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientMessageStore.CountByClient("client1") != 0 {
+		t.Error("delete by client failed")
+	}
+}
@@ -0,0 +1,137 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type CafeClientPinDB struct {
+	modelStore
+}
+
+func NewCafeClientPinStore(db *sql.DB, lock *sync.Mutex) repo.CafeClientPinStore {
+	return &CafeClientPinDB{modelStore{db, lock}}
+}
+
+func (c *CafeClientPinDB) AddOrUpdate(pin *pb.CafeClientPin) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into cafe_client_pins(id, clientId, size, date) values(?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		pin.Id,
+		pin.Client,
+		pin.Size,
+		util.ProtoNanos(pin.Date),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *CafeClientPinDB) Get(id string, clientId string) *pb.CafeClientPin {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from cafe_client_pins where id='" + id + "' and clientId='" + clientId + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
+func (c *CafeClientPinDB) ListByClient(clientId string) []pb.CafeClientPin {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_pins where clientId='" + clientId + "' order by date asc;"
+	return c.handleQuery(stm)
+}
+
+func (c *CafeClientPinDB) CountByClient(clientId string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_client_pins where clientId='" + clientId + "';")
+	var count int
+	row.Scan(&count)
+	return count
+}
+
+func (c *CafeClientPinDB) CountById(id string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_client_pins where id=?;", id)
+	var count int
+	row.Scan(&count)
+	return count
+}
+
+func (c *CafeClientPinDB) SizeByClient(clientId string) int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select coalesce(sum(size), 0) from cafe_client_pins where clientId='" + clientId + "';")
+	var size int64
+	row.Scan(&size)
+	return size
+}
+
+func (c *CafeClientPinDB) SizeByToken(tokenId string) int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select coalesce(sum(size), 0) from cafe_client_pins where clientId in (select id from cafe_clients where tokenId='" + tokenId + "');")
+	var size int64
+	row.Scan(&size)
+	return size
+}
+
+func (c *CafeClientPinDB) Delete(id string, clientId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_pins where id=? and clientId=?", id, clientId)
+	return err
+}
+
+func (c *CafeClientPinDB) DeleteByClient(clientId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_pins where clientId=?", clientId)
+	return err
+}
+
+func (c *CafeClientPinDB) handleQuery(stm string) []pb.CafeClientPin {
+	var list []pb.CafeClientPin
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var id, clientId string
+		var size, dateInt int64
+		if err := rows.Scan(&id, &clientId, &size, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, pb.CafeClientPin{
+			Id:     id,
+			Client: clientId,
+			Size:   size,
+			Date:   util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
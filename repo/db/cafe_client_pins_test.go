@@ -0,0 +1,116 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeClientPinStore repo.CafeClientPinStore
+
+func init() {
+	setupCafeClientPinDB()
+}
+
+func setupCafeClientPinDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	cafeClientPinStore = NewCafeClientPinStore(conn, new(sync.Mutex))
+	clients := NewCafeClientStore(conn, new(sync.Mutex))
+	for _, id := range []string{"client1", "client2"} {
+		clients.Add(&pb.CafeClient{
+			Id:      id,
+			Address: "address",
+			Created: ptypes.TimestampNow(),
+			Seen:    ptypes.TimestampNow(),
+			Token:   "token",
+		})
+	}
+}
+
+func TestCafeClientPinDB_AddOrUpdate(t *testing.T) {
+	for i, id := range []string{"Qm1", "Qm2"} {
+		if err := cafeClientPinStore.AddOrUpdate(&pb.CafeClientPin{
+			Id:     id,
+			Client: "client1",
+			Size:   int64(1024 * (i + 1)),
+			Date:   ptypes.TimestampNow(),
+		}); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	if err := cafeClientPinStore.AddOrUpdate(&pb.CafeClientPin{
+		Id:     "Qm1",
+		Client: "client2",
+		Size:   512,
+		Date:   ptypes.TimestampNow(),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(cafeClientPinStore.ListByClient("client1")) != 2 {
+		t.Error("list by client failed")
+	}
+}
+
+func TestCafeClientPinDB_Get(t *testing.T) {
+	pin := cafeClientPinStore.Get("Qm2", "client1")
+	if pin == nil || pin.Size != 2048 {
+		t.Error("get failed")
+	}
+}
+
+func TestCafeClientPinDB_CountByClient(t *testing.T) {
+	if cafeClientPinStore.CountByClient("client1") != 2 {
+		t.Error("count by client failed")
+	}
+}
+
+func TestCafeClientPinDB_CountById(t *testing.T) {
+	if cafeClientPinStore.CountById("Qm1") != 2 {
+		t.Error("count by id failed")
+	}
+	if cafeClientPinStore.CountById("nope") != 0 {
+		t.Error("count by unknown id failed")
+	}
+}
+
+func TestCafeClientPinDB_SizeByClient(t *testing.T) {
+	if size := cafeClientPinStore.SizeByClient("client1"); size != 3072 {
+		t.Errorf("wrong size by client %d", size)
+	}
+	if size := cafeClientPinStore.SizeByClient("nope"); size != 0 {
+		t.Errorf("wrong size by unknown client %d", size)
+	}
+}
+
+func TestCafeClientPinDB_SizeByToken(t *testing.T) {
+	if size := cafeClientPinStore.SizeByToken("token"); size != 3584 {
+		t.Errorf("wrong size by token %d", size)
+	}
+}
+
+func TestCafeClientPinDB_Delete(t *testing.T) {
+	if err := cafeClientPinStore.Delete("Qm1", "client1"); err != nil {
+		t.Error(err)
+		return
+	}
+	if size := cafeClientPinStore.SizeByClient("client1"); size != 2048 {
+		t.Errorf("wrong size after delete %d", size)
+	}
+}
+
+func TestCafeClientPinDB_DeleteByClient(t *testing.T) {
+	if err := cafeClientPinStore.DeleteByClient("client1"); err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientPinStore.CountByClient("client1") != 0 {
+		t.Error("delete by client failed")
+	}
+}
@@ -2,10 +2,13 @@ package db
 
 import (
 	"database/sql"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
 )
 
 type CafeClientThreadDB struct {
@@ -16,6 +19,7 @@ func NewCafeClientThreadStore(db *sql.DB, lock *sync.Mutex) repo.CafeClientThrea
 	return &CafeClientThreadDB{modelStore{db, lock}}
 }
 
+// AddOrUpdate stores a new version of a client thread, keeping the previous versions
 func (c *CafeClientThreadDB) AddOrUpdate(thrd *pb.CafeClientThread) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -23,7 +27,19 @@ func (c *CafeClientThreadDB) AddOrUpdate(thrd *pb.CafeClientThread) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert or replace into cafe_client_threads(id, clientId, ciphertext) values(?,?,?)`
+
+	var version int32
+	row := tx.QueryRow("select coalesce(max(version), 0) from cafe_client_thread_versions where id=? and clientId=?", thrd.Id, thrd.Client)
+	if err := row.Scan(&version); err != nil {
+		tx.Rollback()
+		return err
+	}
+	thrd.Version = version + 1
+	if thrd.Date == nil {
+		thrd.Date = util.ProtoTs(time.Now().UnixNano())
+	}
+
+	stm := `insert or replace into cafe_client_threads(id, clientId, ciphertext, version, date) values(?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -34,6 +50,20 @@ func (c *CafeClientThreadDB) AddOrUpdate(thrd *pb.CafeClientThread) error {
 		thrd.Id,
 		thrd.Client,
 		thrd.Ciphertext,
+		thrd.Version,
+		util.ProtoNanos(thrd.Date),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("insert into cafe_client_thread_versions(id, clientId, ciphertext, version, date) values(?,?,?,?,?)",
+		thrd.Id,
+		thrd.Client,
+		thrd.Ciphertext,
+		thrd.Version,
+		util.ProtoNanos(thrd.Date),
 	)
 	if err != nil {
 		tx.Rollback()
@@ -43,6 +73,43 @@ func (c *CafeClientThreadDB) AddOrUpdate(thrd *pb.CafeClientThread) error {
 	return nil
 }
 
+// Get returns the latest version of a client thread
+func (c *CafeClientThreadDB) Get(id string, clientId string) *pb.CafeClientThread {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from cafe_client_threads where id='" + id + "' and clientId='" + clientId + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
+// GetVersion returns a specific version of a client thread
+func (c *CafeClientThreadDB) GetVersion(id string, clientId string, version int) *pb.CafeClientThread {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_thread_versions where id='" + id + "' and clientId='" + clientId +
+		"' and version=" + strconv.Itoa(version) + ";"
+	res := c.handleQuery(stm)
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
+// GetAsOf returns the latest version of a client thread stored at or before date
+func (c *CafeClientThreadDB) GetAsOf(id string, clientId string, date time.Time) *pb.CafeClientThread {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_thread_versions where id='" + id + "' and clientId='" + clientId +
+		"' and date<=" + strconv.FormatInt(date.UnixNano(), 10) + " order by version desc limit 1;"
+	res := c.handleQuery(stm)
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
 func (c *CafeClientThreadDB) ListByClient(clientId string) []pb.CafeClientThread {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -50,10 +117,41 @@ func (c *CafeClientThreadDB) ListByClient(clientId string) []pb.CafeClientThread
 	return c.handleQuery(stm)
 }
 
+// ListVersions returns all kept versions of a client thread, newest first
+func (c *CafeClientThreadDB) ListVersions(id string, clientId string) []pb.CafeClientThread {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_thread_versions where id='" + id + "' and clientId='" + clientId +
+		"' order by version desc;"
+	return c.handleQuery(stm)
+}
+
+func (c *CafeClientThreadDB) CountByClient(clientId string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_client_threads where clientId='" + clientId + "';")
+	var count int
+	row.Scan(&count)
+	return count
+}
+
+// PruneVersions deletes all but the latest version and keep previous versions of a client thread
+func (c *CafeClientThreadDB) PruneVersions(id string, clientId string, keep int) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec(`delete from cafe_client_thread_versions where id=? and clientId=? and version<(
+        select version from cafe_client_threads where id=? and clientId=?)-?`, id, clientId, id, clientId, keep)
+	return err
+}
+
 func (c *CafeClientThreadDB) Delete(id string, clientId string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	_, err := c.db.Exec("delete from cafe_client_threads where id=? and clientId=?", id, clientId)
+	if err != nil {
+		return err
+	}
+	_, err = c.db.Exec("delete from cafe_client_thread_versions where id=? and clientId=?", id, clientId)
 	return err
 }
 
@@ -61,6 +159,10 @@ func (c *CafeClientThreadDB) DeleteByClient(clientId string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	_, err := c.db.Exec("delete from cafe_client_threads where clientId=?", clientId)
+	if err != nil {
+		return err
+	}
+	_, err = c.db.Exec("delete from cafe_client_thread_versions where clientId=?", clientId)
 	return err
 }
 
@@ -74,7 +176,9 @@ func (c *CafeClientThreadDB) handleQuery(stm string) []pb.CafeClientThread {
 	for rows.Next() {
 		var id, clientId string
 		var ciphertext []byte
-		if err := rows.Scan(&id, &clientId, &ciphertext); err != nil {
+		var version int32
+		var dateInt int64
+		if err := rows.Scan(&id, &clientId, &ciphertext, &version, &dateInt); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
@@ -82,6 +186,8 @@ func (c *CafeClientThreadDB) handleQuery(stm string) []pb.CafeClientThread {
 			Id:         id,
 			Client:     clientId,
 			Ciphertext: ciphertext,
+			Version:    version,
+			Date:       util.ProtoTs(dateInt),
 		})
 	}
 	return list
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+var cafeClientThreadStore repo.CafeClientThreadStore
+
+func init() {
+	setupCafeClientThreadDB()
+}
+
+func setupCafeClientThreadDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	cafeClientThreadStore = NewCafeClientThreadStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientThreadDB_AddOrUpdate(t *testing.T) {
+	for i, ciphertext := range []string{"v1", "v2", "v3"} {
+		if err := cafeClientThreadStore.AddOrUpdate(&pb.CafeClientThread{
+			Id:         "thread",
+			Client:     "client",
+			Ciphertext: []byte(ciphertext),
+			Date:       util.ProtoTs(int64(i+1) * int64(time.Second)),
+		}); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	thrd := cafeClientThreadStore.Get("thread", "client")
+	if thrd == nil || thrd.Version != 3 || string(thrd.Ciphertext) != "v3" {
+		t.Error("get latest thread version failed")
+	}
+	if cafeClientThreadStore.CountByClient("client") != 1 {
+		t.Error("versions should not count as threads")
+	}
+}
+
+func TestCafeClientThreadDB_GetVersion(t *testing.T) {
+	thrd := cafeClientThreadStore.GetVersion("thread", "client", 2)
+	if thrd == nil || string(thrd.Ciphertext) != "v2" {
+		t.Error("get thread version failed")
+	}
+}
+
+func TestCafeClientThreadDB_GetAsOf(t *testing.T) {
+	thrd := cafeClientThreadStore.GetAsOf("thread", "client", time.Unix(2, 500))
+	if thrd == nil || thrd.Version != 2 {
+		t.Error("get thread as of date failed")
+	}
+	if cafeClientThreadStore.GetAsOf("thread", "client", time.Unix(0, 0)) != nil {
+		t.Error("no thread version should exist before the first")
+	}
+}
+
+func TestCafeClientThreadDB_PruneVersions(t *testing.T) {
+	if err := cafeClientThreadStore.PruneVersions("thread", "client", 1); err != nil {
+		t.Error(err)
+		return
+	}
+	list := cafeClientThreadStore.ListVersions("thread", "client")
+	if len(list) != 2 || list[0].Version != 3 || list[1].Version != 2 {
+		t.Error("prune thread versions failed")
+	}
+}
+
+func TestCafeClientThreadDB_Delete(t *testing.T) {
+	if err := cafeClientThreadStore.Delete("thread", "client"); err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientThreadStore.Get("thread", "client") != nil {
+		t.Error("delete thread failed")
+	}
+	if len(cafeClientThreadStore.ListVersions("thread", "client")) != 0 {
+		t.Error("delete thread versions failed")
+	}
+}
@@ -58,6 +58,15 @@ func (c *CafeMessageDB) List(offset string, limit int) []pb.CafeMessage {
 	return c.handleQuery(stm)
 }
 
+func (c *CafeMessageDB) Count() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_messages;")
+	var count int
+	row.Scan(&count)
+	return count
+}
+
 func (c *CafeMessageDB) AddAttempt(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type CafeReplicationDB struct {
+	modelStore
+}
+
+func NewCafeReplicationStore(db *sql.DB, lock *sync.Mutex) repo.CafeReplicationStore {
+	return &CafeReplicationDB{modelStore{db, lock}}
+}
+
+func (c *CafeReplicationDB) Add(rep *pb.CafeReplication) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert into cafe_replications(id, type, clientId, targetId, date, attempts, neighbor) values(?,?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		rep.Id,
+		int32(rep.Type),
+		rep.Client,
+		rep.Target,
+		util.ProtoNanos(rep.Date),
+		rep.Attempts,
+		rep.Neighbor,
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *CafeReplicationDB) List(offset string, limit int) []pb.CafeReplication {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var stm string
+	if offset != "" {
+		stm = "select * from cafe_replications where date>(select date from cafe_replications where id='" + offset + "') order by date asc limit " + strconv.Itoa(limit) + ";"
+	} else {
+		stm = "select * from cafe_replications order by date asc limit " + strconv.Itoa(limit) + ";"
+	}
+	return c.handleQuery(stm)
+}
+
+func (c *CafeReplicationDB) AddAttempt(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_replications set attempts=attempts+1 where id=?", id)
+	return err
+}
+
+func (c *CafeReplicationDB) Delete(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_replications where id=?", id)
+	return err
+}
+
+func (c *CafeReplicationDB) Count() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_replications;")
+	var count int
+	row.Scan(&count)
+	return count
+}
+
+func (c *CafeReplicationDB) CountByNeighbor(neighbor string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_replications where neighbor=?;", neighbor)
+	var count int
+	row.Scan(&count)
+	return count
+}
+
+func (c *CafeReplicationDB) handleQuery(stm string) []pb.CafeReplication {
+	var list []pb.CafeReplication
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var id, clientId, targetId, neighbor string
+		var typeInt, attempts int
+		var dateInt int64
+		if err := rows.Scan(&id, &typeInt, &clientId, &targetId, &dateInt, &attempts, &neighbor); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, pb.CafeReplication{
+			Id:       id,
+			Type:     pb.CafeReplication_Type(typeInt),
+			Client:   clientId,
+			Target:   targetId,
+			Date:     util.ProtoTs(dateInt),
+			Attempts: int32(attempts),
+			Neighbor: neighbor,
+		})
+	}
+	return list
+}
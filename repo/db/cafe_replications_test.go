@@ -0,0 +1,116 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+var cafeReplicationStore repo.CafeReplicationStore
+
+func init() {
+	setupCafeReplicationDB()
+}
+
+func setupCafeReplicationDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	cafeReplicationStore = NewCafeReplicationStore(conn, new(sync.Mutex))
+}
+
+func TestCafeReplicationDB_Add(t *testing.T) {
+	if err := cafeReplicationStore.Add(&pb.CafeReplication{
+		Id:     "abcde",
+		Type:   pb.CafeReplication_THREAD,
+		Client: "client",
+		Target: "thread",
+		Date:   ptypes.TimestampNow(),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	stmt, err := cafeReplicationStore.PrepareQuery("select id from cafe_replications where id=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+
+	var id string
+	if err := stmt.QueryRow("abcde").Scan(&id); err != nil {
+		t.Error(err)
+		return
+	}
+	if id != "abcde" {
+		t.Errorf(`expected "abcde" got %s`, id)
+	}
+}
+
+func TestCafeReplicationDB_List(t *testing.T) {
+	setupCafeReplicationDB()
+	for i := 0; i < 3; i++ {
+		if err := cafeReplicationStore.Add(&pb.CafeReplication{
+			Id:       strconv.Itoa(i),
+			Type:     pb.CafeReplication_PIN,
+			Client:   "client",
+			Target:   "Qm" + strconv.Itoa(i),
+			Date:     util.ProtoTs(time.Now().Add(time.Minute * time.Duration(i)).UnixNano()),
+			Neighbor: "neighbor" + strconv.Itoa(i%2),
+		}); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	list := cafeReplicationStore.List("", 2)
+	if len(list) != 2 || list[0].Id != "0" {
+		t.Error("returned incorrect number of replications")
+		return
+	}
+	next := cafeReplicationStore.List(list[1].Id, 2)
+	if len(next) != 1 || next[0].Id != "2" {
+		t.Error("returned incorrect offset replications")
+	}
+}
+
+func TestCafeReplicationDB_Count(t *testing.T) {
+	if cafeReplicationStore.Count() != 3 {
+		t.Error("returned incorrect count")
+	}
+}
+
+func TestCafeReplicationDB_CountByNeighbor(t *testing.T) {
+	if cafeReplicationStore.CountByNeighbor("neighbor0") != 2 {
+		t.Error("returned incorrect count by neighbor")
+	}
+	if cafeReplicationStore.CountByNeighbor("nope") != 0 {
+		t.Error("returned incorrect count by unknown neighbor")
+	}
+}
+
+func TestCafeReplicationDB_AddAttempt(t *testing.T) {
+	if err := cafeReplicationStore.AddAttempt("0"); err != nil {
+		t.Error(err)
+		return
+	}
+	list := cafeReplicationStore.List("", 1)
+	if len(list) != 1 || list[0].Attempts != 1 {
+		t.Error("failed to add attempt")
+	}
+}
+
+func TestCafeReplicationDB_Delete(t *testing.T) {
+	if err := cafeReplicationStore.Delete("0"); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(cafeReplicationStore.List("", -1)) != 2 {
+		t.Error("failed to delete replication")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/repo"
@@ -31,7 +32,7 @@ func (c *CafeRequestDB) Add(req *pb.CafeRequest) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into cafe_requests(id, peerId, targetId, cafeId, cafe, type, date, size, groupId, status) values(?,?,?,?,?,?,?,?,?,?)`
+	stm := `insert into cafe_requests(id, peerId, targetId, cafeId, cafe, type, date, size, groupId, status, attempts, nextAttempt) values(?,?,?,?,?,?,?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -44,6 +45,11 @@ func (c *CafeRequestDB) Add(req *pb.CafeRequest) error {
 		return err
 	}
 
+	var next int64
+	if req.Next != nil {
+		next = util.ProtoNanos(req.Next)
+	}
+
 	_, err = stmt.Exec(
 		req.Id,
 		req.Peer,
@@ -55,6 +61,8 @@ func (c *CafeRequestDB) Add(req *pb.CafeRequest) error {
 		req.Size,
 		req.Group,
 		int32(req.Status),
+		req.Attempts,
+		next,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -75,13 +83,27 @@ func (c *CafeRequestDB) Get(id string) *pb.CafeRequest {
 }
 
 func (c *CafeRequestDB) List(offset string, limit int) *pb.CafeRequestList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// requests backing off from a failed attempt are skipped until their next attempt
+	now := strconv.FormatInt(time.Now().UnixNano(), 10)
+	var stm string
+	if offset != "" {
+		stm = "select * from cafe_requests where status!=3 and nextAttempt<=" + now + " and date>(select date from cafe_requests where id='" + offset + "') order by date asc limit " + strconv.Itoa(limit) + ";"
+	} else {
+		stm = "select * from cafe_requests where status!=3 and nextAttempt<=" + now + " order by date asc limit " + strconv.Itoa(limit) + ";"
+	}
+	return c.handleQuery(stm)
+}
+
+func (c *CafeRequestDB) ListFailed(offset string, limit int) *pb.CafeRequestList {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	var stm string
 	if offset != "" {
-		stm = "select * from cafe_requests where date>(select date from cafe_requests where id='" + offset + "') order by date asc limit " + strconv.Itoa(limit) + ";"
+		stm = "select * from cafe_requests where status=3 and date>(select date from cafe_requests where id='" + offset + "') order by date asc limit " + strconv.Itoa(limit) + ";"
 	} else {
-		stm = "select * from cafe_requests order by date asc limit " + strconv.Itoa(limit) + ";"
+		stm = "select * from cafe_requests where status=3 order by date asc limit " + strconv.Itoa(limit) + ";"
 	}
 	return c.handleQuery(stm)
 }
@@ -143,6 +165,15 @@ func (c *CafeRequestDB) CountByGroup(groupId string) int {
 	return count
 }
 
+func (c *CafeRequestDB) CountByStatus(status pb.CafeRequest_Status) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_requests where status=" + strconv.Itoa(int(status)) + ";")
+	var count int
+	row.Scan(&count)
+	return count
+}
+
 func (c *CafeRequestDB) GroupStatus(groupId string) *pb.CafeRequestGroupStatus {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -156,6 +187,49 @@ func (c *CafeRequestDB) UpdateStatus(id string, status pb.CafeRequest_Status) er
 	return err
 }
 
+func (c *CafeRequestDB) AddAttempt(id string, next time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_requests set attempts=attempts+1, nextAttempt=? where id=?", next.UnixNano(), id)
+	return err
+}
+
+// Retry resets a failed request, or all failed requests if id is empty
+func (c *CafeRequestDB) Retry(id string) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "update cafe_requests set status=0, attempts=0, nextAttempt=0 where status=3"
+	args := []interface{}{}
+	if id != "" {
+		stm += " and id=?"
+		args = append(args, id)
+	}
+	res, err := c.db.Exec(stm, args...)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+// DeleteFailed removes a failed request, or all failed requests if id is empty
+func (c *CafeRequestDB) DeleteFailed(id string) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "delete from cafe_requests where status=3"
+	args := []interface{}{}
+	if id != "" {
+		stm += " and id=?"
+		args = append(args, id)
+	}
+	res, err := c.db.Exec(stm, args...)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
 func (c *CafeRequestDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -177,6 +251,47 @@ func (c *CafeRequestDB) DeleteByCafe(cafeId string) error {
 	return err
 }
 
+// Reroute moves new requests for our own peer from one cafe to another,
+// dropping any that are already queued for the destination cafe.
+// Inbox requests are left alone since they target another peer's cafe.
+func (c *CafeRequestDB) Reroute(fromCafeId string, to *pb.Cafe) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	cafe, err := pbMarshaler.MarshalToString(to)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	sel := "cafeId=? and status=? and type!=?"
+	dup := "exists (select 1 from cafe_requests r where r.cafeId=? and r.targetId=cafe_requests.targetId and r.type=cafe_requests.type)"
+	_, err = tx.Exec("delete from cafe_requests where "+sel+" and "+dup,
+		fromCafeId, int32(pb.CafeRequest_NEW), int32(pb.CafeRequest_INBOX), to.Peer)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	res, err := tx.Exec("update cafe_requests set cafeId=?, cafe=? where "+sel,
+		to.Peer, []byte(cafe), fromCafeId, int32(pb.CafeRequest_NEW), int32(pb.CafeRequest_INBOX))
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	tx.Commit()
+	return int(n), nil
+}
+
 func (c *CafeRequestDB) handleQuery(stm string) *pb.CafeRequestList {
 	list := &pb.CafeRequestList{Items: make([]*pb.CafeRequest, 0)}
 	rows, err := c.db.Query(stm)
@@ -187,9 +302,10 @@ func (c *CafeRequestDB) handleQuery(stm string) *pb.CafeRequestList {
 	for rows.Next() {
 		var id, peerId, targetId, cafeId, groupId string
 		var typeInt, statusInt int
-		var dateInt, size int64
+		var dateInt, size, nextInt int64
+		var attempts int32
 		var cafe []byte
-		if err := rows.Scan(&id, &peerId, &targetId, &cafeId, &cafe, &typeInt, &dateInt, &size, &groupId, &statusInt); err != nil {
+		if err := rows.Scan(&id, &peerId, &targetId, &cafeId, &cafe, &typeInt, &dateInt, &size, &groupId, &statusInt, &attempts, &nextInt); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
@@ -200,17 +316,22 @@ func (c *CafeRequestDB) handleQuery(stm string) *pb.CafeRequestList {
 			continue
 		}
 
-		list.Items = append(list.Items, &pb.CafeRequest{
-			Id:     id,
-			Peer:   peerId,
-			Target: targetId,
-			Cafe:   mod,
-			Type:   pb.CafeRequest_Type(typeInt),
-			Date:   util.ProtoTs(dateInt),
-			Size:   size,
-			Group:  groupId,
-			Status: pb.CafeRequest_Status(statusInt),
-		})
+		req := &pb.CafeRequest{
+			Id:       id,
+			Peer:     peerId,
+			Target:   targetId,
+			Cafe:     mod,
+			Type:     pb.CafeRequest_Type(typeInt),
+			Date:     util.ProtoTs(dateInt),
+			Size:     size,
+			Group:    groupId,
+			Status:   pb.CafeRequest_Status(statusInt),
+			Attempts: attempts,
+		}
+		if nextInt > 0 {
+			req.Next = util.ProtoTs(nextInt)
+		}
+		list.Items = append(list.Items, req)
 	}
 	return list
 }
@@ -240,6 +361,9 @@ func (c *CafeRequestDB) handleStatQuery(stm string) *pb.CafeRequestGroupStatus {
 		case 2:
 			group.NumComplete += 1
 			group.SizeComplete += size
+		case 3:
+			group.NumFailed += 1
+			group.SizeFailed += size
 		}
 	}
 	return group
@@ -219,6 +219,27 @@ func TestCafeRequestDB_ListCompletedGroupsAgain(t *testing.T) {
 	}
 }
 
+func TestCafeRequestDB_Failed(t *testing.T) {
+	if err := cafeRequestStore.UpdateStatus("abcde", pb.CafeRequest_FAILED); err != nil {
+		t.Error(err)
+	}
+	for _, req := range cafeRequestStore.List("", -1).Items {
+		if req.Id == "abcde" {
+			t.Error("list included failed request")
+		}
+	}
+	if cafeRequestStore.CountByStatus(pb.CafeRequest_FAILED) != 1 {
+		t.Error("count by status failed")
+	}
+	status := cafeRequestStore.GroupStatus("group1")
+	if status.NumFailed != 1 {
+		t.Errorf("wrong num failed %d", status.NumFailed)
+	}
+	if status.SizeFailed != status.SizeTotal {
+		t.Errorf("wrong size failed %d", status.SizeFailed)
+	}
+}
+
 func TestCafeRequestDB_Delete(t *testing.T) {
 	if err := cafeRequestStore.Delete("abcde"); err != nil {
 		t.Error(err)
@@ -287,3 +308,122 @@ func TestCafeRequestDB_DeleteByCafe(t *testing.T) {
 		t.Error("delete by cafe failed")
 	}
 }
+
+func TestCafeRequestDB_Reroute(t *testing.T) {
+	setupCafeRequestDB()
+	add := func(id string, target string, cafe string, rtype pb.CafeRequest_Type) {
+		if err := cafeRequestStore.Add(&pb.CafeRequest{
+			Id:     id,
+			Peer:   "peer",
+			Target: target,
+			Cafe:   &pb.Cafe{Peer: cafe},
+			Type:   rtype,
+			Date:   ptypes.TimestampNow(),
+			Group:  "group",
+			Status: pb.CafeRequest_NEW,
+		}); err != nil {
+			t.Error(err)
+		}
+	}
+	add("r1", "t1", "bad", pb.CafeRequest_STORE)
+	add("r2", "t2", "bad", pb.CafeRequest_STORE)
+	add("r3", "t3", "bad", pb.CafeRequest_INBOX)
+	add("r4", "t2", "good", pb.CafeRequest_STORE)
+
+	n, err := cafeRequestStore.Reroute("bad", &pb.Cafe{Peer: "good"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if n != 1 {
+		t.Errorf("expected 1 rerouted request, got %d", n)
+		return
+	}
+	if req := cafeRequestStore.Get("r1"); req == nil || req.Cafe.Peer != "good" {
+		t.Error("request was not rerouted")
+	}
+	if cafeRequestStore.Get("r2") != nil {
+		t.Error("duplicate request was not dropped")
+	}
+	if req := cafeRequestStore.Get("r3"); req == nil || req.Cafe.Peer != "bad" {
+		t.Error("inbox request should not be rerouted")
+	}
+}
+
+func TestCafeRequestDB_AddAttempt(t *testing.T) {
+	setupCafeRequestDB()
+	if err := cafeRequestStore.Add(&pb.CafeRequest{
+		Id:     "r1",
+		Peer:   "peer",
+		Target: "t1",
+		Cafe:   &pb.Cafe{Peer: "cafe"},
+		Type:   pb.CafeRequest_STORE,
+		Date:   ptypes.TimestampNow(),
+		Group:  "group",
+		Status: pb.CafeRequest_NEW,
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := cafeRequestStore.AddAttempt("r1", time.Now().Add(time.Hour)); err != nil {
+		t.Error(err)
+		return
+	}
+	req := cafeRequestStore.Get("r1")
+	if req.Attempts != 1 || req.Next == nil {
+		t.Error("add attempt failed")
+		return
+	}
+	if len(cafeRequestStore.List("", -1).Items) != 0 {
+		t.Error("requests backing off should not be listed")
+	}
+}
+
+func TestCafeRequestDB_ListFailed(t *testing.T) {
+	if err := cafeRequestStore.UpdateStatus("r1", pb.CafeRequest_FAILED); err != nil {
+		t.Error(err)
+		return
+	}
+	list := cafeRequestStore.ListFailed("", -1)
+	if len(list.Items) != 1 || list.Items[0].Id != "r1" {
+		t.Error("list failed returned bad requests")
+	}
+}
+
+func TestCafeRequestDB_Retry(t *testing.T) {
+	n, err := cafeRequestStore.Retry("")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if n != 1 {
+		t.Errorf("expected 1 retried request, got %d", n)
+		return
+	}
+	req := cafeRequestStore.Get("r1")
+	if req.Status != pb.CafeRequest_NEW || req.Attempts != 0 || req.Next != nil {
+		t.Error("retry failed")
+		return
+	}
+	if len(cafeRequestStore.List("", -1).Items) != 1 {
+		t.Error("retried request should be listed")
+	}
+}
+
+func TestCafeRequestDB_DeleteFailed(t *testing.T) {
+	if err := cafeRequestStore.UpdateStatus("r1", pb.CafeRequest_FAILED); err != nil {
+		t.Error(err)
+		return
+	}
+	if n, err := cafeRequestStore.DeleteFailed("other"); err != nil || n != 0 {
+		t.Error("delete failed should ignore unknown requests")
+		return
+	}
+	if n, err := cafeRequestStore.DeleteFailed("r1"); err != nil || n != 1 {
+		t.Error("delete failed failed")
+		return
+	}
+	if cafeRequestStore.Get("r1") != nil {
+		t.Error("failed request was not deleted")
+	}
+}
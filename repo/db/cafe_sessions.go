@@ -25,7 +25,10 @@ func (c *CafeSessionDB) AddOrUpdate(session *pb.CafeSession) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert or replace into cafe_sessions(cafeId, access, refresh, expiry, cafe) values(?,?,?,?,?)`
+	// priority and health are kept across refreshes
+	stm := `insert or replace into cafe_sessions(cafeId, access, refresh, expiry, cafe, priority, health) values(?,?,?,?,?,
+        coalesce((select priority from cafe_sessions where cafeId=?),?),
+        coalesce((select health from cafe_sessions where cafeId=?),?))`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -38,12 +41,21 @@ func (c *CafeSessionDB) AddOrUpdate(session *pb.CafeSession) error {
 		return err
 	}
 
+	health, err := marshalCafeHealth(session.Health)
+	if err != nil {
+		return err
+	}
+
 	_, err = stmt.Exec(
 		session.Id,
 		session.Access,
 		session.Refresh,
 		util.ProtoNanos(session.Exp),
 		[]byte(cafe),
+		session.Id,
+		session.Priority,
+		session.Id,
+		health,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -66,10 +78,28 @@ func (c *CafeSessionDB) Get(cafeId string) *pb.CafeSession {
 func (c *CafeSessionDB) List() *pb.CafeSessionList {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	stm := "select * from cafe_sessions order by expiry desc;"
+	stm := "select * from cafe_sessions order by priority desc, expiry desc;"
 	return c.handleQuery(stm)
 }
 
+func (c *CafeSessionDB) UpdatePriority(cafeId string, priority int) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_sessions set priority=? where cafeId=?", priority, cafeId)
+	return err
+}
+
+func (c *CafeSessionDB) UpdateHealth(cafeId string, health *pb.CafeHealth) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	hb, err := marshalCafeHealth(health)
+	if err != nil {
+		return err
+	}
+	_, err = c.db.Exec("update cafe_sessions set health=? where cafeId=?", hb, cafeId)
+	return err
+}
+
 func (c *CafeSessionDB) Delete(cafeId string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -87,8 +117,9 @@ func (c *CafeSessionDB) handleQuery(stm string) *pb.CafeSessionList {
 	for rows.Next() {
 		var cafeId, access, refresh string
 		var expiryInt int64
-		var cafe []byte
-		if err := rows.Scan(&cafeId, &access, &refresh, &expiryInt, &cafe); err != nil {
+		var cafe, health []byte
+		var priority int32
+		if err := rows.Scan(&cafeId, &access, &refresh, &expiryInt, &cafe, &priority, &health); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
@@ -99,13 +130,34 @@ func (c *CafeSessionDB) handleQuery(stm string) *pb.CafeSessionList {
 			continue
 		}
 
+		rhealth := new(pb.CafeHealth)
+		if len(health) > 0 {
+			if err := pbUnmarshaler.Unmarshal(bytes.NewReader(health), rhealth); err != nil {
+				log.Errorf("error unmarshaling cafe health: %s", err)
+				continue
+			}
+		}
+
 		list.Items = append(list.Items, &pb.CafeSession{
-			Id:      cafeId,
-			Access:  access,
-			Refresh: refresh,
-			Exp:     util.ProtoTs(expiryInt),
-			Cafe:    rcafe,
+			Id:       cafeId,
+			Access:   access,
+			Refresh:  refresh,
+			Exp:      util.ProtoTs(expiryInt),
+			Cafe:     rcafe,
+			Priority: priority,
+			Health:   rhealth,
 		})
 	}
 	return list
 }
+
+func marshalCafeHealth(health *pb.CafeHealth) ([]byte, error) {
+	if health == nil {
+		return []byte{}, nil
+	}
+	str, err := pbMarshaler.MarshalToString(health)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(str), nil
+}
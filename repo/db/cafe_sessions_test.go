@@ -0,0 +1,83 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeSessionStore repo.CafeSessionStore
+
+func init() {
+	setupCafeSessionDB()
+}
+
+func setupCafeSessionDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	cafeSessionStore = NewCafeSessionStore(conn, new(sync.Mutex))
+}
+
+func TestCafeSessionDB_AddOrUpdate(t *testing.T) {
+	for _, id := range []string{"cafe1", "cafe2"} {
+		if err := cafeSessionStore.AddOrUpdate(&pb.CafeSession{
+			Id:      id,
+			Access:  "access",
+			Refresh: "refresh",
+			Exp:     ptypes.TimestampNow(),
+			Cafe:    &pb.Cafe{Peer: id},
+		}); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	session := cafeSessionStore.Get("cafe1")
+	if session == nil || session.Priority != 0 || session.Health.Status != pb.CafeHealth_UNKNOWN {
+		t.Error("get session failed")
+	}
+}
+
+func TestCafeSessionDB_UpdatePriority(t *testing.T) {
+	if err := cafeSessionStore.UpdatePriority("cafe2", 10); err != nil {
+		t.Error(err)
+		return
+	}
+	list := cafeSessionStore.List()
+	if len(list.Items) != 2 || list.Items[0].Id != "cafe2" {
+		t.Error("sessions should be listed by priority")
+	}
+}
+
+func TestCafeSessionDB_UpdateHealth(t *testing.T) {
+	if err := cafeSessionStore.UpdateHealth("cafe2", &pb.CafeHealth{
+		Status:   pb.CafeHealth_UNHEALTHY,
+		Failures: 3,
+		Checked:  ptypes.TimestampNow(),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// refreshing a session should keep its priority and health
+	if err := cafeSessionStore.AddOrUpdate(&pb.CafeSession{
+		Id:      "cafe2",
+		Access:  "access2",
+		Refresh: "refresh2",
+		Exp:     ptypes.TimestampNow(),
+		Cafe:    &pb.Cafe{Peer: "cafe2"},
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	session := cafeSessionStore.Get("cafe2")
+	if session.Access != "access2" || session.Priority != 10 {
+		t.Error("session refresh failed")
+	}
+	if session.Health.Status != pb.CafeHealth_UNHEALTHY || session.Health.Failures != 3 {
+		t.Error("update health failed")
+	}
+}
@@ -2,8 +2,10 @@ package db
 
 import (
 	"database/sql"
+	"strings"
 	"sync"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/repo"
 	"github.com/textileio/go-textile/util"
@@ -24,7 +26,7 @@ func (c *CafeTokenDB) Add(token *pb.CafeToken) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into cafe_tokens(id, token, date) values(?,?,?)`
+	stm := `insert into cafe_tokens(id, token, date, scopes, plan, expires, label) values(?,?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -35,6 +37,10 @@ func (c *CafeTokenDB) Add(token *pb.CafeToken) error {
 		token.Id,
 		token.Value,
 		util.ProtoNanos(token.Date),
+		strings.Join(token.Scopes, ","),
+		token.Plan,
+		expiresNanos(token),
+		token.Label,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -76,18 +82,34 @@ func (c *CafeTokenDB) handleQuery(stm string) []pb.CafeToken {
 		return nil
 	}
 	for rows.Next() {
-		var id string
+		var id, scopes, plan, label string
 		var token []byte
-		var dateInt int64
-		if err := rows.Scan(&id, &token, &dateInt); err != nil {
+		var dateInt, expiresInt int64
+		if err := rows.Scan(&id, &token, &dateInt, &scopes, &plan, &expiresInt, &label); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
+		var expires *timestamp.Timestamp
+		if expiresInt > 0 {
+			expires = util.ProtoTs(expiresInt)
+		}
 		list = append(list, pb.CafeToken{
-			Id:    id,
-			Value: token,
-			Date:  util.ProtoTs(dateInt),
+			Id:      id,
+			Value:   token,
+			Date:    util.ProtoTs(dateInt),
+			Scopes:  util.SplitString(scopes, ","),
+			Plan:    plan,
+			Expires: expires,
+			Label:   label,
 		})
 	}
 	return list
 }
+
+// expiresNanos returns a token's expiry in nanoseconds, 0 if it never expires
+func expiresNanos(token *pb.CafeToken) int64 {
+	if token.Expires == nil {
+		return 0
+	}
+	return util.ProtoNanos(token.Expires)
+}
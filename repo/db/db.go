@@ -40,6 +40,10 @@ type SQLiteDatastore struct {
 	cafeTokens         repo.CafeTokenStore
 	cafeClientThreads  repo.CafeClientThreadStore
 	cafeClientMessages repo.CafeClientMessageStore
+	cafeClientPins     repo.CafeClientPinStore
+	webhookMessages    repo.WebhookMessageStore
+	cafeReplications   repo.CafeReplicationStore
+	apiKeys            repo.ApiKeyStore
 	db                 *sql.DB
 	lock               *sync.Mutex
 }
@@ -77,6 +81,10 @@ func Create(repoPath, pin string) (*SQLiteDatastore, error) {
 		cafeTokens:         NewCafeTokenStore(conn, mux),
 		cafeClientThreads:  NewCafeClientThreadStore(conn, mux),
 		cafeClientMessages: NewCafeClientMessageStore(conn, mux),
+		cafeClientPins:     NewCafeClientPinStore(conn, mux),
+		webhookMessages:    NewWebhookMessageStore(conn, mux),
+		cafeReplications:   NewCafeReplicationStore(conn, mux),
+		apiKeys:            NewApiKeyStore(conn, mux),
 		db:                 conn,
 		lock:               mux,
 	}, nil
@@ -158,6 +166,22 @@ func (d *SQLiteDatastore) CafeClientMessages() repo.CafeClientMessageStore {
 	return d.cafeClientMessages
 }
 
+func (d *SQLiteDatastore) CafeClientPins() repo.CafeClientPinStore {
+	return d.cafeClientPins
+}
+
+func (d *SQLiteDatastore) WebhookMessages() repo.WebhookMessageStore {
+	return d.webhookMessages
+}
+
+func (d *SQLiteDatastore) CafeReplications() repo.CafeReplicationStore {
+	return d.cafeReplications
+}
+
+func (d *SQLiteDatastore) ApiKeys() repo.ApiKeyStore {
+	return d.apiKeys
+}
+
 func (d *SQLiteDatastore) Copy(dbPath string, pin string) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -246,9 +270,9 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index notification_blockId on notifications (blockId);
     create index notification_read on notifications (read);
 
-    create table cafe_sessions (cafeId text primary key not null, access text not null, refresh text not null, expiry integer not null, cafe blob not null);
+    create table cafe_sessions (cafeId text primary key not null, access text not null, refresh text not null, expiry integer not null, cafe blob not null, priority integer not null default 0, health blob not null default '');
 
-    create table cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, type integer not null, date integer not null, size integer not null, groupId text not null, status integer not null);
+    create table cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, type integer not null, date integer not null, size integer not null, groupId text not null, status integer not null, attempts integer not null default 0, nextAttempt integer not null default 0);
     create index cafe_request_cafeId on cafe_requests (cafeId);
     create index cafe_request_date on cafe_requests (date);
     create index cafe_request_groupId on cafe_requests (groupId);
@@ -262,14 +286,29 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index cafe_client_address on cafe_clients (address);
     create index cafe_client_lastSeen on cafe_clients (lastSeen);
 
-    create table cafe_client_threads (id text not null, clientId text not null, ciphertext blob not null, primary key (id, clientId));
+    create table cafe_client_threads (id text not null, clientId text not null, ciphertext blob not null, version integer not null default 1, date integer not null default 0, primary key (id, clientId));
     create index cafe_client_thread_clientId on cafe_client_threads (clientId);
 
-    create table cafe_client_messages (id text not null, peerId text not null, clientId text not null, date integer not null, primary key (id, clientId));
+    create table cafe_client_thread_versions (id text not null, clientId text not null, ciphertext blob not null, version integer not null, date integer not null, primary key (id, clientId, version));
+    create index cafe_client_thread_version_clientId on cafe_client_thread_versions (clientId);
+
+    create table cafe_client_messages (id text not null, peerId text not null, clientId text not null, date integer not null, size integer not null, primary key (id, clientId));
     create index cafe_client_message_clientId on cafe_client_messages (clientId);
     create index cafe_client_message_date on cafe_client_messages (date);
 
-    create table cafe_tokens (id text primary key not null, token text not null, date integer not null);
+    create table cafe_tokens (id text primary key not null, token text not null, date integer not null, scopes text not null default '', plan text not null default '', expires integer not null default 0, label text not null default '');
+
+    create table cafe_client_pins (id text not null, clientId text not null, size integer not null, date integer not null, primary key (id, clientId));
+    create index cafe_client_pin_clientId on cafe_client_pins (clientId);
+
+    create table webhook_messages (id text primary key not null, webhookId text not null, body text not null, date integer not null, attempts integer not null, nextAttempt integer not null default 0);
+    create index webhook_message_webhookId on webhook_messages (webhookId);
+    create index webhook_message_date on webhook_messages (date);
+
+    create table cafe_replications (id text primary key not null, type integer not null, clientId text not null, targetId text not null, date integer not null, attempts integer not null, neighbor text not null);
+    create index cafe_replication_date on cafe_replications (date);
+
+    create table api_keys (id text primary key not null, name text not null, scopes text not null, date integer not null, expires integer not null default 0);
     `
 	if _, err := db.Exec(sqlStmt); err != nil {
 		return err
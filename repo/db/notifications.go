@@ -76,16 +76,34 @@ func (c *NotificationDB) ReadAll() error {
 	return err
 }
 
-func (c *NotificationDB) List(offset string, limit int) *pb.NotificationList {
+func (c *NotificationDB) ReadByQuery(query string, args ...interface{}) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	var stm string
+	var q string
+	if query != "" {
+		q = " where " + query
+	}
+	_, err := c.db.Exec("update notifications set read=1"+q+";", args...)
+	return err
+}
+
+func (c *NotificationDB) List(offset string, limit int, query string, args ...interface{}) *pb.NotificationList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var stm, q string
 	if offset != "" {
-		stm = "select * from notifications where date<(select date from notifications where id='" + offset + "') order by date desc limit " + strconv.Itoa(limit) + ";"
+		if query != "" {
+			q = query + " and "
+		}
+		stm = "select * from notifications where " + q + "(date<(select date from notifications where id=?)) order by date desc limit " + strconv.Itoa(limit) + ";"
+		args = append(args, offset)
 	} else {
-		stm = "select * from notifications order by date desc limit " + strconv.Itoa(limit) + ";"
+		if query != "" {
+			q = "where " + query + " "
+		}
+		stm = "select * from notifications " + q + "order by date desc limit " + strconv.Itoa(limit) + ";"
 	}
-	return c.handleQuery(stm)
+	return c.handleQuery(stm, args...)
 }
 
 func (c *NotificationDB) CountUnread() int {
@@ -125,9 +143,20 @@ func (c *NotificationDB) DeleteByBlock(blockId string) error {
 	return err
 }
 
-func (c *NotificationDB) handleQuery(stm string) *pb.NotificationList {
+func (c *NotificationDB) DeleteByQuery(query string, args ...interface{}) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var q string
+	if query != "" {
+		q = " where " + query
+	}
+	_, err := c.db.Exec("delete from notifications"+q+";", args...)
+	return err
+}
+
+func (c *NotificationDB) handleQuery(stm string, args ...interface{}) *pb.NotificationList {
 	list := &pb.NotificationList{Items: make([]*pb.Notification, 0)}
-	rows, err := c.db.Query(stm)
+	rows, err := c.db.Query(stm, args...)
 	if err != nil {
 		log.Errorf("error in db query: %s", err)
 		return list
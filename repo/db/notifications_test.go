@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -63,7 +64,7 @@ func TestNotificationDB_Read(t *testing.T) {
 		t.Error(err)
 		return
 	}
-	notifs := notificationStore.List("", 1)
+	notifs := notificationStore.List("", 1, "")
 	if len(notifs.Items) == 0 || !notifs.Items[0].Read {
 		t.Error("notification read bad result")
 	}
@@ -100,7 +101,7 @@ func TestNotificationDB_ReadAll(t *testing.T) {
 		t.Error(err)
 		return
 	}
-	notifs := notificationStore.List("", -1)
+	notifs := notificationStore.List("", -1, "")
 	if len(notifs.Items) != 2 || !notifs.Items[0].Read || !notifs.Items[1].Read {
 		t.Error("notification read all bad result")
 	}
@@ -157,21 +158,35 @@ func TestNotificationDB_List(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	all := notificationStore.List("", -1)
+	all := notificationStore.List("", -1, "")
 	if len(all.Items) != 4 {
 		t.Error("returned incorrect number of notifications")
 		return
 	}
-	limited := notificationStore.List("", 1)
+	limited := notificationStore.List("", 1, "")
 	if len(limited.Items) != 1 {
 		t.Error("returned incorrect number of notifications")
 		return
 	}
-	offset := notificationStore.List(limited.Items[0].Id, -1)
+	offset := notificationStore.List(limited.Items[0].Id, -1, "")
 	if len(offset.Items) != 3 {
 		t.Error("returned incorrect number of notifications")
 		return
 	}
+	filtered := notificationStore.List("", -1, "actorId=?", "actor1")
+	if len(filtered.Items) != 2 {
+		t.Error("returned incorrect number of notifications")
+		return
+	}
+	filteredOffset := notificationStore.List(filtered.Items[0].Id, -1, "actorId=?", "actor1")
+	if len(filteredOffset.Items) != 1 || filteredOffset.Items[0].Id != "abc" {
+		t.Error("returned incorrect number of notifications")
+		return
+	}
+	injected := notificationStore.List("", -1, "subjectId=?", "x' or '1'='1")
+	if len(injected.Items) != 0 {
+		t.Error("query args should not be interpreted as sql")
+	}
 }
 
 func TestNotificationDB_CountUnread(t *testing.T) {
@@ -181,6 +196,21 @@ func TestNotificationDB_CountUnread(t *testing.T) {
 	}
 }
 
+func TestNotificationDB_ReadByQuery(t *testing.T) {
+	err := notificationStore.ReadByQuery("actorId=?", "actor1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if notificationStore.CountUnread() != 2 {
+		t.Error("notification read by query bad result")
+	}
+	notif := notificationStore.Get("def")
+	if notif == nil || !notif.Read {
+		t.Error("notification read by query bad result")
+	}
+}
+
 func TestNotificationDB_Delete(t *testing.T) {
 	err := notificationStore.Delete("abc")
 	if err != nil {
@@ -236,3 +266,43 @@ func TestNotificationDB_DeleteByBlock(t *testing.T) {
 		t.Error("delete failed")
 	}
 }
+
+func TestNotificationDB_DeleteByQuery(t *testing.T) {
+	setupNotificationDB()
+	err := notificationStore.Add(&pb.Notification{
+		Id:          "abc",
+		Date:        util.ProtoTs(time.Now().Add(-time.Hour).UnixNano()),
+		Actor:       "actor1",
+		SubjectDesc: "test",
+		Subject:     "subject1",
+		Block:       "block1",
+		Type:        pb.Notification_MESSAGE_ADDED,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	err = notificationStore.Add(&pb.Notification{
+		Id:          "def",
+		Date:        ptypes.TimestampNow(),
+		Actor:       "actor1",
+		SubjectDesc: "test",
+		Subject:     "subject1",
+		Block:       "block2",
+		Type:        pb.Notification_MESSAGE_ADDED,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	before := time.Now().Add(-time.Minute).UnixNano()
+	err = notificationStore.DeleteByQuery("date<" + strconv.FormatInt(before, 10))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if notificationStore.Get("abc") != nil {
+		t.Error("delete by query failed")
+	}
+	if notificationStore.Get("def") == nil {
+		t.Error("delete by query removed too much")
+	}
+}
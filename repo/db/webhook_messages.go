@@ -0,0 +1,121 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type WebhookMessageDB struct {
+	modelStore
+}
+
+func NewWebhookMessageStore(db *sql.DB, lock *sync.Mutex) repo.WebhookMessageStore {
+	return &WebhookMessageDB{modelStore{db, lock}}
+}
+
+func (c *WebhookMessageDB) Add(msg *pb.WebhookMessage) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert into webhook_messages(id, webhookId, body, date, attempts, nextAttempt) values(?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+
+	var next int64
+	if msg.Next != nil {
+		next = util.ProtoNanos(msg.Next)
+	}
+
+	_, err = stmt.Exec(
+		msg.Id,
+		msg.Webhook,
+		msg.Body,
+		util.ProtoNanos(msg.Date),
+		msg.Attempts,
+		next,
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *WebhookMessageDB) List(offset string, limit int) []pb.WebhookMessage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// messages backing off from a failed attempt are skipped until their next attempt
+	now := strconv.FormatInt(time.Now().UnixNano(), 10)
+	var stm string
+	if offset != "" {
+		stm = "select * from webhook_messages where nextAttempt<=" + now + " and date>(select date from webhook_messages where id='" + offset + "') order by date asc limit " + strconv.Itoa(limit) + ";"
+	} else {
+		stm = "select * from webhook_messages where nextAttempt<=" + now + " order by date asc limit " + strconv.Itoa(limit) + ";"
+	}
+	return c.handleQuery(stm)
+}
+
+func (c *WebhookMessageDB) AddAttempt(id string, next time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update webhook_messages set attempts=attempts+1, nextAttempt=? where id=?", next.UnixNano(), id)
+	return err
+}
+
+func (c *WebhookMessageDB) Delete(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from webhook_messages where id=?", id)
+	return err
+}
+
+func (c *WebhookMessageDB) DeleteByWebhook(webhookId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from webhook_messages where webhookId=?", webhookId)
+	return err
+}
+
+func (c *WebhookMessageDB) handleQuery(stm string) []pb.WebhookMessage {
+	var list []pb.WebhookMessage
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var id, webhookId, body string
+		var dateInt, nextInt int64
+		var attempts int
+		if err := rows.Scan(&id, &webhookId, &body, &dateInt, &attempts, &nextInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		msg := pb.WebhookMessage{
+			Id:       id,
+			Webhook:  webhookId,
+			Body:     body,
+			Date:     util.ProtoTs(dateInt),
+			Attempts: int32(attempts),
+		}
+		if nextInt > 0 {
+			msg.Next = util.ProtoTs(nextInt)
+		}
+		list = append(list, msg)
+	}
+	return list
+}
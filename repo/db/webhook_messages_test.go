@@ -0,0 +1,119 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+var webhookMessageStore repo.WebhookMessageStore
+
+func init() {
+	setupWebhookMessageDB()
+}
+
+func setupWebhookMessageDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	webhookMessageStore = NewWebhookMessageStore(conn, new(sync.Mutex))
+}
+
+func TestWebhookMessageDB_Add(t *testing.T) {
+	if err := webhookMessageStore.Add(&pb.WebhookMessage{
+		Id:      "abcde",
+		Webhook: "hook",
+		Body:    "{}",
+		Date:    ptypes.TimestampNow(),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	stmt, err := webhookMessageStore.PrepareQuery("select id from webhook_messages where id=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+
+	var id string
+	if err := stmt.QueryRow("abcde").Scan(&id); err != nil {
+		t.Error(err)
+		return
+	}
+	if id != "abcde" {
+		t.Errorf(`expected "abcde" got %s`, id)
+	}
+}
+
+func TestWebhookMessageDB_List(t *testing.T) {
+	setupWebhookMessageDB()
+	for i := 0; i < 3; i++ {
+		if err := webhookMessageStore.Add(&pb.WebhookMessage{
+			Id:      strconv.Itoa(i),
+			Webhook: "hook",
+			Body:    "{}",
+			Date:    util.ProtoTs(time.Now().Add(time.Minute * time.Duration(i)).UnixNano()),
+		}); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	list := webhookMessageStore.List("", 2)
+	if len(list) != 2 || list[0].Id != "0" {
+		t.Error("returned incorrect number of messages")
+		return
+	}
+	next := webhookMessageStore.List(list[1].Id, 2)
+	if len(next) != 1 || next[0].Id != "2" {
+		t.Error("returned incorrect offset messages")
+	}
+}
+
+func TestWebhookMessageDB_AddAttempt(t *testing.T) {
+	if err := webhookMessageStore.AddAttempt("0", time.Now()); err != nil {
+		t.Error(err)
+		return
+	}
+	list := webhookMessageStore.List("", 1)
+	if len(list) != 1 || list[0].Attempts != 1 {
+		t.Error("failed to add attempt")
+		return
+	}
+
+	// messages backing off are skipped
+	if err := webhookMessageStore.AddAttempt("0", time.Now().Add(time.Hour)); err != nil {
+		t.Error(err)
+		return
+	}
+	list = webhookMessageStore.List("", 1)
+	if len(list) != 1 || list[0].Id != "1" {
+		t.Error("failed to skip message backing off")
+	}
+}
+
+func TestWebhookMessageDB_Delete(t *testing.T) {
+	if err := webhookMessageStore.Delete("0"); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(webhookMessageStore.List("", -1)) != 2 {
+		t.Error("failed to delete message")
+	}
+}
+
+func TestWebhookMessageDB_DeleteByWebhook(t *testing.T) {
+	if err := webhookMessageStore.DeleteByWebhook("hook"); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(webhookMessageStore.List("", -1)) != 0 {
+		t.Error("failed to delete messages by webhook")
+	}
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor013 struct{}
+
+func (Minor013) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	query := `
+    create table webhook_messages (id text primary key not null, webhookId text not null, body text not null, date integer not null, attempts integer not null, nextAttempt integer not null default 0);
+    create index webhook_message_webhookId on webhook_messages (webhookId);
+    create index webhook_message_date on webhook_messages (date);
+    `
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f14, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f14.Close()
+	if _, err = f14.Write([]byte("14")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor013) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor013) Major() bool {
+	return false
+}
@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt012(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table cafe_tokens (id text primary key not null, token text not null, date integer not null);
+	`
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test013(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt012(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor013
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new tables
+	_, err = db.Exec("insert into webhook_messages(id, webhookId, body, date, attempts, nextAttempt) values(?,?,?,?,?,?)", "id", "webhookId", "{}", 0, 0, 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "14" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
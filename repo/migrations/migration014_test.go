@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt013(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table webhook_messages (id text primary key not null, webhookId text not null, body text not null, date integer not null, attempts integer not null);
+	`
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test014(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt013(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor014
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new tables
+	_, err = db.Exec("insert into cafe_client_pins(id, clientId, size, date) values(?,?,?,?)", "id", "clientId", 1024, 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "15" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt014(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table cafe_client_messages (id text not null, peerId text not null, clientId text not null, date integer not null, primary key (id, clientId));
+	`
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("insert into cafe_client_messages(id, peerId, clientId, date) values(?,?,?,?)", "id", "peerId", "clientId", 0)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test015(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt014(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor015
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new field
+	_, err = db.Exec("update cafe_client_messages set size=? where id=?", 1024, "id")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "16" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt015(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table cafe_client_messages (id text not null, peerId text not null, clientId text not null, date integer not null, size integer not null default 0, primary key (id, clientId));
+	`
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test016(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt015(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor016
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new tables
+	_, err = db.Exec("insert into cafe_replications(id, type, clientId, targetId, date, attempts, neighbor) values(?,?,?,?,?,?,?)", "id", 0, "clientId", "targetId", 0, 0, "neighbor")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "17" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt016(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table cafe_sessions (cafeId text primary key not null, access text not null, refresh text not null, expiry integer not null, cafe blob not null);
+	`
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("insert into cafe_sessions(cafeId, access, refresh, expiry, cafe) values(?,?,?,?,?)", "cafeId", "access", "refresh", 0, []byte("{}"))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test017(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt016(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor017
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new fields
+	_, err = db.Exec("update cafe_sessions set priority=?, health=? where cafeId=?", 1, []byte("{}"), "cafeId")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "18" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
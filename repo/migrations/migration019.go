@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor019 struct{}
+
+func (Minor019) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	query := `
+    alter table cafe_client_threads add column version integer not null default 1;
+    alter table cafe_client_threads add column date integer not null default 0;
+    create table cafe_client_thread_versions (id text not null, clientId text not null, ciphertext blob not null, version integer not null, date integer not null, primary key (id, clientId, version));
+    create index cafe_client_thread_version_clientId on cafe_client_thread_versions (clientId);
+    insert into cafe_client_thread_versions select id, clientId, ciphertext, version, date from cafe_client_threads;
+    `
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f20, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f20.Close()
+	if _, err = f20.Write([]byte("20")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor019) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor019) Major() bool {
+	return false
+}
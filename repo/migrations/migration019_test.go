@@ -0,0 +1,87 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt018(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table cafe_client_threads (id text not null, clientId text not null, ciphertext blob not null, primary key (id, clientId));
+	`
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("insert into cafe_client_threads(id, clientId, ciphertext) values(?,?,?)", "id", "clientId", []byte("ciphertext"))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test019(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt018(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor019
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new fields
+	_, err = db.Exec("update cafe_client_threads set version=?, date=? where id=?", 2, 1, "id")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// existing snapshots should be kept as the first version
+	var v int
+	row := db.QueryRow("select version from cafe_client_thread_versions where id=? and clientId=?", "id", "clientId")
+	if err := row.Scan(&v); err != nil {
+		t.Error(err)
+		return
+	}
+	if v != 1 {
+		t.Errorf("bad version: %d", v)
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "20" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
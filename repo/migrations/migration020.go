@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor020 struct{}
+
+func (Minor020) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	query := `
+    alter table cafe_tokens add column scopes text not null default '';
+    alter table cafe_tokens add column plan text not null default '';
+    alter table cafe_tokens add column expires integer not null default 0;
+    alter table cafe_tokens add column label text not null default '';
+    `
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f21, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f21.Close()
+	if _, err = f21.Write([]byte("21")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor020) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor020) Major() bool {
+	return false
+}
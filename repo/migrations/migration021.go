@@ -0,0 +1,90 @@
+package migrations
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor021 struct{}
+
+func (Minor021) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	query := `
+    create table api_keys (id text primary key not null, name text not null, scopes text not null, date integer not null, expires integer not null default 0);
+    `
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// require api keys for the local api
+	if err := enableApiAuth(repoPath); err != nil {
+		return err
+	}
+
+	// update version
+	f22, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f22.Close()
+	if _, err = f22.Write([]byte("22")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor021) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor021) Major() bool {
+	return false
+}
+
+// enableApiAuth turns on API.Auth in the textile config, if it exists
+func enableApiAuth(repoPath string) error {
+	configPath := path.Join(repoPath, "textile")
+	data, err := ioutil.ReadFile(configPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var conf map[string]interface{}
+	if err := json.Unmarshal(data, &conf); err != nil {
+		return err
+	}
+	api, ok := conf["API"].(map[string]interface{})
+	if !ok {
+		api = make(map[string]interface{})
+		conf["API"] = api
+	}
+	api["Auth"] = true
+
+	data, err = json.MarshalIndent(conf, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(configPath, data, 0644)
+}
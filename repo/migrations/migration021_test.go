@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func Test021(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	config := []byte(`{"API": {"SizeLimit": 0}, "IsServer": false}`)
+	if err := ioutil.WriteFile("./textile", config, 0644); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor021
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new table
+	_, err = db.Exec("insert into api_keys(id, name, scopes, date, expires) values(?,?,?,?,?)", "id", "name", "read,write", 0, 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test config
+	data, err := ioutil.ReadFile("./textile")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	var conf struct {
+		API struct {
+			Auth bool
+		}
+	}
+	if err := json.Unmarshal(data, &conf); err != nil {
+		t.Error(err)
+		return
+	}
+	if !conf.API.Auth {
+		t.Error("failed to enable api auth")
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "22" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+	os.RemoveAll("./textile")
+}
@@ -44,6 +44,7 @@ type logOptions struct {
 type addressOptions struct {
 	ApiBindAddr       string `short:"a" long:"api-bind-addr" description:"Set the local API address." default:"127.0.0.1:40600"`
 	CafeApiBindAddr   string `short:"c" long:"cafe-bind-addr" description:"Set the cafe REST API address." default:"0.0.0.0:40601"`
+	GrpcBindAddr      string `long:"grpc-bind-addr" description:"Set the local gRPC API address." default:"127.0.0.1:40602"`
 	GatewayBindAddr   string `short:"g" long:"gateway-bind-addr" description:"Set the IPFS gateway address." default:"127.0.0.1:5050"`
 	ProfilingBindAddr string `long:"profile-bind-addr" description:"Set the profiling address." default:"127.0.0.1:6060"`
 }
@@ -54,6 +55,14 @@ type cafeOptions struct {
 	NeighborURL string `long:"cafe-neighbor-url" description:"Specify the URL of a secondary cafe. Must return cafe info, e.g., via a Gateway: https://my-gateway.yolo.com/cafe, or a Cafe API: https://my-cafe.yolo.com"`
 }
 
+type tlsOptions struct {
+	Enabled   bool   `long:"tls" description:"Serve the cafe REST API and gateway over HTTPS. A self-signed certificate is generated unless one is given."`
+	CertFile  string `long:"tls-cert" description:"Path to a PEM certificate, relative to the repo." default:"tls/cert.pem"`
+	KeyFile   string `long:"tls-key" description:"Path to the certificate's PEM private key, relative to the repo." default:"tls/key.pem"`
+	ACME      bool   `long:"tls-acme" description:"Obtain and renew certificates for the cafe URL host from Let's Encrypt."`
+	ACMEEmail string `long:"tls-acme-email" description:"Contact email registered with Let's Encrypt."`
+}
+
 type options struct{}
 
 type walletCmd struct {
@@ -94,6 +103,7 @@ type initCmd struct {
 	RepoPath    string         `short:"r" long:"repo-dir" description:"Specify a custom repository path."`
 	Addresses   addressOptions `group:"Address Options"`
 	CafeOptions cafeOptions    `group:"Cafe Options"`
+	TLS         tlsOptions     `group:"TLS Options"`
 	IPFS        ipfsOptions    `group:"IPFS Options"`
 	Logs        logOptions     `group:"Log Options"`
 }
@@ -318,6 +328,7 @@ func (x *initCmd) Execute(args []string) error {
 		SwarmPorts:      x.IPFS.SwarmPorts,
 		ApiAddr:         x.Addresses.ApiBindAddr,
 		CafeApiAddr:     x.Addresses.CafeApiBindAddr,
+		GrpcAddr:        x.Addresses.GrpcBindAddr,
 		GatewayAddr:     x.Addresses.GatewayBindAddr,
 		ProfilingAddr:   x.Addresses.ProfilingBindAddr,
 		IsMobile:        false,
@@ -327,6 +338,11 @@ func (x *initCmd) Execute(args []string) error {
 		CafeOpen:        x.CafeOptions.Open,
 		CafeURL:         flagOtherwiseEnv("CAFE_HOST_URL", x.CafeOptions.URL),
 		CafeNeighborURL: flagOtherwiseEnv("CAFE_HOST_NEIGHBOR_URL", x.CafeOptions.NeighborURL),
+		TLS:             x.TLS.Enabled,
+		TLSCertFile:     x.TLS.CertFile,
+		TLSKeyFile:      x.TLS.KeyFile,
+		TLSACME:         x.TLS.ACME,
+		TLSACMEEmail:    x.TLS.ACMEEmail,
 	}
 
 	if err := core.InitRepo(config); err != nil {
@@ -518,8 +534,18 @@ func startNode(serveDocs bool) error {
 	}()
 
 	// start apis
+	if node.Config().API.Auth {
+		if err := ensureCredentials(); err != nil {
+			return fmt.Errorf("save api credentials failed: %s", err)
+		}
+	}
 	node.StartApi(node.Config().Addresses.API, serveDocs)
-	gateway.Host.Start(node.Config().Addresses.Gateway)
+	if node.Config().Addresses.GRPC != "" {
+		node.StartGrpcApi(node.Config().Addresses.GRPC)
+	}
+	if err := gateway.Host.Start(node.Config().Addresses.Gateway); err != nil {
+		return err
+	}
 
 	// start profiling api
 	go func() {
@@ -539,12 +565,26 @@ func startNode(serveDocs bool) error {
 	return nil
 }
 
+// Make sure the local credentials file holds an API key for this node,
+// so that the cmd client can use the API
+func ensureCredentials() error {
+	addr := node.Config().Addresses.API
+	key, err := node.EnsureApiKey(cmd.ReadCredential(addr))
+	if err != nil {
+		return err
+	}
+	return cmd.WriteCredential(addr, key)
+}
+
 // Stop the api, then the gateway, then the node, then if possible, the channels
 // If a former fails, do not continue with the latter
 func stopNode() error {
 	if err := node.StopApi(); err != nil {
 		return err
 	}
+	if err := node.StopGrpcApi(); err != nil {
+		return err
+	}
 	if err := gateway.Host.Stop(); err != nil {
 		return err
 	}
@@ -566,6 +606,9 @@ func printSplash() {
 	fmt.Println(cmd.Grey("Repo version: ") + cmd.Grey(repo.Repover))
 	fmt.Println(cmd.Grey("Repo path: ") + cmd.Grey(node.RepoPath()))
 	fmt.Println(cmd.Grey("API address: ") + cmd.Grey(node.ApiAddr()))
+	if node.GrpcApiAddr() != "" {
+		fmt.Println(cmd.Grey("gRPC API address: ") + cmd.Grey(node.GrpcApiAddr()))
+	}
 	fmt.Println(cmd.Grey("Gateway address: ") + cmd.Grey(gateway.Host.Addr()))
 	if node.CafeApiAddr() != "" {
 		fmt.Println(cmd.Grey("Cafe address: ") + cmd.Grey(node.CafeApiAddr()))